@@ -0,0 +1,245 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	encjson "encoding/json"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+)
+
+type avroChunker struct {
+	*jsonChunker
+	file *FileMapping
+
+	reader *goavro.OCFReader
+	schema *avroSchema
+	row    int
+}
+
+// NewAvroChunker returns a chunker for the Avro object container files with the given mapping.
+// The chunks are converted to JSON, so the chunker of any Avro file can parse them.
+func NewAvroChunker(file *FileMapping, batchSize int) Chunker {
+	return &avroChunker{
+		jsonChunker: &jsonChunker{nqs: NewNQuadBuffer(batchSize)},
+		file:        file,
+	}
+}
+
+// Chunk reads the records of the file until a size threshold is reached, or the end of file is
+// reached. The records read are returned as a JSON array of objects.
+func (ac *avroChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if ac.file == nil {
+		return nil, errors.New("Avro files can only be loaded with a mapping")
+	}
+	if ac.reader == nil {
+		var err error
+		if ac.reader, err = goavro.NewOCFReader(r); err != nil {
+			return nil, errors.Wrapf(err, "while reading Avro file")
+		}
+		if ac.schema, err = newAvroSchema(ac.reader.Codec().CanonicalSchema()); err != nil {
+			return nil, err
+		}
+	}
+
+	return chunkObjects(func() (map[string]interface{}, error) {
+		if !ac.reader.Scan() {
+			if err := ac.reader.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		ac.row++
+		rec, err := ac.reader.Read()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading record %d", ac.row)
+		}
+		row := make(map[string]interface{})
+		if err := ac.schema.flatten(ac.schema.root, rec, "", row); err != nil {
+			return nil, errors.Wrapf(err, "while reading record %d", ac.row)
+		}
+		obj, err := ac.file.toObject(row)
+		return obj, errors.Wrapf(err, "while reading record %d", ac.row)
+	})
+}
+
+// avroSchema holds the schema of an Avro file, in its canonical form where all the names are
+// full names.
+type avroSchema struct {
+	root interface{}
+	// named holds the named types by full name.
+	named map[string]map[string]interface{}
+}
+
+func newAvroSchema(canonical string) (*avroSchema, error) {
+	s := &avroSchema{named: make(map[string]map[string]interface{})}
+	if err := encjson.Unmarshal([]byte(canonical), &s.root); err != nil {
+		return nil, errors.Wrapf(err, "while parsing Avro schema")
+	}
+	var collect func(t interface{})
+	collect = func(t interface{}) {
+		switch t := t.(type) {
+		case []interface{}:
+			for _, member := range t {
+				collect(member)
+			}
+		case map[string]interface{}:
+			if name, ok := t["name"].(string); ok {
+				s.named[name] = t
+			}
+			if fields, ok := t["fields"].([]interface{}); ok {
+				for _, f := range fields {
+					if f, ok := f.(map[string]interface{}); ok {
+						collect(f["type"])
+					}
+				}
+			}
+			collect(t["items"])
+			collect(t["values"])
+		}
+	}
+	collect(s.root)
+	if s.kind(s.root) != "record" {
+		return nil, errors.New("Avro file doesn't hold records")
+	}
+	return s, nil
+}
+
+// resolve returns the definition of a type, looking up the named types.
+func (s *avroSchema) resolve(t interface{}) interface{} {
+	if name, ok := t.(string); ok {
+		if def, ok := s.named[name]; ok {
+			return def
+		}
+	}
+	return t
+}
+
+// kind returns the kind of a type, such as record, array or string.
+func (s *avroSchema) kind(t interface{}) string {
+	switch t := s.resolve(t).(type) {
+	case string:
+		return t
+	case []interface{}:
+		return "union"
+	case map[string]interface{}:
+		kind, _ := t["type"].(string)
+		return kind
+	}
+	return ""
+}
+
+// branchName returns the name under which goavro returns the values of a member of a union.
+func (s *avroSchema) branchName(t interface{}) string {
+	if def, ok := s.resolve(t).(map[string]interface{}); ok {
+		if name, ok := def["name"].(string); ok {
+			return name
+		}
+	}
+	return s.kind(t)
+}
+
+// flatten adds the values of a record to row, keyed by their path. The values of arrays of
+// records are gathered in lists for each field.
+func (s *avroSchema) flatten(t, v interface{}, prefix string, row map[string]interface{}) error {
+	if v == nil {
+		return nil
+	}
+	switch s.kind(t) {
+	case "union":
+		// The values of unions are returned as a map from the name of the branch to the value.
+		branches, ok := v.(map[string]interface{})
+		if !ok || len(branches) != 1 {
+			return errors.Errorf("invalid value of union %s", prefix)
+		}
+		for name, bv := range branches {
+			var member interface{}
+			for _, m := range s.resolve(t).([]interface{}) {
+				if s.branchName(m) == name {
+					member = m
+				}
+			}
+			return s.flatten(member, bv, prefix, row)
+		}
+	case "record":
+		rec, ok := v.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("invalid value of record %s", prefix)
+		}
+		fields, _ := s.resolve(t).(map[string]interface{})["fields"].([]interface{})
+		for _, f := range fields {
+			f, _ := f.(map[string]interface{})
+			name, _ := f["name"].(string)
+			path := name
+			if prefix != "" {
+				path = prefix + "." + name
+			}
+			if err := s.flatten(f["type"], rec[name], path, row); err != nil {
+				return err
+			}
+		}
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return errors.Errorf("invalid value of array %s", prefix)
+		}
+		itemType := s.resolve(t).(map[string]interface{})["items"]
+		for _, item := range items {
+			elem := make(map[string]interface{})
+			if err := s.flatten(itemType, item, prefix, elem); err != nil {
+				return err
+			}
+			for name, ev := range elem {
+				list, _ := row[name].([]interface{})
+				if evs, ok := ev.([]interface{}); ok {
+					list = append(list, evs...)
+				} else {
+					list = append(list, ev)
+				}
+				row[name] = list
+			}
+		}
+	case "map":
+		return errors.Errorf("field %s is a map, which isn't supported", prefix)
+	default:
+		row[prefix] = avroValue(v)
+	}
+	return nil
+}
+
+// avroValue returns the value of a primitive Avro value, as read by goavro.
+func avroValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case *big.Rat:
+		f, _ := v.Float64()
+		return f
+	case time.Duration:
+		return v.String()
+	default:
+		return v
+	}
+}
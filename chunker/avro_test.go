@@ -0,0 +1,106 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const testAvroSchema = `{"type": "record", "name": "Person", "namespace": "test", "fields": [
+	{"name": "id", "type": "long"},
+	{"name": "name", "type": "string"},
+	{"name": "age", "type": ["null", "int"]},
+	{"name": "score", "type": "float"},
+	{"name": "address", "type": ["null", {"type": "record", "name": "Address", "fields": [
+		{"name": "city", "type": "string"}]}]},
+	{"name": "phones", "type": {"type": "array", "items": {"type": "record", "name": "Phone",
+		"fields": [{"name": "number", "type": "string"}]}}},
+	{"name": "tags", "type": {"type": "array", "items": "string"}},
+	{"name": "employer", "type": ["null", "long"]}
+]}`
+
+func writeTestAvro(t *testing.T, schema string, records ...map[string]interface{}) *bufio.Reader {
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: schema})
+	require.NoError(t, err)
+	require.NoError(t, w.Append(records))
+	return bufio.NewReader(&buf)
+}
+
+func TestAvroChunk(t *testing.T) {
+	m, err := readTestMapping(t, `{"files": [
+	  {"file": "people.avro", "type": "Person", "xid": "id",
+	   "columns": {
+	     "name": {"predicate": "name"},
+	     "age": {"predicate": "age"},
+	     "score": {"predicate": "score"},
+	     "address.city": {"predicate": "city"},
+	     "phones.number": {"predicate": "phone"},
+	     "tags": {"predicate": "tag"},
+	     "employer": {"predicate": "works_for", "ref": "Company"}}}
+	]}`)
+	require.NoError(t, err)
+	f, err := m.File("people.avro.gz")
+	require.NoError(t, err)
+
+	r := writeTestAvro(t, testAvroSchema,
+		map[string]interface{}{
+			"id": 1, "name": "Alice", "age": goavro.Union("int", 26), "score": 1.5,
+			"address": goavro.Union("test.Address", map[string]interface{}{"city": "Paris"}),
+			"phones": []interface{}{
+				map[string]interface{}{"number": "1"}, map[string]interface{}{"number": "2"}},
+			"tags":     []interface{}{"a"},
+			"employer": goavro.Union("long", 10),
+		},
+		map[string]interface{}{
+			"id": 2, "name": "Bob", "age": nil, "score": 0, "address": nil,
+			"phones": []interface{}{}, "tags": []interface{}{}, "employer": nil,
+		})
+	chunkBuf, err := NewAvroChunker(f, 1000).Chunk(r)
+	require.Equal(t, io.EOF, err)
+	require.JSONEq(t, `[
+		{"uid": "_:Person.1", "dgraph.type": "Person", "name": "Alice", "age": 26,
+		 "score": 1.5, "city": "Paris", "phone": ["1", "2"], "tag": ["a"],
+		 "works_for": {"uid": "_:Company.10"}},
+		{"uid": "_:Person.2", "dgraph.type": "Person", "name": "Bob", "score": 0}
+	]`, chunkBuf.String())
+
+	// The chunks can be parsed by any Avro chunker.
+	parser := NewChunker(AvroFormat, 1000)
+	require.NoError(t, parser.Parse(chunkBuf))
+	_, err = parser.Chunk(r)
+	require.Error(t, err)
+
+	// Maps can't be loaded.
+	r = writeTestAvro(t, `{"type": "record", "name": "A", "fields": [
+		{"name": "id", "type": "long"},
+		{"name": "m", "type": {"type": "map", "values": "string"}}]}`,
+		map[string]interface{}{"id": 1, "m": map[string]interface{}{"a": "b"}})
+	f.Columns["m"] = &ColumnMapping{Predicate: "m"}
+	_, err = NewAvroChunker(f, 1000).Chunk(r)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "record 1")
+
+	_, err = NewAvroChunker(f, 1000).Chunk(bufioReader("not avro"))
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	encjson "encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -60,6 +61,11 @@ func (jc *jsonChunker) NQuads() *NQuadBuffer {
 	return jc.nqs
 }
 
+// jsonLinesChunker reads one JSON object per line, and parses the chunks like a jsonChunker.
+type jsonLinesChunker struct {
+	*jsonChunker
+}
+
 // InputFormat represents the multiple formats supported by Chunker.
 type InputFormat byte
 
@@ -70,6 +76,18 @@ const (
 	RdfFormat
 	// JsonFormat is a constant to denote the input to the live/bulk loader is in the JSON format.
 	JsonFormat
+	// CsvFormat is a constant to denote the input to the live/bulk loader is in the CSV format,
+	// with the columns mapped to predicates by a CSVMapping.
+	CsvFormat
+	// JsonLinesFormat is a constant to denote the input to the live/bulk loader is in the JSON
+	// Lines format, with one JSON object per line.
+	JsonLinesFormat
+	// ParquetFormat is a constant to denote the input to the bulk loader is in the Parquet
+	// format, with the columns mapped to predicates by a Mapping.
+	ParquetFormat
+	// AvroFormat is a constant to denote the input to the live/bulk loader is in the Avro object
+	// container format, with the fields mapped to predicates by a Mapping.
+	AvroFormat
 )
 
 // NewChunker returns a new chunker for the specified format.
@@ -84,6 +102,20 @@ func NewChunker(inputFormat InputFormat, batchSize int) Chunker {
 		return &jsonChunker{
 			nqs: NewNQuadBuffer(batchSize),
 		}
+	case JsonLinesFormat:
+		return &jsonLinesChunker{
+			jsonChunker: &jsonChunker{nqs: NewNQuadBuffer(batchSize)},
+		}
+	case CsvFormat:
+		// Chunks of CSV files are converted to JSON, so they can be parsed without the mapping.
+		// Use NewCSVChunker to read the files.
+		return NewCSVChunker(nil, batchSize)
+	case ParquetFormat:
+		// Use NewParquetChunker to read the files.
+		return NewParquetChunker(nil, nil, 0, batchSize)
+	case AvroFormat:
+		// Use NewAvroChunker to read the files.
+		return NewAvroChunker(nil, batchSize)
 	default:
 		x.Panic(errors.New("unknown input format"))
 		return nil
@@ -136,12 +168,38 @@ func (*rdfChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
 	return batch, nil
 }
 
+// LineError is the error of a line of an RDF chunk, or of an object of a JSON chunk, which can't
+// be parsed.
+type LineError struct {
+	// Line holds the line, or the object on a single line.
+	Line string
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("while parsing line %q: %v", e.Line, e.Err)
+}
+
+// LineErrors holds the errors of the lines of an RDF chunk, or of the objects of a JSON chunk,
+// which can't be parsed. The other lines or objects of the chunk are parsed all the same.
+type LineErrors []*LineError
+
+func (errs LineErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
 // Parse is not thread-safe. Only call it serially, because it reuses lexer object.
+// The lines which can't be parsed are returned as LineErrors.
 func (rc *rdfChunker) Parse(chunkBuf *bytes.Buffer) error {
 	if chunkBuf == nil || chunkBuf.Len() == 0 {
 		return nil
 	}
 
+	var errs LineErrors
 	for chunkBuf.Len() > 0 {
 		str, err := chunkBuf.ReadString('\n')
 		if err != nil && err != io.EOF {
@@ -153,11 +211,14 @@ func (rc *rdfChunker) Parse(chunkBuf *bytes.Buffer) error {
 		case err == ErrEmpty:
 			continue // blank line or comment
 		case err != nil:
-			return errors.Wrapf(err, "while parsing line %q", str)
+			errs = append(errs, &LineError{Line: strings.TrimRight(str, "\n"), Err: err})
 		default:
 			rc.nqs.Push(&nq)
 		}
 	}
+	if len(errs) > 0 {
+		return errs
+	}
 	return nil
 }
 
@@ -245,6 +306,48 @@ func (jc *jsonChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
 	return out, nil
 }
 
+// Chunk reads lines until a size threshold is reached, or the end of file is reached. The
+// objects on the lines read are returned as a JSON array. Blank lines, and comment lines which
+// start with #, are skipped.
+func (jc *jsonLinesChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	out := new(bytes.Buffer)
+	if _, err := out.WriteRune('['); err != nil {
+		return nil, err
+	}
+	hasMapsBefore := false
+	for out.Len() < 1e5 {
+		line, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 && line[0] != '#' {
+			if line[0] != '{' || line[len(line)-1] != '}' {
+				return nil, errors.Errorf("JSON Lines file has a line which isn't an object: %q",
+					line)
+			}
+			if hasMapsBefore {
+				if _, err := out.WriteRune(','); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := out.Write(line); err != nil {
+				return nil, err
+			}
+			hasMapsBefore = true
+		}
+		if err == io.EOF {
+			if _, err := out.WriteRune(']'); err != nil {
+				return nil, err
+			}
+			return out, io.EOF
+		}
+	}
+	if _, err := out.WriteRune(']'); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // consumeMap consumes the next map from the reader, and stores the result into the buffer out.
 // After ignoring spaces, if the reader does not begin with {, no rune will be consumed
 // from the reader.
@@ -300,12 +403,86 @@ func (*jsonChunker) nextRune(r *bufio.Reader) (rune, error) {
 	return ch, nil
 }
 
+// Parse parses the objects of a chunk. The objects which can't be parsed are returned as
+// LineErrors.
 func (jc *jsonChunker) Parse(chunkBuf *bytes.Buffer) error {
 	if chunkBuf == nil || chunkBuf.Len() == 0 {
 		return nil
 	}
 
-	return jc.nqs.ParseJSON(chunkBuf.Bytes(), SetNquads)
+	err := jc.parseObjects(chunkBuf.Bytes())
+	if err == nil {
+		return nil
+	}
+	// Parse the objects of the chunk on their own, to find the ones which can't be parsed.
+	objs, serr := splitObjects(chunkBuf.Bytes())
+	if serr != nil {
+		return err
+	}
+	var errs LineErrors
+	for _, obj := range objs {
+		if err := jc.parseObjects(obj); err != nil {
+			errs = append(errs, &LineError{Line: singleLine(obj), Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// parseObjects parses a JSON object or array of objects, and only pushes their N-Quads once they
+// are all parsed.
+func (jc *jsonChunker) parseObjects(b []byte) error {
+	buf := NewNQuadBuffer(-1)
+	if err := buf.ParseJSON(b, SetNquads); err != nil {
+		return err
+	}
+	jc.nqs.Push(buf.nquads...)
+	for pred, hint := range buf.predHints {
+		jc.nqs.PushPredHint(pred, hint)
+	}
+	return nil
+}
+
+// splitObjects returns the objects of a JSON chunk, which is an array of objects.
+func splitObjects(chunk []byte) ([][]byte, error) {
+	jc := &jsonChunker{}
+	r := bufio.NewReader(bytes.NewReader(chunk))
+	if ch, err := jc.nextRune(r); err != nil || ch != '[' {
+		return nil, errors.New("JSON chunk isn't an array")
+	}
+	var objs [][]byte
+	for {
+		obj := new(bytes.Buffer)
+		if err := jc.consumeMap(r, obj); err != nil {
+			return nil, err
+		}
+		if obj.Len() > 0 {
+			objs = append(objs, obj.Bytes())
+		}
+		ch, err := jc.nextRune(r)
+		if err != nil {
+			return nil, err
+		}
+		switch ch {
+		case ']':
+			return objs, nil
+		case ',':
+		default:
+			return nil, errors.Errorf("JSON map is followed by illegal rune \"%c\"", ch)
+		}
+	}
+}
+
+// singleLine returns a JSON object on a single line. Newlines in JSON are either spaces, or make
+// it invalid anyway.
+func singleLine(obj []byte) string {
+	var b bytes.Buffer
+	if err := encjson.Compact(&b, obj); err == nil {
+		return b.String()
+	}
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(string(obj))
 }
 
 func slurpSpace(r *bufio.Reader) error {
@@ -402,8 +579,9 @@ func IsJSONData(r *bufio.Reader) (bool, error) {
 	return err == nil, nil
 }
 
-// DataFormat returns a file's data format (RDF, JSON, or unknown) based on the filename
-// or the user-provided format option. The file extension has precedence.
+// DataFormat returns a file's data format (RDF, JSON, CSV, JSON Lines, Parquet, Avro, or
+// unknown) based on the filename or the user-provided format option. The file extension has
+// precedence.
 func DataFormat(filename string, format string) InputFormat {
 	format = strings.ToLower(format)
 	filename = strings.TrimSuffix(strings.ToLower(filename), ".gz")
@@ -412,6 +590,15 @@ func DataFormat(filename string, format string) InputFormat {
 		return RdfFormat
 	case strings.HasSuffix(filename, ".json") || format == "json":
 		return JsonFormat
+	case strings.HasSuffix(filename, ".csv") || format == "csv":
+		return CsvFormat
+	case strings.HasSuffix(filename, ".jsonl") || strings.HasSuffix(filename, ".ndjson") ||
+		format == "jsonl":
+		return JsonLinesFormat
+	case strings.HasSuffix(filename, ".parquet") || format == "parquet":
+		return ParquetFormat
+	case strings.HasSuffix(filename, ".avro") || format == "avro":
+		return AvroFormat
 	default:
 		return UnknownFormat
 	}
@@ -225,3 +225,99 @@ func TestJSONLoadSuccessAll(t *testing.T) {
 	}
 	require.Equal(t, io.EOF, err, "end reading JSON document")
 }
+
+func TestJSONLinesChunk(t *testing.T) {
+	doc := "{\"name\": \"alice\"}\n\n  {\"name\": \"bob\", \"age\": 26}  \n# comment\n" +
+		"{\"name\": \"carol\"}"
+	chunker := NewChunker(JsonLinesFormat, 1000)
+	chunkBuf, err := chunker.Chunk(bufioReader(doc))
+	require.Equal(t, io.EOF, err)
+	require.Equal(t,
+		`[{"name": "alice"},{"name": "bob", "age": 26},{"name": "carol"}]`, chunkBuf.String())
+
+	require.NoError(t, chunker.Parse(chunkBuf))
+	nqs := chunker.NQuads()
+	nqs.Flush()
+	var count int
+	for batch := range nqs.Ch() {
+		count += len(batch)
+	}
+	require.Equal(t, 4, count)
+
+	_, err = NewChunker(JsonLinesFormat, 1000).Chunk(bufioReader("{\"name\": \"alice\"}\n[]\n"))
+	require.Error(t, err)
+	require.NotEqual(t, io.EOF, err)
+}
+
+func TestRDFLineErrors(t *testing.T) {
+	doc := "_:a <name> \"alice\" .\n_:b <name> bob .\n_:c <name> \"carol\" .\n_:d <name .\n"
+	chunker := NewChunker(RdfFormat, 1000)
+	chunkBuf, err := chunker.Chunk(bufioReader(doc))
+	require.Equal(t, io.EOF, err)
+
+	err = chunker.Parse(chunkBuf)
+	errs, ok := err.(LineErrors)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+	require.Equal(t, "_:b <name> bob .", errs[0].Line)
+	require.Equal(t, "_:d <name .", errs[1].Line)
+
+	// The lines without errors are parsed all the same.
+	nqs := chunker.NQuads()
+	nqs.Flush()
+	var count int
+	for batch := range nqs.Ch() {
+		count += len(batch)
+	}
+	require.Equal(t, 2, count)
+}
+
+func TestJSONLineErrors(t *testing.T) {
+	doc := `[{"name": "alice"},
+{
+  "name": "bob"
+  "age": 26
+},
+{"name": "carol", "age": 30}]`
+	chunker := NewChunker(JsonFormat, 1000)
+	chunkBuf, err := chunker.Chunk(bufioReader(doc))
+	require.Equal(t, io.EOF, err)
+
+	err = chunker.Parse(chunkBuf)
+	errs, ok := err.(LineErrors)
+	require.True(t, ok)
+	require.Len(t, errs, 1)
+	require.Equal(t, `{"name":"bob""age":26}`, errs[0].Line)
+
+	// The objects without errors are parsed all the same.
+	nqs := chunker.NQuads()
+	nqs.Flush()
+	var count int
+	for batch := range nqs.Ch() {
+		count += len(batch)
+	}
+	require.Equal(t, 3, count)
+}
+
+func TestDataFormat(t *testing.T) {
+	tests := []struct {
+		file   string
+		format string
+		out    InputFormat
+	}{
+		{"data.rdf.gz", "", RdfFormat},
+		{"data.json", "", JsonFormat},
+		{"people.CSV", "", CsvFormat},
+		{"data.jsonl.gz", "", JsonLinesFormat},
+		{"data.ndjson", "", JsonLinesFormat},
+		{"data.txt", "jsonl", JsonLinesFormat},
+		{"data.txt", "csv", CsvFormat},
+		{"data.parquet", "", ParquetFormat},
+		{"data.avro.gz", "", AvroFormat},
+		{"data.txt", "avro", AvroFormat},
+		{"data.txt", "", UnknownFormat},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.out, DataFormat(tc.file, tc.format), tc.file)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+type csvChunker struct {
+	*jsonChunker
+	file *FileMapping
+
+	reader *csv.Reader
+	row    int
+	// names holds the names of the columns of the file.
+	names []string
+}
+
+// NewCSVChunker returns a chunker for the CSV files with the given mapping. The chunks are
+// converted to JSON, so the chunker of any CSV file can parse them.
+func NewCSVChunker(file *FileMapping, batchSize int) Chunker {
+	return &csvChunker{
+		jsonChunker: &jsonChunker{nqs: NewNQuadBuffer(batchSize)},
+		file:        file,
+	}
+}
+
+func (cc *csvChunker) readHeader() error {
+	header, err := cc.reader.Read()
+	if err != nil {
+		return err
+	}
+	cc.row++
+	if len(header) > 0 {
+		// Skip the byte order mark which some tools write at the start of the file.
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	cc.names = make([]string, len(header))
+	for i, name := range header {
+		cc.names[i] = strings.TrimSpace(name)
+	}
+	for _, name := range cc.file.columns() {
+		if !containsString(cc.names, name) {
+			return errors.Errorf("CSV file has no column %s", name)
+		}
+	}
+	return nil
+}
+
+// Chunk reads the rows of the file until a size threshold is reached, or the end of file is
+// reached. The rows read are returned as a JSON array of objects.
+func (cc *csvChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if cc.file == nil {
+		return nil, errors.New("CSV files can only be loaded with a mapping")
+	}
+	if cc.reader == nil {
+		cc.reader = csv.NewReader(r)
+		if cc.file.Delimiter != "" {
+			cc.reader.Comma, _ = utf8.DecodeRuneInString(cc.file.Delimiter)
+		}
+		cc.reader.ReuseRecord = true
+		if err := cc.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := make(map[string]interface{}, len(cc.names))
+	return chunkObjects(func() (map[string]interface{}, error) {
+		record, err := cc.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		cc.row++
+		for i, name := range cc.names {
+			row[name] = record[i]
+		}
+		obj, err := cc.file.toObject(row)
+		return obj, errors.Wrapf(err, "while reading row %d", cc.row)
+	})
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMapping = `{"files": [
+  {"file": "people*.csv", "type": "Person", "xid": "id",
+   "columns": {
+     "name": {"predicate": "name"},
+     "name_fr": {"predicate": "name", "lang": "fr"},
+     "age": {"predicate": "age", "type": "int"},
+     "tags": {"predicate": "tag", "separator": ";"},
+     "employer": {"predicate": "works_for", "ref": "Company"}}},
+  {"file": "companies.tsv", "type": "Company", "xid": "id", "delimiter": "\t",
+   "columns": {"name": {"predicate": "name"}}}
+]}`
+
+func readTestMapping(t *testing.T, mapping string) (*Mapping, error) {
+	dir, err := ioutil.TempDir("", "mapping")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "mapping.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(mapping), 0644))
+	return ReadMapping(file)
+}
+
+func TestCSVMapping(t *testing.T) {
+	m, err := readTestMapping(t, testMapping)
+	require.NoError(t, err)
+
+	f, err := m.File("/data/people-1.csv.gz")
+	require.NoError(t, err)
+	require.Equal(t, "Person", f.Type)
+	f, err = m.File("companies.tsv")
+	require.NoError(t, err)
+	require.Equal(t, "Company", f.Type)
+	_, err = m.File("films.csv")
+	require.Error(t, err)
+
+	for _, mapping := range []string{
+		`{"files": [{"file": "a.csv"}]}`,
+		`{"files": [{"file": "a.csv", "columns": {"a": {}}}]}`,
+		`{"files": [{"file": "a.csv", "columns": {"a": {"predicate": "a", "type": "uid"}}}]}`,
+		`{"files": [{"file": "a.csv", "delimiter": ";;", "columns": {"a": {"predicate": "a"}}}]}`,
+		`{"files": [{"file": "a.csv", "columns": {"a": {"predicate": "a", "ref": "A",
+			"type": "int"}}}]}`,
+		`{"files": [{"file": "[a.csv", "columns": {"a": {"predicate": "a"}}}]}`,
+	} {
+		_, err := readTestMapping(t, mapping)
+		require.Error(t, err, mapping)
+	}
+}
+
+func TestCSVChunk(t *testing.T) {
+	m, err := readTestMapping(t, testMapping)
+	require.NoError(t, err)
+	f, err := m.File("people.csv")
+	require.NoError(t, err)
+
+	doc := "\ufeffid,name,name_fr,age,tags,employer,ignored\n" +
+		"1,Alice,,26,a; b,10,x\n" +
+		"2,\"Bob, Jr.\",Robert,,,,y\n"
+	chunker := NewCSVChunker(f, 1000)
+	chunkBuf, err := chunker.Chunk(bufioReader(doc))
+	require.Equal(t, io.EOF, err)
+	require.JSONEq(t, `[
+		{"uid": "_:Person.1", "dgraph.type": "Person", "name": "Alice", "age": 26,
+		 "tag": ["a", "b"], "works_for": {"uid": "_:Company.10"}},
+		{"uid": "_:Person.2", "dgraph.type": "Person", "name": "Bob, Jr.",
+		 "name@fr": "Robert"}
+	]`, chunkBuf.String())
+
+	// The chunks can be parsed by any CSV chunker.
+	parser := NewChunker(CsvFormat, 1000)
+	require.NoError(t, parser.Parse(chunkBuf))
+	nqs := parser.NQuads()
+	nqs.Flush()
+	var preds []string
+	for batch := range nqs.Ch() {
+		for _, nq := range batch {
+			preds = append(preds, nq.Predicate)
+		}
+	}
+	sort.Strings(preds)
+	require.Equal(t, []string{"age", "dgraph.type", "dgraph.type", "name", "name", "name",
+		"tag", "tag", "works_for"}, preds)
+
+	_, err = NewCSVChunker(f, 1000).Chunk(bufioReader("id,name\n1,Alice\n"))
+	require.Error(t, err)
+	_, err = NewCSVChunker(f, 1000).Chunk(bufioReader(
+		"id,name,name_fr,age,tags,employer\n1,Alice,,old,,\n"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "row 2")
+	_, err = parser.Chunk(bufioReader(doc))
+	require.Error(t, err)
+}
+
+func TestCSVChunkDelimiter(t *testing.T) {
+	m, err := readTestMapping(t, testMapping)
+	require.NoError(t, err)
+	f, err := m.File("companies.tsv")
+	require.NoError(t, err)
+
+	chunkBuf, err := NewCSVChunker(f, 1000).Chunk(bufioReader("id\tname\n10\tDgraph, Inc.\n"))
+	require.Equal(t, io.EOF, err)
+	require.JSONEq(t, `[{"uid": "_:Company.10", "dgraph.type": "Company",
+		"name": "Dgraph, Inc."}]`, chunkBuf.String())
+}
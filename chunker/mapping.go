@@ -0,0 +1,347 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bytes"
+	encjson "encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Mapping describes how the rows of tabular files (CSV, Parquet and Avro files) are loaded.
+// It is read from a JSON file like
+//
+//	{"files": [
+//	  {"file": "people*.csv", "type": "Person", "xid": "id",
+//	   "columns": {
+//	     "name": {"predicate": "name"},
+//	     "age": {"predicate": "age", "type": "int"},
+//	     "employer_id": {"predicate": "works_for", "ref": "Company"}}},
+//	  {"file": "companies.csv", "type": "Company", "xid": "id",
+//	   "columns": {"name": {"predicate": "name"}}}
+//	]}
+//
+// Each row of a file becomes a node. Only the columns in the mapping are loaded.
+type Mapping struct {
+	Files []*FileMapping `json:"files"`
+}
+
+// FileMapping maps the columns of the files whose names match Pattern.
+type FileMapping struct {
+	// Pattern is matched against the base name of the files, without the .gz extension.
+	Pattern string `json:"file"`
+	// Type is set as the dgraph.type of the nodes, if it isn't empty.
+	Type string `json:"type"`
+	// Xid is the column which holds the external ID of the nodes. Nodes with the same type
+	// and external ID are the same node, even across files. If Xid is empty, a new node is
+	// created for each row.
+	Xid string `json:"xid"`
+	// Delimiter separates the fields of CSV files. It is a comma by default.
+	Delimiter string `json:"delimiter"`
+	// Columns maps the column names to predicates. The columns of Parquet and Avro files are
+	// the top-level fields of the records. Nested fields are named by their path, such as
+	// address.city.
+	Columns map[string]*ColumnMapping `json:"columns"`
+}
+
+// ColumnMapping describes the values of a column.
+type ColumnMapping struct {
+	Predicate string `json:"predicate"`
+	// Type is the type of the values, one of string (the default), int, float, bool, datetime
+	// and geo. Geo values must be written in GeoJSON. The values of Parquet and Avro files keep
+	// the type they have in the file, unless they are strings.
+	Type string `json:"type"`
+	// Ref makes the column an edge to the node of the type Ref with the external ID in the
+	// column.
+	Ref string `json:"ref"`
+	// Separator splits the string values of the column into a list of values, if it isn't
+	// empty.
+	Separator string `json:"separator"`
+	// Lang is the language of the values.
+	Lang string `json:"lang"`
+}
+
+// ReadMapping reads and validates the mapping in the given file.
+func ReadMapping(file string) (*Mapping, error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading mapping file %s", file)
+	}
+	var m Mapping
+	if err := encjson.Unmarshal(buf, &m); err != nil {
+		return nil, errors.Wrapf(err, "while parsing mapping file %s", file)
+	}
+	if err := m.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid mapping file %s", file)
+	}
+	return &m, nil
+}
+
+func (m *Mapping) validate() error {
+	for _, f := range m.Files {
+		if f.Pattern == "" {
+			return errors.New("a file has no file name pattern")
+		}
+		if _, err := filepath.Match(f.Pattern, ""); err != nil {
+			return errors.Wrapf(err, "file %s", f.Pattern)
+		}
+		if f.Delimiter != "" && utf8.RuneCountInString(f.Delimiter) != 1 {
+			return errors.Errorf("file %s: delimiter %q isn't a single character",
+				f.Pattern, f.Delimiter)
+		}
+		if len(f.Columns) == 0 {
+			return errors.Errorf("file %s has no columns", f.Pattern)
+		}
+		for name, col := range f.Columns {
+			if col == nil || col.Predicate == "" {
+				return errors.Errorf("file %s: column %s has no predicate", f.Pattern, name)
+			}
+			switch col.Type {
+			case "", "string", "int", "float", "bool", "datetime", "geo":
+			default:
+				return errors.Errorf("file %s: column %s has unknown type %s",
+					f.Pattern, name, col.Type)
+			}
+			if col.Ref != "" && (col.Type != "" || col.Lang != "") {
+				return errors.Errorf("file %s: column %s references nodes, and can't have a "+
+					"type or a language", f.Pattern, name)
+			}
+		}
+	}
+	return nil
+}
+
+// File returns the mapping of the given file. The first file whose pattern matches is used.
+func (m *Mapping) File(filename string) (*FileMapping, error) {
+	base := strings.TrimSuffix(filepath.Base(filename), ".gz")
+	for _, f := range m.Files {
+		// The patterns have been validated, so Match can't fail.
+		if ok, _ := filepath.Match(f.Pattern, base); ok {
+			return f, nil
+		}
+	}
+	return nil, errors.Errorf("no mapping for file %s", filename)
+}
+
+// columns returns the names of the columns which are read, starting with the xid column.
+func (f *FileMapping) columns() []string {
+	var cols []string
+	if f.Xid != "" {
+		cols = append(cols, f.Xid)
+	}
+	for name := range f.Columns {
+		if name != f.Xid {
+			cols = append(cols, name)
+		}
+	}
+	return cols
+}
+
+// xidNode returns the blank node for the external ID xid of a node of type typ.
+func xidNode(typ, xid string) string {
+	if typ == "" {
+		return "_:" + xid
+	}
+	return "_:" + typ + "." + xid
+}
+
+// toObject returns the JSON object of a row, given the values of its columns. The values are
+// strings for CSV files, and can be of any type read from Parquet and Avro files.
+func (f *FileMapping) toObject(row map[string]interface{}) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	if f.Xid != "" {
+		xid := strings.TrimSpace(scalarString(row[f.Xid]))
+		if xid == "" {
+			return nil, errors.Errorf("empty xid column %s", f.Xid)
+		}
+		obj["uid"] = xidNode(f.Type, xid)
+	}
+	if f.Type != "" {
+		obj["dgraph.type"] = f.Type
+	}
+	for name, col := range f.Columns {
+		vals, list, err := columnValues(col, row[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "column %s", name)
+		}
+		if len(vals) == 0 {
+			continue
+		}
+
+		pred := col.Predicate
+		if col.Lang != "" {
+			pred += "@" + col.Lang
+		}
+		// Columns mapped to the same predicate are merged into a list.
+		switch prev := obj[pred].(type) {
+		case nil:
+		case []interface{}:
+			vals, list = append(prev, vals...), true
+		default:
+			vals, list = append([]interface{}{prev}, vals...), true
+		}
+		if list || len(vals) > 1 {
+			obj[pred] = vals
+		} else {
+			obj[pred] = vals[0]
+		}
+	}
+	return obj, nil
+}
+
+// columnValues returns the values of a column, and whether they make a list.
+func columnValues(col *ColumnMapping, v interface{}) ([]interface{}, bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false, nil
+	case []interface{}:
+		var vals []interface{}
+		for _, elem := range v {
+			elemVals, _, err := columnValues(col, elem)
+			if err != nil {
+				return nil, false, err
+			}
+			vals = append(vals, elemVals...)
+		}
+		return vals, true, nil
+	case map[string]interface{}:
+		return nil, false, errors.New("maps and records can't be loaded")
+	case string:
+		if v == "" {
+			return nil, false, nil
+		}
+		cells := []string{v}
+		if col.Separator != "" {
+			cells = strings.Split(v, col.Separator)
+		}
+		var vals []interface{}
+		for _, cell := range cells {
+			if col.Separator != "" || col.Ref != "" {
+				if cell = strings.TrimSpace(cell); cell == "" {
+					continue
+				}
+			}
+			val, err := stringValue(col, cell)
+			if err != nil {
+				return nil, false, err
+			}
+			vals = append(vals, val)
+		}
+		return vals, col.Separator != "", nil
+	default:
+		if col.Ref != "" {
+			return []interface{}{map[string]string{"uid": xidNode(col.Ref, scalarString(v))}},
+				false, nil
+		}
+		switch v := v.(type) {
+		case time.Time:
+			return []interface{}{v.Format(time.RFC3339Nano)}, false, nil
+		case []byte:
+			return columnValues(col, string(v))
+		}
+		if col.Type == "string" {
+			return []interface{}{scalarString(v)}, false, nil
+		}
+		return []interface{}{v}, false, nil
+	}
+}
+
+func stringValue(col *ColumnMapping, cell string) (interface{}, error) {
+	if col.Ref != "" {
+		return map[string]string{"uid": xidNode(col.Ref, cell)}, nil
+	}
+	switch col.Type {
+	case "int":
+		v, err := strconv.ParseInt(strings.TrimSpace(cell), 10, 64)
+		return v, errors.Wrapf(err, "invalid int %q", cell)
+	case "float":
+		v, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+		return v, errors.Wrapf(err, "invalid float %q", cell)
+	case "bool":
+		v, err := strconv.ParseBool(strings.TrimSpace(cell))
+		return v, errors.Wrapf(err, "invalid bool %q", cell)
+	case "geo":
+		if !encjson.Valid([]byte(cell)) {
+			return nil, errors.Errorf("invalid GeoJSON %q", cell)
+		}
+		return encjson.RawMessage(cell), nil
+	default:
+		return cell, nil
+	}
+}
+
+// scalarString returns the string form of a value read from a file.
+func scalarString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// chunkObjects calls next until a size threshold is reached, or next returns io.EOF, and
+// returns the objects returned by next as a JSON array.
+func chunkObjects(next func() (map[string]interface{}, error)) (*bytes.Buffer, error) {
+	out := new(bytes.Buffer)
+	if _, err := out.WriteRune('['); err != nil {
+		return nil, err
+	}
+	for out.Len() < 1e5 {
+		obj, err := next()
+		if err == io.EOF {
+			if _, err := out.WriteRune(']'); err != nil {
+				return nil, err
+			}
+			return out, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		if out.Len() > 1 {
+			if _, err := out.WriteRune(','); err != nil {
+				return nil, err
+			}
+		}
+		buf, err := encjson.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := out.Write(buf); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := out.WriteRune(']'); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
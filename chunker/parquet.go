@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/dgraph-io/dgraph/chunker/parquet"
+	"github.com/pkg/errors"
+)
+
+type parquetChunker struct {
+	*jsonChunker
+	file *FileMapping
+
+	pf       *parquet.File
+	rowGroup int
+	rows     []map[string]interface{}
+	row      int
+}
+
+// NewParquetChunker returns a chunker for a row group of a Parquet file, with the given
+// mapping. As Parquet files can't be read as a stream, the rows are read from pf, and the reader
+// passed to Chunk is not used. The chunks are converted to JSON, so the chunker of any Parquet
+// file can parse them.
+func NewParquetChunker(file *FileMapping, pf *parquet.File, rowGroup, batchSize int) Chunker {
+	return &parquetChunker{
+		jsonChunker: &jsonChunker{nqs: NewNQuadBuffer(batchSize)},
+		file:        file,
+		pf:          pf,
+		rowGroup:    rowGroup,
+	}
+}
+
+// Chunk reads the rows of the row group until a size threshold is reached, or all the rows
+// have been read. The rows read are returned as a JSON array of objects.
+func (pc *parquetChunker) Chunk(_ *bufio.Reader) (*bytes.Buffer, error) {
+	if pc.file == nil || pc.pf == nil {
+		return nil, errors.New("Parquet files can only be loaded with a mapping")
+	}
+	if pc.rows == nil {
+		rows, err := pc.pf.ReadRowGroup(pc.rowGroup, pc.file.columns())
+		if err != nil {
+			return nil, err
+		}
+		pc.rows = rows
+	}
+
+	return chunkObjects(func() (map[string]interface{}, error) {
+		if pc.row >= len(pc.rows) {
+			return nil, io.EOF
+		}
+		row := pc.rows[pc.row]
+		// Let the rows which have been read be garbage collected.
+		pc.rows[pc.row] = nil
+		pc.row++
+		obj, err := pc.file.toObject(row)
+		return obj, errors.Wrapf(err, "while reading row %d of row group %d", pc.row, pc.rowGroup)
+	})
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+var errCorrupt = errors.New("corrupted Parquet page")
+
+func decompress(codec int64, data []byte, size int64) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(make([]byte, 0, size), data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	default:
+		return nil, errors.Errorf("unsupported Parquet compression codec %s, only UNCOMPRESSED, "+
+			"SNAPPY and GZIP are supported", enumName(codecNames, codec))
+	}
+}
+
+// decodeRLE decodes count values of the RLE/bit-packing hybrid encoding.
+func decodeRLE(data []byte, bitWidth, count int) ([]int32, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, errCorrupt
+	}
+	out := make([]int32, 0, count)
+	byteWidth := (bitWidth + 7) / 8
+	for len(out) < count {
+		header, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errCorrupt
+		}
+		data = data[n:]
+		if header&1 == 0 {
+			// A run of the same value.
+			run := int(header >> 1)
+			if len(data) < byteWidth || run > count-len(out) {
+				return nil, errCorrupt
+			}
+			var v int32
+			for i := 0; i < byteWidth; i++ {
+				v |= int32(data[i]) << (8 * uint(i))
+			}
+			data = data[byteWidth:]
+			for i := 0; i < run; i++ {
+				out = append(out, v)
+			}
+			continue
+		}
+		// Groups of 8 bit-packed values, from the least significant bit.
+		groups := int(header >> 1)
+		if groups*bitWidth > len(data) {
+			return nil, errCorrupt
+		}
+		var bit uint
+		for i := 0; i < groups*8; i++ {
+			var v int32
+			for b := 0; b < bitWidth; b++ {
+				if data[bit/8]&(1<<(bit%8)) != 0 {
+					v |= 1 << uint(b)
+				}
+				bit++
+			}
+			// The last group is padded.
+			if len(out) < count {
+				out = append(out, v)
+			}
+		}
+		data = data[groups*bitWidth:]
+	}
+	return out, nil
+}
+
+// decodePlain decodes count values of the PLAIN encoding of the type of the column.
+func (c *column) decodePlain(data []byte, count int) ([]interface{}, error) {
+	out := make([]interface{}, 0, count)
+	fixed := func(size int) error {
+		if size <= 0 || len(data) < size*count {
+			return errCorrupt
+		}
+		return nil
+	}
+	switch c.leaf.typ {
+	case typeBoolean:
+		if len(data)*8 < count {
+			return nil, errCorrupt
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, data[i/8]&(1<<uint(i%8)) != 0)
+		}
+	case typeInt32:
+		if err := fixed(4); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, c.convertInt(int64(int32(binary.LittleEndian.Uint32(data[4*i:])))))
+		}
+	case typeInt64:
+		if err := fixed(8); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, c.convertInt(int64(binary.LittleEndian.Uint64(data[8*i:]))))
+		}
+	case typeInt96:
+		if err := fixed(12); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, int96Time(data[12*i:]))
+		}
+	case typeFloat:
+		if err := fixed(4); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, float64(math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))))
+		}
+	case typeDouble:
+		if err := fixed(8); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:])))
+		}
+	case typeByteArray:
+		for i := 0; i < count; i++ {
+			if len(data) < 4 {
+				return nil, errCorrupt
+			}
+			size := binary.LittleEndian.Uint32(data)
+			if uint64(size) > uint64(len(data)-4) {
+				return nil, errCorrupt
+			}
+			out = append(out, c.convertBytes(data[4:4+size]))
+			data = data[4+size:]
+		}
+	case typeFixedLenByteArray:
+		size := int(c.leaf.typeLength)
+		if err := fixed(size); err != nil {
+			return nil, err
+		}
+		for i := 0; i < count; i++ {
+			out = append(out, c.convertBytes(data[size*i:size*(i+1)]))
+		}
+	default:
+		return nil, errors.Errorf("unknown Parquet type %d", c.leaf.typ)
+	}
+	return out, nil
+}
+
+func (c *column) scale() int64 {
+	if d := c.leaf.logical.strct(logicalDecimal); d != nil {
+		return d.int(1)
+	}
+	return c.leaf.scale
+}
+
+func (c *column) isDecimal() bool {
+	return c.leaf.converted == convDecimal || c.leaf.logical.has(logicalDecimal)
+}
+
+// convertInt returns the value of an INT32 or INT64 value, given its logical type.
+func (c *column) convertInt(v int64) interface{} {
+	leaf := c.leaf
+	unit := int16(0)
+	switch {
+	case leaf.converted == convDate || leaf.logical.has(logicalDate):
+		return time.Unix(v*24*3600, 0).UTC()
+	case c.isDecimal():
+		return float64(v) / math.Pow10(int(c.scale()))
+	case leaf.converted == convTimestampMillis:
+		unit = timeUnitMillis
+	case leaf.converted == convTimestampMicros:
+		unit = timeUnitMicros
+	case leaf.logical.has(logicalTimestamp):
+		for _, u := range []int16{timeUnitMillis, timeUnitMicros, timeUnitNanos} {
+			if leaf.logical.strct(logicalTimestamp).strct(2).has(u) {
+				unit = u
+			}
+		}
+	}
+	switch unit {
+	case timeUnitMillis:
+		return time.Unix(v/1e3, v%1e3*1e6).UTC()
+	case timeUnitMicros:
+		return time.Unix(v/1e6, v%1e6*1e3).UTC()
+	case timeUnitNanos:
+		return time.Unix(0, v).UTC()
+	}
+	return v
+}
+
+// convertBytes returns the value of a BYTE_ARRAY or FIXED_LEN_BYTE_ARRAY value, given its
+// logical type.
+func (c *column) convertBytes(b []byte) interface{} {
+	switch {
+	case c.isDecimal():
+		// Decimals are stored as big-endian two's complement integers.
+		v := new(big.Int).SetBytes(b)
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+		}
+		f, _ := new(big.Float).Quo(new(big.Float).SetInt(v),
+			new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(c.scale()), nil))).
+			Float64()
+		return f
+	case c.leaf.logical.has(logicalUUID) && len(b) == 16:
+		s := hex.EncodeToString(b)
+		return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+	default:
+		return string(b)
+	}
+}
+
+// int96Time returns the time of an INT96 timestamp, written as the nanoseconds within the day
+// followed by the Julian day.
+func int96Time(b []byte) time.Time {
+	const unixEpochJulianDay = 2440588
+	nanos := int64(binary.LittleEndian.Uint64(b))
+	day := int64(binary.LittleEndian.Uint32(b[8:]))
+	return time.Unix((day-unixEpochJulianDay)*24*3600, nanos).UTC()
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Physical types.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Repetition types.
+const (
+	repRequired = 0
+	repOptional = 1
+	repRepeated = 2
+)
+
+// Converted types, the legacy logical types.
+const (
+	convUTF8            = 0
+	convMap             = 1
+	convMapKeyValue     = 2
+	convList            = 3
+	convEnum            = 4
+	convDecimal         = 5
+	convDate            = 6
+	convTimestampMillis = 9
+	convTimestampMicros = 10
+	convJSON            = 19
+)
+
+// Fields of the logical type union.
+const (
+	logicalString    = 1
+	logicalMap       = 2
+	logicalList      = 3
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalJSON      = 12
+	logicalUUID      = 14
+)
+
+// Compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+)
+
+// codecNames are the names of the compression codecs, to report those which aren't supported.
+var codecNames = []string{"UNCOMPRESSED", "SNAPPY", "GZIP", "LZO", "BROTLI", "LZ4", "ZSTD",
+	"LZ4_RAW"}
+
+// Page types.
+const (
+	pageData       = 0
+	pageIndex      = 1
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// Encodings.
+const (
+	encPlain         = 0
+	encPlainDict     = 2
+	encRLE           = 3
+	encRLEDictionary = 8
+)
+
+// encodingNames are the names of the encodings, to report those which aren't supported.
+var encodingNames = []string{"PLAIN", "GROUP_VAR_INT", "PLAIN_DICTIONARY", "RLE", "BIT_PACKED",
+	"DELTA_BINARY_PACKED", "DELTA_LENGTH_BYTE_ARRAY", "DELTA_BYTE_ARRAY", "RLE_DICTIONARY",
+	"BYTE_STREAM_SPLIT"}
+
+// enumName returns the name of the value of an enum, or its number if it's unknown.
+func enumName(names []string, v int64) string {
+	if v >= 0 && v < int64(len(names)) {
+		return names[v]
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+// Fields of the time unit union.
+const (
+	timeUnitMillis = 1
+	timeUnitMicros = 2
+	timeUnitNanos  = 3
+)
+
+// schemaNode is a node of the schema tree of a file.
+type schemaNode struct {
+	name       string
+	typ        int64
+	typeLength int64
+	repetition int64
+	converted  int64
+	logical    tStruct
+	scale      int64
+	children   []*schemaNode
+}
+
+func (n *schemaNode) isList() bool {
+	return n.converted == convList || n.logical.has(logicalList)
+}
+
+func (n *schemaNode) isMap() bool {
+	return n.converted == convMap || n.converted == convMapKeyValue || n.logical.has(logicalMap)
+}
+
+func joinName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// column describes a leaf of the schema tree.
+type column struct {
+	// name is the name of the column, the path of the leaf without the levels which make up
+	// lists.
+	name string
+	// path is the path of the leaf, as written in the column chunks.
+	path    string
+	leaf    *schemaNode
+	maxDef  int
+	maxRep  int
+	invalid error
+}
+
+// newSchema returns the columns of the flattened schema. The first element is the root.
+func newSchema(elems []interface{}) ([]*column, error) {
+	nodes := make([]*schemaNode, 0, len(elems))
+	numChildren := make([]int64, 0, len(elems))
+	for _, e := range elems {
+		s, ok := e.(tStruct)
+		if !ok {
+			return nil, errThrift
+		}
+		n := &schemaNode{
+			name:       s.string(4),
+			typ:        s.int(1),
+			typeLength: s.int(2),
+			repetition: s.int(3),
+			converted:  -1,
+			logical:    s.strct(10),
+			scale:      s.int(7),
+		}
+		if s.has(6) {
+			n.converted = s.int(6)
+		}
+		nodes = append(nodes, n)
+		numChildren = append(numChildren, s.int(5))
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("Parquet file has no schema")
+	}
+
+	// Rebuild the tree from the depth-first list of nodes.
+	pos := 1
+	var build func(n *schemaNode, count int64) error
+	build = func(n *schemaNode, count int64) error {
+		for i := int64(0); i < count; i++ {
+			if pos >= len(nodes) {
+				return errors.New("Parquet file has an invalid schema")
+			}
+			child, childCount := nodes[pos], numChildren[pos]
+			pos++
+			n.children = append(n.children, child)
+			if err := build(child, childCount); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := build(nodes[0], numChildren[0]); err != nil {
+		return nil, err
+	}
+
+	var cols []*column
+	var walk func(n, parent *schemaNode, path []string, name string, def, rep int,
+		inList bool, invalid error)
+	walk = func(n, parent *schemaNode, path []string, name string, def, rep int,
+		inList bool, invalid error) {
+		path = append(path[:len(path):len(path)], n.name)
+		if n.repetition != repRequired {
+			def++
+		}
+		if n.repetition == repRepeated {
+			rep++
+		}
+		// The levels which make up a list are left out of the name of the column, but the
+		// fields of the records in a list are named after the list, such as items.price.
+		if !inList || (len(n.children) == 0 && len(parent.children) > 1) {
+			name = joinName(name, n.name)
+		}
+		if n.isMap() && invalid == nil {
+			invalid = errors.Errorf("column %s is a map, which isn't supported", name)
+		}
+		if len(n.children) == 0 {
+			if rep > 1 && invalid == nil {
+				invalid = errors.Errorf("column %s has nested lists, which aren't supported", name)
+			}
+			cols = append(cols, &column{
+				name:    name,
+				path:    strings.Join(path, "."),
+				leaf:    n,
+				maxDef:  def,
+				maxRep:  rep,
+				invalid: invalid,
+			})
+			return
+		}
+		for _, child := range n.children {
+			walk(child, n, path, name, def, rep, inList || n.isList(), invalid)
+		}
+	}
+	for _, child := range nodes[0].children {
+		walk(child, nodes[0], nil, "", 0, 0, false, nil)
+	}
+	return cols, nil
+}
@@ -0,0 +1,413 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package parquet reads the rows of Parquet files.
+//
+// Only the parts of the format which are needed to load data into Dgraph are supported, which
+// are the defaults of most writers. Files using anything else are rejected with an error rather
+// than decoded:
+//
+//   - Columns can be of any physical type. The STRING, ENUM, JSON, UUID, DECIMAL, DATE and
+//     TIMESTAMP logical types are converted, and the values of other logical types are read as
+//     their physical values.
+//   - Columns can be required, optional or repeated, and be nested in records and lists,
+//     including lists of records and the legacy repeated fields. Maps and lists of lists aren't
+//     supported.
+//   - Data pages of both versions and dictionary pages are read, and index pages are skipped.
+//   - Values can have the PLAIN, PLAIN_DICTIONARY and RLE_DICTIONARY encodings, and levels the
+//     RLE encoding. The BIT_PACKED, DELTA_BINARY_PACKED, DELTA_LENGTH_BYTE_ARRAY,
+//     DELTA_BYTE_ARRAY, BYTE_STREAM_SPLIT and RLE value encodings aren't supported.
+//   - Pages can be UNCOMPRESSED, or compressed with SNAPPY or GZIP. The LZO, BROTLI, LZ4, ZSTD
+//     and LZ4_RAW codecs aren't supported.
+//   - Encrypted files aren't supported.
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+	"math/bits"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	magic = "PAR1"
+	// maxFooterSize limits the size of the metadata read from a file.
+	maxFooterSize = 64 << 20
+)
+
+// File is a Parquet file open for reading.
+type File struct {
+	r         io.ReaderAt
+	size      int64
+	cols      []*column
+	rowGroups []tStruct
+}
+
+// Open reads the metadata of the Parquet file in r, which is size bytes long.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	// The file starts with the magic number, and ends with the metadata, its length and the
+	// magic number again.
+	tail := make([]byte, 8)
+	if size < int64(len(magic)+len(tail)) {
+		return nil, errors.New("file is too small to be a Parquet file")
+	}
+	if _, err := r.ReadAt(tail, size-8); err != nil {
+		return nil, errors.Wrapf(err, "while reading Parquet footer")
+	}
+	if string(tail[4:]) == "PARE" {
+		return nil, errors.New("encrypted Parquet files aren't supported")
+	}
+	if string(tail[4:]) != magic {
+		return nil, errors.New("file is not a Parquet file")
+	}
+	footerSize := int64(binary.LittleEndian.Uint32(tail))
+	if footerSize > maxFooterSize || footerSize > size-8-int64(len(magic)) {
+		return nil, errors.New("Parquet file has an invalid footer")
+	}
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-8-footerSize); err != nil {
+		return nil, errors.Wrapf(err, "while reading Parquet footer")
+	}
+
+	// FileMetaData has the schema in field 2, and the row groups in field 4.
+	d := &thriftDecoder{buf: footer}
+	meta, err := d.strct()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading Parquet metadata")
+	}
+	cols, err := newSchema(meta.list(2))
+	if err != nil {
+		return nil, err
+	}
+	f := &File{r: r, size: size, cols: cols}
+	for _, rg := range meta.list(4) {
+		s, ok := rg.(tStruct)
+		if !ok {
+			return nil, errThrift
+		}
+		f.rowGroups = append(f.rowGroups, s)
+	}
+	return f, nil
+}
+
+// Columns returns the names of the columns of the file.
+func (f *File) Columns() []string {
+	var names []string
+	for _, c := range f.cols {
+		if len(names) == 0 || names[len(names)-1] != c.name {
+			names = append(names, c.name)
+		}
+	}
+	return names
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (f *File) NumRowGroups() int {
+	return len(f.rowGroups)
+}
+
+func (f *File) column(name string) (*column, error) {
+	var col *column
+	for _, c := range f.cols {
+		if c.name != name {
+			continue
+		}
+		if col != nil {
+			return nil, errors.Errorf("Parquet file has more than one column named %s", name)
+		}
+		col = c
+	}
+	if col == nil {
+		return nil, errors.Errorf("Parquet file has no column %s", name)
+	}
+	return col, col.invalid
+}
+
+// ReadRowGroup returns the rows of the ith row group, with the values of the given columns.
+// The values of a row are keyed by the name of their column. Null values are left out, and
+// the values of lists are returned as []interface{}. Row groups can be read concurrently.
+func (f *File) ReadRowGroup(i int, columns []string) ([]map[string]interface{}, error) {
+	if i < 0 || i >= len(f.rowGroups) {
+		return nil, errors.Errorf("Parquet file has no row group %d", i)
+	}
+	// RowGroup has the column chunks in field 1, and the number of rows in field 3.
+	rg := f.rowGroups[i]
+	numRows := rg.int(3)
+	if numRows < 0 || numRows > f.size {
+		return nil, errThrift
+	}
+	rows := make([]map[string]interface{}, numRows)
+	for i := range rows {
+		rows[i] = make(map[string]interface{}, len(columns))
+	}
+
+	for _, name := range columns {
+		col, err := f.column(name)
+		if err != nil {
+			return nil, err
+		}
+		// ColumnChunk has its ColumnMetaData in field 3, which has the path of the column in
+		// field 3.
+		var chunk tStruct
+		for _, c := range rg.list(1) {
+			c, _ := c.(tStruct)
+			var path []string
+			for _, p := range c.strct(3).list(3) {
+				p, _ := p.([]byte)
+				path = append(path, string(p))
+			}
+			if strings.Join(path, ".") == col.path {
+				chunk = c.strct(3)
+				break
+			}
+		}
+		if chunk == nil {
+			return nil, errors.Errorf("Parquet row group %d has no column %s", i, name)
+		}
+		vals, err := f.readChunk(col, chunk, int(numRows))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading column %s of row group %d", name, i)
+		}
+		for r, v := range vals {
+			if v != nil {
+				rows[r][name] = v
+			}
+		}
+	}
+	return rows, nil
+}
+
+// readChunk returns the values of the column in each row of the column chunk.
+func (f *File) readChunk(col *column, meta tStruct, numRows int) ([]interface{}, error) {
+	// ColumnMetaData has the codec in field 4, the compressed size in field 7, and the offsets
+	// of the first data page and of the dictionary page in fields 9 and 11.
+	codec := meta.int(4)
+	start, length := meta.int(9), meta.int(7)
+	if dict := meta.int(11); meta.has(11) && dict > 0 && dict < start {
+		start = dict
+	}
+	if start < 0 || length < 0 || start+length > f.size {
+		return nil, errThrift
+	}
+	buf := make([]byte, length)
+	if _, err := f.r.ReadAt(buf, start); err != nil {
+		return nil, err
+	}
+
+	a := &assembler{col: col, out: make([]interface{}, 0, numRows)}
+	d := &thriftDecoder{buf: buf}
+	for d.pos < len(buf) {
+		// PageHeader has the type in field 1, the uncompressed and compressed sizes in fields
+		// 2 and 3, and the header of each type of page in fields 5, 7 and 8.
+		header, err := d.strct()
+		if err != nil {
+			return nil, err
+		}
+		size := header.int(3)
+		if size < 0 || size > int64(len(buf)-d.pos) {
+			return nil, errCorrupt
+		}
+		page := buf[d.pos : d.pos+int(size)]
+		d.pos += int(size)
+
+		switch typ := header.int(1); typ {
+		case pageDictionary:
+			// DictionaryPageHeader has the number of values in field 1, and the encoding in
+			// field 2.
+			dict := header.strct(7)
+			if enc := dict.int(2); enc != encPlain && enc != encPlainDict {
+				return nil, errors.Errorf("unsupported Parquet dictionary encoding %s",
+					enumName(encodingNames, enc))
+			}
+			data, err := decompress(codec, page, header.int(2))
+			if err != nil {
+				return nil, err
+			}
+			if a.dict, err = col.decodePlain(data, int(dict.int(1))); err != nil {
+				return nil, err
+			}
+		case pageData:
+			data, err := decompress(codec, page, header.int(2))
+			if err != nil {
+				return nil, err
+			}
+			if err := a.readPage(header.strct(5), data); err != nil {
+				return nil, err
+			}
+		case pageDataV2:
+			if err := a.readPageV2(header.strct(8), page, codec, header.int(2)); err != nil {
+				return nil, err
+			}
+		case pageIndex:
+			// Index pages don't hold values.
+		default:
+			return nil, errors.Errorf("unknown Parquet page type %d", typ)
+		}
+	}
+	if len(a.out) != numRows {
+		return nil, errors.Errorf("column chunk has %d rows instead of %d", len(a.out), numRows)
+	}
+	return a.out, nil
+}
+
+// assembler puts together the values of the rows from the pages of a column chunk.
+type assembler struct {
+	col  *column
+	dict []interface{}
+	out  []interface{}
+}
+
+// readPage reads a data page. DataPageHeader has the number of values in field 1, the encoding
+// in field 2, and the encodings of the definition and repetition levels in fields 3 and 4.
+func (a *assembler) readPage(header tStruct, data []byte) error {
+	count := int(header.int(1))
+	// The levels are prefixed by their length.
+	levels := func(max int, encoding int64) ([]int32, error) {
+		if max == 0 {
+			return nil, nil
+		}
+		if encoding != encRLE {
+			return nil, errors.Errorf("unsupported Parquet level encoding %s",
+				enumName(encodingNames, encoding))
+		}
+		if len(data) < 4 {
+			return nil, errCorrupt
+		}
+		size := binary.LittleEndian.Uint32(data)
+		if uint64(size) > uint64(len(data)-4) {
+			return nil, errCorrupt
+		}
+		l, err := decodeRLE(data[4:4+size], bits.Len(uint(max)), count)
+		data = data[4+size:]
+		return l, err
+	}
+	rep, err := levels(a.col.maxRep, header.int(4))
+	if err != nil {
+		return err
+	}
+	def, err := levels(a.col.maxDef, header.int(3))
+	if err != nil {
+		return err
+	}
+	return a.add(count, rep, def, header.int(2), data)
+}
+
+// readPageV2 reads a data page of the second version. DataPageHeaderV2 has the number of values
+// in field 1, the encoding in field 4, the lengths of the definition and repetition levels in
+// fields 5 and 6, and whether the values are compressed in field 7.
+func (a *assembler) readPageV2(header tStruct, page []byte, codec, size int64) error {
+	count := int(header.int(1))
+	defLen, repLen := header.int(5), header.int(6)
+	if defLen < 0 || repLen < 0 || defLen+repLen > int64(len(page)) {
+		return errCorrupt
+	}
+	// The levels aren't written if their max is zero, like for required columns.
+	levels := func(data []byte, max int) ([]int32, error) {
+		if max == 0 {
+			return nil, nil
+		}
+		return decodeRLE(data, bits.Len(uint(max)), count)
+	}
+	rep, err := levels(page[:repLen], a.col.maxRep)
+	if err != nil {
+		return err
+	}
+	def, err := levels(page[repLen:repLen+defLen], a.col.maxDef)
+	if err != nil {
+		return err
+	}
+	data := page[repLen+defLen:]
+	if compressed, ok := header.bool(7); compressed || !ok {
+		if data, err = decompress(codec, data, size-repLen-defLen); err != nil {
+			return err
+		}
+	}
+	return a.add(count, rep, def, header.int(4), data)
+}
+
+// add adds the values of a page, given their repetition and definition levels.
+func (a *assembler) add(count int, rep, def []int32, encoding int64, data []byte) error {
+	level := func(levels []int32, i int, max int) int {
+		if max == 0 {
+			return max
+		}
+		return int(levels[i])
+	}
+	nonNull := count
+	if a.col.maxDef > 0 {
+		nonNull = 0
+		for _, d := range def {
+			if int(d) == a.col.maxDef {
+				nonNull++
+			}
+		}
+	}
+
+	var vals []interface{}
+	switch encoding {
+	case encPlain:
+		var err error
+		if vals, err = a.col.decodePlain(data, nonNull); err != nil {
+			return err
+		}
+	case encPlainDict, encRLEDictionary:
+		if len(data) == 0 && nonNull > 0 {
+			return errCorrupt
+		}
+		var idx []int32
+		if nonNull > 0 {
+			var err error
+			if idx, err = decodeRLE(data[1:], int(data[0]), nonNull); err != nil {
+				return err
+			}
+		}
+		vals = make([]interface{}, 0, nonNull)
+		for _, i := range idx {
+			if i < 0 || int(i) >= len(a.dict) {
+				return errCorrupt
+			}
+			vals = append(vals, a.dict[i])
+		}
+	default:
+		return errors.Errorf("unsupported Parquet encoding %s, only PLAIN, PLAIN_DICTIONARY and "+
+			"RLE_DICTIONARY are supported", enumName(encodingNames, encoding))
+	}
+
+	for i := 0; i < count; i++ {
+		var v interface{}
+		if level(def, i, a.col.maxDef) == a.col.maxDef {
+			v, vals = vals[0], vals[1:]
+		}
+		if a.col.maxRep == 0 {
+			a.out = append(a.out, v)
+			continue
+		}
+		if level(rep, i, a.col.maxRep) == 0 {
+			// The first value of a row.
+			a.out = append(a.out, nil)
+		} else if len(a.out) == 0 {
+			return errCorrupt
+		}
+		// Nulls in lists are left out, as lists can't hold them.
+		if v != nil {
+			list, _ := a.out[len(a.out)-1].([]interface{})
+			a.out[len(a.out)-1] = append(list, v)
+		}
+	}
+	return nil
+}
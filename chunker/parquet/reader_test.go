@@ -0,0 +1,421 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func openTestFile(t *testing.T, name string) *File {
+	buf, err := ioutil.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	f, err := Open(bytes.NewReader(buf), int64(len(buf)))
+	require.NoError(t, err)
+	return f
+}
+
+// The test files have the rows below in two row groups, and are compressed with Snappy and
+// gzip. The name column is dictionary encoded.
+func TestReadRowGroup(t *testing.T) {
+	expected := [][]map[string]interface{}{
+		{
+			{
+				"id": int64(1), "name": "Alice", "age": int64(26),
+				"tags": []interface{}{"a", "b"}, "score": 1.5, "active": true,
+				"born": time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "employer": int64(10),
+				"price": 19.99, "address.city": "Paris",
+			},
+			{
+				"id": int64(2), "name": "Bob", "score": float64(-2), "active": false,
+				"born": time.Unix(0, 0).UTC(), "price": float64(0),
+			},
+		},
+		{
+			{
+				"id": int64(3), "name": "Alice", "age": int64(40), "tags": []interface{}{"c"},
+				"score": 0.25, "active": true, "born": time.Unix(-1, 0).UTC(),
+				"employer": int64(10), "price": float64(0),
+			},
+		},
+	}
+	for _, name := range []string{"people.parquet", "people_gzip.parquet"} {
+		f := openTestFile(t, name)
+		require.Equal(t, []string{"id", "name", "age", "tags", "score", "active", "born",
+			"employer", "price", "address.city"}, f.Columns())
+		require.Equal(t, 2, f.NumRowGroups())
+		for i := 0; i < f.NumRowGroups(); i++ {
+			rows, err := f.ReadRowGroup(i, f.Columns())
+			require.NoError(t, err)
+			require.Equal(t, expected[i], rows, "%s, row group %d", name, i)
+		}
+
+		// Only the given columns are read.
+		rows, err := f.ReadRowGroup(1, []string{"name"})
+		require.NoError(t, err)
+		require.Equal(t, []map[string]interface{}{{"name": "Alice"}}, rows)
+
+		_, err = f.ReadRowGroup(0, []string{"city"})
+		require.Error(t, err)
+		_, err = f.ReadRowGroup(2, []string{"name"})
+		require.Error(t, err)
+	}
+}
+
+func TestOpenInvalid(t *testing.T) {
+	buf, err := ioutil.ReadFile("testdata/people.parquet")
+	require.NoError(t, err)
+	for _, b := range [][]byte{
+		nil,
+		[]byte("PAR1PAR1"),
+		buf[:len(buf)-1],
+		append(append([]byte{}, buf[:len(buf)-8]...), 0xff, 0xff, 0xff, 0x7f, 'P', 'A', 'R', '1'),
+	} {
+		_, err := Open(bytes.NewReader(b), int64(len(b)))
+		require.Error(t, err)
+	}
+}
+
+func TestDecodeRLE(t *testing.T) {
+	// A run of 3 ones, and a bit-packed group of 1, 0, 1, padded to 8 values.
+	vals, err := decodeRLE([]byte{3 << 1, 1, 1<<1 | 1, 0x05}, 1, 6)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 1, 1, 1, 0, 1}, vals)
+
+	_, err = decodeRLE([]byte{5 << 1, 1}, 1, 3)
+	require.Error(t, err)
+	_, err = decodeRLE([]byte{1<<1 | 1}, 1, 8)
+	require.Error(t, err)
+}
+
+// testChunk is a column chunk of a file built by the tests.
+type testChunk struct {
+	path  []string
+	codec int32
+	pages [][]byte
+}
+
+// testFile returns a Parquet file with the given schema elements and one row group.
+func testFile(schema []fields, numRows int64, chunks ...testChunk) []byte {
+	buf := []byte(magic)
+	var cols []fields
+	for _, c := range chunks {
+		start := int64(len(buf))
+		for _, p := range c.pages {
+			buf = append(buf, p...)
+		}
+		// ColumnMetaData has the path in field 3, the codec in field 4, the compressed size in
+		// field 7 and the offset of the first page in field 9.
+		cols = append(cols, fields{{2, start}, {3, fields{
+			{3, c.path}, {4, c.codec}, {7, int64(len(buf)) - start}, {9, start}}}})
+	}
+	meta := thriftEncode(fields{
+		{1, int32(1)},
+		{2, schema},
+		{3, numRows},
+		{4, []fields{{{1, cols}, {2, int64(0)}, {3, numRows}}}},
+	})
+	buf = append(buf, meta...)
+	size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(size, uint32(len(meta)))
+	buf = append(buf, size...)
+	return append(buf, magic...)
+}
+
+func openBytes(t *testing.T, buf []byte) *File {
+	f, err := Open(bytes.NewReader(buf), int64(len(buf)))
+	require.NoError(t, err)
+	return f
+}
+
+// group returns a schema element for a group with n children, and the given repetition and
+// converted type, or -1 for none.
+func group(name string, repetition, n, converted int32) fields {
+	g := fields{{3, repetition}, {4, name}, {5, n}}
+	if converted >= 0 {
+		g = append(g, field{6, converted})
+	}
+	return g
+}
+
+// leaf returns a schema element for a column of the given type.
+func leaf(name string, typ, repetition, converted int32) fields {
+	l := fields{{1, typ}, {3, repetition}, {4, name}}
+	if converted >= 0 {
+		l = append(l, field{6, converted})
+	}
+	return l
+}
+
+// root returns the root element of a schema with n children.
+func root(n int32) fields {
+	return fields{{4, "schema"}, {5, n}}
+}
+
+// page returns a page with the given header fields and body.
+func page(typ int32, header field, body []byte, size int) []byte {
+	return append(thriftEncode(fields{
+		{1, typ}, {2, int32(size)}, {3, int32(len(body))}, header}), body...)
+}
+
+// dataPage returns a data page of the first version, with the levels encoded with RLE.
+func dataPage(count, encoding int32, body []byte) []byte {
+	return page(pageData, field{5, fields{{1, count}, {2, encoding}, {3, int32(encRLE)},
+		{4, int32(encRLE)}}}, body, len(body))
+}
+
+func dictPage(count int32, body []byte) []byte {
+	return page(pageDictionary, field{7, fields{{1, count}, {2, int32(encPlain)}}}, body,
+		len(body))
+}
+
+// dataPageV2 returns a data page of the second version, with uncompressed values.
+func dataPageV2(count, encoding int32, rep, def, values []byte) []byte {
+	body := append(append(append([]byte{}, rep...), def...), values...)
+	return page(pageDataV2, field{8, fields{{1, count}, {2, int32(0)}, {3, count},
+		{4, encoding}, {5, int32(len(def))}, {6, int32(len(rep))}, {7, false}}}, body, len(body))
+}
+
+// rle returns the values bit-packed with the RLE/bit-packing hybrid encoding.
+func rle(bitWidth int, vals ...int32) []byte {
+	groups := (len(vals) + 7) / 8
+	out := []byte{byte(groups<<1 | 1)}
+	packed := make([]byte, groups*bitWidth)
+	for i, v := range vals {
+		for b := 0; b < bitWidth; b++ {
+			if v&(1<<uint(b)) != 0 {
+				bit := i*bitWidth + b
+				packed[bit/8] |= 1 << uint(bit%8)
+			}
+		}
+	}
+	return append(out, packed...)
+}
+
+// levels returns levels encoded as in data pages of the first version, prefixed by their length.
+func levels(bitWidth int, vals ...int32) []byte {
+	l := rle(bitWidth, vals...)
+	size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(size, uint32(len(l)))
+	return append(size, l...)
+}
+
+func plainStrings(vals ...string) []byte {
+	var out []byte
+	for _, v := range vals {
+		size := make([]byte, 4)
+		binary.LittleEndian.PutUint32(size, uint32(len(v)))
+		out = append(append(out, size...), v...)
+	}
+	return out
+}
+
+func plainInt32s(vals ...int32) []byte {
+	out := make([]byte, 4*len(vals))
+	for i, v := range vals {
+		binary.LittleEndian.PutUint32(out[4*i:], uint32(v))
+	}
+	return out
+}
+
+func TestDictionaryWithNulls(t *testing.T) {
+	schema := []fields{root(1), leaf("name", typeByteArray, repOptional, convUTF8)}
+	dict := dictPage(2, plainStrings("Alice", "Bob"))
+	expected := []map[string]interface{}{
+		{"name": "Alice"}, {}, {"name": "Bob"}, {}, {"name": "Alice"},
+	}
+
+	// The nulls have no index in the dictionary, and the rows span two pages.
+	v1 := testFile(schema, 5, testChunk{path: []string{"name"}, pages: [][]byte{
+		dict,
+		dataPage(3, encRLEDictionary, append(levels(1, 1, 0, 1), append([]byte{1},
+			rle(1, 0, 1)...)...)),
+		dataPage(2, encPlainDict, append(levels(1, 0, 1), append([]byte{1}, rle(1, 0)...)...)),
+	}})
+	v2 := testFile(schema, 5, testChunk{path: []string{"name"}, pages: [][]byte{
+		dict,
+		dataPageV2(5, encRLEDictionary, nil, rle(1, 1, 0, 1, 0, 1),
+			append([]byte{1}, rle(1, 0, 1, 0)...)),
+	}})
+	for _, buf := range [][]byte{v1, v2} {
+		rows, err := openBytes(t, buf).ReadRowGroup(0, []string{"name"})
+		require.NoError(t, err)
+		require.Equal(t, expected, rows)
+	}
+
+	// An index past the end of the dictionary is rejected.
+	buf := testFile(schema, 1, testChunk{path: []string{"name"}, pages: [][]byte{
+		dict, dataPage(1, encRLEDictionary, append(levels(1, 1), append([]byte{2},
+			rle(2, 2)...)...)),
+	}})
+	_, err := openBytes(t, buf).ReadRowGroup(0, []string{"name"})
+	require.Error(t, err)
+}
+
+func TestNestedColumns(t *testing.T) {
+	optional, repeated, required := int32(repOptional), int32(repRepeated), int32(repRequired)
+	schema := []fields{
+		root(5),
+		// A list of strings.
+		group("tags", optional, 1, convList),
+		group("list", repeated, 1, -1),
+		leaf("element", typeByteArray, optional, convUTF8),
+		// A list of records.
+		group("items", optional, 1, convList),
+		group("list", repeated, 1, -1),
+		group("element", optional, 2, -1),
+		leaf("sku", typeByteArray, required, convUTF8),
+		leaf("qty", typeInt32, optional, -1),
+		// A legacy repeated field.
+		leaf("nums", typeInt32, repeated, -1),
+		// A list of lists.
+		group("matrix", optional, 1, convList),
+		group("list", repeated, 1, -1),
+		group("element", optional, 1, convList),
+		group("list", repeated, 1, -1),
+		leaf("element", typeInt32, optional, -1),
+		// A map.
+		group("attrs", optional, 1, convMap),
+		group("key_value", repeated, 2, -1),
+		leaf("key", typeByteArray, required, convUTF8),
+		leaf("value", typeInt32, optional, -1),
+	}
+
+	// The rows have the tags [a, b], none, [] and [c, null], the items [{x, 1}, {y, null}],
+	// none, [] and [{z, 3}], and the nums [1, 2], [], [3] and [].
+	buf := testFile(schema, 4,
+		testChunk{path: []string{"tags", "list", "element"}, pages: [][]byte{
+			dataPageV2(6, encPlain, rle(1, 0, 1, 0, 0, 0, 1), rle(2, 3, 3, 0, 1, 3, 2),
+				plainStrings("a", "b", "c")),
+		}},
+		testChunk{path: []string{"items", "list", "element", "sku"}, pages: [][]byte{
+			dataPage(5, encPlain, append(append(levels(1, 0, 1, 0, 0, 0),
+				levels(2, 3, 3, 0, 1, 3)...), plainStrings("x", "y", "z")...)),
+		}},
+		testChunk{path: []string{"items", "list", "element", "qty"}, pages: [][]byte{
+			dataPage(5, encPlain, append(append(levels(1, 0, 1, 0, 0, 0),
+				levels(3, 4, 3, 0, 1, 4)...), plainInt32s(1, 3)...)),
+		}},
+		testChunk{path: []string{"nums"}, pages: [][]byte{
+			dataPage(5, encPlain, append(append(levels(1, 0, 1, 0, 0, 0),
+				levels(1, 1, 1, 0, 1, 0)...), plainInt32s(1, 2, 3)...)),
+		}},
+	)
+	f := openBytes(t, buf)
+	require.Equal(t, []string{"tags", "items.sku", "items.qty", "nums", "matrix",
+		"attrs.key_value.key", "attrs.key_value.value"}, f.Columns())
+
+	rows, err := f.ReadRowGroup(0, []string{"tags", "items.sku", "items.qty", "nums"})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{
+			"tags": []interface{}{"a", "b"}, "items.sku": []interface{}{"x", "y"},
+			"items.qty": []interface{}{int64(1)}, "nums": []interface{}{int64(1), int64(2)},
+		},
+		{},
+		{"nums": []interface{}{int64(3)}},
+		{
+			"tags": []interface{}{"c"}, "items.sku": []interface{}{"z"},
+			"items.qty": []interface{}{int64(3)},
+		},
+	}, rows)
+
+	// The columns which can't be read are rejected, rather than decoded wrongly.
+	_, err = f.ReadRowGroup(0, []string{"matrix"})
+	require.EqualError(t, err, "column matrix has nested lists, which aren't supported")
+	_, err = f.ReadRowGroup(0, []string{"attrs.key_value.key"})
+	require.EqualError(t, err, "column attrs is a map, which isn't supported")
+}
+
+func TestUnsupportedFiles(t *testing.T) {
+	required := []fields{root(1), leaf("n", typeInt32, repRequired, -1)}
+	optional := []fields{root(1), leaf("n", typeInt32, repOptional, -1)}
+	values := plainInt32s(1, 2)
+	chunk := func(codec int32, pages ...[]byte) testChunk {
+		return testChunk{path: []string{"n"}, codec: codec, pages: pages}
+	}
+	var gzipped bytes.Buffer
+	w := gzip.NewWriter(&gzipped)
+	_, err := w.Write(values)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	tests := []struct {
+		name   string
+		schema []fields
+		chunk  testChunk
+		err    string
+	}{
+		{"plain", required, chunk(codecUncompressed, dataPage(2, encPlain, values)), ""},
+		{"gzip", required, chunk(codecGzip, page(pageData, field{5, fields{{1, int32(2)},
+			{2, int32(encPlain)}, {3, int32(encRLE)}, {4, int32(encRLE)}}}, gzipped.Bytes(),
+			len(values))), ""},
+		{"index page", required, chunk(codecUncompressed, page(pageIndex, field{6, fields{}},
+			nil, 0), dataPage(2, encPlain, values)), ""},
+		{"v2", required, chunk(codecUncompressed, dataPageV2(2, encPlain, nil, nil, values)), ""},
+		{"zstd", required, chunk(6, dataPage(2, encPlain, values)),
+			"unsupported Parquet compression codec ZSTD"},
+		{"lz4", required, chunk(5, dataPage(2, encPlain, values)),
+			"unsupported Parquet compression codec LZ4"},
+		{"brotli", required, chunk(4, dataPage(2, encPlain, values)),
+			"unsupported Parquet compression codec BROTLI"},
+		{"unknown codec", required, chunk(42, dataPage(2, encPlain, values)),
+			"unsupported Parquet compression codec 42"},
+		{"delta", required, chunk(codecUncompressed, dataPage(2, 5, values)),
+			"unsupported Parquet encoding DELTA_BINARY_PACKED"},
+		{"delta v2", required, chunk(codecUncompressed, dataPageV2(2, 7, nil, nil, values)),
+			"unsupported Parquet encoding DELTA_BYTE_ARRAY"},
+		{"byte stream split", required, chunk(codecUncompressed, dataPage(2, 9, values)),
+			"unsupported Parquet encoding BYTE_STREAM_SPLIT"},
+		{"rle values", required, chunk(codecUncompressed, dataPage(2, encRLE, values)),
+			"unsupported Parquet encoding RLE"},
+		{"bit-packed levels", optional, chunk(codecUncompressed, page(pageData, field{5, fields{
+			{1, int32(2)}, {2, int32(encPlain)}, {3, int32(4)}, {4, int32(encRLE)}}},
+			append([]byte{0x03}, values...), len(values)+1)),
+			"unsupported Parquet level encoding BIT_PACKED"},
+		{"dictionary encoding", required, chunk(codecUncompressed, page(pageDictionary,
+			field{7, fields{{1, int32(2)}, {2, int32(encRLEDictionary)}}}, values, len(values)),
+			dataPage(2, encRLEDictionary, append([]byte{1}, rle(1, 0, 1)...))),
+			"unsupported Parquet dictionary encoding RLE_DICTIONARY"},
+		{"unknown page", required, chunk(codecUncompressed, page(7, field{5, fields{}},
+			values, len(values))), "unknown Parquet page type 7"},
+	}
+	for _, tc := range tests {
+		rows, err := openBytes(t, testFile(tc.schema, 2, tc.chunk)).ReadRowGroup(0,
+			[]string{"n"})
+		if tc.err == "" {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []map[string]interface{}{{"n": int64(1)}, {"n": int64(2)}}, rows,
+				tc.name)
+			continue
+		}
+		require.Error(t, err, tc.name)
+		require.Contains(t, err.Error(), tc.err, tc.name)
+	}
+
+	// Encrypted files end with another magic number.
+	buf := testFile(required, 2, chunk(codecUncompressed, dataPage(2, encPlain, values)))
+	copy(buf[len(buf)-4:], "PARE")
+	_, err = Open(bytes.NewReader(buf), int64(len(buf)))
+	require.EqualError(t, err, "encrypted Parquet files aren't supported")
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// The metadata of Parquet files is encoded with the Thrift compact protocol. Rather than
+// generating code from the Thrift definitions, the structs are decoded into tStructs, and the
+// fields which are needed are read from them.
+
+// Types of the Thrift compact protocol.
+const (
+	ctStop         = 0
+	ctBooleanTrue  = 1
+	ctBooleanFalse = 2
+	ctByte         = 3
+	ctI16          = 4
+	ctI32          = 5
+	ctI64          = 6
+	ctDouble       = 7
+	ctBinary       = 8
+	ctList         = 9
+	ctSet          = 10
+	ctMap          = 11
+	ctStruct       = 12
+)
+
+// A tStruct holds the fields of a struct by field ID. The values are bool, int64, float64,
+// []byte, []interface{} (for lists and sets) or tStruct. Maps are skipped.
+type tStruct map[int16]interface{}
+
+func (s tStruct) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s tStruct) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s tStruct) bool(id int16) (bool, bool) {
+	v, ok := s[id].(bool)
+	return v, ok
+}
+
+func (s tStruct) string(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s tStruct) strct(id int16) tStruct {
+	v, _ := s[id].(tStruct)
+	return v
+}
+
+func (s tStruct) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+var errThrift = errors.New("malformed Thrift data")
+
+// A thriftDecoder decodes values of the Thrift compact protocol from buf.
+type thriftDecoder struct {
+	buf []byte
+	pos int
+	// depth guards against deeply nested data.
+	depth int
+}
+
+func (d *thriftDecoder) byte() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, errThrift
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *thriftDecoder) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errThrift
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *thriftDecoder) varint() (int64, error) {
+	v, err := d.uvarint()
+	// Zigzag decoding.
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (d *thriftDecoder) bytes() ([]byte, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(d.buf)-d.pos) {
+		return nil, errThrift
+	}
+	b := d.buf[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+func (d *thriftDecoder) value(typ byte) (interface{}, error) {
+	switch typ {
+	case ctBooleanTrue:
+		return true, nil
+	case ctBooleanFalse:
+		return false, nil
+	case ctByte:
+		b, err := d.byte()
+		return int64(int8(b)), err
+	case ctI16, ctI32, ctI64:
+		return d.varint()
+	case ctDouble:
+		if len(d.buf)-d.pos < 8 {
+			return nil, errThrift
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf[d.pos:]))
+		d.pos += 8
+		return v, nil
+	case ctBinary:
+		return d.bytes()
+	case ctList, ctSet:
+		return d.list()
+	case ctMap:
+		return nil, d.skipMap()
+	case ctStruct:
+		return d.strct()
+	default:
+		return nil, errors.Errorf("unknown Thrift type %d", typ)
+	}
+}
+
+func (d *thriftDecoder) list() ([]interface{}, error) {
+	h, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	size, typ := uint64(h>>4), h&0x0f
+	if size == 15 {
+		if size, err = d.uvarint(); err != nil {
+			return nil, err
+		}
+	}
+	// Each element takes at least one byte.
+	if size > uint64(len(d.buf)-d.pos) {
+		return nil, errThrift
+	}
+	list := make([]interface{}, 0, size)
+	for i := uint64(0); i < size; i++ {
+		v, err := d.elem(typ)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+// elem decodes an element of a list or a map.
+func (d *thriftDecoder) elem(typ byte) (interface{}, error) {
+	if typ == ctBooleanTrue || typ == ctBooleanFalse {
+		// Booleans in lists and maps are encoded as one byte.
+		b, err := d.byte()
+		return b == ctBooleanTrue, err
+	}
+	return d.value(typ)
+}
+
+func (d *thriftDecoder) skipMap() error {
+	size, err := d.uvarint()
+	if err != nil || size == 0 {
+		return err
+	}
+	types, err := d.byte()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < size; i++ {
+		if _, err := d.elem(types >> 4); err != nil {
+			return err
+		}
+		if _, err := d.elem(types & 0x0f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *thriftDecoder) strct() (tStruct, error) {
+	if d.depth++; d.depth > 64 {
+		return nil, errThrift
+	}
+	defer func() { d.depth-- }()
+
+	s := make(tStruct)
+	var id int16
+	for {
+		h, err := d.byte()
+		if err != nil {
+			return nil, err
+		}
+		typ := h & 0x0f
+		if typ == ctStop {
+			return s, nil
+		}
+		if delta := int16(h >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := d.varint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		v, err := d.value(typ)
+		if err != nil {
+			return nil, err
+		}
+		s[id] = v
+	}
+}
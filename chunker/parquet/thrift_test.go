@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// field is a field of a Thrift struct written by the tests. The values are bool, int32, int64,
+// string, []byte, []string, []int32, fields for a nested struct, or []fields for a list of
+// structs.
+type field struct {
+	id int16
+	v  interface{}
+}
+
+type fields []field
+
+// thriftEncode encodes a struct with the Thrift compact protocol.
+func thriftEncode(s fields) []byte {
+	var buf []byte
+	uvarint := func(v uint64) {
+		buf = append(buf, make([]byte, binary.MaxVarintLen64)...)
+		n := binary.PutUvarint(buf[len(buf)-binary.MaxVarintLen64:], v)
+		buf = buf[:len(buf)-binary.MaxVarintLen64+n]
+	}
+	varint := func(v int64) { uvarint(uint64(v<<1) ^ uint64(v>>63)) }
+	str := func(b []byte) {
+		uvarint(uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	listHeader := func(size int, typ byte) {
+		if size < 15 {
+			buf = append(buf, byte(size)<<4|typ)
+			return
+		}
+		buf = append(buf, 0xf0|typ)
+		uvarint(uint64(size))
+	}
+
+	var strct func(s fields)
+	strct = func(s fields) {
+		var last int16
+		for _, f := range s {
+			var typ byte
+			switch v := f.v.(type) {
+			case bool:
+				typ = ctBooleanFalse
+				if v {
+					typ = ctBooleanTrue
+				}
+			case int32:
+				typ = ctI32
+			case int64:
+				typ = ctI64
+			case string, []byte:
+				typ = ctBinary
+			case []string, []int32, []fields:
+				typ = ctList
+			case fields:
+				typ = ctStruct
+			default:
+				panic("unknown type of Thrift value")
+			}
+			if delta := f.id - last; delta > 0 && delta <= 15 {
+				buf = append(buf, byte(delta)<<4|typ)
+			} else {
+				buf = append(buf, typ)
+				varint(int64(f.id))
+			}
+			last = f.id
+
+			switch v := f.v.(type) {
+			case int32:
+				varint(int64(v))
+			case int64:
+				varint(v)
+			case string:
+				str([]byte(v))
+			case []byte:
+				str(v)
+			case []string:
+				listHeader(len(v), ctBinary)
+				for _, e := range v {
+					str([]byte(e))
+				}
+			case []int32:
+				listHeader(len(v), ctI32)
+				for _, e := range v {
+					varint(int64(e))
+				}
+			case []fields:
+				listHeader(len(v), ctStruct)
+				for _, e := range v {
+					strct(e)
+				}
+			case fields:
+				strct(v)
+			}
+		}
+		buf = append(buf, ctStop)
+	}
+	strct(s)
+	return buf
+}
+
+func TestThriftDecode(t *testing.T) {
+	long := make([]string, 20)
+	for i := range long {
+		long[i] = "x"
+	}
+	buf := thriftEncode(fields{
+		{1, int32(-3)},
+		{2, true},
+		{3, false},
+		{5, "name"},
+		{40, int64(1) << 40},
+		{41, fields{{1, []int32{1, 2}}}},
+		{42, []fields{{{1, "a"}}, {{1, "b"}}}},
+		{43, long},
+	})
+	d := &thriftDecoder{buf: buf}
+	s, err := d.strct()
+	require.NoError(t, err)
+	require.Equal(t, len(buf), d.pos)
+	require.Equal(t, int64(-3), s.int(1))
+	v, ok := s.bool(2)
+	require.True(t, ok && v)
+	v, ok = s.bool(3)
+	require.True(t, ok && !v)
+	require.Equal(t, "name", s.string(5))
+	require.Equal(t, int64(1)<<40, s.int(40))
+	require.Equal(t, []interface{}{int64(1), int64(2)}, s.strct(41).list(1))
+	require.Len(t, s.list(42), 2)
+	require.Equal(t, "b", s.list(42)[1].(tStruct).string(1))
+	require.Len(t, s.list(43), 20)
+
+	// Truncated data and deeply nested structs are rejected.
+	_, err = (&thriftDecoder{buf: buf[:len(buf)-1]}).strct()
+	require.Error(t, err)
+	nested := fields{{1, int32(1)}}
+	for i := 0; i < 100; i++ {
+		nested = fields{{1, nested}}
+	}
+	_, err = (&thriftDecoder{buf: thriftEncode(nested)}).strct()
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/chunker/parquet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParquetChunk(t *testing.T) {
+	m, err := readTestMapping(t, `{"files": [
+	  {"file": "people.parquet", "type": "Person", "xid": "id",
+	   "columns": {
+	     "name": {"predicate": "name"},
+	     "age": {"predicate": "age", "type": "string"},
+	     "tags": {"predicate": "tag"},
+	     "born": {"predicate": "born"},
+	     "price": {"predicate": "price"},
+	     "employer": {"predicate": "works_for", "ref": "Company"},
+	     "address.city": {"predicate": "city"}}}
+	]}`)
+	require.NoError(t, err)
+	f, err := m.File("people.parquet")
+	require.NoError(t, err)
+
+	buf, err := ioutil.ReadFile("parquet/testdata/people.parquet")
+	require.NoError(t, err)
+	pf, err := parquet.Open(bytes.NewReader(buf), int64(len(buf)))
+	require.NoError(t, err)
+	require.Equal(t, 2, pf.NumRowGroups())
+
+	chunkBuf, err := NewParquetChunker(f, pf, 0, 1000).Chunk(nil)
+	require.Equal(t, io.EOF, err)
+	require.JSONEq(t, `[
+		{"uid": "_:Person.1", "dgraph.type": "Person", "name": "Alice", "age": "26",
+		 "tag": ["a", "b"], "born": "2000-01-01T00:00:00Z", "price": 19.99,
+		 "works_for": {"uid": "_:Company.10"}, "city": "Paris"},
+		{"uid": "_:Person.2", "dgraph.type": "Person", "name": "Bob",
+		 "born": "1970-01-01T00:00:00Z", "price": 0}
+	]`, chunkBuf.String())
+
+	// The chunks can be parsed by any Parquet chunker.
+	parser := NewChunker(ParquetFormat, 1000)
+	require.NoError(t, parser.Parse(chunkBuf))
+	_, err = parser.Chunk(nil)
+	require.Error(t, err)
+
+	// Columns which aren't in the file can't be loaded.
+	f.Columns["missing"] = &ColumnMapping{Predicate: "missing"}
+	_, err = NewParquetChunker(f, pf, 1, 1000).Chunk(nil)
+	require.Error(t, err)
+}
@@ -565,13 +565,15 @@ func (n *Node) proposeConfChange(ctx context.Context, conf raftpb.ConfChange) er
 	}
 }
 
-func (n *Node) addToCluster(ctx context.Context, pid uint64) error {
+// addToCluster adds the peer to the Raft group, as a voter or else as a learner.
+func (n *Node) addToCluster(ctx context.Context, pid uint64, learner bool) error {
 	addr, ok := n.Peer(pid)
 	x.AssertTruef(ok, "Unable to find conn pool for peer: %#x", pid)
 	rc := &pb.RaftContext{
-		Addr:  addr,
-		Group: n.RaftContext.Group,
-		Id:    pid,
+		Addr:      addr,
+		Group:     n.RaftContext.Group,
+		Id:        pid,
+		IsLearner: learner,
 	}
 	rcBytes, err := rc.Marshal()
 	x.Check(err)
@@ -581,9 +583,12 @@ func (n *Node) addToCluster(ctx context.Context, pid uint64) error {
 		NodeID:  pid,
 		Context: rcBytes,
 	}
+	if learner {
+		cc.Type = raftpb.ConfChangeAddLearnerNode
+	}
 	err = errInternalRetry
 	for err == errInternalRetry {
-		glog.Infof("Trying to add %#x to cluster. Addr: %v\n", pid, addr)
+		glog.Infof("Trying to add %#x to cluster. Addr: %v Learner: %v\n", pid, addr, learner)
 		glog.Infof("Current confstate at %#x: %+v\n", n.Id, n.ConfState())
 		err = n.proposeConfChange(ctx, cc)
 	}
@@ -745,7 +750,7 @@ func (n *Node) joinCluster(ctx context.Context, rc *pb.RaftContext) (*api.Payloa
 	}
 	n.Connect(rc.Id, rc.Addr)
 
-	err := n.addToCluster(context.Background(), rc.Id)
+	err := n.addToCluster(context.Background(), rc.Id, rc.IsLearner)
 	glog.Infof("[%#x] Done joining cluster with err: %v", rc.Id, err)
 	return &api.Payload{}, err
 }
@@ -19,13 +19,16 @@ package alpha
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"strconv"
 
+	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
@@ -105,7 +108,20 @@ func exportHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	if err := worker.ExportOverNetwork(context.Background(), format); err != nil {
+
+	req := &pb.ExportRequest{
+		Format:     format,
+		Predicates: r.Form["predicate"],
+		Types:      r.Form["type"],
+	}
+	if q := r.FormValue("query"); len(q) > 0 {
+		var err error
+		if req.Uids, req.ReadTs, err = edgraph.QueryUids(r.Context(), q); err != nil {
+			x.SetStatus(w, err.Error(), "Export failed.")
+			return
+		}
+	}
+	if err := worker.ExportOverNetwork(context.Background(), req); err != nil {
 		x.SetStatus(w, err.Error(), "Export failed.")
 		return
 	}
@@ -113,6 +129,39 @@ func exportHandler(w http.ResponseWriter, r *http.Request) {
 	x.Check2(w.Write([]byte(`{"code": "Success", "message": "Export completed."}`)))
 }
 
+// validateSchemaHandler scans the nodes of the types given by the type parameters, or of all
+// the types with constraints, and returns the nodes that violate the constraints.
+func validateSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, map[string]bool{
+		http.MethodGet: true,
+	}) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		x.SetHttpStatus(w, http.StatusBadRequest, "Parse of validate request failed.")
+		return
+	}
+
+	violations, err := worker.ValidateTypeConstraints(r.Context(), r.Form["type"])
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	if violations == nil {
+		violations = []*worker.ConstraintViolation{}
+	}
+	resp, err := json.Marshal(map[string]interface{}{
+		"code":       "Success",
+		"violations": violations,
+	})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(resp))
+}
+
 func memoryLimitHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
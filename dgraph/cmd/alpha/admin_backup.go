@@ -21,6 +21,7 @@ package alpha
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/worker"
@@ -52,6 +53,12 @@ func backupHandler(w http.ResponseWriter, r *http.Request) {
 		SecretKey:    secretKey,
 		SessionToken: sessionToken,
 		Anonymous:    anonymous,
+		Filter: &pb.PredicateFilter{
+			IncludePredicates: formList(r, "include_predicates"),
+			ExcludePredicates: formList(r, "exclude_predicates"),
+			IncludeTypes:      formList(r, "include_types"),
+			ExcludeTypes:      formList(r, "exclude_types"),
+		},
 	}
 
 	if err := worker.ProcessBackupRequest(context.Background(), &req, forceFull); err != nil {
@@ -62,3 +69,14 @@ func backupHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	x.Check2(w.Write([]byte(`{"code": "Success", "message": "Backup completed."}`)))
 }
+
+// formList returns the comma-separated values of the form field.
+func formList(r *http.Request, key string) []string {
+	var list []string
+	for _, v := range strings.Split(r.FormValue(key), ",") {
+		if v = strings.TrimSpace(v); len(v) > 0 {
+			list = append(list, v)
+		}
+	}
+	return list
+}
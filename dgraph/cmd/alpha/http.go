@@ -144,6 +144,50 @@ func parseDuration(r *http.Request, name string) (time.Duration, error) {
 	return durationValue, nil
 }
 
+// parseTime reads the value for given URL parameter from request and
+// parses it as an RFC 3339 time, empty string is converted into zero value
+func parseTime(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	timeValue, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "while parsing %s as RFC 3339 time", name)
+	}
+
+	return timeValue, nil
+}
+
+// parseHistoricalTs returns the timestamp at which a time-travel query should be run. It's given
+// either directly by the read_ts parameter or as a time by the as_of parameter, which is mapped
+// to a timestamp by Zero. Zero is returned if neither is set.
+func parseHistoricalTs(r *http.Request) (uint64, error) {
+	readTs, err := parseUint64(r, "read_ts")
+	if err != nil {
+		return 0, err
+	}
+	asOf, err := parseTime(r, "as_of")
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case readTs > 0 && !asOf.IsZero():
+		return 0, errors.New("Only one of read_ts and as_of can be set")
+	case !asOf.IsZero():
+		cp, err := worker.TimestampAt(r.Context(), asOf)
+		if err != nil {
+			return 0, errors.Wrapf(err, "while getting timestamp as of %s", asOf)
+		}
+		readTs = cp.Ts
+	case readTs == 0:
+		return 0, nil
+	}
+	return readTs, worker.ValidateReadTs(readTs)
+}
+
 // This method should just build the request and proxy it to the Query method of dgraph.Server.
 // It can then encode the response as appropriate before sending it back to the user.
 func queryHandler(w http.ResponseWriter, r *http.Request) {
@@ -166,6 +210,16 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	historicalTs, err := parseHistoricalTs(r)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	if historicalTs > 0 && startTs > 0 {
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"read_ts and as_of can't be used within a transaction")
+		return
+	}
 
 	body := readRequest(w, r)
 	if body == nil {
@@ -209,6 +263,12 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		StartTs: startTs,
 	}
 
+	// Time-travel queries are read-only, since they run at a snapshot in the past.
+	if historicalTs > 0 {
+		req.StartTs = historicalTs
+		req.ReadOnly = true
+	}
+
 	if req.StartTs == 0 {
 		// If be is set, run this as a best-effort query.
 		isBestEffort, err := parseBool(r, "be")
@@ -123,6 +123,9 @@ they form a Raft group and provide synchronous replication.
 	flag.String("abort_older_than", "5m",
 		"Abort any pending transactions older than this duration. The liveness of a"+
 			" transaction is determined by its last mutation.")
+	flag.Duration("history_retention", 0,
+		"Keep old versions of the data for this duration, so that read-only queries can be run"+
+			" at any time within it. Zero keeps only the versions needed by pending transactions.")
 
 	// OpenCensus flags.
 	flag.Float64("trace", 1.0, "The ratio of queries to trace.")
@@ -145,6 +148,14 @@ they form a Raft group and provide synchronous replication.
 		"Comma separated list of Dgraph zero addresses of the form IP_ADDRESS:PORT.")
 	flag.Uint64("idx", 0,
 		"Optional Raft ID that this Dgraph Alpha will use to join RAFT groups.")
+	flag.Bool("learner", false,
+		"Join the group as a learner, a replica which receives the Raft log and serves reads, "+
+			"but doesn't vote. Learners don't count towards --replicas of Zero.")
+	flag.String("replicate_to", "",
+		"Comma separated list of internal IP_ADDRESS:PORT of the Alphas of a standby cluster, "+
+			"to which the changes of this cluster are asynchronously replicated.")
+	flag.Duration("replication_interval", time.Second,
+		"How often the changes are shipped to the standby cluster set via --replicate_to.")
 	flag.Int("max_retries", -1,
 		"Commits to disk will give up after these number of retries to prevent locking the worker"+
 			" in a failed state. Use -1 to retry infinitely.")
@@ -493,6 +504,7 @@ func setupServer(closer *y.Closer) {
 	http.HandleFunc("/admin/schema", func(w http.ResponseWriter, r *http.Request) {
 		adminSchemaHandler(w, r, adminServer)
 	})
+	http.HandleFunc("/admin/schema/validate", validateSchemaHandler)
 
 	addr := fmt.Sprintf("%s:%d", laddr, httpPort())
 	glog.Infof("Bringing up GraphQL HTTP API at %s/graphql", addr)
@@ -609,6 +621,12 @@ func run() {
 		StartTime:           startTime,
 		LudicrousMode:       Alpha.Conf.GetBool("ludicrous_mode"),
 		BadgerKeyFile:       worker.Config.BadgerKeyFile,
+		HistoryRetention:    Alpha.Conf.GetDuration("history_retention"),
+		Learner:             Alpha.Conf.GetBool("learner"),
+		ReplicationInterval: Alpha.Conf.GetDuration("replication_interval"),
+	}
+	if replicateTo := Alpha.Conf.GetString("replicate_to"); len(replicateTo) > 0 {
+		x.WorkerConfig.ReplicateTo = strings.Split(replicateTo, ",")
 	}
 
 	setupCustomTokenizers()
@@ -0,0 +1,383 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+const checkpointFile = "checkpoint.json"
+
+// checkpoint records the progress of a bulk load in its tmp directory. A load which stops is
+// resumed by running it again with the same options: the map output is verified and reused, only
+// the input files which weren't mapped are mapped, and only the reduce shards which weren't done
+// are reduced again.
+type checkpoint struct {
+	sync.Mutex `json:"-"`
+
+	// Load identifies the load, from the options and input files which determine its output.
+	Load    string `json:"load"`
+	WriteTs uint64 `json:"write_ts"`
+	// MappedFiles holds the input files whose map output files are all written.
+	MappedFiles []string `json:"mapped_files"`
+	// MapFiles holds the map output files written, by their path in their map shard.
+	MapFiles map[string]mapFile `json:"map_files"`
+	MapDone  bool               `json:"map_done"`
+	// Schema holds the schema as of the last input files mapped, including the predicates whose
+	// schema was guessed from the data.
+	Schema map[string]*pb.SchemaUpdate `json:"schema,omitempty"`
+	// PredShards holds the map shard of each predicate, so that the entries of a predicate are
+	// written to the same map shard after the load is resumed.
+	PredShards map[string]int `json:"pred_shards,omitempty"`
+	NextShard  int            `json:"next_shard"`
+	// ReducedShards holds the number of keys written to each reduce shard which is done.
+	ReducedShards map[int]int64 `json:"reduced_shards"`
+
+	path string
+}
+
+type mapFile struct {
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+}
+
+func newCheckpoint(opt *options, writeTs uint64) *checkpoint {
+	return &checkpoint{
+		Load:          loadId(opt),
+		WriteTs:       writeTs,
+		MapFiles:      make(map[string]mapFile),
+		ReducedShards: make(map[int]int64),
+		path:          filepath.Join(opt.TmpDir, checkpointFile),
+	}
+}
+
+// loadId returns a digest of the options and input files of the load. A load is only resumed
+// from a checkpoint with the same digest.
+func loadId(opt *options) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q %q %q %v %q %d %d %v %v %q %v %v %q\n", opt.DataFormat,
+		opt.MappingFile, opt.SchemaFile, opt.Encrypted, opt.OutDir, opt.MapShards,
+		opt.ReduceShards, opt.StoreXids, opt.NewUids, opt.ClientDir, opt.Incremental,
+		opt.IgnoreErrors, opt.CustomTokenizers)
+
+	paths := append(strings.Split(opt.DataFiles, ","), opt.SchemaFile, opt.MappingFile)
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		err := filepath.Walk(strings.TrimSpace(path),
+			func(path string, fi os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !fi.IsDir() {
+					fmt.Fprintf(h, "%q %d %d\n", path, fi.Size(), fi.ModTime().UnixNano())
+				}
+				return nil
+			})
+		x.Check(err)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readCheckpoint returns the checkpoint of an earlier run of the load, if it can be resumed.
+func readCheckpoint(opt *options) *checkpoint {
+	path := filepath.Join(opt.TmpDir, checkpointFile)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	x.Check(err)
+
+	var c checkpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		fmt.Printf("Ignoring unreadable checkpoint %s: %v\n", path, err)
+		return nil
+	}
+	c.path = path
+	switch {
+	case c.Load != loadId(opt):
+		fmt.Printf("Ignoring checkpoint %s of a load with other options or input files\n", path)
+		return nil
+	case !c.MapDone && opt.ClientDir == "":
+		// The uids assigned to the xids of the mapped files are only kept with --xidmap.
+		fmt.Printf("Ignoring checkpoint %s of a load which stopped during its map stage "+
+			"without --xidmap\n", path)
+		return nil
+	}
+	dir := filepath.Join(opt.TmpDir, reduceShardDir)
+	if !c.MapDone {
+		dir = filepath.Join(opt.TmpDir, mapShardDir)
+		if err := c.pruneMapFiles(opt.TmpDir); err != nil {
+			fmt.Printf("Ignoring checkpoint %s: %v\n", path, err)
+			return nil
+		}
+	}
+	if err := c.verifyMapFiles(dir); err != nil {
+		fmt.Printf("Ignoring checkpoint %s: %v\n", path, err)
+		return nil
+	}
+	if c.ReducedShards == nil {
+		c.ReducedShards = make(map[int]int64)
+	}
+	return &c
+}
+
+// save writes the checkpoint to a temporary file, and then renames it, so that a load stopping
+// while it is written leaves the previous checkpoint intact.
+func (c *checkpoint) save() {
+	data, err := json.MarshalIndent(c, "", "  ")
+	x.Check(err)
+	tmp := c.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	x.Check(err)
+	x.Check2(f.Write(data))
+	x.Check(f.Sync())
+	x.Check(f.Close())
+	x.Check(os.Rename(tmp, c.path))
+}
+
+// mapFileName returns the name of a map output file in the checkpoint, which doesn't change when
+// its map shard is moved into a reduce shard.
+func mapFileName(path string) string {
+	return filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path))
+}
+
+// mapFileId returns the number in the name of a map output file, or 0 if it isn't one.
+func mapFileId(name string) uint32 {
+	var id uint32
+	if _, err := fmt.Sscanf(filepath.Base(name), "%06d.map.gz", &id); err != nil {
+		return 0
+	}
+	return id
+}
+
+// addMapFile records a map output file. It is saved along with the input files it was mapped
+// from, by finishMapRound.
+func (c *checkpoint) addMapFile(path string, size int64, checksum uint32) {
+	c.Lock()
+	defer c.Unlock()
+	c.MapFiles[mapFileName(path)] = mapFile{Size: size, Checksum: checksum}
+}
+
+// lastMapFileId returns the highest number of the map output files recorded.
+func (c *checkpoint) lastMapFileId() uint32 {
+	c.Lock()
+	defer c.Unlock()
+	var last uint32
+	for name := range c.MapFiles {
+		if id := mapFileId(name); id > last {
+			last = id
+		}
+	}
+	return last
+}
+
+// unmapped returns the input files which aren't recorded as mapped, in their order.
+func (c *checkpoint) unmapped(files []string) []string {
+	c.Lock()
+	defer c.Unlock()
+	mapped := make(map[string]bool, len(c.MappedFiles))
+	for _, file := range c.MappedFiles {
+		mapped[file] = true
+	}
+	var left []string
+	for _, file := range files {
+		if !mapped[file] {
+			left = append(left, file)
+		}
+	}
+	return left
+}
+
+// finishMapRound records the input files of a map round as mapped, once the mappers have written
+// all of their entries, along with the schema and the map shards of the predicates seen so far.
+func (c *checkpoint) finishMapRound(files []string, s *schemaStore, shards *shardMap) {
+	c.Lock()
+	defer c.Unlock()
+	c.MappedFiles = append(c.MappedFiles, files...)
+	c.snapshot(s, shards)
+	c.save()
+}
+
+func (c *checkpoint) finishMap(s *schemaStore, shards *shardMap) {
+	c.Lock()
+	defer c.Unlock()
+	c.snapshot(s, shards)
+	c.MapDone = true
+	c.save()
+}
+
+// snapshot copies the schema and the map shards of the predicates into the checkpoint. It must
+// be called with the checkpoint locked.
+func (c *checkpoint) snapshot(s *schemaStore, shards *shardMap) {
+	s.RLock()
+	c.Schema = make(map[string]*pb.SchemaUpdate, len(s.schemaMap))
+	for pred, sch := range s.schemaMap {
+		c.Schema[pred] = sch
+	}
+	s.RUnlock()
+
+	shards.RLock()
+	c.PredShards = make(map[string]int, len(shards.predToShard))
+	for pred, shard := range shards.predToShard {
+		c.PredShards[pred] = shard
+	}
+	c.NextShard = shards.nextShard
+	shards.RUnlock()
+}
+
+// shardReduced returns whether a reduce shard was done by an earlier run, and db still holds
+// the keys written to it then.
+func (c *checkpoint) shardReduced(shard int, db *badger.DB) bool {
+	c.Lock()
+	keys, ok := c.ReducedShards[shard]
+	c.Unlock()
+	if !ok {
+		return false
+	}
+	if countKeys(db) == keys {
+		fmt.Printf("Reduce shard %d was done by an earlier run\n", shard)
+		return true
+	}
+	// The stream writer drops the incomplete data before reducing the shard again.
+	fmt.Printf("Reduce shard %d doesn't hold the keys written by an earlier run. "+
+		"Reducing it again\n", shard)
+	return false
+}
+
+func (c *checkpoint) finishReduce(shard int, keys int64) {
+	c.Lock()
+	defer c.Unlock()
+	c.ReducedShards[shard] = keys
+	c.save()
+}
+
+// pruneMapFiles prepares the map output of a load which stopped during its map stage to be
+// resumed. The map shards which were already moved into reduce shards are moved back, and the map
+// output files which weren't recorded, because the input files they were mapped from weren't all
+// mapped, are removed.
+func (c *checkpoint) pruneMapFiles(tmpDir string) error {
+	mapDir := filepath.Join(tmpDir, mapShardDir)
+	if err := os.MkdirAll(mapDir, 0750); err != nil {
+		return err
+	}
+	for _, reduceShard := range readShardDirs(filepath.Join(tmpDir, reduceShardDir)) {
+		for _, shard := range readShardDirs(reduceShard) {
+			if err := os.Rename(shard, filepath.Join(mapDir, filepath.Base(shard))); err != nil {
+				return errors.Wrapf(err, "while moving map shard %s back", shard)
+			}
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(tmpDir, reduceShardDir)); err != nil {
+		return err
+	}
+
+	for _, path := range filenamesInTree(mapDir) {
+		if _, ok := c.MapFiles[mapFileName(path)]; ok {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyMapFiles checks that the map output files in dir are the ones the checkpoint holds, with
+// the same sizes and checksums. A missing dir holds no files.
+func (c *checkpoint) verifyMapFiles(dir string) error {
+	var paths []string
+	if _, err := os.Stat(dir); err == nil {
+		paths = filenamesInTree(dir)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	found := make(map[string]bool)
+	for _, path := range paths {
+		name := mapFileName(path)
+		mf, ok := c.MapFiles[name]
+		if !ok {
+			return errors.Errorf("map output file %s isn't complete", path)
+		}
+		size, checksum, err := fileChecksum(path)
+		if err != nil {
+			return err
+		}
+		if size != mf.Size || checksum != mf.Checksum {
+			return errors.Errorf("map output file %s has changed", path)
+		}
+		found[name] = true
+	}
+	for name := range c.MapFiles {
+		if !found[name] {
+			return errors.Errorf("map output file %s is missing", name)
+		}
+	}
+	return nil
+}
+
+func fileChecksum(path string) (int64, uint32, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	h := crc32.NewIEEE()
+	n, err := io.Copy(h, bufio.NewReader(f))
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "while reading %s", path)
+	}
+	return n, h.Sum32(), nil
+}
+
+// countKeys returns the number of keys written by the reducers to db, leaving out the schema
+// and types.
+func countKeys(db *badger.DB) int64 {
+	txn := db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	itr := txn.NewIterator(opts)
+	defer itr.Close()
+
+	var n int64
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		if key := itr.Item().Key(); key[0] != x.ByteSchema && key[0] != x.ByteType {
+			n++
+		}
+	}
+	return n
+}
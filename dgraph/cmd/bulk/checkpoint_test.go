@@ -0,0 +1,193 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// testLoad returns the options of a load of a data file in a new directory, which also holds its
+// tmp directory.
+func testLoad(t *testing.T) (*options, func()) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	require.NoError(t, err)
+	data := filepath.Join(dir, "data.rdf")
+	require.NoError(t, ioutil.WriteFile(data, []byte("_:a <name> \"A\" .\n"), 0644))
+	opt := &options{
+		DataFiles:    data,
+		DataFormat:   "rdf",
+		OutDir:       filepath.Join(dir, "out"),
+		TmpDir:       filepath.Join(dir, "tmp"),
+		MapShards:    2,
+		ReduceShards: 1,
+	}
+	require.NoError(t, os.MkdirAll(opt.TmpDir, 0750))
+	return opt, func() { os.RemoveAll(dir) }
+}
+
+// writeMapFile writes a map output file, and records it in the checkpoint if c isn't nil.
+func writeMapFile(t *testing.T, c *checkpoint, path, data string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0644))
+	if c != nil {
+		size, checksum, err := fileChecksum(path)
+		require.NoError(t, err)
+		c.addMapFile(path, size, checksum)
+	}
+}
+
+func TestReadCheckpointOptions(t *testing.T) {
+	opt, cleanup := testLoad(t)
+	defer cleanup()
+
+	require.Nil(t, readCheckpoint(opt))
+	c := newCheckpoint(opt, 10)
+	c.MapDone = true
+	c.ReducedShards[0] = 5
+	c.save()
+
+	got := readCheckpoint(opt)
+	require.NotNil(t, got)
+	require.Equal(t, uint64(10), got.WriteTs)
+	require.Equal(t, map[int]int64{0: 5}, got.ReducedShards)
+
+	// Other options, or changed input files, give another load.
+	other := *opt
+	other.ReduceShards = 2
+	require.Nil(t, readCheckpoint(&other))
+	other = *opt
+	other.StoreXids = true
+	require.Nil(t, readCheckpoint(&other))
+	require.NoError(t, ioutil.WriteFile(opt.DataFiles, []byte("_:a <name> \"B\" .\n"), 0644))
+	require.Nil(t, readCheckpoint(opt))
+
+	// A load which stopped during its map stage is only resumed with --xidmap.
+	c = newCheckpoint(opt, 10)
+	c.save()
+	require.Nil(t, readCheckpoint(opt))
+	opt.ClientDir = filepath.Join(filepath.Dir(opt.TmpDir), "xids")
+	c = newCheckpoint(opt, 10)
+	c.save()
+	require.NotNil(t, readCheckpoint(opt))
+
+	require.NoError(t, ioutil.WriteFile(c.path, []byte("{not json"), 0644))
+	require.Nil(t, readCheckpoint(opt))
+}
+
+func TestVerifyMapFiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, dir string)
+		err    string
+	}{
+		{"unchanged", func(t *testing.T, dir string) {}, ""},
+		{"tampered", func(t *testing.T, dir string) {
+			writeMapFile(t, nil, filepath.Join(dir, "000", "000001.map.gz"), "xxxxxx")
+		}, "has changed"},
+		{"truncated", func(t *testing.T, dir string) {
+			require.NoError(t, os.Truncate(filepath.Join(dir, "001", "000002.map.gz"), 2))
+		}, "has changed"},
+		{"unrecorded", func(t *testing.T, dir string) {
+			writeMapFile(t, nil, filepath.Join(dir, "001", "000003.map.gz"), "third")
+		}, "isn't complete"},
+		{"missing", func(t *testing.T, dir string) {
+			require.NoError(t, os.Remove(filepath.Join(dir, "000", "000001.map.gz")))
+		}, "is missing"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opt, cleanup := testLoad(t)
+			defer cleanup()
+			dir := filepath.Join(opt.TmpDir, mapShardDir)
+			c := newCheckpoint(opt, 10)
+			writeMapFile(t, c, filepath.Join(dir, "000", "000001.map.gz"), "first")
+			writeMapFile(t, c, filepath.Join(dir, "001", "000002.map.gz"), "second")
+
+			tc.change(t, dir)
+			err := c.verifyMapFiles(dir)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestResumeMapStage(t *testing.T) {
+	opt, cleanup := testLoad(t)
+	defer cleanup()
+	opt.ClientDir = filepath.Join(filepath.Dir(opt.TmpDir), "xids")
+	mapDir := filepath.Join(opt.TmpDir, mapShardDir)
+	reduceDir := filepath.Join(opt.TmpDir, reduceShardDir)
+
+	// The load stopped after its map shard 001 was moved into a reduce shard, and while the
+	// input file of map output file 000002 was being mapped.
+	c := newCheckpoint(opt, 10)
+	writeMapFile(t, c, filepath.Join(mapDir, "000", "000001.map.gz"), "first")
+	writeMapFile(t, nil, filepath.Join(mapDir, "000", "000002.map.gz"), "partial")
+	writeMapFile(t, c, filepath.Join(reduceDir, "shard_0", "001", "000003.map.gz"), "third")
+	c.MappedFiles = []string{opt.DataFiles}
+	c.save()
+
+	got := readCheckpoint(opt)
+	require.NotNil(t, got)
+	require.Equal(t, []string{
+		filepath.Join(mapDir, "000", "000001.map.gz"),
+		filepath.Join(mapDir, "001", "000003.map.gz"),
+	}, filenamesInTree(mapDir))
+	_, err := os.Stat(reduceDir)
+	require.True(t, os.IsNotExist(err))
+
+	// New map output files are numbered after the recorded ones, and only the input files
+	// which weren't mapped are mapped again.
+	require.Equal(t, uint32(3), got.lastMapFileId())
+	require.Equal(t, []string{"other.rdf"}, got.unmapped([]string{opt.DataFiles, "other.rdf"}))
+	require.Equal(t, uint32(0), mapFileId("checkpoint.json"))
+}
+
+func TestShardReduced(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reduce")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db, err := badger.OpenManaged(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+
+	txn := db.NewTransactionAt(1, true)
+	require.NoError(t, txn.Set(x.DataKey("name", 1), []byte("A")))
+	require.NoError(t, txn.Set(x.DataKey("name", 2), []byte("B")))
+	require.NoError(t, txn.Set(x.SchemaKey("name"), []byte("string")))
+	require.NoError(t, txn.Set(x.TypeKey("Person"), []byte("name")))
+	require.NoError(t, txn.CommitAt(2, nil))
+	require.Equal(t, int64(2), countKeys(db))
+
+	c := &checkpoint{ReducedShards: map[int]int64{0: 2, 1: 3}}
+	// Shard 0 is skipped, shard 1 lost some of its keys and shard 2 wasn't done.
+	require.True(t, c.shardReduced(0, db))
+	require.False(t, c.shardReduced(1, db))
+	require.False(t, c.shardReduced(2, db))
+}
@@ -73,7 +73,8 @@ func (c *countIndexer) addUid(rawKey []byte, count int) {
 		}
 		c.cur.pred = key.Attr
 		c.cur.rev = key.IsReverse()
-		c.cur.track = c.schema.getSchema(key.Attr).GetCount()
+		// The count indexes of an incremental load are rebuilt by the Alphas ingesting it.
+		c.cur.track = c.schema.getSchema(key.Attr).GetCount() && !c.opt.Incremental
 	}
 	if c.cur.track {
 		c.counts[count] = append(c.counts[count], key.Uid)
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/dgraph-io/badger/v2"
+	bpb "github.com/dgraph-io/badger/v2/pb"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// An incremental load writes the data keys as deltas instead of complete posting lists, and
+// leaves out the index, reverse and count keys. The keys of each predicate are then streamed to
+// the leader of the group serving it, like when a predicate is moved. The leader proposes them
+// to its group, which merges them with the data it holds, and rebuilds the indexes of the
+// predicate.
+
+// incrementalDir is the directory under --tmp which the reducers of an incremental load write to.
+const incrementalDir = "incremental"
+
+// cluster holds the connections to the leaders of the groups of the cluster loaded into.
+type cluster struct {
+	state   *pb.MembershipState
+	leaders map[uint32]*grpc.ClientConn
+}
+
+// connectToCluster reads the membership state of the cluster from Zero, and connects to the
+// leader of each group.
+func connectToCluster(zero *grpc.ClientConn) (*cluster, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewZeroClient(zero).StreamMembership(ctx, &api.Payload{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading membership state from Zero")
+	}
+	state, err := stream.Recv()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading membership state from Zero")
+	}
+
+	c := &cluster{state: state, leaders: make(map[uint32]*grpc.ClientConn)}
+	for gid, group := range state.Groups {
+		var leader *pb.Member
+		for _, m := range group.Members {
+			if m.Leader {
+				leader = m
+			}
+		}
+		if leader == nil {
+			c.close()
+			return nil, errors.Errorf("group %d has no leader", gid)
+		}
+		conn, err := grpc.Dial(leader.Addr,
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(x.GrpcMaxSize),
+				grpc.MaxCallSendMsgSize(x.GrpcMaxSize)),
+			grpc.WithInsecure())
+		if err != nil {
+			c.close()
+			return nil, errors.Wrapf(err, "while connecting to the leader of group %d", gid)
+		}
+		c.leaders[gid] = conn
+	}
+	if len(c.leaders) == 0 {
+		return nil, errors.New("the cluster has no groups")
+	}
+	return c, nil
+}
+
+func (c *cluster) close() {
+	for _, conn := range c.leaders {
+		if err := conn.Close(); err != nil {
+			fmt.Printf("Error while closing connection: %v\n", err)
+		}
+	}
+}
+
+// tablet returns the tablet of the predicate, or nil if no group serves it yet.
+func (c *cluster) tablet(pred string) (*pb.Tablet, error) {
+	var tablet *pb.Tablet
+	for _, group := range c.state.Groups {
+		for _, tab := range group.Tablets {
+			switch {
+			case tab.Predicate != pred:
+			case tab.StartUid > 0:
+				return nil, errors.Errorf("predicate %s is sharded by uid ranges, and can't be "+
+					"loaded incrementally", pred)
+			default:
+				tablet = tab
+			}
+		}
+	}
+	return tablet, nil
+}
+
+// groupForNewTablet returns the group which is assigned the new predicates, the one with the
+// least data which isn't draining. Zero can still assign them elsewhere, to a pinned group.
+func (c *cluster) groupForNewTablet() uint32 {
+	var gids []uint32
+	for gid := range c.leaders {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+
+	var dst uint32
+	var min int64
+	for _, gid := range gids {
+		group := c.state.Groups[gid]
+		if group.Draining {
+			continue
+		}
+		var size int64
+		for _, tab := range group.Tablets {
+			size += tab.Space
+		}
+		if dst == 0 || size < min {
+			dst, min = gid, size
+		}
+	}
+	if dst == 0 {
+		dst = gids[0]
+	}
+	return dst
+}
+
+// loadClusterSchema replaces the schema of the predicates which the cluster already holds with
+// theirs, so that the values loaded are converted to the types the cluster holds. The types the
+// cluster already holds are merged with the ones loaded.
+func (s *schemaStore) loadClusterSchema(c *cluster) {
+	var typeNames []string
+	for _, typ := range s.types {
+		typeNames = append(typeNames, typ.TypeName)
+	}
+	for gid, conn := range c.leaders {
+		res, err := pb.NewWorkerClient(conn).Schema(context.Background(),
+			&pb.SchemaRequest{GroupId: gid, Fields: []string{"type", "list", "lang"},
+				Types: typeNames})
+		x.Checkf(err, "while reading the schema of group %d", gid)
+
+		s.Lock()
+		// All the groups hold the types.
+		if typeNames != nil {
+			s.types = mergeTypes(res.Types, s.types)
+			typeNames = nil
+		}
+		for _, node := range res.Schema {
+			typ, ok := types.TypeForName(node.Type)
+			if !ok {
+				s.Unlock()
+				x.Fatalf("Unknown type %s of predicate %s in the cluster", node.Type,
+					node.Predicate)
+			}
+			sch := &pb.SchemaUpdate{ValueType: typ.Enum(), List: node.List, Lang: node.Lang}
+			if old, ok := s.schemaMap[node.Predicate]; ok &&
+				(old.ValueType != sch.ValueType || old.List != sch.List) {
+				fmt.Printf("Predicate %s is loaded with the schema it has in the cluster, "+
+					"type %s and list %v\n", node.Predicate, node.Type, node.List)
+			}
+			s.schemaMap[node.Predicate] = sch
+			s.inCluster[node.Predicate] = true
+		}
+		s.Unlock()
+	}
+}
+
+// mergeTypes adds the fields and composite indexes of the types loaded to the types the cluster
+// holds. The fields the cluster holds are kept as they are.
+func mergeTypes(cluster, loaded []*pb.TypeUpdate) []*pb.TypeUpdate {
+	held := make(map[string]*pb.TypeUpdate)
+	for _, typ := range cluster {
+		held[typ.TypeName] = typ
+	}
+	merged := make([]*pb.TypeUpdate, 0, len(loaded))
+	for _, typ := range loaded {
+		old, ok := held[typ.TypeName]
+		if !ok {
+			merged = append(merged, typ)
+			continue
+		}
+		fields := make(map[string]bool)
+		for _, field := range old.Fields {
+			fields[field.Predicate] = true
+		}
+		for _, field := range typ.Fields {
+			if !fields[field.Predicate] {
+				old.Fields = append(old.Fields, field)
+			}
+		}
+		indexes := make(map[string]bool)
+		for _, ci := range old.CompositeIndexes {
+			indexes[schema.CompositeIndexName(ci)] = true
+		}
+		for _, ci := range typ.CompositeIndexes {
+			if !indexes[schema.CompositeIndexName(ci)] {
+				old.CompositeIndexes = append(old.CompositeIndexes, ci)
+			}
+		}
+		fmt.Printf("Type %s is merged with the type the cluster holds\n", typ.TypeName)
+		merged = append(merged, old)
+	}
+	return merged
+}
+
+// ingest streams the predicates written by the reducers, and the types of the schema, to the
+// cluster.
+func (ld *loader) ingest() {
+	ld.prog.setPhase(ingestPhase)
+	c, err := connectToCluster(ld.zero)
+	x.Check(err)
+	defer c.close()
+
+	// Find the groups serving the predicates before ingesting any of them.
+	type predicate struct {
+		name string
+		db   *badger.DB
+		gid  uint32
+	}
+	var preds []predicate
+	for _, db := range ld.dbs {
+		for _, pred := range ld.schema.getPredicates(db) {
+			tab, err := c.tablet(pred)
+			x.Check(err)
+			if tab == nil {
+				tab, err = pb.NewZeroClient(ld.zero).ShouldServe(context.Background(),
+					&pb.Tablet{Predicate: pred, GroupId: c.groupForNewTablet()})
+				x.Checkf(err, "while assigning predicate %s to a group", pred)
+			}
+			if _, ok := c.leaders[tab.GroupId]; !ok {
+				x.Fatalf("Predicate %s is served by unknown group %d", pred, tab.GroupId)
+			}
+			preds = append(preds, predicate{name: pred, db: db, gid: tab.GroupId})
+		}
+	}
+
+	// Type definitions are held by all groups. They are proposed at a new timestamp, like by an
+	// alter operation, so that the groups apply them in order with the other schema changes.
+	if len(ld.schema.types) > 0 {
+		ts := getWriteTimestamp(ld.zero)
+		for gid, conn := range c.leaders {
+			fmt.Printf("Ingesting %d types into group %d\n", len(ld.schema.types), gid)
+			_, err := pb.NewWorkerClient(conn).Mutate(context.Background(),
+				&pb.Mutations{GroupId: gid, StartTs: ts, Types: ld.schema.types})
+			x.Checkf(err, "while ingesting types into group %d", gid)
+		}
+	}
+
+	for _, pred := range preds {
+		fmt.Printf("Ingesting predicate %s into group %d\n", pred.name, pred.gid)
+		err := ld.ingestPredicate(c.leaders[pred.gid], pred.db, pred.name)
+		x.Checkf(err, "while ingesting predicate %s", pred.name)
+	}
+}
+
+// ingestPredicate streams the keys of a predicate to the leader of the group serving it, at a
+// new timestamp. The schema of the predicate is sent first if the cluster doesn't hold it yet.
+func (ld *loader) ingestPredicate(leader *grpc.ClientConn, db *badger.DB, pred string) error {
+	ctx := context.Background()
+	s, err := pb.NewWorkerClient(leader).ReceivePredicate(ctx)
+	if err != nil {
+		return err
+	}
+	// The keys are written above the data the cluster already holds.
+	ts := getWriteTimestamp(ld.zero)
+	if sch := ld.schema.getSchema(pred); sch != nil && !ld.schema.isInCluster(pred) {
+		v, err := sch.Marshal()
+		if err != nil {
+			return err
+		}
+		kv := &bpb.KV{
+			Key:      x.SchemaKey(pred),
+			Value:    v,
+			UserMeta: []byte{posting.BitSchemaPosting},
+			Version:  1,
+		}
+		if err := s.Send(&pb.KVS{Kv: []*bpb.KV{kv}, Ingest: true}); err != nil {
+			return err
+		}
+	}
+
+	stream := db.NewStreamAt(ld.writeTs)
+	stream.LogPrefix = fmt.Sprintf("Ingesting predicate: [%s]", pred)
+	stream.Prefix = x.PredicatePrefix(pred)
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		item := itr.Item()
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		atomic.AddInt64(&ld.prog.ingestKeyCount, 1)
+		kv := &bpb.KV{
+			Key:      item.KeyCopy(nil),
+			Value:    val,
+			UserMeta: []byte{item.UserMeta()},
+			Version:  ts,
+		}
+		return &bpb.KVList{Kv: []*bpb.KV{kv}}, nil
+	}
+	stream.Send = func(list *bpb.KVList) error {
+		return s.Send(&pb.KVS{Kv: list.Kv, Ingest: true})
+	}
+	if err := stream.Orchestrate(ctx); err != nil {
+		return err
+	}
+	_, err = s.CloseAndRecv()
+	return err
+}
@@ -17,6 +17,7 @@
 package bulk
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -27,6 +28,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 	"github.com/dgraph-io/badger/v2/y"
 
 	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/chunker/parquet"
 	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
@@ -46,6 +49,7 @@ import (
 type options struct {
 	DataFiles        string
 	DataFormat       string
+	MappingFile      string
 	SchemaFile       string
 	OutDir           string
 	ReplaceOutDir    bool
@@ -64,6 +68,10 @@ type options struct {
 	NewUids          bool
 	ClientDir        string
 	Encrypted        bool
+	// Incremental is set if the data is loaded into a running cluster instead of --out.
+	Incremental bool
+	// Resume is set if the load resumes from the checkpoint of an earlier run in TmpDir.
+	Resume bool
 
 	MapShards    int
 	ReduceShards int
@@ -87,6 +95,7 @@ type state struct {
 	mapFileId     uint32 // Used atomically to name the output files of the mappers.
 	dbs           []*badger.DB
 	writeTs       uint64 // All badger writes use this timestamp
+	ckpt          *checkpoint
 }
 
 type loader struct {
@@ -110,14 +119,18 @@ func newLoader(opt *options) *loader {
 		grpc.WithInsecure())
 	x.Checkf(err, "Unable to connect to zero, Is it running at %s?", opt.ZeroAddr)
 	st := &state{
-		opt:    opt,
-		prog:   newProgress(),
-		shards: newShardMap(opt.MapShards),
-		// Lots of gz readers, so not much channel buffer needed.
-		readerChunkCh: make(chan *bytes.Buffer, opt.NumGoroutines),
-		writeTs:       getWriteTimestamp(zero),
+		opt:     opt,
+		prog:    newProgress(),
+		shards:  newShardMap(opt.MapShards),
+		writeTs: getWriteTimestamp(zero),
 	}
 	st.schema = newSchemaStore(readSchema(opt), opt, st)
+	if opt.Incremental {
+		c, err := connectToCluster(zero)
+		x.Checkf(err, "Unable to connect to the cluster")
+		st.schema.loadClusterSchema(c)
+		c.close()
+	}
 	ld := &loader{
 		state:   st,
 		mappers: make([]*mapper, opt.NumGoroutines),
@@ -130,6 +143,35 @@ func newLoader(opt *options) *loader {
 	return ld
 }
 
+// startCheckpoint resumes the load from the checkpoint of an earlier run, or starts a new one.
+func (ld *loader) startCheckpoint(c *checkpoint) {
+	if c == nil {
+		ld.ckpt = newCheckpoint(ld.opt, ld.writeTs)
+		ld.ckpt.save()
+		return
+	}
+	if c.MapDone {
+		fmt.Printf("Resuming the load from checkpoint %s. %d of %d reduce shards are done.\n",
+			c.path, len(c.ReducedShards), ld.opt.ReduceShards)
+	} else {
+		fmt.Printf("Resuming the load from checkpoint %s. %d input files are mapped.\n",
+			c.path, len(c.MappedFiles))
+	}
+	ld.ckpt = c
+	ld.writeTs = c.WriteTs
+	ld.schema.Lock()
+	for pred, sch := range c.Schema {
+		ld.schema.schemaMap[pred] = sch
+	}
+	ld.schema.Unlock()
+	for pred, shard := range c.PredShards {
+		ld.shards.predToShard[pred] = shard
+	}
+	ld.shards.nextShard = c.NextShard
+	// The map output files written after the load is resumed mustn't replace the earlier ones.
+	ld.mapFileId = c.lastMapFileId()
+}
+
 func getWriteTimestamp(zero *grpc.ClientConn) uint64 {
 	client := pb.NewZeroClient(zero)
 	for {
@@ -168,6 +210,46 @@ func readSchema(opt *options) *schema.ParsedSchema {
 	return result
 }
 
+// readChunks sends the chunks read from r to the mappers.
+func (ld *loader) readChunks(chunk chunker.Chunker, r *bufio.Reader) {
+	for {
+		chunkBuf, err := chunk.Chunk(r)
+		if chunkBuf != nil && chunkBuf.Len() > 0 {
+			ld.readerChunkCh <- chunkBuf
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			x.Check(err)
+		}
+	}
+}
+
+// readParquetFile reads the row groups of a Parquet file in parallel, and returns the open file,
+// which must be closed once they have all been read. Parquet files are read at random, so they
+// can't be compressed or encrypted as a whole like the other files.
+func (ld *loader) readParquetFile(file string, fm *chunker.FileMapping, thr *y.Throttle) *os.File {
+	if strings.HasSuffix(file, ".gz") || ld.opt.Encrypted {
+		fmt.Printf("Parquet file %s can't be compressed with gzip or encrypted\n", file)
+		os.Exit(1)
+	}
+	f, err := os.Open(file)
+	x.Check(err)
+	fi, err := f.Stat()
+	x.Check(err)
+	pf, err := parquet.Open(f, fi.Size())
+	x.Checkf(err, "while opening Parquet file %s", file)
+
+	for i := 0; i < pf.NumRowGroups(); i++ {
+		x.Check(thr.Do())
+		go func(i int) {
+			defer thr.Done(nil)
+			ld.readChunks(chunker.NewParquetChunker(fm, pf, i, 1000), nil)
+		}(i)
+	}
+	return f
+}
+
 func (ld *loader) mapStage() {
 	ld.prog.setPhase(mapPhase)
 	var db *badger.DB
@@ -180,22 +262,86 @@ func (ld *loader) mapStage() {
 	}
 	ld.xids = xidmap.New(ld.zero, db)
 
-	files := x.FindDataFiles(ld.opt.DataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	files := x.FindDataFiles(ld.opt.DataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz",
+		".csv", ".csv.gz", ".jsonl", ".jsonl.gz", ".ndjson", ".ndjson.gz", ".parquet", ".avro",
+		".avro.gz"})
 	if len(files) == 0 {
 		fmt.Printf("No data files found in %s.\n", ld.opt.DataFiles)
 		os.Exit(1)
 	}
 
 	// Because mappers must handle chunks that may be from different input files, they must all
-	// assume the same data format, either RDF or JSON. Use the one specified by the user or by
-	// the first load file.
+	// assume the same data format. Use the one specified by the user or by the first load file.
 	loadType := chunker.DataFormat(files[0], ld.opt.DataFormat)
 	if loadType == chunker.UnknownFormat {
 		// Dont't try to detect JSON input in bulk loader.
-		fmt.Printf("Need --format=rdf or --format=json to load %s", files[0])
+		fmt.Printf("Need --format=rdf, --format=json, --format=csv, --format=jsonl, "+
+			"--format=parquet or --format=avro to load %s", files[0])
 		os.Exit(1)
 	}
+	var mapping *chunker.Mapping
+	switch loadType {
+	case chunker.CsvFormat, chunker.ParquetFormat, chunker.AvroFormat:
+		if ld.opt.MappingFile == "" {
+			fmt.Printf("Need --mapping to load %s\n", files[0])
+			os.Exit(1)
+		}
+		var err error
+		mapping, err = chunker.ReadMapping(ld.opt.MappingFile)
+		x.Check(err)
+		// Check that all the files are mapped before loading any of them.
+		for _, file := range files {
+			_, err := mapping.File(file)
+			x.Check(err)
+		}
+	}
+
+	// The files are mapped in rounds, at the end of which the mappers write out all of their
+	// entries and the checkpoint records the files of the round as mapped. A load which stops
+	// during its map stage then only maps the files of its last round again.
+	total := len(files)
+	files = ld.ckpt.unmapped(files)
+	mapped := total - len(files)
+	for len(files) > 0 {
+		round := mapRoundFiles(files)
+		ld.mapRound(round, mapped, total, loadType, mapping)
+		mapped += len(round)
+		files = files[len(round):]
+	}
 
+	// Allow memory to GC before the reduce phase.
+	for i := range ld.mappers {
+		ld.mappers[i] = nil
+	}
+	x.Check(ld.xids.Flush())
+	if db != nil {
+		x.Check(db.Close())
+	}
+	ld.xids = nil
+}
+
+// mapRoundSize is the size of the input files which are mapped in a round.
+const mapRoundSize = 1 << 30
+
+// mapRoundFiles returns the files at the start of files which are mapped in the next round.
+func mapRoundFiles(files []string) []string {
+	var size int64
+	for i, file := range files {
+		fi, err := os.Stat(file)
+		x.Check(err)
+		if size += fi.Size(); size >= mapRoundSize {
+			return files[:i+1]
+		}
+	}
+	return files
+}
+
+// mapRound maps files, and then checkpoints the load. mapped is the number of files mapped in
+// earlier rounds, out of the total number of files in the load.
+func (ld *loader) mapRound(files []string, mapped, total int, loadType chunker.InputFormat,
+	mapping *chunker.Mapping) {
+	// Lots of gz readers, so not much channel buffer needed.
+	ld.readerChunkCh = make(chan *bytes.Buffer, ld.opt.NumGoroutines)
 	var mapperWg sync.WaitGroup
 	mapperWg.Add(len(ld.mappers))
 	for _, m := range ld.mappers {
@@ -207,9 +353,17 @@ func (ld *loader) mapStage() {
 
 	// This is the main map loop.
 	thr := y.NewThrottle(ld.opt.NumGoroutines)
+	var parquetFiles []*os.File
 	for i, file := range files {
+		if loadType == chunker.ParquetFormat {
+			fmt.Printf("Processing file (%d out of %d): %s\n", mapped+i+1, total, file)
+			fm, err := mapping.File(file)
+			x.Check(err)
+			parquetFiles = append(parquetFiles, ld.readParquetFile(file, fm, thr))
+			continue
+		}
 		x.Check(thr.Do())
-		fmt.Printf("Processing file (%d out of %d): %s\n", i+1, len(files), file)
+		fmt.Printf("Processing file (%d out of %d): %s\n", mapped+i+1, total, file)
 
 		go func(file string) {
 			defer thr.Done(nil)
@@ -222,33 +376,29 @@ func (ld *loader) mapStage() {
 			defer cleanup()
 
 			chunk := chunker.NewChunker(loadType, 1000)
-			for {
-				chunkBuf, err := chunk.Chunk(r)
-				if chunkBuf != nil && chunkBuf.Len() > 0 {
-					ld.readerChunkCh <- chunkBuf
-				}
-				if err == io.EOF {
-					break
-				} else if err != nil {
-					x.Check(err)
+			if mapping != nil {
+				f, err := mapping.File(file)
+				x.Check(err)
+				if loadType == chunker.AvroFormat {
+					chunk = chunker.NewAvroChunker(f, 1000)
+				} else {
+					chunk = chunker.NewCSVChunker(f, 1000)
 				}
 			}
+			ld.readChunks(chunk, r)
 		}(file)
 	}
 	x.Check(thr.Finish())
+	for _, f := range parquetFiles {
+		x.Check(f.Close())
+	}
 
 	close(ld.readerChunkCh)
 	mapperWg.Wait()
 
-	// Allow memory to GC before the reduce phase.
-	for i := range ld.mappers {
-		ld.mappers[i] = nil
-	}
-	x.Check(ld.xids.Flush())
-	if db != nil {
-		x.Check(db.Close())
-	}
-	ld.xids = nil
+	// The uids of the xids in the files must be kept before the files are recorded as mapped.
+	x.Check(ld.xids.Sync())
+	ld.ckpt.finishMapRound(files, ld.schema, ld.shards)
 }
 
 func (ld *loader) reduceStage() {
@@ -22,6 +22,8 @@ import (
 	"compress/gzip"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
+	"io"
 	"log"
 	"math"
 	"os"
@@ -109,12 +111,16 @@ func (m *mapper) writeMapEntriesToFile(entries []*pb.MapEntry, encodedSize uint6
 	f, err := m.openOutputFile(shardIdx)
 	x.Check(err)
 
+	// The checksum lets a resumed load verify the file.
+	h := crc32.NewIEEE()
+	cw := &countingWriter{w: io.MultiWriter(f, h)}
 	defer func() {
 		x.Check(f.Sync())
 		x.Check(f.Close())
+		m.ckpt.addMapFile(f.Name(), cw.n, h.Sum32())
 	}()
 
-	gzWriter := gzip.NewWriter(f)
+	gzWriter := gzip.NewWriter(cw)
 	w := bufio.NewWriter(gzWriter)
 	defer func() {
 		x.Check(w.Flush())
@@ -159,6 +165,17 @@ func (m *mapper) writeMapEntriesToFile(entries []*pb.MapEntry, encodedSize uint6
 	}
 }
 
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
 func (m *mapper) run(inputFormat chunker.InputFormat) {
 	chunk := chunker.NewChunker(inputFormat, 1000)
 	nquads := chunk.NQuads()
@@ -206,7 +223,11 @@ func (m *mapper) run(inputFormat chunker.InputFormat) {
 			sh.mu.Lock() // One write at a time.
 			m.writeMapEntriesToFile(sh.entries, sh.encodedSize, i)
 		}
-		m.shards[i].mu.Lock() // Ensure that the last file write finishes.
+		sh.mu.Lock() // Ensure that the last file write finishes.
+		// Leave the shard empty for the next map round.
+		sh.entries = make([]*pb.MapEntry, 0, 32)
+		sh.encodedSize = 0
+		sh.mu.Unlock()
 	}
 }
 
@@ -253,6 +274,10 @@ func (m *mapper) processNQuad(nq gql.NQuad) {
 	key := x.DataKey(nq.Predicate, sid)
 	m.addMapEntry(key, fwd, shard)
 
+	// The indexes of an incremental load are rebuilt by the Alphas ingesting it.
+	if m.opt.Incremental {
+		return
+	}
 	if rev != nil {
 		key = x.ReverseKey(nq.Predicate, oid)
 		m.addMapEntry(key, rev, shard)
@@ -30,6 +30,7 @@ const (
 	nothing phase = iota
 	mapPhase
 	reducePhase
+	ingestPhase
 )
 
 type progress struct {
@@ -38,6 +39,7 @@ type progress struct {
 	mapEdgeCount    int64
 	reduceEdgeCount int64
 	reduceKeyCount  int64
+	ingestKeyCount  int64
 	numEncoding     int32
 
 	start       time.Time
@@ -118,6 +120,13 @@ func (p *progress) reportOnce() {
 			niceFloat(float64(reduceKeyCount)/elapsed.Seconds()),
 			atomic.LoadInt32(&p.numEncoding),
 		)
+	case ingestPhase:
+		ingestKeyCount := atomic.LoadInt64(&p.ingestKeyCount)
+		fmt.Printf("[%s] INGEST %s key_count:%s\n",
+			timestamp,
+			x.FixedDuration(time.Since(p.start)),
+			niceFloat(float64(ingestKeyCount)),
+		)
 	default:
 		x.AssertTruef(false, "invalid phase")
 	}
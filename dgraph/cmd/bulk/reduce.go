@@ -61,6 +61,10 @@ func (r *reducer) run() error {
 
 	thr := y.NewThrottle(r.opt.NumReducers)
 	for i := 0; i < r.opt.ReduceShards; i++ {
+		db := r.createBadger(i)
+		if r.ckpt.shardReduced(i, db) {
+			continue
+		}
 		if err := thr.Do(); err != nil {
 			return err
 		}
@@ -97,7 +101,8 @@ func (r *reducer) run() error {
 					fmt.Printf("Error while closing iterator: %v", err)
 				}
 			}
-		}(i, r.createBadger(i))
+			r.ckpt.finishReduce(shardId, countKeys(db))
+		}(i, db)
 	}
 	return thr.Finish()
 }
@@ -445,6 +450,8 @@ func (r *reducer) toList(bufEntries [][]byte, list *bpb.KVList) []*countIndexEnt
 			uids = append(uids, uid)
 			if mapEntry.Posting != nil {
 				pl.Postings = append(pl.Postings, mapEntry.Posting)
+			} else if r.opt.Incremental {
+				pl.Postings = append(pl.Postings, &pb.Posting{Uid: uid})
 			}
 		}
 
@@ -478,7 +485,22 @@ func (r *reducer) toList(bufEntries [][]byte, list *bpb.KVList) []*countIndexEnt
 
 		pl.Pack = codec.Encode(uids, 256)
 		shouldSplit := pl.Size() > (1<<20)/2 && len(pl.Pack.Blocks) > 1
-		if shouldSplit {
+		if r.opt.Incremental {
+			// The lists of an incremental load are merged with the lists held by the cluster,
+			// so they are written as deltas.
+			pl.Pack = nil
+			for _, p := range pl.Postings {
+				p.Op = posting.Set
+			}
+			val, err := pl.Marshal()
+			x.Check(err)
+			list.Kv = append(list.Kv, &bpb.KV{
+				Key:      y.Copy(currentKey),
+				Value:    val,
+				UserMeta: []byte{posting.BitDeltaPosting},
+				Version:  writeVersionTs,
+			})
+		} else if shouldSplit {
 			l := posting.NewList(y.Copy(currentKey), pl, writeVersionTs)
 			kvs, err := l.Rollup()
 			x.Check(err)
@@ -53,11 +53,16 @@ func init() {
 
 	flag := Bulk.Cmd.Flags()
 	flag.StringP("files", "f", "",
-		"Location of *.rdf(.gz) or *.json(.gz) file(s) to load.")
+		"Location of *.rdf(.gz), *.json(.gz), *.csv(.gz), *.jsonl(.gz), *.parquet or "+
+			"*.avro(.gz) file(s) to load.")
 	flag.StringP("schema", "s", "",
 		"Location of schema file.")
 	flag.String("format", "",
-		"Specify file format (rdf or json) instead of getting it from filename.")
+		"Specify file format (rdf, json, csv, jsonl, parquet or avro) instead of getting it "+
+			"from filename.")
+	flag.String("mapping", "",
+		"Location of the JSON file which maps the columns of CSV, Parquet and Avro files to "+
+			"predicates.")
 	flag.Bool("encrypted", false,
 		"Flag to indicate whether schema and data files are encrypted.")
 	flag.String("out", defaultOutDir,
@@ -100,6 +105,14 @@ func init() {
 		"Comma separated list of tokenizer plugins")
 	flag.Bool("new_uids", false,
 		"Ignore UIDs in load files and assign new ones.")
+	flag.Bool("resume", true,
+		"Resume the load from the checkpoint which an earlier run with the same options and "+
+			"input files left in --tmp. A load which stopped during its map stage is only "+
+			"resumed with --xidmap.")
+	flag.Bool("incremental", false,
+		"Load the data into the running cluster of the Zero at --zero, instead of writing "+
+			"new data directories to --out. Use the same --xidmap directory as earlier loads "+
+			"to refer to the nodes they created.")
 
 	// Options around how to set up Badger.
 	flag.String("encryption_key_file", "",
@@ -115,6 +128,7 @@ func run() {
 	opt := options{
 		DataFiles:        Bulk.Conf.GetString("files"),
 		DataFormat:       Bulk.Conf.GetString("format"),
+		MappingFile:      Bulk.Conf.GetString("mapping"),
 		SchemaFile:       Bulk.Conf.GetString("schema"),
 		Encrypted:        Bulk.Conf.GetBool("encrypted"),
 		OutDir:           Bulk.Conf.GetString("out"),
@@ -134,6 +148,8 @@ func run() {
 		ReduceShards:     Bulk.Conf.GetInt("reduce_shards"),
 		CustomTokenizers: Bulk.Conf.GetString("custom_tokenizers"),
 		NewUids:          Bulk.Conf.GetBool("new_uids"),
+		Incremental:      Bulk.Conf.GetBool("incremental"),
+		Resume:           Bulk.Conf.GetBool("resume"),
 		ClientDir:        Bulk.Conf.GetString("xidmap"),
 
 		BadgerKeyFile:          Bulk.Conf.GetString("encryption_key_file"),
@@ -201,9 +217,16 @@ func run() {
 		log.Fatal(http.ListenAndServe(opt.HttpAddr, nil))
 	}()
 
+	// A resumed load keeps its tmp directory, and the output of the reduce shards which are done.
+	var ckpt *checkpoint
+	if opt.Resume {
+		ckpt = readCheckpoint(&opt)
+	}
+	resume := ckpt != nil
+
 	// Make sure it's OK to create or replace the directory specified with the --out option.
 	// It is always OK to create or replace the default output directory.
-	if opt.OutDir != defaultOutDir && !opt.ReplaceOutDir {
+	if opt.OutDir != defaultOutDir && !opt.ReplaceOutDir && !opt.Incremental && !resume {
 		err := x.IsMissingOrEmptyDir(opt.OutDir)
 		if err == nil {
 			fmt.Fprintf(os.Stderr, "Output directory exists and is not empty."+
@@ -215,17 +238,21 @@ func run() {
 	}
 
 	// Delete and recreate the output dirs to ensure they are empty.
-	x.Check(os.RemoveAll(opt.OutDir))
-	for i := 0; i < opt.ReduceShards; i++ {
-		dir := filepath.Join(opt.OutDir, strconv.Itoa(i), "p")
-		x.Check(os.MkdirAll(dir, 0700))
-		opt.shardOutputDirs = append(opt.shardOutputDirs, dir)
+	if !opt.Incremental {
+		if !resume {
+			x.Check(os.RemoveAll(opt.OutDir))
+		}
+		for i := 0; i < opt.ReduceShards; i++ {
+			dir := filepath.Join(opt.OutDir, strconv.Itoa(i), "p")
+			x.Check(os.MkdirAll(dir, 0700))
+			opt.shardOutputDirs = append(opt.shardOutputDirs, dir)
 
-		x.Check(x.WriteGroupIdFile(dir, uint32(i+1)))
+			x.Check(x.WriteGroupIdFile(dir, uint32(i+1)))
+		}
 	}
 
 	// Create a directory just for bulk loader's usage.
-	if !opt.SkipMapPhase {
+	if !opt.SkipMapPhase && !resume {
 		x.Check(os.RemoveAll(opt.TmpDir))
 		x.Check(os.MkdirAll(opt.TmpDir, 0700))
 	}
@@ -233,13 +260,32 @@ func run() {
 		defer os.RemoveAll(opt.TmpDir)
 	}
 
+	// The reducers of an incremental load write to scratch directories, which are then ingested
+	// by the cluster.
+	if opt.Incremental {
+		if !resume {
+			x.Check(os.RemoveAll(filepath.Join(opt.TmpDir, incrementalDir)))
+		}
+		for i := 0; i < opt.ReduceShards; i++ {
+			dir := filepath.Join(opt.TmpDir, incrementalDir, strconv.Itoa(i))
+			x.Check(os.MkdirAll(dir, 0700))
+			opt.shardOutputDirs = append(opt.shardOutputDirs, dir)
+		}
+	}
+
 	loader := newLoader(&opt)
-	if !opt.SkipMapPhase {
+	loader.startCheckpoint(ckpt)
+	if !opt.SkipMapPhase && !loader.ckpt.MapDone {
 		loader.mapStage()
 		mergeMapShardsIntoReduceShards(&opt)
+		loader.ckpt.finishMap(loader.schema, loader.shards)
 	}
 	loader.reduceStage()
-	loader.writeSchema()
+	if opt.Incremental {
+		loader.ingest()
+	} else {
+		loader.writeSchema()
+	}
 	loader.cleanup()
 }
 
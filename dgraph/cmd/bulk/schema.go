@@ -34,6 +34,8 @@ type schemaStore struct {
 	sync.RWMutex
 	schemaMap map[string]*pb.SchemaUpdate
 	types     []*pb.TypeUpdate
+	// inCluster holds the predicates which the cluster of an incremental load already holds.
+	inCluster map[string]bool
 	*state
 }
 
@@ -44,6 +46,7 @@ func newSchemaStore(initial *schema.ParsedSchema, opt *options, state *state) *s
 
 	s := &schemaStore{
 		schemaMap: map[string]*pb.SchemaUpdate{},
+		inCluster: map[string]bool{},
 		state:     state,
 	}
 
@@ -83,6 +86,12 @@ func (s *schemaStore) getSchema(pred string) *pb.SchemaUpdate {
 	return s.schemaMap[pred]
 }
 
+func (s *schemaStore) isInCluster(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.inCluster[pred]
+}
+
 func (s *schemaStore) setSchemaAsList(pred string) {
 	s.Lock()
 	defer s.Unlock()
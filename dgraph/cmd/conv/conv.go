@@ -18,6 +18,7 @@ package conv
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
 	"fmt"
@@ -27,8 +28,10 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
 )
 
 // TODO: Reconsider if we need this binary.
@@ -62,27 +65,19 @@ func writeToFile(fpath string, ch chan []byte) error {
 
 func convertGeoFile(input string, output string) error {
 	fmt.Printf("\nProcessing %s\n\n", input)
-	f, err := os.Open(input)
+	props, err := parseProperties(opt.properties)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
-
-	var gz io.Reader
-	if filepath.Ext(input) == ".gz" {
-		gz, err = gzip.NewReader(f)
-		if err != nil {
-			return err
-		}
-	} else {
-		gz = f
-	}
-
-	// TODO - This might not be a good idea for large files. Use json.Decode to read features.
-	b, err := ioutil.ReadAll(gz)
+	features, proj, err := readFeatures(input)
 	if err != nil {
 		return err
 	}
+	if opt.crs != "" {
+		if proj, err = parseCRS(opt.crs); err != nil {
+			return err
+		}
+	}
 	basename := filepath.Base(input)
 	name := strings.TrimSuffix(basename, filepath.Ext(basename))
 
@@ -92,17 +87,27 @@ func convertGeoFile(input string, output string) error {
 		che <- writeToFile(output, chb)
 	}()
 
-	fc := geojson.NewFeatureCollection()
-	err = json.Unmarshal(b, fc)
-	if err != nil {
-		return err
-	}
-
+	s := &geoSchema{indexable: true, types: make(map[string]types.TypeID)}
 	count := 0
 	rdfCount := 0
-	for _, f := range fc.Features {
+	skipped := 0
+	invalid := 0
+	for i, f := range features {
+		if f.Geometry == nil {
+			skipped++
+			continue
+		}
+		if err := transformGeometry(f.Geometry, proj, opt.simplify); err != nil {
+			close(chb)
+			<-che
+			return errors.Wrapf(err, "while converting feature %d", i)
+		}
+		s.indexable = s.indexable && isIndexable(f.Geometry)
+
 		b, err := json.Marshal(f.Geometry)
 		if err != nil {
+			close(chb)
+			<-che
 			return err
 		}
 
@@ -110,14 +115,28 @@ func convertGeoFile(input string, output string) error {
 		bn := fmt.Sprintf("_:%s-%d", name, count)
 		rdf := fmt.Sprintf("%s <%s> \"%s\"^^<geo:geojson> .\n", bn, opt.geopred, geometry)
 		chb <- []byte(rdf)
+		if opt.typ != "" {
+			rdfCount++
+			chb <- []byte(fmt.Sprintf("%s <dgraph.type> %s .\n", bn, escapedString(opt.typ)))
+		}
 
-		for k := range f.Properties {
-			// TODO - Support other types later.
-			if str, err := f.PropertyString(k); err == nil {
-				rdfCount++
-				rdf = fmt.Sprintf("%s <%s> \"%s\" .\n", bn, k, str)
-				chb <- []byte(rdf)
+		for _, p := range featureProperties(f, props) {
+			v, ok := f.Properties[p.name]
+			if !ok || v == nil {
+				continue
 			}
+			lit, tid, err := literal(v, p.tid)
+			if err != nil {
+				if invalid == 0 {
+					fmt.Printf("Skipping invalid value of property %s: %v\n", p.name, err)
+				}
+				invalid++
+				continue
+			}
+			s.add(p.predicate, tid)
+			rdfCount++
+			rdf = fmt.Sprintf("%s <%s> %s .\n", bn, p.predicate, lit)
+			chb <- []byte(rdf)
 		}
 		count++
 		rdfCount++
@@ -127,5 +146,92 @@ func convertGeoFile(input string, output string) error {
 	}
 	close(chb)
 	fmt.Printf("%d features converted. %d rdf's generated\n", count, rdfCount)
-	return <-che
+	if skipped > 0 {
+		fmt.Printf("%d features without a geometry skipped\n", skipped)
+	}
+	if invalid > 0 {
+		fmt.Printf("%d invalid property values skipped\n", invalid)
+	}
+	if err := <-che; err != nil {
+		return err
+	}
+	if opt.schema != "" {
+		return s.write(opt.schema)
+	}
+	return nil
+}
+
+// readFeatures reads the features of a geo file from its extension, and the projection of their
+// positions. The positions of KML and GPX files are always longitudes and latitudes.
+func readFeatures(input string) ([]*geojson.Feature, projection, error) {
+	ext := strings.ToLower(filepath.Ext(input))
+	switch ext {
+	case ".shp":
+		return readShapefile(input)
+	case ".kmz":
+		features, err := readKMZ(input)
+		return features, nil, err
+	}
+
+	f, err := os.Open(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var gz io.Reader
+	if ext == ".gz" {
+		gz, err = gzip.NewReader(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(input, filepath.Ext(input))))
+	} else {
+		gz = f
+	}
+
+	switch ext {
+	case ".kml":
+		features, err := readKML(gz)
+		return features, nil, err
+	case ".gpx":
+		features, err := readGPX(gz)
+		return features, nil, err
+	}
+
+	// TODO - This might not be a good idea for large files. Use json.Decode to read features.
+	b, err := ioutil.ReadAll(gz)
+	if err != nil {
+		return nil, nil, err
+	}
+	fc := geojson.NewFeatureCollection()
+	// Numbers are read as json.Number to tell integers from floats.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(fc); err != nil {
+		return nil, nil, err
+	}
+	proj, err := geoJSONProjection(fc.CRS)
+	return fc.Features, proj, err
+}
+
+// geoJSONProjection returns the projection of a named CRS of a GeoJSON file, like
+// {"type": "name", "properties": {"name": "urn:ogc:def:crs:EPSG::3857"}}. GeoJSON files without
+// one are in longitudes and latitudes.
+func geoJSONProjection(crs map[string]interface{}) (projection, error) {
+	props, _ := crs["properties"].(map[string]interface{})
+	name, _ := props["name"].(string)
+	if name == "" {
+		return nil, nil
+	}
+	return parseCRS(name)
+}
+
+func escapedString(str string) string {
+	// json.Marshal escapes the string as RDF expects it.
+	b, err := json.Marshal(str)
+	if err != nil {
+		return fmt.Sprintf("%q", str)
+	}
+	return string(b)
 }
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"math"
+
+	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
+)
+
+// transformGeometry reprojects the positions of a geometry to WGS84 and simplifies its lines and
+// rings with a tolerance in degrees. It also drops the altitude of the positions and closes the
+// rings of polygons, as Dgraph only accepts two dimensional positions and closed rings.
+func transformGeometry(g *geojson.Geometry, proj projection, tolerance float64) error {
+	t := &transformer{proj: proj, tolerance: tolerance}
+	return t.geometry(g)
+}
+
+type transformer struct {
+	proj      projection
+	tolerance float64
+}
+
+func (t *transformer) geometry(g *geojson.Geometry) error {
+	// The bounding box and CRS of the geometry are those of the original positions.
+	g.BoundingBox = nil
+	g.CRS = nil
+
+	var err error
+	switch g.Type {
+	case geojson.GeometryPoint:
+		g.Point, err = t.position(g.Point)
+	case geojson.GeometryMultiPoint:
+		g.MultiPoint, err = t.positions(g.MultiPoint)
+	case geojson.GeometryLineString:
+		g.LineString, err = t.line(g.LineString)
+	case geojson.GeometryMultiLineString:
+		for i, line := range g.MultiLineString {
+			if g.MultiLineString[i], err = t.line(line); err != nil {
+				break
+			}
+		}
+	case geojson.GeometryPolygon:
+		g.Polygon, err = t.polygon(g.Polygon)
+	case geojson.GeometryMultiPolygon:
+		for i, polygon := range g.MultiPolygon {
+			if g.MultiPolygon[i], err = t.polygon(polygon); err != nil {
+				break
+			}
+		}
+	case geojson.GeometryCollection:
+		for _, child := range g.Geometries {
+			if err = t.geometry(child); err != nil {
+				break
+			}
+		}
+	default:
+		err = errors.Errorf("unknown geometry type %s", g.Type)
+	}
+	return err
+}
+
+func (t *transformer) position(p []float64) ([]float64, error) {
+	if len(p) < 2 {
+		return nil, errors.Errorf("position %v has less than two coordinates", p)
+	}
+	x, y := p[0], p[1]
+	if t.proj != nil {
+		x, y = t.proj(x, y)
+	}
+	return []float64{x, y}, nil
+}
+
+func (t *transformer) positions(ps [][]float64) ([][]float64, error) {
+	out := make([][]float64, len(ps))
+	for i, p := range ps {
+		var err error
+		if out[i], err = t.position(p); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (t *transformer) line(ps [][]float64) ([][]float64, error) {
+	line, err := t.positions(ps)
+	if err != nil {
+		return nil, err
+	}
+	return simplify(line, t.tolerance), nil
+}
+
+// polygon closes and simplifies the rings of a polygon. The holes which the simplification
+// reduces to less than four positions are dropped, and the outer ring isn't simplified then.
+func (t *transformer) polygon(rings [][][]float64) ([][][]float64, error) {
+	if len(rings) == 0 {
+		return nil, errors.Errorf("polygon has no rings")
+	}
+	out := make([][][]float64, 0, len(rings))
+	for i, ps := range rings {
+		ring, err := t.positions(ps)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(ring); n > 0 && (ring[0][0] != ring[n-1][0] || ring[0][1] != ring[n-1][1]) {
+			ring = append(ring, ring[0])
+		}
+		if len(ring) < 4 {
+			return nil, errors.Errorf("ring %v has less than four positions", ps)
+		}
+		switch simplified := simplify(ring, t.tolerance); {
+		case len(simplified) >= 4:
+			out = append(out, simplified)
+		case i == 0:
+			out = append(out, ring)
+		}
+	}
+	return out, nil
+}
+
+// simplify simplifies a line with the Douglas-Peucker algorithm, which drops the positions that
+// are closer than the tolerance to the simplified line. The first and last positions are kept,
+// so rings stay closed.
+func simplify(line [][]float64, tolerance float64) [][]float64 {
+	if tolerance <= 0 || len(line) < 3 {
+		return line
+	}
+	keep := make([]bool, len(line))
+	keep[0], keep[len(line)-1] = true, true
+	douglasPeucker(line, 0, len(line)-1, tolerance, keep)
+
+	out := make([][]float64, 0, len(line))
+	for i, p := range line {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func douglasPeucker(line [][]float64, first, last int, tolerance float64, keep []bool) {
+	farthest, max := 0, 0.0
+	for i := first + 1; i < last; i++ {
+		if d := segmentDistance(line[i], line[first], line[last]); d > max {
+			farthest, max = i, d
+		}
+	}
+	if max <= tolerance {
+		return
+	}
+	keep[farthest] = true
+	douglasPeucker(line, first, farthest, tolerance, keep)
+	douglasPeucker(line, farthest, last, tolerance, keep)
+}
+
+// segmentDistance returns the planar distance from p to the segment from a to b.
+func segmentDistance(p, a, b []float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
+
+// isIndexable returns whether the geo index of Dgraph supports the geometry.
+func isIndexable(g *geojson.Geometry) bool {
+	switch g.Type {
+	case geojson.GeometryPoint, geojson.GeometryPolygon, geojson.GeometryMultiPolygon:
+		return true
+	}
+	return false
+}
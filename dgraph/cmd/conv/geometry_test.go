@@ -0,0 +1,121 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"testing"
+
+	"github.com/paulmach/go.geojson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      [][]float64
+		tolerance float64
+		want      [][]float64
+	}{
+		{
+			name:      "no tolerance",
+			line:      [][]float64{{0, 0}, {1, 0.1}, {2, 0}},
+			tolerance: 0,
+			want:      [][]float64{{0, 0}, {1, 0.1}, {2, 0}},
+		},
+		{
+			name:      "two positions",
+			line:      [][]float64{{0, 0}, {2, 0}},
+			tolerance: 1,
+			want:      [][]float64{{0, 0}, {2, 0}},
+		},
+		{
+			name:      "within tolerance",
+			line:      [][]float64{{0, 0}, {1, 0.1}, {2, -0.1}, {3, 0}},
+			tolerance: 0.2,
+			want:      [][]float64{{0, 0}, {3, 0}},
+		},
+		{
+			name:      "farthest kept",
+			line:      [][]float64{{0, 0}, {1, 0.45}, {2, 1}, {3, 0.45}, {4, 0}},
+			tolerance: 0.2,
+			want:      [][]float64{{0, 0}, {2, 1}, {4, 0}},
+		},
+		{
+			name:      "recursive",
+			line:      [][]float64{{0, 0}, {1, 1}, {2, 0}, {3, -1}, {4, 0}},
+			tolerance: 0.5,
+			want:      [][]float64{{0, 0}, {1, 1}, {3, -1}, {4, 0}},
+		},
+		{
+			name:      "closed ring",
+			line:      [][]float64{{0, 0}, {0, 1}, {0.5, 1.01}, {1, 1}, {1, 0}, {0, 0}},
+			tolerance: 0.1,
+			want:      [][]float64{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}},
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, simplify(tc.line, tc.tolerance), tc.name)
+	}
+}
+
+func TestSegmentDistance(t *testing.T) {
+	tests := []struct {
+		p, a, b []float64
+		want    float64
+	}{
+		{[]float64{1, 1}, []float64{0, 0}, []float64{2, 0}, 1},
+		{[]float64{-3, 4}, []float64{0, 0}, []float64{2, 0}, 5},
+		{[]float64{5, 4}, []float64{0, 0}, []float64{2, 0}, 5},
+		{[]float64{3, 4}, []float64{0, 0}, []float64{0, 0}, 5},
+	}
+	for _, tc := range tests {
+		require.InDelta(t, tc.want, segmentDistance(tc.p, tc.a, tc.b), 1e-12, "%v", tc)
+	}
+}
+
+func TestTransformGeometry(t *testing.T) {
+	// The ring is closed and simplified, the hole which is simplified away is dropped, and the
+	// altitudes are dropped.
+	g := geojson.NewPolygonGeometry([][][]float64{
+		{{0, 0, 5}, {0, 1, 5}, {0.5, 1.01, 5}, {1, 1, 5}, {1, 0, 5}},
+		{{0.4, 0.4}, {0.41, 0.4}, {0.41, 0.41}, {0.4, 0.4}},
+	})
+	g.BoundingBox = []float64{0, 0, 1, 1}
+	require.NoError(t, transformGeometry(g, nil, 0.1))
+	require.Equal(t, [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}, g.Polygon)
+	require.Nil(t, g.BoundingBox)
+
+	// The outer ring is kept unsimplified if the simplification leaves too few positions.
+	g = geojson.NewPolygonGeometry([][][]float64{{{0, 0}, {0.01, 0}, {0.01, 0.01}, {0, 0}}})
+	require.NoError(t, transformGeometry(g, nil, 0.1))
+	require.Equal(t, [][][]float64{{{0, 0}, {0.01, 0}, {0.01, 0.01}, {0, 0}}}, g.Polygon)
+
+	// The positions are projected.
+	proj, err := parseCRS("EPSG:32633")
+	require.NoError(t, err)
+	g = geojson.NewCollectionGeometry(geojson.NewPointGeometry([]float64{500000, 0}))
+	require.NoError(t, transformGeometry(g, proj, 0))
+	require.InDeltaSlice(t, []float64{15, 0}, g.Geometries[0].Point, 1e-9)
+
+	for _, g := range []*geojson.Geometry{
+		geojson.NewPointGeometry([]float64{1}),
+		geojson.NewPolygonGeometry([][][]float64{{{0, 0}, {1, 1}, {0, 0}}}),
+		geojson.NewPolygonGeometry(nil),
+	} {
+		require.Error(t, transformGeometry(g, nil, 0), "%v", g)
+	}
+}
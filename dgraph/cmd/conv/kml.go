@@ -0,0 +1,297 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
+)
+
+type kmlPlacemark struct {
+	Name        string `xml:"name"`
+	Description string `xml:"description"`
+	Data        []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value"`
+	} `xml:"ExtendedData>Data"`
+	SimpleData []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"ExtendedData>SchemaData>SimpleData"`
+	kmlGeometries
+}
+
+type kmlGeometries struct {
+	Points        []kmlCoordinates `xml:"Point"`
+	LineStrings   []kmlCoordinates `xml:"LineString"`
+	LinearRings   []kmlCoordinates `xml:"LinearRing"`
+	Polygons      []kmlPolygon     `xml:"Polygon"`
+	MultiGeometry []kmlGeometries  `xml:"MultiGeometry"`
+}
+
+type kmlCoordinates struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlPolygon struct {
+	Outer kmlCoordinates   `xml:"outerBoundaryIs>LinearRing"`
+	Inner []kmlCoordinates `xml:"innerBoundaryIs>LinearRing"`
+}
+
+// readKML reads the placemarks of a KML document as features, whose properties are their name,
+// description and extended data. The placemarks can be nested in any number of folders.
+func readKML(r io.Reader) ([]*geojson.Feature, error) {
+	var features []*geojson.Feature
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return features, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading KML")
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "Placemark" {
+			continue
+		}
+
+		var p kmlPlacemark
+		if err := dec.DecodeElement(&p, &start); err != nil {
+			return nil, errors.Wrapf(err, "while reading KML placemark")
+		}
+		g, err := p.geometry()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading KML placemark %q", p.Name)
+		}
+		f := geojson.NewFeature(g)
+		setProperty(f, "name", p.Name)
+		setProperty(f, "description", p.Description)
+		for _, d := range p.Data {
+			setProperty(f, d.Name, d.Value)
+		}
+		for _, d := range p.SimpleData {
+			setProperty(f, d.Name, d.Value)
+		}
+		features = append(features, f)
+	}
+}
+
+// readKMZ reads the KML document of a KMZ archive, which is its first .kml file.
+func readKMZ(path string) ([]*geojson.Feature, error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while opening KMZ file %s", path)
+	}
+	defer z.Close()
+
+	for _, file := range z.File {
+		if strings.ToLower(filepath.Ext(file.Name)) != ".kml" {
+			continue
+		}
+		r, err := file.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while opening %s in %s", file.Name, path)
+		}
+		defer r.Close()
+		return readKML(r)
+	}
+	return nil, errors.Errorf("KMZ file %s has no KML document", path)
+}
+
+// geometry returns the geometry of the placemark. A placemark with more than one geometry, in a
+// MultiGeometry, has a multipolygon if they are all polygons, and a geometry collection otherwise.
+func (g *kmlGeometries) geometry() (*geojson.Geometry, error) {
+	var geometries []*geojson.Geometry
+	for _, p := range g.Points {
+		ps, err := p.positions()
+		if err != nil {
+			return nil, err
+		}
+		if len(ps) != 1 {
+			return nil, errors.Errorf("point has %d positions", len(ps))
+		}
+		geometries = append(geometries, geojson.NewPointGeometry(ps[0]))
+	}
+	for _, l := range append(g.LineStrings, g.LinearRings...) {
+		ps, err := l.positions()
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, geojson.NewLineStringGeometry(ps))
+	}
+	for _, p := range g.Polygons {
+		var rings [][][]float64
+		for _, r := range append([]kmlCoordinates{p.Outer}, p.Inner...) {
+			ps, err := r.positions()
+			if err != nil {
+				return nil, err
+			}
+			rings = append(rings, ps)
+		}
+		geometries = append(geometries, geojson.NewPolygonGeometry(rings))
+	}
+	for _, m := range g.MultiGeometry {
+		child, err := m.geometry()
+		if err != nil {
+			return nil, err
+		}
+		if child != nil {
+			geometries = append(geometries, child)
+		}
+	}
+
+	switch len(geometries) {
+	case 0:
+		return nil, nil
+	case 1:
+		return geometries[0], nil
+	}
+	var polygons [][][][]float64
+	for _, child := range geometries {
+		switch child.Type {
+		case geojson.GeometryPolygon:
+			polygons = append(polygons, child.Polygon)
+		case geojson.GeometryMultiPolygon:
+			polygons = append(polygons, child.MultiPolygon...)
+		default:
+			return geojson.NewCollectionGeometry(geometries...), nil
+		}
+	}
+	return geojson.NewMultiPolygonGeometry(polygons...), nil
+}
+
+// positions parses the space separated lon,lat[,alt] tuples of the coordinates.
+func (c kmlCoordinates) positions() ([][]float64, error) {
+	var ps [][]float64
+	for _, tuple := range strings.Fields(c.Coordinates) {
+		coords := strings.Split(tuple, ",")
+		if len(coords) < 2 {
+			return nil, errors.Errorf("invalid coordinates %q", tuple)
+		}
+		p := make([]float64, len(coords))
+		for i, coord := range coords {
+			var err error
+			if p[i], err = strconv.ParseFloat(coord, 64); err != nil {
+				return nil, errors.Errorf("invalid coordinates %q", tuple)
+			}
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+type gpxFile struct {
+	Waypoints []gpxPoint `xml:"wpt"`
+	Routes    []struct {
+		gpxMetadata
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+	Tracks []struct {
+		gpxMetadata
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+type gpxMetadata struct {
+	Name        string `xml:"name"`
+	Description string `xml:"desc"`
+	Type        string `xml:"type"`
+}
+
+type gpxPoint struct {
+	gpxMetadata
+	Lat       float64  `xml:"lat,attr"`
+	Lon       float64  `xml:"lon,attr"`
+	Elevation *float64 `xml:"ele"`
+	Time      string   `xml:"time"`
+}
+
+// readGPX reads the waypoints of a GPX file as points, and its routes and tracks as lines. The
+// elevation and time of the waypoints are properties of their features.
+func readGPX(r io.Reader) ([]*geojson.Feature, error) {
+	var gpx gpxFile
+	if err := xml.NewDecoder(r).Decode(&gpx); err != nil {
+		return nil, errors.Wrapf(err, "while reading GPX")
+	}
+
+	var features []*geojson.Feature
+	for _, w := range gpx.Waypoints {
+		f := gpxFeature(geojson.NewPointGeometry([]float64{w.Lon, w.Lat}), w.gpxMetadata)
+		if w.Elevation != nil {
+			f.Properties["ele"] = *w.Elevation
+		}
+		if t, err := time.Parse(time.RFC3339Nano, w.Time); err == nil {
+			f.Properties["time"] = t
+		}
+		features = append(features, f)
+	}
+	for _, rte := range gpx.Routes {
+		line := gpxPositions(rte.Points)
+		features = append(features, gpxFeature(geojson.NewLineStringGeometry(line),
+			rte.gpxMetadata))
+	}
+	for _, trk := range gpx.Tracks {
+		var lines [][][]float64
+		for _, seg := range trk.Segments {
+			lines = append(lines, gpxPositions(seg.Points))
+		}
+		var g *geojson.Geometry
+		switch len(lines) {
+		case 0:
+		case 1:
+			g = geojson.NewLineStringGeometry(lines[0])
+		default:
+			g = geojson.NewMultiLineStringGeometry(lines...)
+		}
+		features = append(features, gpxFeature(g, trk.gpxMetadata))
+	}
+	return features, nil
+}
+
+func gpxFeature(g *geojson.Geometry, m gpxMetadata) *geojson.Feature {
+	f := geojson.NewFeature(g)
+	setProperty(f, "name", m.Name)
+	setProperty(f, "desc", m.Description)
+	setProperty(f, "type", m.Type)
+	return f
+}
+
+func gpxPositions(points []gpxPoint) [][]float64 {
+	ps := make([][]float64, len(points))
+	for i, p := range points {
+		ps[i] = []float64{p.Lon, p.Lat}
+	}
+	return ps
+}
+
+// setProperty sets a string property of a feature, unless it is empty.
+func setProperty(f *geojson.Feature, name, value string) {
+	if value = strings.TrimSpace(value); name != "" && value != "" {
+		f.Properties[name] = value
+	}
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A projection converts projected coordinates to the longitude and latitude of WGS84. A nil
+// projection leaves the coordinates unchanged. The datum shifts between WGS84 and the other
+// datums, like NAD83 or ETRS89, are ignored as they are below the precision of the geo index.
+type projection func(x, y float64) (lon, lat float64)
+
+// Semi-major axis and inverse flattening of the ellipsoids.
+const (
+	wgs84A  = 6378137.0
+	wgs84Rf = 298.257223563
+	grs80Rf = 298.257222101
+)
+
+// parseCRS returns the projection of a coordinate reference system given by its EPSG code, like
+// EPSG:3857 or urn:ogc:def:crs:EPSG::32633.
+func parseCRS(name string) (projection, error) {
+	crs := strings.ToUpper(strings.TrimSpace(name))
+	if strings.HasSuffix(crs, "CRS84") {
+		return nil, nil
+	}
+	if i := strings.LastIndex(crs, "EPSG:"); i >= 0 {
+		crs = strings.TrimLeft(crs[i+len("EPSG:"):], ":")
+	}
+	code, err := strconv.Atoi(crs)
+	if err != nil {
+		return nil, errors.Errorf("unknown CRS %q, it should be an EPSG code like EPSG:4326", name)
+	}
+
+	switch {
+	case code == 4326, code == 4269, code == 4258:
+		// WGS84, NAD83 and ETRS89 longitudes and latitudes.
+		return nil, nil
+	case code == 3857, code == 3785, code == 900913, code == 102100:
+		return webMercator, nil
+	case code > 32600 && code <= 32660:
+		return utm(code-32600, true, wgs84Rf), nil
+	case code > 32700 && code <= 32760:
+		return utm(code-32700, false, wgs84Rf), nil
+	case code > 26900 && code <= 26923:
+		// NAD83 UTM zones.
+		return utm(code-26900, true, grs80Rf), nil
+	case code >= 25828 && code <= 25838:
+		// ETRS89 UTM zones.
+		return utm(code-25800, true, grs80Rf), nil
+	}
+	return nil, errors.Errorf("unsupported CRS %s, reproject the file to EPSG:4326 first, "+
+		"for example with ogr2ogr", name)
+}
+
+// parsePRJ returns the projection described by the WKT of the .prj file of a Shapefile.
+func parsePRJ(wkt string) (projection, error) {
+	root, err := parseWKT(wkt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing projection")
+	}
+	switch root.keyword {
+	case "GEOGCS":
+		return nil, nil
+	case "PROJCS":
+	default:
+		return nil, errors.Errorf("unknown coordinate system %s", root.keyword)
+	}
+
+	name := strings.ToLower(root.name())
+	projName := root.child("PROJECTION").name()
+	method := strings.ToLower(projName)
+	switch {
+	case method == "mercator_auxiliary_sphere", method == "popular_visualisation_pseudo_mercator",
+		strings.Contains(name, "pseudo-mercator"), strings.Contains(name, "web_mercator"):
+		return webMercator, nil
+	case method != "transverse_mercator":
+		return nil, errors.Errorf("unsupported projection %s of %s, reproject the file to "+
+			"EPSG:4326 first, for example with ogr2ogr", projName, root.name())
+	}
+
+	params := make(map[string]float64)
+	for _, n := range root.children("PARAMETER") {
+		params[strings.ToLower(n.name())] = n.number(1, 0)
+	}
+	tm := &transverseMercator{
+		a:    wgs84A,
+		rf:   wgs84Rf,
+		k0:   params["scale_factor"],
+		lon0: params["central_meridian"] * math.Pi / 180,
+		lat0: params["latitude_of_origin"] * math.Pi / 180,
+		fe:   params["false_easting"],
+		fn:   params["false_northing"],
+		unit: root.child("UNIT").number(1, 1),
+	}
+	if s := root.find("SPHEROID"); s != nil {
+		tm.a, tm.rf = s.number(1, wgs84A), s.number(2, wgs84Rf)
+	}
+	if tm.k0 == 0 {
+		tm.k0 = 1
+	}
+	return tm.inverse, nil
+}
+
+// webMercator converts the coordinates of EPSG:3857, which projects WGS84 on a sphere.
+func webMercator(x, y float64) (lon, lat float64) {
+	lon = x / wgs84A * 180 / math.Pi
+	lat = (2*math.Atan(math.Exp(y/wgs84A)) - math.Pi/2) * 180 / math.Pi
+	return lon, lat
+}
+
+// utm returns the projection of a UTM zone.
+func utm(zone int, north bool, rf float64) projection {
+	tm := &transverseMercator{
+		a:    wgs84A,
+		rf:   rf,
+		k0:   0.9996,
+		lon0: float64(6*zone-183) * math.Pi / 180,
+		fe:   500000,
+		unit: 1,
+	}
+	if !north {
+		tm.fn = 10000000
+	}
+	return tm.inverse
+}
+
+type transverseMercator struct {
+	// Semi-major axis and inverse flattening of the ellipsoid.
+	a, rf float64
+	// Scale factor on the central meridian.
+	k0 float64
+	// Longitude of the central meridian and latitude of origin, in radians.
+	lon0, lat0 float64
+	// False easting and northing, in projected units.
+	fe, fn float64
+	// Length of the projected units in meters.
+	unit float64
+}
+
+// inverse converts the coordinates with the formulas of Snyder's Map Projections: A Working
+// Manual, which are accurate to the millimeter within a few degrees of the central meridian.
+func (tm *transverseMercator) inverse(x, y float64) (lon, lat float64) {
+	f := 1 / tm.rf
+	e2 := 2*f - f*f
+	ep2 := e2 / (1 - e2)
+	x = (x - tm.fe) * tm.unit
+	y = (y - tm.fn) * tm.unit
+
+	m := tm.meridianArc(tm.lat0, e2) + y/tm.k0
+	mu := m / (tm.a * (1 - e2/4 - 3*e2*e2/64 - 5*e2*e2*e2/256))
+	e1 := (1 - math.Sqrt(1-e2)) / (1 + math.Sqrt(1-e2))
+	phi := mu + (3*e1/2-27*math.Pow(e1, 3)/32)*math.Sin(2*mu) +
+		(21*e1*e1/16-55*math.Pow(e1, 4)/32)*math.Sin(4*mu) +
+		(151*math.Pow(e1, 3)/96)*math.Sin(6*mu) +
+		(1097*math.Pow(e1, 4)/512)*math.Sin(8*mu)
+
+	sin, cos, tan := math.Sin(phi), math.Cos(phi), math.Tan(phi)
+	c := ep2 * cos * cos
+	t := tan * tan
+	n := tm.a / math.Sqrt(1-e2*sin*sin)
+	r := tm.a * (1 - e2) / math.Pow(1-e2*sin*sin, 1.5)
+	d := x / (n * tm.k0)
+
+	lat = phi - (n*tan/r)*(d*d/2-
+		(5+3*t+10*c-4*c*c-9*ep2)*math.Pow(d, 4)/24+
+		(61+90*t+298*c+45*t*t-252*ep2-3*c*c)*math.Pow(d, 6)/720)
+	lon = tm.lon0 + (d-(1+2*t+c)*math.Pow(d, 3)/6+
+		(5-2*c+28*t-3*c*c+8*ep2+24*t*t)*math.Pow(d, 5)/120)/cos
+	return lon * 180 / math.Pi, lat * 180 / math.Pi
+}
+
+// meridianArc returns the distance from the equator to a latitude along a meridian.
+func (tm *transverseMercator) meridianArc(phi, e2 float64) float64 {
+	e4, e6 := e2*e2, e2*e2*e2
+	return tm.a * ((1-e2/4-3*e4/64-5*e6/256)*phi -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*phi) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*phi) -
+		(35*e6/3072)*math.Sin(6*phi))
+}
+
+// wktNode is a node of a coordinate system in Well-Known Text, like
+// PARAMETER["False_Easting",500000.0]. Its arguments are strings, numbers or nodes.
+type wktNode struct {
+	keyword string
+	args    []interface{}
+}
+
+func parseWKT(wkt string) (*wktNode, error) {
+	p := &wktParser{s: wkt}
+	n, err := p.node()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.s) {
+		return nil, errors.Errorf("unexpected %q at offset %d", p.s[p.pos:], p.pos)
+	}
+	return n, nil
+}
+
+// name returns the first argument of the node, which is its name. It is empty for a nil node.
+func (n *wktNode) name() string {
+	if n == nil || len(n.args) == 0 {
+		return ""
+	}
+	s, _ := n.args[0].(string)
+	return s
+}
+
+// number returns the i-th argument of the node, or def if it isn't a number.
+func (n *wktNode) number(i int, def float64) float64 {
+	if n == nil || i >= len(n.args) {
+		return def
+	}
+	if f, ok := n.args[i].(float64); ok {
+		return f
+	}
+	return def
+}
+
+// children returns the arguments of the node which are nodes with the keyword.
+func (n *wktNode) children(keyword string) []*wktNode {
+	var nodes []*wktNode
+	for _, arg := range n.args {
+		if c, ok := arg.(*wktNode); ok && c.keyword == keyword {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// child returns the first argument of the node which is a node with the keyword, or nil.
+func (n *wktNode) child(keyword string) *wktNode {
+	if nodes := n.children(keyword); len(nodes) > 0 {
+		return nodes[0]
+	}
+	return nil
+}
+
+// find returns the first node with the keyword under the node, depth first, or nil.
+func (n *wktNode) find(keyword string) *wktNode {
+	for _, arg := range n.args {
+		c, ok := arg.(*wktNode)
+		if !ok {
+			continue
+		}
+		if c.keyword == keyword {
+			return c
+		}
+		if f := c.find(keyword); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// node parses a keyword and its arguments between brackets or parentheses. A keyword without
+// arguments, like EAST in AXIS["X",EAST], is a node without arguments.
+func (p *wktParser) node() (*wktNode, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '_' || isLetterOrDigit(p.s[p.pos])) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, errors.Errorf("expected a keyword at offset %d", start)
+	}
+	n := &wktNode{keyword: strings.ToUpper(p.s[start:p.pos])}
+	if p.skipSpace(); p.pos >= len(p.s) || (p.s[p.pos] != '[' && p.s[p.pos] != '(') {
+		return n, nil
+	}
+	p.pos++
+
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return nil, errors.Errorf("unterminated %s", n.keyword)
+		}
+		switch c := p.s[p.pos]; {
+		case c == '"':
+			end := strings.IndexByte(p.s[p.pos+1:], '"')
+			if end < 0 {
+				return nil, errors.Errorf("unterminated string at offset %d", p.pos)
+			}
+			n.args = append(n.args, p.s[p.pos+1:p.pos+1+end])
+			p.pos += end + 2
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			start := p.pos
+			for p.pos < len(p.s) && strings.IndexByte("0123456789.eE+-", p.s[p.pos]) >= 0 {
+				p.pos++
+			}
+			f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while parsing number at offset %d", start)
+			}
+			n.args = append(n.args, f)
+		default:
+			child, err := p.node()
+			if err != nil {
+				return nil, err
+			}
+			n.args = append(n.args, child)
+		}
+
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return nil, errors.Errorf("unterminated %s", n.keyword)
+		}
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case ']', ')':
+			p.pos++
+			return n, nil
+		default:
+			return nil, errors.Errorf("unexpected %q at offset %d", p.s[p.pos], p.pos)
+		}
+	}
+}
+
+func isLetterOrDigit(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// utm33N is the WKT of the .prj file of EPSG:32633 written by ESRI tools.
+const utm33N = `PROJCS["WGS_1984_UTM_Zone_33N",GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",` +
+	`SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],` +
+	`UNIT["Degree",0.0174532925199433]],PROJECTION["Transverse_Mercator"],` +
+	`PARAMETER["False_Easting",500000.0],PARAMETER["False_Northing",0.0],` +
+	`PARAMETER["Central_Meridian",15.0],PARAMETER["Scale_Factor",0.9996],` +
+	`PARAMETER["Latitude_Of_Origin",0.0],UNIT["Meter",1.0]]`
+
+// requireLonLat checks that a projection converts x and y to lon and lat.
+func requireLonLat(t *testing.T, proj projection, x, y, lon, lat float64) {
+	gotLon, gotLat := proj(x, y)
+	requirePosition(t, []float64{gotLon, gotLat}, lon, lat)
+}
+
+// requirePosition checks that a position is at lon and lat within about a centimeter.
+func requirePosition(t *testing.T, p []float64, lon, lat float64) {
+	require.InDelta(t, lon, p[0], 1e-7, "longitude of %v", p)
+	require.InDelta(t, lat, p[1], 1e-7, "latitude of %v", p)
+}
+
+func TestParseCRS(t *testing.T) {
+	// The projected coordinates of the points were computed with the series of Krüger, which
+	// are accurate to the nanometer.
+	tests := []struct {
+		crs      string
+		x, y     float64
+		lon, lat float64
+	}{
+		{"EPSG:32633", 500000, 0, 15, 0},
+		{"EPSG:32633", 391779.2593, 5820072.1592, 13.405, 52.52},
+		{"EPSG:32633", 602065.2074, 5340353.5943, 16.3738, 48.2082},
+		{"urn:ogc:def:crs:EPSG::32633", 438308.1774, 4522563.4111, 14.2681, 40.8518},
+		{"EPSG:32618", 583959.3723, 4507350.9982, -74.006, 40.7128},
+		{"EPSG:32756", 334368.6336, 6250948.3454, 151.2093, -33.8688},
+		{"EPSG:3857", 0, 0, 0, 0},
+		{"EPSG:3857", 1492237.7741, 6894699.8013, 13.405, 52.52},
+		{"EPSG:900913", 1822723.0784, 6141562.0543, 16.3738, 48.2082},
+		{"EPSG:3857", -20037508.3428, 20037508.3428, -180, 85.0511287798},
+	}
+	for _, tc := range tests {
+		proj, err := parseCRS(tc.crs)
+		require.NoError(t, err, tc.crs)
+		require.NotNil(t, proj, tc.crs)
+		requireLonLat(t, proj, tc.x, tc.y, tc.lon, tc.lat)
+	}
+
+	for _, crs := range []string{"EPSG:4326", "epsg:4269", "urn:ogc:def:crs:OGC:1.3:CRS84"} {
+		proj, err := parseCRS(crs)
+		require.NoError(t, err, crs)
+		require.Nil(t, proj, crs)
+	}
+	for _, crs := range []string{"EPSG:2154", "WGS84", "EPSG:32661"} {
+		_, err := parseCRS(crs)
+		require.Error(t, err, crs)
+	}
+}
+
+func TestParsePRJ(t *testing.T) {
+	proj, err := parsePRJ(utm33N)
+	require.NoError(t, err)
+	requireLonLat(t, proj, 391779.2593, 5820072.1592, 13.405, 52.52)
+
+	// The false easting and northing are in the units of the projection.
+	feet := `PROJCS["Test",GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",` +
+		`SPHEROID["WGS_1984",6378137.0,298.257223563]]],PROJECTION["Transverse_Mercator"],` +
+		`PARAMETER["False_Easting",1640419.9475],PARAMETER["Central_Meridian",15.0],` +
+		`PARAMETER["Scale_Factor",0.9996],UNIT["Foot",0.3048]]`
+	proj, err = parsePRJ(feet)
+	require.NoError(t, err)
+	requireLonLat(t, proj, 391779.2593/0.3048, 5820072.1592/0.3048, 13.405, 52.52)
+
+	mercator := `PROJCS["WGS_1984_Web_Mercator_Auxiliary_Sphere",GEOGCS["GCS_WGS_1984",` +
+		`DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]]],` +
+		`PROJECTION["Mercator_Auxiliary_Sphere"],UNIT["Meter",1.0]]`
+	proj, err = parsePRJ(mercator)
+	require.NoError(t, err)
+	requireLonLat(t, proj, 1492237.7741, 6894699.8013, 13.405, 52.52)
+
+	proj, err = parsePRJ(`GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",` +
+		`SPHEROID["WGS_1984",6378137.0,298.257223563]]]`)
+	require.NoError(t, err)
+	require.Nil(t, proj)
+
+	_, err = parsePRJ(`PROJCS["NAD83 / Conus Albers",PROJECTION["Albers_Conic_Equal_Area"]]`)
+	require.Error(t, err)
+}
+
+func TestParseWKT(t *testing.T) {
+	n, err := parseWKT(` PARAMETER [ "False_Easting" , -5.0e+5 ] `)
+	require.NoError(t, err)
+	require.Equal(t, &wktNode{keyword: "PARAMETER", args: []interface{}{"False_Easting", -5e5}}, n)
+
+	n, err = parseWKT(`projcs("A",axis["X",EAST],UNIT["m",1],Unit["ft",0.3048])`)
+	require.NoError(t, err)
+	require.Equal(t, "PROJCS", n.keyword)
+	require.Equal(t, "A", n.name())
+	require.Equal(t, &wktNode{keyword: "EAST"}, n.child("AXIS").args[1])
+	require.Len(t, n.children("UNIT"), 2)
+	require.Equal(t, 1.0, n.child("UNIT").number(1, 0))
+	require.Equal(t, 2.0, n.child("UNIT").number(2, 2))
+	require.Equal(t, 3.0, n.child("UNIT").number(0, 3))
+	require.Nil(t, n.child("PRIMEM"))
+	require.Equal(t, "", n.child("PRIMEM").name())
+
+	root, err := parseWKT(utm33N)
+	require.NoError(t, err)
+	require.Equal(t, "WGS_1984", root.find("SPHEROID").name())
+	require.Equal(t, 298.257223563, root.find("SPHEROID").number(2, 0))
+	require.Len(t, root.children("PARAMETER"), 5)
+
+	for _, wkt := range []string{
+		``,
+		`["A"]`,
+		`PROJCS["A"`,
+		`PROJCS["A]`,
+		`PROJCS["A",1 2]`,
+		`PROJCS["A",1.2.3]`,
+		`PROJCS["A"] extra`,
+	} {
+		_, err := parseWKT(wkt)
+		require.Error(t, err, wkt)
+	}
+}
+
+func TestTransverseMercatorRoundTrip(t *testing.T) {
+	// The inverse of the origin of the projection is its central meridian and latitude of
+	// origin, whatever its false easting and northing.
+	tm := &transverseMercator{a: wgs84A, rf: grs80Rf, k0: 1, lon0: 10 * math.Pi / 180,
+		lat0: 45 * math.Pi / 180, fe: 1000, fn: 2000, unit: 1}
+	requireLonLat(t, tm.inverse, 1000, 2000, 10, 45)
+}
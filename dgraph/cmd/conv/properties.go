@@ -0,0 +1,205 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
+)
+
+// Map from our types to RDF types, as in the RDF exports.
+var rdfTypeMap = map[types.TypeID]string{
+	types.StringID:   "xs:string",
+	types.DateTimeID: "xs:dateTime",
+	types.IntID:      "xs:int",
+	types.FloatID:    "xs:float",
+	types.BoolID:     "xs:boolean",
+}
+
+// property maps a property of the features to a predicate. The values of a property of the
+// default type keep the type they have in the file.
+type property struct {
+	name      string
+	predicate string
+	tid       types.TypeID
+}
+
+// parseProperties parses a comma separated list of property[:predicate[:type]] mappings, like
+// NAME:name,POP:population:int.
+func parseProperties(spec string) ([]*property, error) {
+	var props []*property
+	for _, m := range strings.Split(spec, ",") {
+		if m = strings.TrimSpace(m); m == "" {
+			continue
+		}
+		parts := strings.Split(m, ":")
+		if len(parts) > 3 || parts[0] == "" {
+			return nil, errors.Errorf("invalid property mapping %q, it should be "+
+				"property[:predicate[:type]]", m)
+		}
+		p := &property{name: parts[0], predicate: parts[0], tid: types.DefaultID}
+		if len(parts) > 1 && parts[1] != "" {
+			p.predicate = parts[1]
+		}
+		if len(parts) > 2 && parts[2] != "" {
+			tid, ok := types.TypeForName(parts[2])
+			if _, scalar := rdfTypeMap[tid]; !ok || !scalar {
+				return nil, errors.Errorf("invalid type %q of property %s, it should be one of "+
+					"string, int, float, bool or datetime", parts[2], p.name)
+			}
+			p.tid = tid
+		}
+		props = append(props, p)
+	}
+	return props, nil
+}
+
+// featureProperties returns the mapped properties, or all the properties of the feature, sorted
+// by name, if none are mapped.
+func featureProperties(f *geojson.Feature, props []*property) []*property {
+	if len(props) > 0 {
+		return props
+	}
+	names := make([]string, 0, len(f.Properties))
+	for name := range f.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	all := make([]*property, len(names))
+	for i, name := range names {
+		all[i] = &property{name: name, predicate: name, tid: types.DefaultID}
+	}
+	return all
+}
+
+// literal returns the RDF literal of a property value of a type, and the type. The values of the
+// default type are JSON numbers, which are ints or floats, and the values read from Shapefiles
+// and GPX files, which have the type of their field. Strings and JSON objects and arrays are
+// strings. Values of the other types are converted to the type.
+func literal(v interface{}, tid types.TypeID) (string, types.TypeID, error) {
+	var str string
+	valueType := types.StringID
+	switch v := v.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+		if _, err := v.Int64(); err == nil {
+			valueType = types.IntID
+		} else {
+			valueType = types.FloatID
+		}
+	case int64:
+		str, valueType = strconv.FormatInt(v, 10), types.IntID
+	case float64:
+		str, valueType = strconv.FormatFloat(v, 'f', -1, 64), types.FloatID
+	case bool:
+		str, valueType = strconv.FormatBool(v), types.BoolID
+	case time.Time:
+		str, valueType = v.Format(time.RFC3339Nano), types.DateTimeID
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", 0, err
+		}
+		str = string(b)
+	}
+
+	if tid == types.DefaultID {
+		tid = valueType
+	}
+	if tid == types.StringID {
+		return escapedString(str), tid, nil
+	}
+	val, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(str)}, tid)
+	if err != nil {
+		return "", 0, err
+	}
+	out := types.Val{Tid: types.StringID}
+	if err := types.Marshal(val, &out); err != nil {
+		return "", 0, err
+	}
+	return fmt.Sprintf("%s^^<%s>", escapedString(out.Value.(string)), rdfTypeMap[tid]), tid, nil
+}
+
+// geoSchema holds the types of the predicates of the converted features.
+type geoSchema struct {
+	// indexable is whether the geo index supports all the geometries.
+	indexable bool
+	types     map[string]types.TypeID
+}
+
+// add adds a value of a type to a predicate. A predicate with int and float values is a float,
+// and one with values of other different types is a string.
+func (s *geoSchema) add(pred string, tid types.TypeID) {
+	old, ok := s.types[pred]
+	switch {
+	case !ok || old == tid:
+		s.types[pred] = tid
+	case old.IsNumber() && tid.IsNumber():
+		s.types[pred] = types.FloatID
+	default:
+		s.types[pred] = types.StringID
+	}
+}
+
+// write writes the schema of the predicates, and the type of the features if they have one.
+func (s *geoSchema) write(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "while creating schema file %s", path)
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	preds := make([]string, 0, len(s.types))
+	for pred := range s.types {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+
+	// Dgraph stores lines and multipoints, like the tracks of GPX files, but can't index them.
+	index := ""
+	if s.indexable {
+		index = " @index(geo)"
+	}
+	fmt.Fprintf(w, "<%s>: geo%s .\n", opt.geopred, index)
+	for _, pred := range preds {
+		fmt.Fprintf(w, "<%s>: %s .\n", pred, s.types[pred].Name())
+	}
+	if opt.typ != "" {
+		fmt.Fprintf(w, "\ntype <%s> {\n  <%s>\n", opt.typ, opt.geopred)
+		for _, pred := range preds {
+			fmt.Fprintf(w, "  <%s>\n", pred)
+		}
+		fmt.Fprintln(w, "}")
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrapf(err, "while writing schema file %s", path)
+	}
+	return f.Close()
+}
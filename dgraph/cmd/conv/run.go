@@ -28,9 +28,14 @@ import (
 var Conv x.SubCommand
 
 var opt struct {
-	geo     string
-	out     string
-	geopred string
+	geo        string
+	out        string
+	geopred    string
+	crs        string
+	simplify   float64
+	properties string
+	typ        string
+	schema     string
 }
 
 func init() {
@@ -48,9 +53,24 @@ func init() {
 	}
 
 	flag := Conv.Cmd.Flags()
-	flag.StringVar(&opt.geo, "geo", "", "Location of geo file to convert")
+	flag.StringVar(&opt.geo, "geo", "", "Location of geo file to convert. It can be a GeoJSON "+
+		"file, a Shapefile (.shp), a KML (.kml or .kmz) or a GPX (.gpx) file. GeoJSON, KML and "+
+		"GPX files can be gzipped.")
 	flag.StringVar(&opt.out, "out", "output.rdf.gz", "Location of output rdf.gz file")
 	flag.StringVar(&opt.geopred, "geopred", "loc", "Predicate to use to store geometries")
+	flag.StringVar(&opt.crs, "crs", "", "Coordinate reference system of the geo file, like "+
+		"EPSG:3857 or EPSG:32633, whose coordinates are reprojected to WGS84. By default it is "+
+		"read from the .prj file of Shapefiles and the crs of GeoJSON files.")
+	flag.Float64Var(&opt.simplify, "simplify", 0, "Tolerance in degrees to simplify lines and "+
+		"polygons with, like 0.0001 for about 10 meters. Positions closer than the tolerance "+
+		"to the simplified shape are dropped.")
+	flag.StringVar(&opt.properties, "properties", "", "Comma separated list of the feature "+
+		"properties to convert, as property[:predicate[:type]] where the type is one of "+
+		"string, int, float, bool or datetime. All the properties are converted to predicates "+
+		"of the same name and of the type of their values by default.")
+	flag.StringVar(&opt.typ, "type", "", "dgraph.type of the converted features")
+	flag.StringVar(&opt.schema, "schema", "", "Location of the schema file to write for the "+
+		"output, with the types of the predicates")
 	x.Check(Conv.Cmd.MarkFlagRequired("geo"))
 }
 
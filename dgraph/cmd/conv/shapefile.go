@@ -0,0 +1,243 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonas-p/go-shp"
+	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
+)
+
+// readShapefile reads the shapes of a Shapefile as features, whose properties are the attributes
+// of the .dbf file, and the projection of the .prj file. Shapes without a geometry are read as
+// features without one.
+func readShapefile(path string) ([]*geojson.Feature, projection, error) {
+	proj, err := readPRJ(strings.TrimSuffix(path, ".shp") + ".prj")
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := shp.Open(path)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while opening Shapefile %s", path)
+	}
+	defer r.Close()
+
+	fields := r.Fields()
+	var features []*geojson.Feature
+	for r.Next() {
+		row, shape := r.Shape()
+		f := geojson.NewFeature(shapeGeometry(shape))
+		for i, field := range fields {
+			if v := attributeValue(field, r.ReadAttribute(row, i)); v != nil {
+				f.Properties[field.String()] = v
+			}
+		}
+		features = append(features, f)
+	}
+	if err := r.Err(); err != nil {
+		return nil, nil, errors.Wrapf(err, "while reading Shapefile %s", path)
+	}
+	return features, proj, nil
+}
+
+// readPRJ returns the projection of a .prj file. Shapefiles without one are assumed to be in
+// longitudes and latitudes.
+func readPRJ(path string) (projection, error) {
+	wkt, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "while reading %s", path)
+	}
+	proj, err := parsePRJ(string(wkt))
+	return proj, errors.Wrapf(err, "while reading %s", path)
+}
+
+// attributeValue returns the value of an attribute from the type of its field: an int64 or a
+// float64 for numbers, a bool for logicals, a time.Time for dates and a string otherwise. Empty
+// values and values which don't match the type of their field, like the asterisks some tools
+// write for null numbers, are nil.
+func attributeValue(field shp.Field, value string) interface{} {
+	// Some tools pad the values with null bytes instead of spaces.
+	if value = strings.Trim(value, " \x00"); value == "" {
+		return nil
+	}
+	switch field.Fieldtype {
+	case 'N', 'F':
+		if field.Fieldtype == 'N' && field.Precision == 0 {
+			if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return i
+			}
+		}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+		return nil
+	case 'L':
+		switch value {
+		case "Y", "y", "T", "t":
+			return true
+		case "N", "n", "F", "f":
+			return false
+		}
+		return nil
+	case 'D':
+		if t, err := time.Parse("20060102", value); err == nil {
+			return t
+		}
+		return nil
+	}
+	return value
+}
+
+// shapeGeometry returns the geometry of a shape, or nil for a null shape. The measures and the
+// altitudes of the points are dropped.
+func shapeGeometry(shape shp.Shape) *geojson.Geometry {
+	switch s := shape.(type) {
+	case *shp.Point:
+		return geojson.NewPointGeometry([]float64{s.X, s.Y})
+	case *shp.PointZ:
+		return geojson.NewPointGeometry([]float64{s.X, s.Y})
+	case *shp.PointM:
+		return geojson.NewPointGeometry([]float64{s.X, s.Y})
+	case *shp.MultiPoint:
+		return geojson.NewMultiPointGeometry(positions(s.Points)...)
+	case *shp.MultiPointZ:
+		return geojson.NewMultiPointGeometry(positions(s.Points)...)
+	case *shp.MultiPointM:
+		return geojson.NewMultiPointGeometry(positions(s.Points)...)
+	case *shp.PolyLine:
+		return lineGeometry(parts(s.Parts, s.Points))
+	case *shp.PolyLineZ:
+		return lineGeometry(parts(s.Parts, s.Points))
+	case *shp.PolyLineM:
+		return lineGeometry(parts(s.Parts, s.Points))
+	case *shp.Polygon:
+		return polygonGeometry(parts(s.Parts, s.Points))
+	case *shp.PolygonZ:
+		return polygonGeometry(parts(s.Parts, s.Points))
+	case *shp.PolygonM:
+		return polygonGeometry(parts(s.Parts, s.Points))
+	}
+	return nil
+}
+
+func positions(points []shp.Point) [][]float64 {
+	ps := make([][]float64, len(points))
+	for i, p := range points {
+		ps[i] = []float64{p.X, p.Y}
+	}
+	return ps
+}
+
+// parts splits the points of a shape into its parts, which start at the given indices.
+func parts(starts []int32, points []shp.Point) [][][]float64 {
+	ps := make([][][]float64, 0, len(starts))
+	for i, start := range starts {
+		end := int32(len(points))
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		if start < 0 || start > end || end > int32(len(points)) {
+			break
+		}
+		ps = append(ps, positions(points[start:end]))
+	}
+	return ps
+}
+
+func lineGeometry(lines [][][]float64) *geojson.Geometry {
+	switch len(lines) {
+	case 0:
+		return nil
+	case 1:
+		return geojson.NewLineStringGeometry(lines[0])
+	}
+	return geojson.NewMultiLineStringGeometry(lines...)
+}
+
+// polygonGeometry returns the polygon of the rings of a shape. The outer rings of Shapefiles are
+// clockwise and their holes are counter-clockwise. Each hole belongs to the outer ring which
+// contains it, and a shape with more than one outer ring is a multipolygon.
+func polygonGeometry(rings [][][]float64) *geojson.Geometry {
+	var polygons [][][][]float64
+	var holes [][][]float64
+	for _, ring := range rings {
+		if len(ring) == 0 {
+			continue
+		}
+		if signedArea(ring) <= 0 {
+			polygons = append(polygons, [][][]float64{ring})
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	for _, hole := range holes {
+		i := -1
+		for j, polygon := range polygons {
+			if containsPosition(polygon[0], hole[0]) {
+				i = j
+				break
+			}
+		}
+		// A hole outside of all the outer rings is an outer ring with the wrong orientation.
+		if i < 0 {
+			polygons = append(polygons, [][][]float64{hole})
+			continue
+		}
+		polygons[i] = append(polygons[i], hole)
+	}
+
+	switch len(polygons) {
+	case 0:
+		return nil
+	case 1:
+		return geojson.NewPolygonGeometry(polygons[0])
+	}
+	return geojson.NewMultiPolygonGeometry(polygons...)
+}
+
+// signedArea returns the area of a ring, which is positive if it is counter-clockwise.
+func signedArea(ring [][]float64) float64 {
+	var a float64
+	for i := range ring {
+		p, q := ring[i], ring[(i+1)%len(ring)]
+		a += p[0]*q[1] - q[0]*p[1]
+	}
+	return a / 2
+}
+
+// containsPosition returns whether a ring contains a position, with the ray casting algorithm.
+func containsPosition(ring [][]float64, p []float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > p[1]) != (b[1] > p[1]) &&
+			p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+	}
+	return in
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonas-p/go-shp"
+	"github.com/paulmach/go.geojson"
+	"github.com/stretchr/testify/require"
+)
+
+// square returns a closed square ring, clockwise or counter-clockwise.
+func square(x, y, size float64, clockwise bool) [][]float64 {
+	if clockwise {
+		return [][]float64{{x, y}, {x, y + size}, {x + size, y + size}, {x + size, y}, {x, y}}
+	}
+	return [][]float64{{x, y}, {x + size, y}, {x + size, y + size}, {x, y + size}, {x, y}}
+}
+
+func TestPolygonGeometry(t *testing.T) {
+	outer, hole := square(0, 0, 10, true), square(2, 2, 2, false)
+	other, otherHole := square(20, 0, 10, true), square(22, 2, 2, false)
+	tests := []struct {
+		name  string
+		rings [][][]float64
+		want  *geojson.Geometry
+	}{
+		{
+			name:  "no rings",
+			rings: [][][]float64{{}},
+			want:  nil,
+		},
+		{
+			name:  "outer ring",
+			rings: [][][]float64{outer},
+			want:  geojson.NewPolygonGeometry([][][]float64{outer}),
+		},
+		{
+			name:  "hole",
+			rings: [][][]float64{outer, hole},
+			want:  geojson.NewPolygonGeometry([][][]float64{outer, hole}),
+		},
+		{
+			name:  "holes of two outer rings",
+			rings: [][][]float64{otherHole, outer, other, hole},
+			want: geojson.NewMultiPolygonGeometry(
+				[][][]float64{outer, hole}, [][][]float64{other, otherHole}),
+		},
+		{
+			name:  "counter-clockwise outer ring",
+			rings: [][][]float64{hole},
+			want:  geojson.NewPolygonGeometry([][][]float64{hole}),
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, polygonGeometry(tc.rings), tc.name)
+	}
+}
+
+func TestSignedArea(t *testing.T) {
+	require.Equal(t, -100.0, signedArea(square(0, 0, 10, true)))
+	require.Equal(t, 4.0, signedArea(square(2, 2, 2, false)))
+}
+
+func TestContainsPosition(t *testing.T) {
+	ring := [][]float64{{0, 0}, {0, 10}, {5, 5}, {10, 10}, {10, 0}, {0, 0}}
+	tests := []struct {
+		p    []float64
+		want bool
+	}{
+		{[]float64{1, 1}, true},
+		{[]float64{5, 4}, true},
+		{[]float64{5, 8}, false},
+		{[]float64{9, 9}, true},
+		{[]float64{-1, 5}, false},
+		{[]float64{11, 5}, false},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, containsPosition(ring, tc.p), "%v", tc.p)
+	}
+}
+
+func TestAttributeValue(t *testing.T) {
+	field := func(fieldtype byte, precision uint8) shp.Field {
+		return shp.Field{Fieldtype: fieldtype, Precision: precision}
+	}
+	tests := []struct {
+		field shp.Field
+		value string
+		want  interface{}
+	}{
+		{field('C', 0), " Mitte \x00\x00", "Mitte"},
+		{field('C', 0), "   ", nil},
+		{field('N', 0), "  380000", int64(380000)},
+		{field('N', 0), "1e3", 1000.0},
+		{field('N', 2), "12", 12.0},
+		{field('N', 0), "**********", nil},
+		{field('F', 3), "-1.250", -1.25},
+		{field('L', 0), "T", true},
+		{field('L', 0), "n", false},
+		{field('L', 0), "?", nil},
+		{field('D', 0), "19200101", time.Date(1920, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{field('D', 0), "1920", nil},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, attributeValue(tc.field, tc.value), "%q", tc.value)
+	}
+}
+
+func TestReadShapefile(t *testing.T) {
+	// The fixture holds two districts in EPSG:32633. The first one is a polygon with a hole,
+	// whose first position is in Berlin, and the second one is a multipolygon whose first
+	// position is in Vienna.
+	features, proj, err := readShapefile("testdata/districts.shp")
+	require.NoError(t, err)
+	require.NotNil(t, proj)
+	require.Len(t, features, 2)
+
+	require.Equal(t, map[string]interface{}{
+		"NAME":    "Mitte",
+		"POP":     int64(380000),
+		"AREA":    39.47,
+		"FOUNDED": time.Date(1920, 1, 1, 0, 0, 0, 0, time.UTC),
+		"CAPITAL": true,
+	}, features[0].Properties)
+	// The attributes which are empty or don't match their field are left out.
+	require.Equal(t, map[string]interface{}{"NAME": "Innere Stadt"}, features[1].Properties)
+
+	g := features[0].Geometry
+	require.Equal(t, geojson.GeometryPolygon, g.Type)
+	require.Len(t, g.Polygon, 2)
+	require.NoError(t, transformGeometry(g, proj, 0))
+	requirePosition(t, g.Polygon[0][0], 13.405, 52.52)
+
+	g = features[1].Geometry
+	require.Equal(t, geojson.GeometryMultiPolygon, g.Type)
+	require.Len(t, g.MultiPolygon, 2)
+	require.NoError(t, transformGeometry(g, proj, 0))
+	requirePosition(t, g.MultiPolygon[0][0][0], 16.3738, 48.2082)
+}
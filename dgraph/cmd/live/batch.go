@@ -34,6 +34,7 @@ import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/chunker"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -79,6 +80,12 @@ type loader struct {
 	txns uint64
 	// Num of aborts
 	aborts uint64
+	// Num of aborts due to conflicting transactions
+	txnConflicts uint64
+	// Num of lines or chunks which couldn't be parsed
+	parseErrors uint64
+	// Num of N-Quads rejected by Dgraph
+	schemaErrors uint64
 	// To get time elapsed
 	start time.Time
 
@@ -89,6 +96,12 @@ type loader struct {
 	reqs     chan request
 	zeroconn *grpc.ClientConn
 	schema   *schema
+	// mapping maps the columns of the CSV and Avro files to predicates.
+	mapping *chunker.Mapping
+	// upsertLock serializes the upserts of xids, see upsertUids.
+	upsertLock sync.Mutex
+	// deadLetter holds the N-Quads which can't be loaded, if set.
+	deadLetter *deadLetter
 }
 
 // Counter keeps a track of various parameters about a batch mutation. Running totals are printed
@@ -100,6 +113,12 @@ type Counter struct {
 	TxnsDone uint64
 	// Number of Aborts
 	Aborts uint64
+	// Number of Aborts due to conflicting transactions.
+	Conflicts uint64
+	// Number of lines or chunks which couldn't be parsed.
+	ParseErrors uint64
+	// Number of N-Quads rejected by the server.
+	SchemaErrors uint64
 	// Time elapsed since the batch started.
 	Elapsed time.Duration
 }
@@ -138,6 +157,13 @@ func handleError(err error, isRetry bool) {
 func (l *loader) infinitelyRetry(req request) {
 	defer l.retryRequestsWg.Done()
 	defer l.deregister(&req)
+	if l.deadLetter != nil {
+		// The retries are capped, so that the requests which keep failing don't stop the load.
+		if err := l.mutate(req.Set); err != nil {
+			l.reject(req.Set, req.format, err)
+		}
+		return
+	}
 	nretries := 1
 	for i := time.Millisecond; ; i *= 2 {
 		txn := l.dc.NewTxn()
@@ -154,7 +180,7 @@ func (l *loader) infinitelyRetry(req request) {
 		}
 		nretries++
 		handleError(err, true)
-		atomic.AddUint64(&l.aborts, 1)
+		l.countAbort(err)
 		if i >= 10*time.Second {
 			i = 10 * time.Second
 		}
@@ -174,12 +200,27 @@ func (l *loader) request(req request) {
 		l.deregister(&req)
 		return
 	}
-	handleError(err, false)
-	atomic.AddUint64(&l.aborts, 1)
 	l.retryRequestsWg.Add(1)
+	if l.deadLetter != nil && !isRetryable(err) {
+		go func() {
+			defer l.retryRequestsWg.Done()
+			defer l.deregister(&req)
+			l.reject(req.Set, req.format, err)
+		}()
+		return
+	}
+	handleError(err, false)
+	l.countAbort(err)
 	go l.infinitelyRetry(req)
 }
 
+func (l *loader) countAbort(err error) {
+	atomic.AddUint64(&l.aborts, 1)
+	if isConflict(err) {
+		atomic.AddUint64(&l.txnConflicts, 1)
+	}
+}
+
 func getTypeVal(val *api.Value) (types.Val, error) {
 	p := gql.TypeValFrom(val)
 	//Convert value to bytes
@@ -431,5 +472,9 @@ func (l *loader) Counter() Counter {
 		TxnsDone: atomic.LoadUint64(&l.txns),
 		Elapsed:  time.Since(l.start),
 		Aborts:   atomic.LoadUint64(&l.aborts),
+
+		Conflicts:    atomic.LoadUint64(&l.txnConflicts),
+		ParseErrors:  atomic.LoadUint64(&l.parseErrors),
+		SchemaErrors: atomic.LoadUint64(&l.schemaErrors),
 	}
 }
@@ -0,0 +1,378 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package live
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Map from our types to RDF types, as in the RDF exports.
+var rdfTypeMap = map[types.TypeID]string{
+	types.StringID:   "xs:string",
+	types.DateTimeID: "xs:dateTime",
+	types.IntID:      "xs:int",
+	types.FloatID:    "xs:float",
+	types.BoolID:     "xs:boolean",
+	types.GeoID:      "geo:geojson",
+	types.BinaryID:   "xs:base64Binary",
+	types.PasswordID: "xs:password",
+}
+
+// deadLetter holds the files which the input that can't be loaded is written to, each line after
+// a comment with its error. The lines of RDF files, and the N-Quads read from them which Dgraph
+// rejects, are written to an RDF file. The objects of the other formats, which are all read as
+// JSON objects, and the N-Quads read from them which Dgraph rejects, are written to a JSON Lines
+// file. Loading the files once the errors are fixed loads them.
+type deadLetter struct {
+	rdf   *deadLetterFile
+	jsonl *deadLetterFile
+}
+
+type deadLetterFile struct {
+	sync.Mutex
+	path string
+	f    *os.File
+	w    *bufio.Writer
+	// Number of lines written.
+	n uint64
+}
+
+// deadLetterPaths returns the paths of the RDF and JSON Lines dead-letter files for path, whose
+// extension is replaced.
+func deadLetterPaths(path string) (string, string) {
+	switch ext := filepath.Ext(path); ext {
+	case ".rdf", ".json", ".jsonl":
+		path = strings.TrimSuffix(path, ext)
+	}
+	return path + ".rdf", path + ".jsonl"
+}
+
+func newDeadLetter(path string) (*deadLetter, error) {
+	rdfPath, jsonPath := deadLetterPaths(path)
+	rdf, err := newDeadLetterFile(rdfPath)
+	if err != nil {
+		return nil, err
+	}
+	jsonl, err := newDeadLetterFile(jsonPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deadLetter{rdf: rdf, jsonl: jsonl}, nil
+}
+
+func newDeadLetterFile(path string) (*deadLetterFile, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating dead-letter file %s", path)
+	}
+	return &deadLetterFile{path: path, f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// write writes the lines after a comment with the kind and message of their error.
+func (d *deadLetterFile) write(kind string, err error, lines ...string) {
+	d.Lock()
+	defer d.Unlock()
+	msg := strings.Replace(err.Error(), "\n", " ", -1)
+	fmt.Fprintf(d.w, "# %s error: %s\n", kind, msg)
+	for _, line := range lines {
+		fmt.Fprintln(d.w, line)
+	}
+	d.n += uint64(len(lines))
+}
+
+// close flushes the file, and removes it if nothing was written to it.
+func (d *deadLetterFile) close() error {
+	if err := d.w.Flush(); err != nil {
+		return err
+	}
+	if err := d.f.Close(); err != nil {
+		return err
+	}
+	if d.n == 0 {
+		return os.Remove(d.path)
+	}
+	return nil
+}
+
+// writeNQuad writes an N-Quad which Dgraph rejected to the file of its input format: as an RDF
+// line if it was read from an RDF file, and else as a JSON object. Its subject and object are
+// written as the UIDs they were loaded with, so that loading it links it to the nodes which were
+// loaded.
+func (d *deadLetter) writeNQuad(nq *api.NQuad, format chunker.InputFormat, err error) {
+	kind := "schema"
+	if isRetryable(err) {
+		kind = "mutation"
+	}
+	f, toLine := d.jsonl, nquadToJSON
+	if format == chunker.RdfFormat {
+		f, toLine = d.rdf, nquadToRDF
+	}
+	line, rerr := toLine(nq)
+	if rerr != nil {
+		f.write(kind, err, fmt.Sprintf("# %v", nq))
+		return
+	}
+	f.write(kind, err, line)
+}
+
+// writeChunk writes the lines or objects of a chunk which can't be parsed, each after its own
+// error. A chunk whose lines or objects can't be told apart is written as it is after its error,
+// so that loading the file fails on it until it is fixed.
+func (d *deadLetter) writeChunk(format chunker.InputFormat, chunk string, err error) uint64 {
+	f := d.jsonl
+	if format == chunker.RdfFormat {
+		f = d.rdf
+	}
+	if errs, ok := err.(chunker.LineErrors); ok {
+		for _, lerr := range errs {
+			f.write("parse", lerr.Err, lerr.Line)
+		}
+		return uint64(len(errs))
+	}
+	f.write("parse", err, strings.TrimRight(chunk, "\n"))
+	return 1
+}
+
+// close closes the files, and returns the paths of the ones which were written to.
+func (d *deadLetter) close() ([]string, error) {
+	var paths []string
+	for _, f := range []*deadLetterFile{d.rdf, d.jsonl} {
+		if err := f.close(); err != nil {
+			return nil, err
+		}
+		if f.n > 0 {
+			paths = append(paths, f.path)
+		}
+	}
+	return paths, nil
+}
+
+// nquadToRDF returns the RDF line of an N-Quad whose subject and object are UIDs.
+func nquadToRDF(nq *api.NQuad) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%s> <%s> ", nq.Subject, nq.Predicate)
+	if nq.ObjectValue == nil {
+		fmt.Fprintf(&b, "<%s>", nq.ObjectId)
+	} else {
+		val, err := getTypeVal(nq.ObjectValue)
+		if err != nil {
+			return "", err
+		}
+		str, err := types.Convert(val, types.StringID)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(escapedString(str.Value.(string)))
+		switch rdfType, ok := rdfTypeMap[val.Tid]; {
+		case nq.Lang != "":
+			b.WriteString("@" + nq.Lang)
+		case ok:
+			b.WriteString("^^<" + rdfType + ">")
+		}
+	}
+
+	if len(nq.Facets) > 0 {
+		b.WriteString(" (")
+		for i, f := range nq.Facets {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			val, err := facets.ValFor(f)
+			if err != nil {
+				return "", err
+			}
+			str := types.Val{Tid: types.StringID}
+			if err := types.Marshal(val, &str); err != nil {
+				return "", err
+			}
+			if val.Tid == types.StringID {
+				fmt.Fprintf(&b, "%s=%s", f.Key, escapedString(str.Value.(string)))
+			} else {
+				fmt.Fprintf(&b, "%s=%s", f.Key, str.Value.(string))
+			}
+		}
+		b.WriteString(")")
+	}
+	b.WriteString(" .")
+	return b.String(), nil
+}
+
+// nquadToJSON returns the JSON object of an N-Quad whose subject and object are UIDs, as the JSON
+// parser reads it back: its facets are set on the object node of a uid edge, and on the subject
+// node else.
+func nquadToJSON(nq *api.NQuad) (string, error) {
+	pred := nq.Predicate
+	if nq.Lang != "" {
+		pred += "@" + nq.Lang
+	}
+	obj := map[string]interface{}{"uid": nq.Subject}
+	facetsOn := obj
+	if nq.ObjectValue == nil {
+		facetsOn = map[string]interface{}{"uid": nq.ObjectId}
+		obj[pred] = facetsOn
+	} else {
+		val, err := getTypeVal(nq.ObjectValue)
+		if err != nil {
+			return "", err
+		}
+		if val, err = types.Convert(val, val.Tid); err != nil {
+			return "", err
+		}
+		if obj[pred], err = jsonValue(val); err != nil {
+			return "", err
+		}
+	}
+
+	for _, f := range nq.Facets {
+		val, err := facets.ValFor(f)
+		if err != nil {
+			return "", err
+		}
+		if facetsOn[nq.Predicate+x.FacetDelimeter+f.Key], err = jsonValue(val); err != nil {
+			return "", err
+		}
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// jsonValue returns the JSON value of a native value, which the JSON parser reads as a value of
+// the same type, or as a string which the schema converts to it, like datetimes.
+func jsonValue(val types.Val) (interface{}, error) {
+	switch v := val.Value.(type) {
+	case float64:
+		// The JSON parser reads numbers without a fraction as integers.
+		str := strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.Contains(str, ".") {
+			str += ".0"
+		}
+		return json.Number(str), nil
+	case geom.T:
+		b, err := geojson.Marshal(v)
+		return json.RawMessage(b), err
+	}
+	return val.Value, nil
+}
+
+func escapedString(str string) string {
+	// json.Marshal escapes the string as RDF expects it.
+	b, err := json.Marshal(str)
+	if err != nil {
+		return fmt.Sprintf("%q", str)
+	}
+	return string(b)
+}
+
+func isConflict(err error) bool {
+	return err == dgo.ErrAborted || err == zero.ErrConflict || status.Code(err) == codes.Aborted
+}
+
+// isRetryable returns whether a mutation which failed with err can succeed once retried. The
+// other errors come from the N-Quads, like a value which doesn't match the schema.
+func isRetryable(err error) bool {
+	if isConflict(err) {
+		return true
+	}
+	s := status.Convert(err)
+	switch {
+	case s.Code() == codes.Internal, s.Code() == codes.Unavailable,
+		s.Code() == codes.DeadlineExceeded, s.Code() == codes.ResourceExhausted:
+		return true
+	case strings.Contains(s.Message(), "Server overloaded."),
+		strings.Contains(strings.ToLower(s.Message()), "retry"):
+		return true
+	}
+	return false
+}
+
+// maxRetries is the number of times a mutation which fails with an error other than a conflict
+// is retried, before its N-Quads are written to the dead-letter file.
+const maxRetries = 5
+
+// mutate commits the N-Quads, retrying until they are committed, Dgraph rejects them or the
+// retries of errors other than conflicts run out.
+func (l *loader) mutate(nqs []*api.NQuad) error {
+	var retries int
+	for i := time.Millisecond; ; i *= 2 {
+		_, err := l.dc.NewTxn().Mutate(l.opts.Ctx, &api.Mutation{Set: nqs, CommitNow: true})
+		if err == nil {
+			atomic.AddUint64(&l.nquads, uint64(len(nqs)))
+			atomic.AddUint64(&l.txns, 1)
+			return nil
+		}
+		if !isRetryable(err) {
+			return err
+		}
+		if !isConflict(err) {
+			if retries == maxRetries {
+				return err
+			}
+			retries++
+		}
+		handleError(err, true)
+		l.countAbort(err)
+		if i >= 10*time.Second {
+			i = 10 * time.Second
+		}
+		time.Sleep(i)
+	}
+}
+
+// reject splits a batch of N-Quads which Dgraph rejected, until the N-Quads which Dgraph rejects
+// on their own are found. These are written to the dead-letter file, and the others are loaded.
+// A batch which failed after its retries ran out is written to the dead-letter file as it is, as
+// splitting it doesn't help it succeed.
+func (l *loader) reject(nqs []*api.NQuad, format chunker.InputFormat, err error) {
+	if len(nqs) == 1 || isRetryable(err) {
+		atomic.AddUint64(&l.schemaErrors, uint64(len(nqs)))
+		for _, nq := range nqs {
+			l.deadLetter.writeNQuad(nq, format, err)
+		}
+		return
+	}
+	half := len(nqs) / 2
+	for _, part := range [][]*api.NQuad{nqs[:half], nqs[half:]} {
+		if err := l.mutate(part); err != nil {
+			l.reject(part, format, err)
+		}
+	}
+}
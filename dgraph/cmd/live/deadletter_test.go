@@ -0,0 +1,353 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package live
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// parseFile parses a data file with the chunker of its format, and returns its N-Quads.
+func parseFile(t *testing.T, path string, format chunker.InputFormat) ([]*api.NQuad, error) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	ck := chunker.NewChunker(format, 1000)
+	r := bufio.NewReader(f)
+	var perr error
+	for {
+		chunkBuf, err := ck.Chunk(r)
+		if err != nil && err != io.EOF {
+			require.NoError(t, err)
+		}
+		if chunkBuf != nil && chunkBuf.Len() > 0 {
+			if err := ck.Parse(chunkBuf); err != nil {
+				perr = err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	nqs := ck.NQuads()
+	nqs.Flush()
+	var all []*api.NQuad
+	for batch := range nqs.Ch() {
+		all = append(all, batch...)
+	}
+	return all, perr
+}
+
+// writeFailed parses a chunk, and writes the lines or objects which can't be parsed to dl.
+func writeFailed(t *testing.T, dl *deadLetter, format chunker.InputFormat, chunk string) {
+	ck := chunker.NewChunker(format, 1000)
+	err := ck.Parse(bytes.NewBufferString(chunk))
+	require.Error(t, err)
+	dl.writeChunk(format, chunk, err)
+}
+
+func TestDeadLetterReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deadletter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dl, err := newDeadLetter(filepath.Join(dir, "failed.rdf"))
+	require.NoError(t, err)
+	writeFailed(t, dl, chunker.RdfFormat, "_:a <name> \"alice\" .\n_:b <name> bob .\n")
+	writeFailed(t, dl, chunker.JsonFormat, `[{"name": "carol"},{"name": "dave" "age": 40}]`)
+	dl.writeNQuad(&api.NQuad{
+		Subject:     "0x2a",
+		Predicate:   "age",
+		ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "ten"}},
+	}, chunker.RdfFormat, errors.New("strconv.ParseInt: parsing \"ten\": invalid syntax"))
+	paths, err := dl.close()
+	require.NoError(t, err)
+	rdfPath, jsonPath := filepath.Join(dir, "failed.rdf"), filepath.Join(dir, "failed.jsonl")
+	require.Equal(t, []string{rdfPath, jsonPath}, paths)
+
+	// The rejected N-Quad is loaded, and the input which can't be parsed still fails.
+	nqs, err := parseFile(t, rdfPath, chunker.RdfFormat)
+	require.Len(t, err.(chunker.LineErrors), 1)
+	require.Len(t, nqs, 1)
+	require.Equal(t, "0x2a", nqs[0].Subject)
+	require.Equal(t, "ten", nqs[0].ObjectValue.GetStrVal())
+	_, err = parseFile(t, jsonPath, chunker.JsonLinesFormat)
+	require.Len(t, err.(chunker.LineErrors), 1)
+
+	// Once the errors are fixed, the files are loaded.
+	fix := func(path, old, new string) {
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(data), old)
+		data = []byte(strings.Replace(string(data), old, new, 1))
+		require.NoError(t, ioutil.WriteFile(path, data, 0644))
+	}
+	fix(rdfPath, "\n_:b <name> bob .\n", "\n_:b <name> \"bob\" .\n")
+	fix(jsonPath, `"dave""age"`, `"dave","age"`)
+	nqs, err = parseFile(t, rdfPath, chunker.RdfFormat)
+	require.NoError(t, err)
+	require.Len(t, nqs, 2)
+	nqs, err = parseFile(t, jsonPath, chunker.JsonLinesFormat)
+	require.NoError(t, err)
+	require.Len(t, nqs, 2)
+}
+
+// parseNQuad parses an RDF line, or a JSON object, into its N-Quad.
+func parseNQuad(t *testing.T, format chunker.InputFormat, data string) *api.NQuad {
+	ck := chunker.NewChunker(format, 1000)
+	require.NoError(t, ck.Parse(bytes.NewBufferString(data)), data)
+	nqs := ck.NQuads()
+	nqs.Flush()
+	var all []*api.NQuad
+	for batch := range nqs.Ch() {
+		all = append(all, batch...)
+	}
+	require.Len(t, all, 1, data)
+	return all[0]
+}
+
+// requireSameNQuad checks that two N-Quads hold the same edge, value and facets.
+func requireSameNQuad(t *testing.T, want, got *api.NQuad) {
+	// The JSON parser writes the UIDs in decimal.
+	uid := func(s string) uint64 {
+		if s == "" {
+			return 0
+		}
+		n, err := strconv.ParseUint(s, 0, 64)
+		require.NoError(t, err)
+		return n
+	}
+	require.Equal(t, uid(want.Subject), uid(got.Subject))
+	require.Equal(t, want.Predicate, got.Predicate)
+	require.Equal(t, uid(want.ObjectId), uid(got.ObjectId))
+	require.Equal(t, want.Lang, got.Lang)
+	if want.ObjectValue != nil {
+		wantVal, err := getTypeVal(want.ObjectValue)
+		require.NoError(t, err)
+		gotVal, err := getTypeVal(got.ObjectValue)
+		require.NoError(t, err)
+		// JSON has no datetime values, which are read back as strings, and the schema converts
+		// them.
+		wantNative, err := types.Convert(wantVal, wantVal.Tid)
+		require.NoError(t, err)
+		gotNative, err := types.Convert(gotVal, wantVal.Tid)
+		require.NoError(t, err)
+		require.Equal(t, wantNative, gotNative)
+	}
+	require.ElementsMatch(t, want.Facets, got.Facets)
+}
+
+func TestNQuadToRDFAndJSON(t *testing.T) {
+	tests := []struct {
+		rdf  string
+		json string
+	}{
+		{`<0x1> <name> "alice \"al\" \\ smith" .`,
+			`{"name":"alice \"al\" \\ smith","uid":"0x1"}`},
+		{`<0x1> <name> "Alice"@en .`, `{"name@en":"Alice","uid":"0x1"}`},
+		{`<0x1> <age> "42"^^<xs:int> .`, `{"age":42,"uid":"0x1"}`},
+		{`<0x1> <height> "2"^^<xs:float> .`, `{"height":2.0,"uid":"0x1"}`},
+		{`<0x1> <height> "1.68"^^<xs:float> .`, `{"height":1.68,"uid":"0x1"}`},
+		{`<0x1> <alive> "true"^^<xs:boolean> .`, `{"alive":true,"uid":"0x1"}`},
+		{`<0x1> <born> "1990-05-01T00:00:00Z"^^<xs:dateTime> .`,
+			`{"born":"1990-05-01T00:00:00Z","uid":"0x1"}`},
+		{`<0x1> <loc> "{'type':'Point','coordinates':[13.4,52.5]}"^^<geo:geojson> .`,
+			`{"loc":{"type":"Point","coordinates":[13.4,52.5]},"uid":"0x1"}`},
+		{`<0x1> <friend> <0x2> .`, `{"friend":{"uid":"0x2"},"uid":"0x1"}`},
+		{`<0x1> <friend> <0x2> (since=2006, close=true, weight=0.5, note="old") .`,
+			`{"friend":{"friend|close":true,"friend|note":"old","friend|since":2006,` +
+				`"friend|weight":0.5,"uid":"0x2"},"uid":"0x1"}`},
+		{`<0x1> <name> "Alice" (since=2006-01-02T15:04:05Z) .`,
+			`{"name":"Alice","name|since":"2006-01-02T15:04:05Z","uid":"0x1"}`},
+	}
+	for _, tc := range tests {
+		nq := parseNQuad(t, chunker.RdfFormat, tc.rdf)
+
+		line, err := nquadToRDF(nq)
+		require.NoError(t, err, tc.rdf)
+		requireSameNQuad(t, nq, parseNQuad(t, chunker.RdfFormat, line))
+
+		obj, err := nquadToJSON(nq)
+		require.NoError(t, err, tc.rdf)
+		require.Equal(t, tc.json, obj, tc.rdf)
+		requireSameNQuad(t, nq, parseNQuad(t, chunker.JsonFormat, obj))
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err       error
+		retryable bool
+	}{
+		{dgo.ErrAborted, true},
+		{status.Error(codes.Aborted, "Transaction has been aborted. Please retry"), true},
+		{status.Error(codes.Unavailable, "connection refused"), true},
+		{status.Error(codes.Internal, "transport is closing"), true},
+		{status.Error(codes.DeadlineExceeded, "context deadline exceeded"), true},
+		{status.Error(codes.Unknown, "Server overloaded."), true},
+		{status.Error(codes.Unknown, "Predicate is being moved, please retry later"), true},
+		{status.Error(codes.Unknown, "strconv.ParseInt: parsing \"ten\": invalid syntax"), false},
+		{status.Error(codes.InvalidArgument, "Input for predicate age of type int is uid"), false},
+		{errors.New("Schema change not allowed"), false},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.retryable, isRetryable(tc.err), "%v", tc.err)
+	}
+}
+
+// testClient fails the mutations with an N-Quad of a predicate in reject, and all of them with
+// failAll if it is set.
+type testClient struct {
+	api.DgraphClient
+	reject  string
+	failAll error
+
+	sync.Mutex
+	loaded []*api.NQuad
+	calls  int
+}
+
+func (c *testClient) Query(ctx context.Context, req *api.Request,
+	opts ...grpc.CallOption) (*api.Response, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.calls++
+	if c.failAll != nil {
+		return nil, c.failAll
+	}
+	for _, nq := range req.Mutations[0].Set {
+		if nq.Predicate == c.reject {
+			return nil, status.Errorf(codes.Unknown, "rejected %s", nq.Predicate)
+		}
+	}
+	c.loaded = append(c.loaded, req.Mutations[0].Set...)
+	return &api.Response{}, nil
+}
+
+func (c *testClient) CommitOrAbort(ctx context.Context, tc *api.TxnContext,
+	opts ...grpc.CallOption) (*api.TxnContext, error) {
+	return tc, nil
+}
+
+func TestReject(t *testing.T) {
+	var nqs []*api.NQuad
+	for _, line := range []string{
+		`<0x1> <name> "alice" .`,
+		`<0x1> <age> "ten" .`,
+		`<0x2> <name> "bob" .`,
+		`<0x2> <friend> <0x1> .`,
+		`<0x3> <age> "twelve" .`,
+	} {
+		nqs = append(nqs, parseNQuad(t, chunker.RdfFormat, line))
+	}
+	rejected := status.Error(codes.Unknown, "rejected age")
+
+	tests := []struct {
+		name    string
+		format  chunker.InputFormat
+		failAll error
+		loaded  int
+		rdf     string
+		jsonl   string
+	}{
+		{"rdf", chunker.RdfFormat, nil, 3, strings.Replace(`#
+<0x1> <age> "ten" .
+#
+<0x3> <age> "twelve" .
+`, "#", "# schema error: rpc error: code = Unknown desc = rejected age", -1), ""},
+		{"json", chunker.JsonFormat, nil, 3, "", strings.Replace(`#
+{"age":"ten","uid":"0x1"}
+#
+{"age":"twelve","uid":"0x3"}
+`, "#", "# schema error: rpc error: code = Unknown desc = rejected age", -1)},
+		// The batch which keeps failing is written as it is once its retries run out.
+		{"retries", chunker.CsvFormat, status.Error(codes.DeadlineExceeded, "timeout"), 0, "",
+			strings.Replace(`#
+{"name":"alice","uid":"0x1"}
+#
+{"age":"ten","uid":"0x1"}
+#
+{"name":"bob","uid":"0x2"}
+#
+{"friend":{"uid":"0x1"},"uid":"0x2"}
+#
+{"age":"twelve","uid":"0x3"}
+`, "#", "# mutation error: rpc error: code = DeadlineExceeded desc = timeout", -1)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "deadletter")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dl, err := newDeadLetter(filepath.Join(dir, "failed"))
+			require.NoError(t, err)
+
+			client := &testClient{reject: "age", failAll: tc.failAll}
+			l := &loader{
+				opts:       batchMutationOptions{Ctx: context.Background()},
+				dc:         dgo.NewDgraphClient(client),
+				deadLetter: dl,
+			}
+			err = rejected
+			if tc.failAll != nil {
+				err = l.mutate(nqs)
+				require.Equal(t, tc.failAll, err)
+				require.Equal(t, maxRetries+1, client.calls)
+			}
+			l.reject(nqs, tc.format, err)
+			_, err = dl.close()
+			require.NoError(t, err)
+
+			require.Len(t, client.loaded, tc.loaded)
+			for _, nq := range client.loaded {
+				require.NotEqual(t, "age", nq.Predicate)
+			}
+			read := func(ext string) string {
+				data, err := ioutil.ReadFile(filepath.Join(dir, "failed"+ext))
+				if os.IsNotExist(err) {
+					return ""
+				}
+				require.NoError(t, err)
+				return string(data)
+			}
+			require.Equal(t, tc.rdf, read(".rdf"))
+			require.Equal(t, tc.jsonl, read(".jsonl"))
+			require.Equal(t, uint64(len(nqs)-tc.loaded), l.schemaErrors)
+		})
+	}
+}
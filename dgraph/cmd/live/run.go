@@ -33,6 +33,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/metadata"
@@ -56,6 +57,7 @@ import (
 type options struct {
 	dataFiles      string
 	dataFormat     string
+	mappingFile    string
 	schemaFile     string
 	keyfile        string
 	zero           string
@@ -68,6 +70,10 @@ type options struct {
 	verbose        bool
 	httpAddr       string
 	bufferSize     int
+	// upsertPredicate is the predicate holding the xids of the nodes, if xids are upserted.
+	upsertPredicate string
+	// deadLetter is the path of the files which the input that can't be loaded is written to, if set.
+	deadLetter string
 }
 
 type predicate struct {
@@ -92,6 +98,8 @@ type schema struct {
 type request struct {
 	*api.Mutation
 	conflicts []uint64
+	// format is the format of the input the N-Quads were read from.
+	format chunker.InputFormat
 }
 
 func (l *schema) init() {
@@ -124,12 +132,15 @@ func init() {
 	Live.EnvPrefix = "DGRAPH_LIVE"
 
 	flag := Live.Cmd.Flags()
-	flag.StringP("files", "f", "", "Location of *.rdf(.gz) or *.json(.gz) file(s) to load")
+	flag.StringP("files", "f", "", "Location of *.rdf(.gz), *.json(.gz), *.csv(.gz), "+
+		"*.jsonl(.gz) or *.avro(.gz) file(s) to load")
 	flag.StringP("schema", "s", "", "Location of schema file")
 	flag.StringP("keyfile", "k", "", "Location of the key file to decrypt the schema "+
 		"and data files")
-	flag.String("format", "", "Specify file format (rdf or json) instead of getting it "+
-		"from filename")
+	flag.String("format", "", "Specify file format (rdf, json, csv, jsonl or avro) instead of "+
+		"getting it from filename")
+	flag.String("mapping", "", "Location of the JSON file which maps the columns of CSV and "+
+		"Avro files to predicates")
 	flag.StringP("alpha", "a", "127.0.0.1:9080",
 		"Comma-separated list of Dgraph alpha gRPC server addresses")
 	flag.StringP("zero", "z", "127.0.0.1:5080", "Dgraph zero gRPC server address")
@@ -149,6 +160,12 @@ func init() {
 	flag.StringP("user", "u", "", "Username if login is required.")
 	flag.StringP("password", "p", "", "Password of the user.")
 	flag.StringP("bufferSize", "m", "100", "Buffer for each thread")
+	flag.StringP("upsert_predicate", "U", "", "Look up the xids of the nodes as values of "+
+		"this indexed string predicate in the cluster, and store the xids of new nodes in it, "+
+		"so that loading the same data again doesn't create new nodes.")
+	flag.String("dead_letter", "", "Write the input which can't be parsed or is rejected "+
+		"by Dgraph, with its errors, to this path with the extension .rdf for RDF files and "+
+		"the extension .jsonl for the other formats, instead of stopping or retrying it.")
 
 	// TLS configuration
 	x.RegisterClientTLSFlags(flag)
@@ -259,18 +276,38 @@ func (l *loader) processFile(ctx context.Context, filename string, keyfile strin
 			if isJson {
 				loadType = chunker.JsonFormat
 			} else {
-				return errors.Errorf("need --format=rdf or --format=json to load %s", filename)
+				return errors.Errorf("need --format=rdf, --format=json, --format=csv, "+
+					"--format=jsonl or --format=avro to load %s", filename)
 			}
 		}
 	}
+	switch loadType {
+	case chunker.ParquetFormat:
+		return errors.Errorf("Parquet file %s can only be loaded by the bulk loader", filename)
+	case chunker.CsvFormat, chunker.AvroFormat:
+		if l.mapping == nil {
+			return errors.Errorf("need --mapping to load %s", filename)
+		}
+		f, err := l.mapping.File(filename)
+		if err != nil {
+			return err
+		}
+		if loadType == chunker.AvroFormat {
+			return l.processLoadFile(ctx, rd, loadType, chunker.NewAvroChunker(f, opt.batchSize))
+		}
+		return l.processLoadFile(ctx, rd, loadType, chunker.NewCSVChunker(f, opt.batchSize))
+	}
 
-	return l.processLoadFile(ctx, rd, chunker.NewChunker(loadType, opt.batchSize))
+	return l.processLoadFile(ctx, rd, loadType, chunker.NewChunker(loadType, opt.batchSize))
 }
 
-func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunker.Chunker) error {
+func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader,
+	loadType chunker.InputFormat, ck chunker.Chunker) error {
 	var wg sync.WaitGroup
 	wg.Add(1)
 	nqbuf := ck.NQuads()
+	// The N-Quads are skipped once an upsert of their xids fails.
+	var upsertErr error
 	// Spin a goroutine to push NQuads to mutation channel.
 	go func() {
 		defer wg.Done()
@@ -303,18 +340,23 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 				if len(buffer) < opt.batchSize {
 					sz = len(buffer)
 				}
-				mu := request{Mutation: &api.Mutation{Set: buffer[:sz]}}
+				mu := request{Mutation: &api.Mutation{Set: buffer[:sz]}, format: loadType}
 				l.reqs <- mu
 				buffer = buffer[sz:]
 			}
 		}
 
 		for nqs := range nqbuf.Ch() {
-			if len(nqs) == 0 {
+			if len(nqs) == 0 || upsertErr != nil {
 				continue
 			}
 
 			l.allocateUids(nqs)
+			if opt.upsertPredicate != "" {
+				if upsertErr = l.upsertUids(nqs); upsertErr != nil {
+					continue
+				}
+			}
 			for _, nq := range nqs {
 				nq.Subject = l.uid(nq.Subject)
 				if len(nq.ObjectId) > 0 {
@@ -340,11 +382,18 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 		}
 
 		chunkBuf, err := ck.Chunk(rd)
+		var chunk string
+		if l.deadLetter != nil && chunkBuf != nil {
+			chunk = chunkBuf.String()
+		}
 		// Parses the rdf entries from the chunk, groups them into batches (each one
 		// containing opt.batchSize entries) and sends the batches to the loader.reqs channel (see
 		// above).
 		if oerr := ck.Parse(chunkBuf); oerr != nil {
-			return errors.Wrap(oerr, "During parsing chunk in processLoadFile")
+			if l.deadLetter == nil {
+				return errors.Wrap(oerr, "During parsing chunk in processLoadFile")
+			}
+			atomic.AddUint64(&l.parseErrors, l.deadLetter.writeChunk(loadType, chunk, oerr))
 		}
 		if err == io.EOF {
 			break
@@ -355,7 +404,20 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 	nqbuf.Flush()
 	wg.Wait()
 
-	return nil
+	return upsertErr
+}
+
+// sameFile returns whether both paths are the same existing file.
+func sameFile(a, b string) bool {
+	fa, err := os.Stat(strings.TrimSpace(a))
+	if err != nil {
+		return false
+	}
+	fb, err := os.Stat(strings.TrimSpace(b))
+	if err != nil {
+		return false
+	}
+	return os.SameFile(fa, fb)
 }
 
 func setup(opts batchMutationOptions, dc *dgo.Dgraph) *loader {
@@ -404,6 +466,7 @@ func run() error {
 	opt = options{
 		dataFiles:      Live.Conf.GetString("files"),
 		dataFormat:     Live.Conf.GetString("format"),
+		mappingFile:    Live.Conf.GetString("mapping"),
 		schemaFile:     Live.Conf.GetString("schema"),
 		keyfile:        Live.Conf.GetString("keyfile"),
 		zero:           Live.Conf.GetString("zero"),
@@ -416,6 +479,9 @@ func run() error {
 		verbose:        Live.Conf.GetBool("verbose"),
 		httpAddr:       Live.Conf.GetString("http"),
 		bufferSize:     Live.Conf.GetInt("bufferSize"),
+
+		upsertPredicate: Live.Conf.GetString("upsert_predicate"),
+		deadLetter:      Live.Conf.GetString("dead_letter"),
 	}
 	go func() {
 		if err := http.ListenAndServe(opt.httpAddr, nil); err != nil {
@@ -457,18 +523,45 @@ func run() error {
 		fmt.Printf("Error while loading schema from alpha %s\n", err)
 		return err
 	}
+	if opt.upsertPredicate != "" {
+		if err := l.checkUpsertPredicate(); err != nil {
+			fmt.Printf("Error while checking upsert predicate: %s\n", err)
+			return err
+		}
+	}
 
 	if opt.dataFiles == "" {
-		return errors.New("RDF or JSON file(s) location must be specified")
+		return errors.New("RDF, JSON, CSV, JSON Lines or Avro file(s) location must be specified")
+	}
+	if opt.mappingFile != "" {
+		if l.mapping, err = chunker.ReadMapping(opt.mappingFile); err != nil {
+			fmt.Printf("Error while reading mapping file %s\n", err)
+			return err
+		}
 	}
 
-	filesList := x.FindDataFiles(opt.dataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	filesList := x.FindDataFiles(opt.dataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz",
+		".csv", ".csv.gz", ".jsonl", ".jsonl.gz", ".ndjson", ".ndjson.gz", ".avro", ".avro.gz"})
 	totalFiles := len(filesList)
 	if totalFiles == 0 {
 		return errors.Errorf("No data files found in %s", opt.dataFiles)
 	}
 	fmt.Printf("Found %d data file(s) to process\n", totalFiles)
 
+	if opt.deadLetter != "" {
+		rdfPath, jsonPath := deadLetterPaths(opt.deadLetter)
+		for _, file := range filesList {
+			if sameFile(file, rdfPath) || sameFile(file, jsonPath) {
+				fmt.Printf("Dead-letter file %q is one of the data files\n", file)
+				return errors.Errorf("dead-letter file %s is one of the data files", file)
+			}
+		}
+		if l.deadLetter, err = newDeadLetter(opt.deadLetter); err != nil {
+			fmt.Printf("Error while creating dead-letter file: %s\n", err)
+			return err
+		}
+	}
+
 	//	x.Check(dgraphClient.NewSyncMarks(filesList))
 	errCh := make(chan error, totalFiles)
 	for _, file := range filesList {
@@ -510,6 +603,19 @@ func run() error {
 	fmt.Printf("Number of N-Quads processed  : %d\n", c.Nquads)
 	fmt.Printf("Time spent                   : %v\n", c.Elapsed)
 	fmt.Printf("N-Quads processed per second : %d\n", rate)
+	fmt.Printf("Number of txn conflicts      : %d\n", c.Conflicts)
+	fmt.Printf("Number of parse errors       : %d\n", c.ParseErrors)
+	fmt.Printf("Number of schema errors      : %d\n", c.SchemaErrors)
+
+	if l.deadLetter != nil {
+		paths, err := l.deadLetter.close()
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Printf("Failed N-Quads written to    : %s\n", path)
+		}
+	}
 
 	if l.db != nil {
 		if err := l.alloc.Flush(); err != nil {
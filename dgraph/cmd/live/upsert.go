@@ -0,0 +1,182 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkUpsertPredicate checks that the xids can be looked up by the upsert predicate.
+func (l *loader) checkUpsertPredicate() error {
+	pred, ok := l.schema.preds[opt.upsertPredicate]
+	if !ok {
+		return errors.Errorf("upsert predicate %s isn't in the schema", opt.upsertPredicate)
+	}
+	if pred.ValueType != types.StringID || pred.List {
+		return errors.Errorf("upsert predicate %s must be of type string", opt.upsertPredicate)
+	}
+	var eq bool
+	for _, tok := range pred.Tokenizer {
+		eq = eq || tok == "exact" || tok == "hash"
+	}
+	if !eq {
+		return errors.Errorf("upsert predicate %s needs an exact or hash index",
+			opt.upsertPredicate)
+	}
+	if !pred.Upsert {
+		fmt.Printf("Upsert predicate %s doesn't have the @upsert directive. Loaders running at "+
+			"once can create several nodes for the same xid.\n", opt.upsertPredicate)
+	}
+	return nil
+}
+
+// upsertUids maps the xids of the N-Quads which aren't mapped yet to the nodes which have them as
+// value of the upsert predicate. The nodes which don't exist yet are created, with their xid as
+// value of the upsert predicate, so that loading the same data again reuses them.
+func (l *loader) upsertUids(nqs []*api.NQuad) error {
+	// Only one batch of xids is upserted at a time, so that the same xid is upserted once.
+	l.upsertLock.Lock()
+	defer l.upsertLock.Unlock()
+
+	var xids []string
+	seen := make(map[string]bool)
+	add := func(xid string) {
+		if xid == "" || seen[xid] {
+			return
+		}
+		seen[xid] = true
+		if !opt.newUids {
+			if _, err := strconv.ParseUint(xid, 0, 64); err == nil {
+				return
+			}
+		}
+		if _, ok := l.alloc.CheckUid(xid); !ok {
+			xids = append(xids, xid)
+		}
+	}
+	for _, nq := range nqs {
+		add(nq.Subject)
+		add(nq.ObjectId)
+	}
+	if len(xids) == 0 {
+		return nil
+	}
+
+	req := upsertRequest(opt.upsertPredicate, xids)
+	var resp *api.Response
+	for i := time.Millisecond; ; i *= 2 {
+		var err error
+		resp, err = l.dc.NewTxn().Do(l.opts.Ctx, req)
+		if err == nil {
+			break
+		}
+		switch code := status.Code(err); {
+		case err == dgo.ErrAborted, code == codes.Aborted, code == codes.Internal,
+			code == codes.Unavailable:
+			handleError(err, true)
+			l.countAbort(err)
+		default:
+			return errors.Wrapf(err, "while upserting xids")
+		}
+		if i >= 10*time.Second {
+			i = 10 * time.Second
+		}
+		time.Sleep(i)
+	}
+
+	uids, err := upsertedUids(resp, xids)
+	if err != nil {
+		return err
+	}
+	for i, xid := range xids {
+		l.alloc.SetUid(xid, uids[i])
+	}
+	return nil
+}
+
+// upsertRequest returns the upsert which looks up the nodes having the xids as value of pred, and
+// sets them on new nodes for the xids which don't have one. The xids are passed as variables, so
+// that they don't need to be escaped. For example, for the xid "a":
+//
+//	query q($x0: string) {
+//	  q0(func: eq(<xid>, $x0)) { u0 as uid }
+//	}
+//	uid(u0) <xid> "a" .
+func upsertRequest(pred string, xids []string) *api.Request {
+	var query strings.Builder
+	vars := make(map[string]string, len(xids))
+	set := make([]*api.NQuad, 0, len(xids))
+	query.WriteString("query q(")
+	for i := range xids {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		fmt.Fprintf(&query, "$x%d: string", i)
+	}
+	query.WriteString(") {\n")
+	for i, xid := range xids {
+		fmt.Fprintf(&query, "  q%d(func: eq(<%s>, $x%d)) { u%d as uid }\n", i, pred, i, i)
+		vars[fmt.Sprintf("$x%d", i)] = xid
+		set = append(set, &api.NQuad{
+			Subject:     fmt.Sprintf("uid(u%d)", i),
+			Predicate:   pred,
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: xid}},
+		})
+	}
+	query.WriteString("}")
+	return &api.Request{
+		Query:     query.String(),
+		Vars:      vars,
+		Mutations: []*api.Mutation{{Set: set}},
+		CommitNow: true,
+	}
+}
+
+// upsertedUids returns the uid of each xid from the response to its upsertRequest: the node
+// found with the xid, or else the node created for it.
+func upsertedUids(resp *api.Response, xids []string) ([]uint64, error) {
+	var found map[string][]struct {
+		Uid string `json:"uid"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &found); err != nil {
+		return nil, errors.Wrapf(err, "while reading upserted xids")
+	}
+	uids := make([]uint64, len(xids))
+	for i, xid := range xids {
+		uid := resp.Uids[fmt.Sprintf("uid(u%d)", i)]
+		if nodes := found[fmt.Sprintf("q%d", i)]; len(nodes) > 0 {
+			uid = nodes[0].Uid
+		}
+		n, err := strconv.ParseUint(uid, 0, 64)
+		if err != nil {
+			return nil, errors.Errorf("no uid upserted for xid %s", xid)
+		}
+		uids[i] = n
+	}
+	return uids, nil
+}
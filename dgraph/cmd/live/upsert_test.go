@@ -0,0 +1,91 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package live
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+)
+
+func TestUpsertRequest(t *testing.T) {
+	// The xids are passed as variables, whatever they hold.
+	xids := []string{"a", "quote\" and \\ backslash", "nul\x00 bell\a", " "}
+	req := upsertRequest("xid", xids)
+	require.Equal(t, `query q($x0: string, $x1: string, $x2: string, $x3: string) {
+  q0(func: eq(<xid>, $x0)) { u0 as uid }
+  q1(func: eq(<xid>, $x1)) { u1 as uid }
+  q2(func: eq(<xid>, $x2)) { u2 as uid }
+  q3(func: eq(<xid>, $x3)) { u3 as uid }
+}`, req.Query)
+	require.Equal(t, map[string]string{
+		"$x0": xids[0], "$x1": xids[1], "$x2": xids[2], "$x3": xids[3],
+	}, req.Vars)
+	require.True(t, req.CommitNow)
+	require.Len(t, req.Mutations, 1)
+	set := req.Mutations[0].Set
+	require.Len(t, set, len(xids))
+	for i, nq := range set {
+		require.Equal(t, "xid", nq.Predicate)
+		require.Equal(t, xids[i], nq.ObjectValue.GetStrVal())
+	}
+	require.Equal(t, "uid(u2)", set[2].Subject)
+
+	// The query parses, with the values of the variables, and its uid variables are used by
+	// the mutation.
+	res, err := gql.ParseWithNeedVars(gql.Request{Str: req.Query, Variables: req.Vars},
+		[]string{"u0", "u1", "u2", "u3"})
+	require.NoError(t, err)
+	require.Len(t, res.Query, len(xids))
+	for i, q := range res.Query {
+		require.Equal(t, "eq", q.Func.Name)
+		require.Equal(t, xids[i], q.Func.Args[0].Value)
+	}
+}
+
+func TestUpsertedUids(t *testing.T) {
+	xids := []string{"found", "created", "both"}
+	tests := []struct {
+		name string
+		resp *api.Response
+		uids []uint64
+		err  string
+	}{
+		{"found and created", &api.Response{
+			Json: []byte(`{"q0": [{"uid": "0x10"}], "q1": [], "q2": [{"uid": "0x12"}]}`),
+			Uids: map[string]string{"uid(u1)": "0x21", "uid(u2)": "0x22"},
+		}, []uint64{0x10, 0x21, 0x12}, ""},
+		{"none", &api.Response{
+			Json: []byte(`{"q0": [{"uid": "0x10"}], "q2": [{"uid": "0x12"}]}`),
+		}, nil, "no uid upserted for xid created"},
+		{"unreadable", &api.Response{Json: []byte(`{"q0": `)}, nil,
+			"while reading upserted xids"},
+	}
+	for _, tc := range tests {
+		uids, err := upsertedUids(tc.resp, xids)
+		if tc.err != "" {
+			require.Error(t, err, tc.name)
+			require.Contains(t, err.Error(), tc.err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.uids, uids, tc.name)
+	}
+}
@@ -23,6 +23,8 @@ const (
 	floatType
 	doubleType
 	datetimeType
+	boolType
+	geoType
 	uidType // foreign key reference, which would corrspond to uid type in Dgraph
 )
 
@@ -40,6 +42,8 @@ func initDataTypes() {
 	typeToString[floatType] = "float"
 	typeToString[doubleType] = "double"
 	typeToString[datetimeType] = "datetime"
+	typeToString[boolType] = "bool"
+	typeToString[geoType] = "geo"
 	typeToString[uidType] = "uid"
 
 	sqlTypeToInternal = make(map[string]dataType)
@@ -51,7 +55,7 @@ func initDataTypes() {
 	sqlTypeToInternal["time"] = datetimeType
 	sqlTypeToInternal["datetime"] = datetimeType
 	sqlTypeToInternal["float"] = floatType
-	sqlTypeToInternal["double"] = doubleType
+	sqlTypeToInternal["double"] = floatType
 	sqlTypeToInternal["decimal"] = floatType
 }
 
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A dialect holds the queries which differ between the SQL databases that can be migrated.
+type dialect interface {
+	// dsn returns the data source name of the database for sql.Open.
+	dsn(host, port, user, password, db string) string
+	defaultPort() string
+	// tablesQuery lists the tables of the database.
+	tablesQuery(db string) (string, []interface{})
+	// columnsQuery lists the name and data type of the columns of a table, in alphabetical order.
+	columnsQuery(table, db string) (string, []interface{})
+	// indicesQuery lists the index name and column name of the indexed columns of a table. The
+	// index name of the primary key is PRIMARY.
+	indicesQuery(table, db string) (string, []interface{})
+	// foreignKeysQuery lists the column name, constraint name, referenced table name and
+	// referenced column name of the foreign key columns of a table.
+	foreignKeysQuery(table, db string) (string, []interface{})
+	// quote quotes an identifier like a table or column name.
+	quote(name string) string
+	// columnType returns the type of a column from its data type.
+	columnType(dbType string) (dataType, bool)
+}
+
+func getDialect(driver string) (dialect, error) {
+	switch driver {
+	case "mysql":
+		return mysqlDialect{}, nil
+	case "postgres":
+		return &postgresDialect{}, nil
+	}
+	return nil, errors.Errorf("unknown driver %q, it should be mysql or postgres", driver)
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) dsn(host, port, user, password, db string) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true", user, password, host, port, db)
+}
+
+func (mysqlDialect) defaultPort() string {
+	return "3306"
+}
+
+func (mysqlDialect) tablesQuery(db string) (string, []interface{}) {
+	return "show tables", nil
+}
+
+func (mysqlDialect) columnsQuery(table, db string) (string, []interface{}) {
+	return `select COLUMN_NAME,DATA_TYPE from INFORMATION_SCHEMA.COLUMNS
+where TABLE_NAME = ? AND TABLE_SCHEMA = ? ORDER BY COLUMN_NAME`, []interface{}{table, db}
+}
+
+func (mysqlDialect) indicesQuery(table, db string) (string, []interface{}) {
+	return `select INDEX_NAME,COLUMN_NAME from INFORMATION_SCHEMA.STATISTICS
+where TABLE_NAME = ? AND INDEX_SCHEMA = ?`, []interface{}{table, db}
+}
+
+func (mysqlDialect) foreignKeysQuery(table, db string) (string, []interface{}) {
+	return `select COLUMN_NAME,CONSTRAINT_NAME,REFERENCED_TABLE_NAME,
+REFERENCED_COLUMN_NAME from INFORMATION_SCHEMA.KEY_COLUMN_USAGE where TABLE_NAME = ?
+AND CONSTRAINT_SCHEMA = ? AND REFERENCED_TABLE_NAME IS NOT NULL`, []interface{}{table, db}
+}
+
+func (mysqlDialect) quote(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+func (mysqlDialect) columnType(dbType string) (dataType, bool) {
+	return getDataType(dbType), false
+}
+
+// postgresDialect reads the tables of the current schema of the database, which is public
+// unless the search_path of the user says otherwise.
+type postgresDialect struct {
+	// enums holds the names of the enum types of the database.
+	enums map[string]bool
+}
+
+func (*postgresDialect) dsn(host, port, user, password, db string) string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(user, password),
+		Host:   net.JoinHostPort(host, port),
+		Path:   "/" + db,
+	}
+	return u.String()
+}
+
+func (*postgresDialect) defaultPort() string {
+	return "5432"
+}
+
+func (*postgresDialect) tablesQuery(db string) (string, []interface{}) {
+	return `select table_name from information_schema.tables
+where table_schema = current_schema() and table_type = 'BASE TABLE'`, nil
+}
+
+// columnsQuery returns the internal name of the data type of the columns, like int4 or _text
+// for an array of text, as the data_type of arrays and enums doesn't tell their element type.
+func (*postgresDialect) columnsQuery(table, db string) (string, []interface{}) {
+	return `select column_name, udt_name from information_schema.columns
+where table_schema = current_schema() and table_name = $1 order by column_name`,
+		[]interface{}{table}
+}
+
+func (*postgresDialect) indicesQuery(table, db string) (string, []interface{}) {
+	return `select case when ix.indisprimary then 'PRIMARY' else i.relname end, a.attname
+from pg_index ix
+join pg_class t on t.oid = ix.indrelid
+join pg_class i on i.oid = ix.indexrelid
+join pg_namespace n on n.oid = t.relnamespace
+join pg_attribute a on a.attrelid = t.oid and a.attnum = any(ix.indkey)
+where t.relname = $1 and n.nspname = current_schema()`, []interface{}{table}
+}
+
+func (*postgresDialect) foreignKeysQuery(table, db string) (string, []interface{}) {
+	return `select a.attname, c.conname, ft.relname, fa.attname
+from pg_constraint c
+join pg_class t on t.oid = c.conrelid
+join pg_namespace n on n.oid = t.relnamespace
+join pg_class ft on ft.oid = c.confrelid
+cross join lateral unnest(c.conkey, c.confkey) as k(col, fcol)
+join pg_attribute a on a.attrelid = c.conrelid and a.attnum = k.col
+join pg_attribute fa on fa.attrelid = c.confrelid and fa.attnum = k.fcol
+where c.contype = 'f' and t.relname = $1 and n.nspname = current_schema()`,
+		[]interface{}{table}
+}
+
+func (*postgresDialect) quote(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// pgTypeToInternal maps the internal names of the PostgreSQL data types to our types. JSON and
+// UUID values are migrated as strings.
+var pgTypeToInternal = map[string]dataType{
+	"int2":        intType,
+	"int4":        intType,
+	"int8":        intType,
+	"float4":      floatType,
+	"float8":      floatType,
+	"numeric":     floatType,
+	"bool":        boolType,
+	"text":        stringType,
+	"varchar":     stringType,
+	"bpchar":      stringType,
+	"char":        stringType,
+	"name":        stringType,
+	"citext":      stringType,
+	"uuid":        stringType,
+	"json":        stringType,
+	"jsonb":       stringType,
+	"time":        stringType,
+	"timetz":      stringType,
+	"date":        datetimeType,
+	"timestamp":   datetimeType,
+	"timestamptz": datetimeType,
+}
+
+// columnType returns the type of a column and whether it is an array, whose values are migrated
+// as a list. The values of enum columns are migrated as strings.
+func (d *postgresDialect) columnType(udtName string) (dataType, bool) {
+	list := strings.HasPrefix(udtName, "_")
+	udtName = strings.TrimPrefix(udtName, "_")
+	if d.enums[udtName] {
+		return stringType, list
+	}
+	if t, ok := pgTypeToInternal[udtName]; ok {
+		return t, list
+	}
+	return unknownType, list
+}
+
+// readEnums reads the names of the enum types of the database.
+func (d *postgresDialect) readEnums(pool *sql.DB) error {
+	rows, err := pool.Query(`select typname from pg_type where typtype = 'e'`)
+	if err != nil {
+		return errors.Wrapf(err, "while reading enum types")
+	}
+	defer rows.Close()
+
+	d.enums = make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return errors.Wrapf(err, "while scanning enum type")
+		}
+		d.enums[name] = true
+	}
+	return rows.Err()
+}
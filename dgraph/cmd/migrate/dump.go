@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -37,6 +38,8 @@ type dumpMeta struct {
 	dataWriter   *bufio.Writer
 	schemaWriter *bufio.Writer
 	sqlPool      *sql.DB
+	// dialect is the dialect of the database in sqlPool.
+	dialect dialect
 
 	buf strings.Builder // reusable buf for building strings, call buf.Reset before use
 }
@@ -61,6 +64,16 @@ func (m *dumpMeta) dumpSchema() error {
 			}
 		}
 	}
+	for table := range m.tableGuides {
+		tableInfo := m.tableInfos[table]
+		if tableInfo.mapping.Edge != nil {
+			continue
+		}
+		_, err := m.schemaWriter.WriteString("\n" + createDgraphType(tableInfo, m.tableInfos))
+		if err != nil {
+			return errors.Wrapf(err, "while writing schema")
+		}
+	}
 	return m.schemaWriter.Flush()
 }
 
@@ -91,8 +104,7 @@ func (m *dumpMeta) dumpTable(table string) error {
 	tableGuide := m.tableGuides[table]
 	tableInfo := m.tableInfos[table]
 
-	query := fmt.Sprintf(`select %s from %s`, strings.Join(tableInfo.columnNames, ","), table)
-	rows, err := m.sqlPool.Query(query)
+	rows, err := m.sqlPool.Query(selectQuery(m.dialect, tableInfo))
 	if err != nil {
 		return err
 	}
@@ -110,7 +122,7 @@ func (m *dumpMeta) dumpTable(table string) error {
 
 	for rows.Next() {
 		// step 1: read the row's column values
-		colValues, err := getColumnValues(tableInfo.columnNames, tableInfo.columnDataTypes, rows)
+		colValues, err := getColumnValues(tableInfo, rows)
 		if err != nil {
 			return err
 		}
@@ -118,7 +130,9 @@ func (m *dumpMeta) dumpTable(table string) error {
 
 		// step 2: output the column values in RDF format
 		row.blankNodeLabel = tableGuide.blankNode.generate(tableInfo, colValues)
-		m.outputRow(row, tableInfo)
+		if tableInfo.mapping.Edge == nil {
+			m.outputRow(row, tableInfo)
+		}
 
 		// step 3: record mappings to the blankNodeLabel so that future tables can look up the
 		// blankNodeLabel
@@ -135,8 +149,7 @@ func (m *dumpMeta) dumpTableConstraints(table string) error {
 	tableGuide := m.tableGuides[table]
 	tableInfo := m.tableInfos[table]
 
-	query := fmt.Sprintf(`select %s from %s`, strings.Join(tableInfo.columnNames, ","), table)
-	rows, err := m.sqlPool.Query(query)
+	rows, err := m.sqlPool.Query(selectQuery(m.dialect, tableInfo))
 	if err != nil {
 		return err
 	}
@@ -147,7 +160,7 @@ func (m *dumpMeta) dumpTableConstraints(table string) error {
 	}
 	for rows.Next() {
 		// step 1: read the row's column values
-		colValues, err := getColumnValues(tableInfo.columnNames, tableInfo.columnDataTypes, rows)
+		colValues, err := getColumnValues(tableInfo, rows)
 		if err != nil {
 			return err
 		}
@@ -156,12 +169,26 @@ func (m *dumpMeta) dumpTableConstraints(table string) error {
 		// step 2: output the constraints in RDF format
 		row.blankNodeLabel = tableGuide.blankNode.generate(tableInfo, colValues)
 
-		m.outputConstraints(row, tableInfo)
+		if tableInfo.mapping.Edge != nil {
+			m.outputEdge(row, tableInfo)
+		} else {
+			m.outputConstraints(row, tableInfo)
+		}
 	}
 
 	return nil
 }
 
+// selectQuery returns the query which reads the columns of a table.
+func selectQuery(d dialect, info *sqlTable) string {
+	columns := make([]string, 0, len(info.columnNames))
+	for _, column := range info.columnNames {
+		columns = append(columns, d.quote(column))
+	}
+	return fmt.Sprintf(`select %s from %s`, strings.Join(columns, ","),
+		d.quote(info.tableName))
+}
+
 // outputRow takes a row with its metadata as well as the table metadata, and
 // spits out one or more RDF entries to the dumpMeta's dataWriter.
 // Consider the following table "salary"
@@ -190,9 +217,11 @@ func (m *dumpMeta) dumpTableConstraints(table string) error {
 // _:person_company_Google_employee_id_100 to the foreign blank node _:person_2
 // is recorded through the person table's valuesRecorder.
 func (m *dumpMeta) outputRow(row *sqlRow, tableInfo *sqlTable) {
+	fmt.Fprintf(m.dataWriter, "%s <dgraph.type> %q .\n", row.blankNodeLabel,
+		tableInfo.mapping.typeName(tableInfo.tableName))
 	for i, colValue := range row.values {
 		colName := tableInfo.columnNames[i]
-		if !tableInfo.isForeignKey[colName] {
+		if !tableInfo.isForeignKey[colName] && !tableInfo.mapping.column(colName).Skip {
 			predicate := tableInfo.predNames[i]
 			m.outputPlainCell(row.blankNodeLabel, predicate, tableInfo.columnDataTypes[i], colValue)
 		}
@@ -205,9 +234,7 @@ func (m *dumpMeta) outputConstraints(row *sqlRow, tableInfo *sqlTable) {
 			logger.Fatalf("The constraint should have at least one part: %v", constraint)
 		}
 
-		foreignTableName := constraint.parts[0].remoteTableName
-
-		refLabel, err := row.getRefLabelFromConstraint(m.tableInfos[foreignTableName], constraint)
+		foreignBlankNode, err := m.getForeignBlankNode(row, constraint)
 		if err != nil {
 			if !quiet {
 				logger.Printf("ignoring the constraint because of error "+
@@ -215,13 +242,88 @@ func (m *dumpMeta) outputConstraints(row *sqlRow, tableInfo *sqlTable) {
 			}
 			return
 		}
-		foreignBlankNode := m.tableGuides[foreignTableName].valuesRecorder.getBlankNode(refLabel)
 		m.outputPlainCell(row.blankNodeLabel,
-			getPredFromConstraint(tableInfo.tableName, separator, constraint), uidType,
+			getPredFromConstraint(tableInfo, separator, constraint), uidType,
 			foreignBlankNode)
 	}
 }
 
+// getForeignBlankNode returns the blank node label of the row referenced by a foreign key
+// constraint.
+func (m *dumpMeta) getForeignBlankNode(row *sqlRow, constraint *fkConstraint) (string, error) {
+	foreignTableName := constraint.parts[0].remoteTableName
+
+	refLabel, err := row.getRefLabelFromConstraint(m.tableInfos[foreignTableName], constraint)
+	if err != nil {
+		return "", err
+	}
+	blankNode := m.tableGuides[foreignTableName].valuesRecorder.getBlankNode(refLabel)
+	if len(blankNode) == 0 {
+		return "", errors.Errorf("no row of table %s found for %s", foreignTableName, refLabel)
+	}
+	return blankNode, nil
+}
+
+// outputEdge sends to the writer the edge of a row of a join table, from the row referenced
+// by its from foreign key to the row referenced by its to foreign key. The other columns of
+// the row are the facets of the edge.
+// Consider the join table "person_company"
+// person_id int
+// company_id int
+// since datetime
+// and the mapping {"edge": {"from": "person_id", "to": "company_id", "predicate": "works_for"}}
+// Then a row 1 (person_id), 2 (company_id), 2006-01-02 (since) generates the RDF entry
+// _:person.1 <works_for> _:company.2 (since=2006-01-02T00:00:00Z) .
+func (m *dumpMeta) outputEdge(row *sqlRow, tableInfo *sqlTable) {
+	from, err := m.getForeignBlankNode(row, tableInfo.edgeFrom)
+	if err == nil {
+		var to string
+		if to, err = m.getForeignBlankNode(row, tableInfo.edgeTo); err == nil {
+			m.buf.Reset()
+			fmt.Fprintf(&m.buf, "%s <%s> %s", from, edgePredicate(tableInfo), to)
+			m.writeFacets(row, tableInfo)
+			m.buf.WriteString(" .\n")
+			fmt.Fprintf(m.dataWriter, "%s", m.buf.String())
+			return
+		}
+	}
+	if !quiet {
+		logger.Printf("ignoring the row of table %s because of error when getting "+
+			"the nodes of its edge: %+v\n", tableInfo.tableName, err)
+	}
+}
+
+// writeFacets writes to m.buf the facets of the edge of a row of a join table.
+func (m *dumpMeta) writeFacets(row *sqlRow, tableInfo *sqlTable) {
+	var facets []string
+	for i, colValue := range row.values {
+		colName := tableInfo.columnNames[i]
+		column := tableInfo.columns[colName]
+		if tableInfo.isForeignKey[colName] || tableInfo.mapping.column(colName).Skip ||
+			column.list {
+			continue
+		}
+		key := colName
+		if pred := tableInfo.mapping.column(colName).Predicate; pred != "" {
+			key = pred
+		}
+
+		if column.dataType == stringType {
+			if colValue.([]byte) != nil {
+				facets = append(facets, fmt.Sprintf("%s=%q", key, colValue))
+			}
+			continue
+		}
+		// NULL values have no facet
+		if val, err := getValue(column.dataType, colValue); err == nil {
+			facets = append(facets, fmt.Sprintf("%s=%s", key, val))
+		}
+	}
+	if len(facets) > 0 {
+		fmt.Fprintf(&m.buf, " (%s)", strings.Join(facets, ", "))
+	}
+}
+
 // outputPlainCell sends to the writer a RDF where the subject is the blankNode
 // the predicate is the predName, and the object is the colValue
 func (m *dumpMeta) outputPlainCell(blankNode string, predName string, dataType dataType,
@@ -230,6 +332,11 @@ func (m *dumpMeta) outputPlainCell(blankNode string, predName string, dataType d
 	m.buf.Reset()
 	fmt.Fprintf(&m.buf, "%s <%s> ", blankNode, predName)
 
+	if arr, ok := colValue.(pqArray); ok {
+		m.outputArrayCell(blankNode, predName, dataType, arr)
+		return
+	}
+
 	switch dataType {
 	case stringType:
 		fmt.Fprintf(&m.buf, "%q .\n", colValue)
@@ -287,3 +394,39 @@ func (row *sqlRow) getRefLabelFromConstraint(foreignTableInfo *sqlTable,
 		colValues:        row.values,
 	})
 }
+
+// outputArrayCell sends to the writer one RDF per element of an array, whose elements are
+// read as strings. NULL elements are left out.
+func (m *dumpMeta) outputArrayCell(blankNode string, predName string, dataType dataType,
+	arr pqArray) {
+	for _, elem := range arr.elems {
+		if !elem.Valid {
+			continue
+		}
+		objectVal := elem.String
+		if dataType == datetimeType {
+			t, err := parsePgTime(objectVal)
+			if err != nil {
+				if !quiet {
+					logger.Printf("ignoring object %v because of error when getting value: %v",
+						objectVal, err)
+				}
+				continue
+			}
+			objectVal = t.Format(time.RFC3339Nano)
+		}
+		fmt.Fprintf(m.dataWriter, "%s <%s> %q .\n", blankNode, predName, objectVal)
+	}
+}
+
+// parsePgTime parses the text of a PostgreSQL date or timestamp, with or without time zone.
+func parsePgTime(s string) (time.Time, error) {
+	layouts := []string{"2006-01-02 15:04:05.999999999Z07", "2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999", "2006-01-02"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("unable to parse time %s", s)
+}
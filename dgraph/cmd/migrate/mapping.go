@@ -0,0 +1,133 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// mapping overrides how the tables are migrated. For example, the mapping below migrates the
+// person table as nodes of type Person, with an exact index on their names, and the
+// person_company join table as edges from the persons to the companies, whose facet is the
+// since column of the join table.
+//
+//	{
+//	  "tables": {
+//	    "person": {
+//	      "type": "Person",
+//	      "columns": {
+//	        "name": {"predicate": "name", "index": ["exact"]},
+//	        "password_hash": {"skip": true}
+//	      }
+//	    },
+//	    "person_company": {
+//	      "edge": {"from": "person_id", "to": "company_id", "predicate": "works_for"}
+//	    },
+//	    "audit_log": {"skip": true}
+//	  }
+//	}
+type mapping struct {
+	Tables map[string]*tableMapping `json:"tables"`
+}
+
+type tableMapping struct {
+	// Type is the Dgraph type of the rows of the table, the table name by default.
+	Type string `json:"type,omitempty"`
+	// Skip leaves the table out of the migration.
+	Skip bool `json:"skip,omitempty"`
+	// Edge migrates the rows of a join table as edges instead of nodes.
+	Edge *edgeMapping `json:"edge,omitempty"`
+	// Columns holds the overrides of the columns, by column name.
+	Columns map[string]*columnMapping `json:"columns,omitempty"`
+}
+
+// edgeMapping migrates each row of a join table as an edge between the rows referenced by two of
+// its foreign keys. The other columns of the join table are migrated as facets of the edge.
+type edgeMapping struct {
+	// From is a column of the foreign key referencing the source of the edge.
+	From string `json:"from"`
+	// To is a column of the foreign key referencing the target of the edge.
+	To string `json:"to"`
+	// Predicate is the predicate of the edge, the table name by default.
+	Predicate string `json:"predicate,omitempty"`
+}
+
+type columnMapping struct {
+	// Predicate is the predicate of the column, or the facet key in a join table.
+	Predicate string `json:"predicate,omitempty"`
+	// Index holds the tokenizers of the index of the predicate.
+	Index []string `json:"index,omitempty"`
+	// Skip leaves the column out of the migration. The column can still be part of the
+	// primary key or a foreign key.
+	Skip bool `json:"skip,omitempty"`
+}
+
+func readMapping(file string) (*mapping, error) {
+	m := &mapping{}
+	if file == "" {
+		return m, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading mapping file")
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrapf(err, "while parsing mapping file %s", file)
+	}
+	for name, table := range m.Tables {
+		if table == nil {
+			return nil, errors.Errorf("table %s has no mapping in %s", name, file)
+		}
+		edge := table.Edge
+		switch {
+		case edge == nil:
+		case edge.From == "" || edge.To == "":
+			return nil, errors.Errorf("the edge of table %s needs a from and a to column",
+				name)
+		case edge.From == edge.To:
+			return nil, errors.Errorf("the edge of table %s goes from and to the same column %s",
+				name, edge.From)
+		}
+	}
+	return m, nil
+}
+
+// table returns the overrides of a table, which are empty if it has none.
+func (m *mapping) table(name string) *tableMapping {
+	if t := m.Tables[name]; t != nil {
+		return t
+	}
+	return &tableMapping{}
+}
+
+// column returns the overrides of a column, which are empty if it has none.
+func (t *tableMapping) column(name string) *columnMapping {
+	if c := t.Columns[name]; c != nil {
+		return c
+	}
+	return &columnMapping{}
+}
+
+func (t *tableMapping) typeName(table string) string {
+	if t.Type != "" {
+		return t.Type
+	}
+	return table
+}
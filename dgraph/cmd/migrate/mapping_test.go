@@ -0,0 +1,198 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeMapping writes a mapping file in dir and reads it.
+func writeMapping(t *testing.T, dir, data string) (*mapping, error) {
+	file := filepath.Join(dir, "mapping.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(data), 0644))
+	return readMapping(file)
+}
+
+func TestReadMapping(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mapping")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m, err := readMapping("")
+	require.NoError(t, err)
+	require.Equal(t, &tableMapping{}, m.table("person"))
+	_, err = readMapping(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"valid", `{"tables": {
+			"person": {"type": "Person", "columns": {"name": {"index": ["exact"]}}},
+			"person_company": {"edge": {"from": "person_id", "to": "company_id"}}
+		}}`, ""},
+		{"not json", `{"tables": `, "while parsing mapping file"},
+		{"wrong type", `{"tables": {"person": {"skip": "yes"}}}`, "while parsing mapping file"},
+		{"null table", `{"tables": {"person": null}}`, "table person has no mapping"},
+		{"edge without to", `{"tables": {"person_company": {"edge": {"from": "person_id"}}}}`,
+			"the edge of table person_company needs a from and a to column"},
+		{"edge without from", `{"tables": {"person_company": {"edge": {"to": "company_id"}}}}`,
+			"the edge of table person_company needs a from and a to column"},
+		{"edge to itself", `{"tables": {"person_company": {"edge": {"from": "person_id",
+			"to": "person_id"}}}}`,
+			"the edge of table person_company goes from and to the same column person_id"},
+	}
+	for _, tc := range tests {
+		_, err := writeMapping(t, dir, tc.data)
+		if tc.err == "" {
+			require.NoError(t, err, tc.name)
+			continue
+		}
+		require.Error(t, err, tc.name)
+		require.Contains(t, err.Error(), tc.err, tc.name)
+	}
+}
+
+// testTables returns the person, company and person_company tables, with the overrides of m.
+func testTables(m *mapping) map[string]*sqlTable {
+	column := func(name string, typ dataType, key keyType) *columnInfo {
+		return &columnInfo{name: name, dataType: typ, keyType: key}
+	}
+	fk := func(table, column, remoteTable string) *fkConstraint {
+		return &fkConstraint{parts: []*constraintPart{{tableName: table, columnName: column,
+			remoteTableName: remoteTable, remoteColumnName: "id"}}}
+	}
+	tables := map[string]*sqlTable{
+		"person": {
+			tableName: "person",
+			columns: map[string]*columnInfo{
+				"id":            column("id", intType, primary),
+				"name":          column("name", stringType, none),
+				"password_hash": column("password_hash", stringType, none),
+			},
+			columnNames:           []string{"id", "name", "password_hash"},
+			isForeignKey:          map[string]bool{},
+			foreignKeyConstraints: map[string]*fkConstraint{},
+		},
+		"company": {
+			tableName:             "company",
+			columns:               map[string]*columnInfo{"id": column("id", intType, primary)},
+			columnNames:           []string{"id"},
+			isForeignKey:          map[string]bool{},
+			foreignKeyConstraints: map[string]*fkConstraint{},
+		},
+		"person_company": {
+			tableName: "person_company",
+			columns: map[string]*columnInfo{
+				"company_id": column("company_id", intType, secondary),
+				"person_id":  column("person_id", intType, secondary),
+				"since":      column("since", datetimeType, none),
+			},
+			columnNames:  []string{"company_id", "person_id", "since"},
+			isForeignKey: map[string]bool{"company_id": true, "person_id": true},
+			foreignKeyConstraints: map[string]*fkConstraint{
+				"pc_company": fk("person_company", "company_id", "company"),
+				"pc_person":  fk("person_company", "person_id", "person"),
+			},
+		},
+	}
+	for name, table := range tables {
+		table.mapping = m.table(name)
+	}
+	return tables
+}
+
+func TestApplyMapping(t *testing.T) {
+	initDataTypes()
+	m := &mapping{Tables: map[string]*tableMapping{
+		"person": {Type: "Person", Columns: map[string]*columnMapping{
+			"name":          {Predicate: "name", Index: []string{"exact", "term"}},
+			"password_hash": {Skip: true},
+		}},
+		"person_company": {Edge: &edgeMapping{From: "person_id", To: "company_id",
+			Predicate: "works_for"}},
+	}}
+	tables := testTables(m)
+	require.NoError(t, setEdgeConstraints(tables))
+	require.Equal(t, tables["person_company"].foreignKeyConstraints["pc_person"],
+		tables["person_company"].edgeFrom)
+	require.Equal(t, tables["person_company"].foreignKeyConstraints["pc_company"],
+		tables["person_company"].edgeTo)
+
+	schema := func(table string) []string {
+		s := createDgraphSchema(tables[table])
+		sort.Strings(s)
+		return s
+	}
+	require.Equal(t, []string{"name: string @index(exact, term) .\n", "person.id: int .\n"},
+		schema("person"))
+	require.Equal(t, []string{"works_for: [uid] .\n"}, schema("person_company"))
+	require.Equal(t, []string{"company.id: int .\n"}, schema("company"))
+
+	// The edges of the join table are fields of the type of their source.
+	require.Equal(t, "type Person {\n  name\n  person.id\n  works_for\n}\n",
+		createDgraphType(tables["person"], tables))
+	require.Equal(t, "type company {\n  company.id\n}\n",
+		createDgraphType(tables["company"], tables))
+}
+
+func TestEdgeMappingErrors(t *testing.T) {
+	tests := []struct {
+		from, to string
+		err      string
+	}{
+		{"since", "company_id",
+			"column since of table person_company isn't a foreign key to a migrated table"},
+		{"person_id", "missing",
+			"column missing of table person_company isn't a foreign key to a migrated table"},
+	}
+	for _, tc := range tests {
+		m := &mapping{Tables: map[string]*tableMapping{
+			"person_company": {Edge: &edgeMapping{From: tc.from, To: tc.to}},
+		}}
+		err := setEdgeConstraints(testTables(m))
+		require.Error(t, err)
+		require.Equal(t, tc.err, err.Error())
+	}
+
+	// The columns of a composite foreign key reference the same rows.
+	m := &mapping{Tables: map[string]*tableMapping{
+		"person_company": {Edge: &edgeMapping{From: "person_id", To: "company_id"}},
+	}}
+	tables := testTables(m)
+	pc := tables["person_company"]
+	composite := pc.foreignKeyConstraints["pc_person"]
+	composite.parts = append(composite.parts, pc.foreignKeyConstraints["pc_company"].parts...)
+	delete(pc.foreignKeyConstraints, "pc_company")
+	require.EqualError(t, setEdgeConstraints(tables),
+		"columns person_id and company_id of table person_company are the same foreign key")
+
+	// Without an edge mapping, the join table is migrated as nodes with edges to both tables.
+	tables = testTables(&mapping{})
+	require.NoError(t, setEdgeConstraints(tables))
+	require.Equal(t, "type person_company {\n  person_company.company_id\n  "+
+		"person_company.person_id\n  person_company.since\n}\n",
+		createDgraphType(tables["person_company"], tables))
+}
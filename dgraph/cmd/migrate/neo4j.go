@@ -0,0 +1,486 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/pkg/errors"
+)
+
+// neo4jEntity is a node or a relationship of a Neo4j export, in the format of the JSON exports
+// of APOC (apoc.export.json.all). For example:
+// {"type":"node","id":"0","labels":["User"],"properties":{"name":"Adam","age":42}}
+// {"type":"relationship","id":"0","label":"KNOWS","properties":{"since":1993},
+// "start":{"id":"0","labels":["User"]},"end":{"id":"1","labels":["User"]}}
+type neo4jEntity struct {
+	Type       string                 `json:"type"`
+	ID         neo4jID                `json:"id"`
+	Labels     []string               `json:"labels"`
+	Label      string                 `json:"label"`
+	Properties map[string]interface{} `json:"properties"`
+	Start      neo4jNodeRef           `json:"start"`
+	End        neo4jNodeRef           `json:"end"`
+}
+
+type neo4jNodeRef struct {
+	ID     neo4jID  `json:"id"`
+	Labels []string `json:"labels"`
+}
+
+// neo4jID is the id of a node, which the exports hold as a string or as a number.
+type neo4jID string
+
+func (id *neo4jID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = neo4jID(s)
+		return nil
+	}
+	*id = neo4jID(bytes.TrimSpace(data))
+	return nil
+}
+
+// neo4jPredicate is the schema of a predicate, inferred from the values of a node property or
+// from a relationship type.
+type neo4jPredicate struct {
+	dataType dataType
+	list     bool
+}
+
+// neo4jMigrator converts the nodes of a Neo4j export into Dgraph nodes, with their labels as
+// dgraph.type and their properties as predicates, and the relationships into edges, with their
+// properties as facets. The schema of the predicates is inferred from the values.
+type neo4jMigrator struct {
+	dataWriter *bufio.Writer
+	predicates map[string]*neo4jPredicate
+	// types holds the predicates of the nodes of each label
+	types map[string]map[string]struct{}
+
+	nodes, relationships int
+	buf                  strings.Builder // reusable buf for building strings
+}
+
+func migrateNeo4j(files []string, schemaOutput, dataOutput string) error {
+	dataWriter, dataCancelFunc, err := getFileWriter(dataOutput)
+	if err != nil {
+		return err
+	}
+	defer dataCancelFunc()
+
+	m := &neo4jMigrator{
+		dataWriter: dataWriter,
+		predicates: make(map[string]*neo4jPredicate),
+		types:      make(map[string]map[string]struct{}),
+	}
+	for _, file := range files {
+		file = strings.TrimSpace(file)
+		fmt.Printf("Reading Neo4j export %s\n", file)
+		if err := m.readFile(file); err != nil {
+			return errors.Wrapf(err, "while reading Neo4j export %s", file)
+		}
+	}
+	if err := dataWriter.Flush(); err != nil {
+		return errors.Wrapf(err, "while writing data file")
+	}
+	fmt.Printf("Migrated %d nodes and %d relationships\n", m.nodes, m.relationships)
+
+	schemaWriter, schemaCancelFunc, err := getFileWriter(schemaOutput)
+	if err != nil {
+		return err
+	}
+	defer schemaCancelFunc()
+	if err := m.dumpSchema(schemaWriter); err != nil {
+		return errors.Wrapf(err, "while writing schema file")
+	}
+	return nil
+}
+
+func (m *neo4jMigrator) readFile(file string) error {
+	rd, cleanup := chunker.FileReader(file, "")
+	defer cleanup()
+
+	name := strings.TrimSuffix(strings.ToLower(file), ".gz")
+	if strings.HasSuffix(name, ".csv") {
+		return m.readCSV(rd)
+	}
+	return m.readJSON(rd)
+}
+
+// readJSON reads a JSON export, with one entity per line or with an array of entities.
+func (m *neo4jMigrator) readJSON(rd *bufio.Reader) error {
+	dec := json.NewDecoder(rd)
+	dec.UseNumber()
+	if isJSONArray(rd) {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+	for dec.More() {
+		var e neo4jEntity
+		if err := dec.Decode(&e); err != nil {
+			return err
+		}
+		if err := m.addEntity(&e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isJSONArray returns whether the reader is at the start of a JSON array.
+func isJSONArray(rd *bufio.Reader) bool {
+	for i := 1; ; i++ {
+		buf, err := rd.Peek(i)
+		if err != nil {
+			return false
+		}
+		switch buf[i-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '[':
+			return true
+		}
+		return false
+	}
+}
+
+// readCSV reads a CSV export (apoc.export.csv.all), whose columns are _id, _labels, the
+// properties, _start, _end and _type. For example:
+// "_id","_labels","name","age","_start","_end","_type","since"
+// "0",":User","Adam","42",,,,
+// ,,,,"0","1","KNOWS","1993"
+func (m *neo4jMigrator) readCSV(rd *bufio.Reader) error {
+	r := csv.NewReader(rd)
+	header, err := r.Read()
+	if err != nil {
+		return errors.Wrapf(err, "while reading CSV header")
+	}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		e := neo4jEntity{Type: "node", Properties: make(map[string]interface{})}
+		for i, cell := range record {
+			if len(cell) == 0 || i >= len(header) {
+				continue
+			}
+			switch header[i] {
+			case "_id":
+				e.ID = neo4jID(cell)
+			case "_labels":
+				for _, label := range strings.Split(cell, ":") {
+					if len(label) > 0 {
+						e.Labels = append(e.Labels, label)
+					}
+				}
+			case "_start":
+				e.Start.ID = neo4jID(cell)
+			case "_end":
+				e.End.ID = neo4jID(cell)
+			case "_type":
+				e.Type, e.Label = "relationship", cell
+			default:
+				e.Properties[header[i]] = csvValue(cell)
+			}
+		}
+		if err := m.addEntity(&e); err != nil {
+			return err
+		}
+	}
+}
+
+// csvValue returns the value of a CSV cell as it would be decoded from a JSON export. Lists and
+// points are exported as JSON.
+func csvValue(cell string) interface{} {
+	if cell[0] == '[' || cell[0] == '{' {
+		dec := json.NewDecoder(strings.NewReader(cell))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err == nil {
+			return v
+		}
+	}
+	if _, err := strconv.ParseFloat(cell, 64); err == nil {
+		return json.Number(cell)
+	}
+	if b, err := strconv.ParseBool(cell); err == nil && (cell == "true" || cell == "false") {
+		return b
+	}
+	return cell
+}
+
+func (m *neo4jMigrator) addEntity(e *neo4jEntity) error {
+	switch e.Type {
+	case "node":
+		if len(e.ID) == 0 {
+			return errors.Errorf("found a node without id")
+		}
+		m.addNode(e)
+	case "relationship":
+		if len(e.Start.ID) == 0 || len(e.End.ID) == 0 || len(e.Label) == 0 {
+			return errors.Errorf("relationship %s needs a start, an end and a type", e.ID)
+		}
+		m.addRelationship(e)
+	default:
+		return errors.Errorf("unknown entity type %q", e.Type)
+	}
+	return nil
+}
+
+func neo4jBlankNode(id neo4jID) string {
+	return fmt.Sprintf("_:node%s%s", separator, id)
+}
+
+// addNode writes the labels and the properties of a node. For example, the node
+// {"type":"node","id":"0","labels":["User"],"properties":{"name":"Adam","kids":["Sam","Anna"]}}
+// generates the RDF entries
+// _:node.0 <dgraph.type> "User" .
+// _:node.0 <name> "Adam" .
+// _:node.0 <kids> "Sam" .
+// _:node.0 <kids> "Anna" .
+func (m *neo4jMigrator) addNode(e *neo4jEntity) {
+	m.nodes++
+	node := neo4jBlankNode(e.ID)
+	for _, label := range e.Labels {
+		fmt.Fprintf(m.dataWriter, "%s <dgraph.type> %q .\n", node, label)
+		if _, ok := m.types[label]; !ok {
+			m.types[label] = make(map[string]struct{})
+		}
+	}
+	for _, key := range sortedKeys(e.Properties) {
+		value := e.Properties[key]
+		values, list := []interface{}{value}, false
+		if l, ok := value.([]interface{}); ok {
+			values, list = l, true
+		}
+		for _, v := range values {
+			object, dataType, ok := neo4jObject(v)
+			if !ok {
+				continue
+			}
+			m.addPredicate(key, dataType, list)
+			fmt.Fprintf(m.dataWriter, "%s <%s> %s .\n", node, key, object)
+		}
+		for _, label := range e.Labels {
+			m.addField(label, key)
+		}
+	}
+}
+
+// addRelationship writes a relationship as an edge. For example, the relationship
+// {"type":"relationship","id":"0","label":"KNOWS","properties":{"since":1993},
+// "start":{"id":"0","labels":["User"]},"end":{"id":"1","labels":["User"]}}
+// generates the RDF entry
+// _:node.0 <KNOWS> _:node.1 (since=1993) .
+func (m *neo4jMigrator) addRelationship(e *neo4jEntity) {
+	m.relationships++
+	m.addPredicate(e.Label, uidType, true)
+
+	m.buf.Reset()
+	fmt.Fprintf(&m.buf, "%s <%s> %s", neo4jBlankNode(e.Start.ID), e.Label,
+		neo4jBlankNode(e.End.ID))
+	var facets []string
+	for _, key := range sortedKeys(e.Properties) {
+		if facet, ok := neo4jFacet(e.Properties[key]); ok {
+			facets = append(facets, fmt.Sprintf("%s=%s", key, facet))
+		}
+	}
+	if len(facets) > 0 {
+		fmt.Fprintf(&m.buf, " (%s)", strings.Join(facets, ", "))
+	}
+	m.buf.WriteString(" .\n")
+	fmt.Fprintf(m.dataWriter, "%s", m.buf.String())
+
+	// The labels of the start node are only known in the JSON exports.
+	for _, label := range e.Start.Labels {
+		m.addField(label, e.Label)
+	}
+}
+
+func (m *neo4jMigrator) addField(label, predicate string) {
+	fields, ok := m.types[label]
+	if !ok {
+		fields = make(map[string]struct{})
+		m.types[label] = fields
+	}
+	fields[predicate] = struct{}{}
+}
+
+// addPredicate merges the type of a value into the inferred type of its predicate. Integers and
+// floats are merged as floats, and other different types as strings.
+func (m *neo4jMigrator) addPredicate(name string, dataType dataType, list bool) {
+	pred, ok := m.predicates[name]
+	switch {
+	case !ok:
+		m.predicates[name] = &neo4jPredicate{dataType: dataType, list: list}
+		return
+	case pred.dataType == dataType:
+	case pred.dataType == uidType || dataType == uidType:
+		if !quiet {
+			logger.Printf("predicate %s is both a property and a relationship type, "+
+				"its property values can't be loaded\n", name)
+		}
+		pred.dataType = uidType
+	case (pred.dataType == intType && dataType == floatType) ||
+		(pred.dataType == floatType && dataType == intType):
+		pred.dataType = floatType
+	default:
+		pred.dataType = stringType
+	}
+	pred.list = pred.list || list
+}
+
+// neo4jObject returns the RDF object of a property value, and its type.
+func neo4jObject(v interface{}) (string, dataType, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", unknownType, false
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return fmt.Sprintf("%q", v), intType, true
+		}
+		return fmt.Sprintf("%q", v), floatType, true
+	case bool:
+		return fmt.Sprintf("\"%t\"", v), boolType, true
+	case string:
+		if isNeo4jTime(v) {
+			return fmt.Sprintf("%q", v), datetimeType, true
+		}
+		return fmt.Sprintf("%q", v), stringType, true
+	case map[string]interface{}:
+		if geo, ok := neo4jPoint(v); ok {
+			return fmt.Sprintf("%q^^<geo:geojson>", geo), geoType, true
+		}
+	}
+	// Other values, like maps and nested lists, are migrated as JSON strings.
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", unknownType, false
+	}
+	return fmt.Sprintf("%q", b), stringType, true
+}
+
+// neo4jFacet returns the RDF facet value of a relationship property. Facets hold no lists,
+// so lists are migrated as JSON strings.
+func neo4jFacet(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", false
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case string:
+		if isNeo4jTime(v) {
+			return v, true
+		}
+		return strconv.Quote(v), true
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", false
+	}
+	return strconv.Quote(string(b)), true
+}
+
+// isNeo4jTime returns whether a string is a date or a date time. Years on their own, which
+// Dgraph parses as dates too, are left as strings.
+func isNeo4jTime(s string) bool {
+	if len(s) < len("2006-01-02") {
+		return false
+	}
+	_, err := types.ParseTime(s)
+	return err == nil
+}
+
+// neo4jPoint returns the GeoJSON of a Neo4j point, exported as
+// {"crs":"wgs-84","latitude":56.7,"longitude":12.78,"height":null}.
+func neo4jPoint(v map[string]interface{}) (string, bool) {
+	lat, ok1 := v["latitude"].(json.Number)
+	lon, ok2 := v["longitude"].(json.Number)
+	if !ok1 || !ok2 {
+		return "", false
+	}
+	return fmt.Sprintf(`{"type":"Point","coordinates":[%s,%s]}`, lon, lat), true
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// dumpSchema writes the inferred schema of the predicates, and one type per label.
+func (m *neo4jMigrator) dumpSchema(w *bufio.Writer) error {
+	names := make([]string, 0, len(m.predicates))
+	for name := range m.predicates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		pred := m.predicates[name]
+		if pred.list {
+			fmt.Fprintf(&buf, "<%s>: [%s] .\n", name, pred.dataType)
+		} else {
+			fmt.Fprintf(&buf, "<%s>: %s .\n", name, pred.dataType)
+		}
+	}
+
+	labels := make([]string, 0, len(m.types))
+	for label := range m.types {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		fields := make([]string, 0, len(m.types[label]))
+		for field := range m.types[label] {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		fmt.Fprintf(&buf, "\ntype <%s> {\n", label)
+		for _, field := range fields {
+			fmt.Fprintf(&buf, "  <%s>\n", field)
+		}
+		buf.WriteString("}\n")
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// migrateExport migrates a Neo4j export file with the given name and data, and returns the RDF
+// and the schema written.
+func migrateExport(t *testing.T, name, data string) (string, string, error) {
+	dir, err := ioutil.TempDir("", "neo4j")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(file, []byte(data), 0644))
+
+	schemaFile, dataFile := filepath.Join(dir, "schema.txt"), filepath.Join(dir, "data.rdf")
+	if err := migrateNeo4j([]string{file}, schemaFile, dataFile); err != nil {
+		return "", "", err
+	}
+	rdf, err := ioutil.ReadFile(dataFile)
+	require.NoError(t, err)
+	schema, err := ioutil.ReadFile(schemaFile)
+	require.NoError(t, err)
+	return string(rdf), string(schema), nil
+}
+
+func TestMigrateNeo4j(t *testing.T) {
+	initDataTypes()
+	tests := []struct {
+		name string
+		json string
+		csv  string
+		rdf  string
+		// csvSchema is the schema of the CSV export, if it differs from the one of the JSON
+		// export.
+		schema, csvSchema string
+	}{
+		{
+			name: "labels and properties",
+			json: `[
+{"type":"node","id":"0","labels":["User","Admin"],"properties":{"name":"Adam","age":42,"born":"1980-01-02"}},
+{"type":"node","id":1,"labels":["User"],"properties":{"name":"Eve","age":41.5,"zip":"10001-2345"}}
+]`,
+			csv: `"_id","_labels","age","born","name","zip","_start","_end","_type"
+"0",":User:Admin","42","1980-01-02","Adam",,,,
+"1",":User","41.5",,"Eve","10001-2345",,,
+`,
+			rdf: `_:node.0 <dgraph.type> "User" .
+_:node.0 <dgraph.type> "Admin" .
+_:node.0 <age> "42" .
+_:node.0 <born> "1980-01-02" .
+_:node.0 <name> "Adam" .
+_:node.1 <dgraph.type> "User" .
+_:node.1 <age> "41.5" .
+_:node.1 <name> "Eve" .
+_:node.1 <zip> "10001-2345" .
+`,
+			schema: `<age>: float .
+<born>: datetime .
+<name>: string .
+<zip>: string .
+
+type <Admin> {
+  <age>
+  <born>
+  <name>
+}
+
+type <User> {
+  <age>
+  <born>
+  <name>
+  <zip>
+}
+`,
+		},
+		{
+			name: "relationships with facets",
+			json: `{"type":"node","id":"0","labels":["User"],"properties":{"name":"Adam"}}
+{"type":"node","id":"1","labels":["User"],"properties":{"name":"Eve"}}
+{"type":"relationship","id":"0","label":"KNOWS","properties":{"since":1993,"close":true,"note":"met \"at\" work","met":"2001-05-06T10:00:00Z","places":["Berlin","Rome"]},"start":{"id":"0","labels":["User"]},"end":{"id":"1","labels":["User"]}}
+{"type":"relationship","id":"1","label":"KNOWS","start":{"id":"1","labels":["User"]},"end":{"id":"0","labels":["User"]}}
+`,
+			csv: `"_id","_labels","name","_start","_end","_type","close","met","note","places","since"
+"0",":User","Adam",,,,,,,,
+"1",":User","Eve",,,,,,,,
+,,,"0","1","KNOWS","true","2001-05-06T10:00:00Z","met ""at"" work","[""Berlin"",""Rome""]","1993"
+,,,"1","0","KNOWS",,,,,
+`,
+			rdf: `_:node.0 <dgraph.type> "User" .
+_:node.0 <name> "Adam" .
+_:node.1 <dgraph.type> "User" .
+_:node.1 <name> "Eve" .
+_:node.0 <KNOWS> _:node.1 (close=true, met=2001-05-06T10:00:00Z, note="met \"at\" work", ` +
+				`places="[\"Berlin\",\"Rome\"]", since=1993) .
+_:node.1 <KNOWS> _:node.0 .
+`,
+			schema: `<KNOWS>: [uid] .
+<name>: string .
+
+type <User> {
+  <KNOWS>
+  <name>
+}
+`,
+			// The labels of the start nodes of the relationships aren't in the CSV exports.
+			csvSchema: `<KNOWS>: [uid] .
+<name>: string .
+
+type <User> {
+  <name>
+}
+`,
+		},
+		{
+			name: "lists, points and mixed types",
+			json: `{"type":"node","id":"0","labels":["Place"],"properties":{"kids":["Sam","Anna"],"loc":{"crs":"wgs-84","latitude":56.7,"longitude":12.78,"height":null},"code":7,"meta":{"a":1}}}
+{"type":"node","id":"1","labels":["Place"],"properties":{"kids":["Bo"],"code":"x7","owner":"Adam"}}
+{"type":"relationship","id":"0","label":"owner","start":{"id":"1","labels":["Place"]},"end":{"id":"0","labels":["Place"]}}
+`,
+			csv: `"_id","_labels","code","kids","loc","meta","owner","_start","_end","_type"
+"0",":Place","7","[""Sam"",""Anna""]","{""crs"":""wgs-84"",""latitude"":56.7,""longitude"":12.78,""height"":null}","{""a"":1}",,,,
+"1",":Place","x7","[""Bo""]",,,"Adam",,,
+,,,,,,,"1","0","owner"
+`,
+			rdf: `_:node.0 <dgraph.type> "Place" .
+_:node.0 <code> "7" .
+_:node.0 <kids> "Sam" .
+_:node.0 <kids> "Anna" .
+_:node.0 <loc> "{\"type\":\"Point\",\"coordinates\":[12.78,56.7]}"^^<geo:geojson> .
+_:node.0 <meta> "{\"a\":1}" .
+_:node.1 <dgraph.type> "Place" .
+_:node.1 <code> "x7" .
+_:node.1 <kids> "Bo" .
+_:node.1 <owner> "Adam" .
+_:node.1 <owner> _:node.0 .
+`,
+			// A predicate which is both a property and a relationship type is an edge, whose property
+			// values are written but can't be loaded.
+			schema: `<code>: string .
+<kids>: [string] .
+<loc>: geo .
+<meta>: string .
+<owner>: [uid] .
+
+type <Place> {
+  <code>
+  <kids>
+  <loc>
+  <meta>
+  <owner>
+}
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rdf, schema, err := migrateExport(t, "export.json", tc.json)
+			require.NoError(t, err)
+			require.Equal(t, tc.rdf, rdf)
+			require.Equal(t, tc.schema, schema)
+
+			rdf, schema, err = migrateExport(t, "export.csv", tc.csv)
+			require.NoError(t, err)
+			require.Equal(t, tc.rdf, rdf)
+			if tc.csvSchema == "" {
+				tc.csvSchema = tc.schema
+			}
+			require.Equal(t, tc.csvSchema, schema)
+		})
+	}
+}
+
+func TestMigrateNeo4jErrors(t *testing.T) {
+	tests := []struct {
+		name, data, err string
+	}{
+		{"export.json", `{"type":"node","labels":["User"]}`, "found a node without id"},
+		{"export.json", `{"type":"relationship","id":"3","label":"KNOWS","start":{"id":"0"}}`,
+			"relationship 3 needs a start, an end and a type"},
+		{"export.json", `{"type":"index","id":"0"}`, `unknown entity type "index"`},
+		{"export.json", `{"type":"node",`, "unexpected EOF"},
+		{"export.csv", "\"_id\",\"_labels\",\"name\"\n,\":User\",\"Adam\"\n",
+			"found a node without id"},
+		{"export.csv", "\"_id\",\"_start\",\"_end\",\"_type\"\n,\"0\",,\"KNOWS\"\n",
+			"relationship  needs a start, an end and a type"},
+		{"export.csv", "\"_id\",\"name\"\n\"0\",\"Adam\n", "extraneous or missing \""},
+	}
+	for _, tc := range tests {
+		_, _, err := migrateExport(t, tc.name, tc.data)
+		require.Error(t, err, tc.data)
+		require.Contains(t, err.Error(), tc.err, tc.data)
+	}
+}
@@ -59,7 +59,13 @@ func init() {
 	flag.StringP("separator", "p", ".", "The separator for constructing predicate names")
 	flag.BoolP("quiet", "q", false, "Enable quiet mode to suppress the warning logs")
 	flag.StringP("host", "", "localhost", "The hostname or IP address of the database server.")
-	flag.StringP("port", "", "3306", "The port of the database server.")
+	flag.StringP("port", "", "", "The port of the database server, 3306 for MySQL and "+
+		"5432 for PostgreSQL by default.")
+	flag.StringP("driver", "", "mysql", "The database server, mysql or postgres.")
+	flag.StringP("mapping", "", "", "The JSON file overriding the predicate names and indices "+
+		"of the columns, and which tables are migrated as types or as edges.")
+	flag.StringP("neo4j", "", "", "The comma separated list of Neo4j export files, in the JSON "+
+		"or CSV format of APOC, to migrate instead of a SQL database")
 }
 
 func run(conf *viper.Viper) error {
@@ -71,8 +77,15 @@ func run(conf *viper.Viper) error {
 	dataOutput := conf.GetString("output_data")
 	host := conf.GetString("host")
 	port := conf.GetString("port")
+	driver := conf.GetString("driver")
+	mappingFile := conf.GetString("mapping")
 	quiet = conf.GetBool("quiet")
 	separator = conf.GetString("separator")
+	initDataTypes()
+
+	if neo4jFiles := conf.GetString("neo4j"); len(neo4jFiles) > 0 {
+		return runNeo4j(strings.Split(neo4jFiles, ","), schemaOutput, dataOutput)
+	}
 
 	switch {
 	case len(user) == 0:
@@ -95,28 +108,51 @@ func run(conf *viper.Viper) error {
 		return err
 	}
 
-	initDataTypes()
+	d, err := getDialect(driver)
+	if err != nil {
+		return err
+	}
+	if len(port) == 0 {
+		port = d.defaultPort()
+	}
+	overrides, err := readMapping(mappingFile)
+	if err != nil {
+		return err
+	}
 
-	pool, err := getPool(host, port, user, password, db)
+	pool, err := getPool(d, driver, host, port, user, password, db)
 	if err != nil {
 		return err
 	}
 	defer pool.Close()
 
-	tablesToRead, err := showTables(pool, tables)
+	if pg, ok := d.(*postgresDialect); ok {
+		if err := pg.readEnums(pool); err != nil {
+			return err
+		}
+	}
+
+	tablesToRead, err := showTables(pool, d, tables, db)
 	if err != nil {
 		return err
 	}
 
 	tableInfos := make(map[string]*sqlTable)
 	for _, table := range tablesToRead {
-		tableInfo, err := parseTables(pool, table, db)
+		tableMapping := overrides.table(table)
+		if tableMapping.Skip {
+			continue
+		}
+		tableInfo, err := parseTables(pool, d, table, db, tableMapping)
 		if err != nil {
 			return err
 		}
 		tableInfos[tableInfo.tableName] = tableInfo
 	}
 	populateReferencedByColumns(tableInfos)
+	if err := setEdgeConstraints(tableInfos); err != nil {
+		return err
+	}
 
 	tableGuides := getTableGuides(tableInfos)
 
@@ -124,9 +160,27 @@ func run(conf *viper.Viper) error {
 		tableInfos:  tableInfos,
 		tableGuides: tableGuides,
 		sqlPool:     pool,
+		dialect:     d,
 	}, schemaOutput, dataOutput)
 }
 
+func runNeo4j(files []string, schemaOutput, dataOutput string) error {
+	switch {
+	case len(schemaOutput) == 0:
+		logger.Fatalf("Please use the --output_schema option to " +
+			"provide the schema output file.")
+	case len(dataOutput) == 0:
+		logger.Fatalf("Please use the --output_data option to provide the data output file.")
+	}
+	if err := checkFile(schemaOutput); err != nil {
+		return err
+	}
+	if err := checkFile(dataOutput); err != nil {
+		return err
+	}
+	return migrateNeo4j(files, schemaOutput, dataOutput)
+}
+
 // checkFile checks if the program is trying to output to an existing file.
 // If so, we would need to ask the user whether we should overwrite the file or abort the program.
 func checkFile(file string) error {
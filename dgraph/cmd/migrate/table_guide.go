@@ -19,7 +19,10 @@ package migrate
 import (
 	"database/sql"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
@@ -177,8 +180,12 @@ func getValue(dataType dataType, value interface{}) (string, error) {
 		if !value.(mysql.NullTime).Valid {
 			return "", errors.Errorf("found invalid nulltime")
 		}
-		dateVal, _ := value.(mysql.NullTime).Value()
-		return fmt.Sprintf("%v", dateVal), nil
+		return value.(mysql.NullTime).Time.Format(time.RFC3339Nano), nil
+	case boolType:
+		if !value.(sql.NullBool).Valid {
+			return "", errors.Errorf("found invalid nullbool")
+		}
+		return strconv.FormatBool(value.(sql.NullBool).Bool), nil
 	case floatType:
 		if !value.(sql.NullFloat64).Valid {
 			return "", errors.Errorf("found invalid nullfloat")
@@ -214,44 +221,133 @@ func createLabel(ref *ref) (string, error) {
 
 // createDgraphSchema generates one Dgraph predicate per SQL column
 // and the type of the predicate is inferred from the SQL column type.
+// A join table migrated as edges generates the predicate of its edges instead.
 func createDgraphSchema(info *sqlTable) []string {
 	dgraphIndices := make([]string, 0)
+	if info.mapping.Edge != nil {
+		return append(dgraphIndices, fmt.Sprintf("%s: [%s] .\n", edgePredicate(info), uidType))
+	}
 
 	for _, column := range info.columnNames {
-		if info.isForeignKey[column] {
+		if info.isForeignKey[column] || info.mapping.column(column).Skip {
 			// we do not store the plain values in foreign key columns
 			continue
 		}
-		predicate := fmt.Sprintf("%s%s%s", info.tableName, separator, column)
+		predicate := predicateName(info, column)
 
-		dataType := info.columns[column].dataType
+		dataType := info.columns[column].dataType.String()
+		if info.columns[column].list {
+			dataType = "[" + dataType + "]"
+		}
+		var index string
+		if tokenizers := info.mapping.column(column).Index; len(tokenizers) > 0 {
+			index = fmt.Sprintf(" @index(%s)", strings.Join(tokenizers, ", "))
+		}
 
-		dgraphIndices = append(dgraphIndices, fmt.Sprintf("%s: %s .\n",
-			predicate, dataType))
+		dgraphIndices = append(dgraphIndices, fmt.Sprintf("%s: %s%s .\n",
+			predicate, dataType, index))
 	}
 
 	for _, cst := range info.foreignKeyConstraints {
-		pred := getPredFromConstraint(info.tableName, separator, cst)
+		pred := getPredFromConstraint(info, separator, cst)
 		dgraphIndices = append(dgraphIndices, fmt.Sprintf("%s: [%s] .\n",
 			pred, uidType))
 	}
 	return dgraphIndices
 }
 
+// createDgraphType generates the Dgraph type of the rows of a table, whose fields are the
+// predicates of the table and of the edges from its rows.
+func createDgraphType(info *sqlTable, tables map[string]*sqlTable) string {
+	var fields []string
+	for _, column := range info.columnNames {
+		if !info.isForeignKey[column] && !info.mapping.column(column).Skip {
+			fields = append(fields, predicateName(info, column))
+		}
+	}
+	for _, cst := range info.foreignKeyConstraints {
+		fields = append(fields, getPredFromConstraint(info, separator, cst))
+	}
+	for _, table := range tables {
+		if table.edgeFrom != nil && table.edgeFrom.parts[0].remoteTableName == info.tableName {
+			fields = append(fields, edgePredicate(table))
+		}
+	}
+	sort.Strings(fields)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "type %s {\n", info.mapping.typeName(info.tableName))
+	for _, field := range fields {
+		fmt.Fprintf(&buf, "  %s\n", field)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
 func getPredFromConstraint(
-	tableName string, separator string, constraint *fkConstraint) string {
+	info *sqlTable, separator string, constraint *fkConstraint) string {
+	if len(constraint.parts) == 1 {
+		// the predicate of a single column foreign key can be overridden as the column's
+		if pred := info.mapping.column(constraint.parts[0].columnName).Predicate; pred != "" {
+			return pred
+		}
+	}
 	columnNames := make([]string, 0)
 	for _, part := range constraint.parts {
 		columnNames = append(columnNames, part.columnName)
 	}
-	return fmt.Sprintf("%s%s%s", tableName, separator,
+	return fmt.Sprintf("%s%s%s", info.tableName, separator,
 		strings.Join(columnNames, separator))
 }
 
 func predicateName(info *sqlTable, column string) string {
+	if pred := info.mapping.column(column).Predicate; pred != "" {
+		return pred
+	}
 	return fmt.Sprintf("%s%s%s", info.tableName, separator, column)
 }
 
+// edgePredicate returns the predicate of the edges of a join table.
+func edgePredicate(info *sqlTable) string {
+	if pred := info.mapping.Edge.Predicate; pred != "" {
+		return pred
+	}
+	return info.tableName
+}
+
+// setEdgeConstraints finds the foreign key constraints which the edges of the join tables go
+// from and to.
+func setEdgeConstraints(tables map[string]*sqlTable) error {
+	for _, info := range tables {
+		edge := info.mapping.Edge
+		if edge == nil {
+			continue
+		}
+		for _, cst := range info.foreignKeyConstraints {
+			for _, part := range cst.parts {
+				switch part.columnName {
+				case edge.From:
+					info.edgeFrom = cst
+				case edge.To:
+					info.edgeTo = cst
+				}
+			}
+		}
+		switch {
+		case info.edgeFrom == nil:
+			return errors.Errorf("column %s of table %s isn't a foreign key to a migrated table",
+				edge.From, info.tableName)
+		case info.edgeTo == nil:
+			return errors.Errorf("column %s of table %s isn't a foreign key to a migrated table",
+				edge.To, info.tableName)
+		case info.edgeFrom == info.edgeTo:
+			return errors.Errorf("columns %s and %s of table %s are the same foreign key",
+				edge.From, edge.To, info.tableName)
+		}
+	}
+	return nil
+}
+
 type tableGuide struct {
 	blankNode      blankNode
 	valuesRecorder valuesRecorder
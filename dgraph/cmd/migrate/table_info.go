@@ -18,7 +18,6 @@ package migrate
 
 import (
 	"database/sql"
-	"fmt"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/x"
@@ -39,6 +38,8 @@ type columnInfo struct {
 	name     string
 	keyType  keyType
 	dataType dataType
+	// list is set for the array columns, whose values are migrated as a list.
+	list bool
 }
 
 // fkConstraint represents a foreign key constraint
@@ -79,6 +80,12 @@ type sqlTable struct {
 
 	// the list of foreign key constraints using this table as the target
 	cstSources []*fkConstraint
+
+	// the overrides of the mapping file for the table
+	mapping *tableMapping
+	// the foreign key constraints which the edges of a join table go from and to
+	edgeFrom *fkConstraint
+	edgeTo   *fkConstraint
 }
 
 func getDataType(dbType string) dataType {
@@ -90,17 +97,17 @@ func getDataType(dbType string) dataType {
 	return unknownType
 }
 
-func getColumnInfo(fieldName string, dbType string) *columnInfo {
+func getColumnInfo(d dialect, fieldName string, dbType string) *columnInfo {
 	columnInfo := columnInfo{}
 	columnInfo.name = fieldName
-	columnInfo.dataType = getDataType(dbType)
+	columnInfo.dataType, columnInfo.list = d.columnType(dbType)
 	return &columnInfo
 }
 
-func parseTables(pool *sql.DB, tableName string, database string) (*sqlTable, error) {
-	query := fmt.Sprintf(`select COLUMN_NAME,DATA_TYPE from INFORMATION_SCHEMA.
-COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tableName, database)
-	columns, err := pool.Query(query)
+func parseTables(pool *sql.DB, d dialect, tableName string, database string,
+	tableMapping *tableMapping) (*sqlTable, error) {
+	query, args := d.columnsQuery(tableName, database)
+	columns, err := pool.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +122,7 @@ COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tab
 		predNames:             make([]string, 0),
 		dstTables:             make(map[string]interface{}),
 		foreignKeyConstraints: make(map[string]*fkConstraint),
+		mapping:               tableMapping,
 	}
 
 	for columns.Next() {
@@ -138,15 +146,22 @@ COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tab
 
 		// TODO, should store the column data types into the table info as an array
 		// and the RMI should simply get the data types from the table info
-		table.columns[fieldName] = getColumnInfo(fieldName, dbType)
+		info := getColumnInfo(d, fieldName, dbType)
+		if info.dataType == unknownType {
+			if !quiet {
+				logger.Printf("ignoring column %s of table %s because of its unsupported "+
+					"type %s\n", fieldName, tableName, dbType)
+			}
+			continue
+		}
+		table.columns[fieldName] = info
 		table.columnNames = append(table.columnNames, fieldName)
-		table.columnDataTypes = append(table.columnDataTypes, getDataType(dbType))
+		table.columnDataTypes = append(table.columnDataTypes, info.dataType)
 	}
 
 	// query indices
-	indexQuery := fmt.Sprintf(`select INDEX_NAME,COLUMN_NAME from INFORMATION_SCHEMA.`+
-		`STATISTICS where TABLE_NAME = "%s" AND index_schema="%s"`, tableName, database)
-	indices, err := pool.Query(indexQuery)
+	indexQuery, args := d.indicesQuery(tableName, database)
+	indices, err := pool.Query(indexQuery, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -157,19 +172,23 @@ COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tab
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to scan index info for table %s", tableName)
 		}
+		column, ok := table.columns[columnName]
+		if !ok {
+			continue
+		}
 		switch indexName {
 		case "PRIMARY":
-			table.columns[columnName].keyType = primary
+			column.keyType = primary
 		default:
-			table.columns[columnName].keyType = secondary
+			if column.keyType != primary {
+				column.keyType = secondary
+			}
 		}
 
 	}
 
-	foreignKeysQuery := fmt.Sprintf(`select COLUMN_NAME,CONSTRAINT_NAME,REFERENCED_TABLE_NAME,
-		REFERENCED_COLUMN_NAME from INFORMATION_SCHEMA.KEY_COLUMN_USAGE where TABLE_NAME = "%s"
-        AND CONSTRAINT_SCHEMA="%s" AND REFERENCED_TABLE_NAME IS NOT NULL`, tableName, database)
-	fkeys, err := pool.Query(foreignKeysQuery)
+	foreignKeysQuery, args := d.foreignKeysQuery(tableName, database)
+	fkeys, err := pool.Query(foreignKeysQuery, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +263,21 @@ func validateAndGetReverse(constraint *fkConstraint) (string, *fkConstraint) {
 // and stores them in tables[table name].cstSources
 func populateReferencedByColumns(tables map[string]*sqlTable) {
 	for _, tableInfo := range tables {
-		for _, constraint := range tableInfo.foreignKeyConstraints {
+		for name, constraint := range tableInfo.foreignKeyConstraints {
 			reverseTable, reverseConstraint := validateAndGetReverse(constraint)
+			if _, ok := tables[reverseTable]; !ok {
+				// The referenced table isn't migrated, so the values of the foreign key columns
+				// are migrated as they are instead.
+				if !quiet {
+					logger.Printf("ignoring the constraint %s of table %s because table %s "+
+						"isn't migrated\n", name, tableInfo.tableName, reverseTable)
+				}
+				delete(tableInfo.foreignKeyConstraints, name)
+				for _, part := range constraint.parts {
+					delete(tableInfo.isForeignKey, part.columnName)
+				}
+				continue
+			}
 
 			tables[reverseTable].cstSources = append(tables[reverseTable].cstSources,
 				reverseConstraint)
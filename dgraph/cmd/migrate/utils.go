@@ -19,20 +19,19 @@ package migrate
 import (
 	"bufio"
 	"database/sql"
-	"fmt"
 	"os"
 	"reflect"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
-func getPool(host, port, user, password, db string) (*sql.DB,
+func getPool(d dialect, driver, host, port, user, password, db string) (*sql.DB,
 	error) {
-	return sql.Open("mysql",
-		fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true", user, password, host, port, db))
+	return sql.Open(driver, d.dsn(host, port, user, password, db))
 }
 
 // showTables will return a slice of table names using one of the following logic
@@ -40,12 +39,12 @@ func getPool(host, port, user, password, db string) (*sql.DB,
 // by splitting the parameter with the separate comma
 // 2) if the parameter is empty, this function will read all the tables under the given
 // database and then return the result
-func showTables(pool *sql.DB, tableNames string) ([]string, error) {
+func showTables(pool *sql.DB, d dialect, tableNames string, db string) ([]string, error) {
 	if len(tableNames) > 0 {
 		return strings.Split(tableNames, ","), nil
 	}
-	query := "show tables"
-	rows, err := pool.Query(query)
+	query, args := d.tablesQuery(db)
+	rows, err := pool.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +93,8 @@ func getFileWriter(filename string) (*bufio.Writer, func(), error) {
 	return bufio.NewWriter(output), func() { _ = output.Close() }, nil
 }
 
-func getColumnValues(columns []string, dataTypes []dataType,
-	rows *sql.Rows) ([]interface{}, error) {
+func getColumnValues(info *sqlTable, rows *sql.Rows) ([]interface{}, error) {
+	columns, dataTypes := info.columnNames, info.columnDataTypes
 	// ptrToValues takes a slice of pointers, deference them, and return the values referenced
 	// by these pointers
 	ptrToValues := func(ptrs []interface{}) []interface{} {
@@ -110,6 +109,11 @@ func getColumnValues(columns []string, dataTypes []dataType,
 
 	valuePtrs := make([]interface{}, 0, len(columns))
 	for i := 0; i < len(columns); i++ {
+		if info.columns[columns[i]].list {
+			// the elements of arrays are read as strings, and converted when they are written
+			valuePtrs = append(valuePtrs, &pqArray{})
+			continue
+		}
 		switch dataTypes[i] {
 		case stringType:
 			valuePtrs = append(valuePtrs, new([]byte)) // the value can be nil
@@ -119,6 +123,8 @@ func getColumnValues(columns []string, dataTypes []dataType,
 			valuePtrs = append(valuePtrs, new(sql.NullFloat64))
 		case datetimeType:
 			valuePtrs = append(valuePtrs, new(mysql.NullTime))
+		case boolType:
+			valuePtrs = append(valuePtrs, new(sql.NullBool))
 		default:
 			x.Panic(errors.Errorf("detected unsupported type %s on column %s",
 				dataTypes[i], columns[i]))
@@ -130,3 +136,13 @@ func getColumnValues(columns []string, dataTypes []dataType,
 	colValues := ptrToValues(valuePtrs)
 	return colValues, nil
 }
+
+// pqArray reads a PostgreSQL array, whose elements can be NULL.
+type pqArray struct {
+	elems []sql.NullString
+}
+
+func (a *pqArray) Scan(src interface{}) error {
+	a.elems = nil
+	return pq.Array(&a.elems).Scan(src)
+}
@@ -0,0 +1,430 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	geom "github.com/twpayne/go-geom"
+)
+
+// maxDistinct is the number of distinct values of a predicate which are kept to tell whether its
+// values are unique.
+const maxDistinct = 10000
+
+// inferrer infers a schema from the N-Quads of a sample of the data.
+type inferrer struct {
+	preds    map[string]*predicate
+	subjects map[string]*subject
+	nquads   int
+	// Number of RDF lines and JSON objects which couldn't be parsed.
+	skipped int
+}
+
+// predicate holds what the sampled values of a predicate tell about its schema.
+type predicate struct {
+	// Number of values of each type, with edges counted as uids.
+	counts map[types.TypeID]int
+	// Number of values of each subject and language, to find the lists.
+	values map[string]int
+	list   bool
+	lang   bool
+
+	// Distinct strings, up to maxDistinct, whether a string was seen twice, the number of
+	// strings, the number of them with more than one word and their total number of words.
+	distinct   map[string]struct{}
+	duplicates bool
+	strs       int
+	multiWord  int
+	words      int
+
+	// Whether the datetimes have a time of day, a day of month other than the first one and a
+	// month other than January, which tell the granularity of their index.
+	hasTime, hasDay, hasMonth bool
+	// Whether some geometries can't be indexed, like lines.
+	unindexable bool
+}
+
+// subject holds the types and the predicates of a node.
+type subject struct {
+	types []string
+	preds map[string]struct{}
+}
+
+func newInferrer() *inferrer {
+	return &inferrer{
+		preds:    make(map[string]*predicate),
+		subjects: make(map[string]*subject),
+	}
+}
+
+func (in *inferrer) add(nq *api.NQuad) {
+	in.nquads++
+	s, ok := in.subjects[nq.Subject]
+	if !ok {
+		s = &subject{preds: make(map[string]struct{})}
+		in.subjects[nq.Subject] = s
+	}
+	if nq.Predicate == "dgraph.type" {
+		if nq.ObjectValue != nil {
+			s.types = append(s.types, nq.ObjectValue.GetStrVal()+nq.ObjectValue.GetDefaultVal())
+		}
+		return
+	}
+	// The schema of the other internal predicates is predefined.
+	if strings.HasPrefix(nq.Predicate, "dgraph.") {
+		return
+	}
+	s.preds[nq.Predicate] = struct{}{}
+
+	p, ok := in.preds[nq.Predicate]
+	if !ok {
+		p = &predicate{
+			counts:   make(map[types.TypeID]int),
+			values:   make(map[string]int),
+			distinct: make(map[string]struct{}),
+		}
+		in.preds[nq.Predicate] = p
+	}
+	key := nq.Subject + "@" + nq.Lang
+	if p.values[key]++; p.values[key] > 1 {
+		p.list = true
+	}
+	if nq.Lang != "" {
+		p.lang = true
+	}
+	if nq.ObjectValue == nil {
+		p.counts[types.UidID]++
+		return
+	}
+	tid, v := valueType(nq.ObjectValue)
+	p.counts[tid]++
+	p.addValue(tid, v)
+}
+
+// addHints marks the predicates which the JSON chunker found as lists, even with one value.
+func (in *inferrer) addHints(md *pb.Metadata) {
+	for pred, hint := range md.GetPredHints() {
+		if p, ok := in.preds[pred]; ok && hint == pb.Metadata_LIST {
+			p.list = true
+		}
+	}
+}
+
+func (p *predicate) addValue(tid types.TypeID, v interface{}) {
+	switch v := v.(type) {
+	case string:
+		p.strs++
+		if n := len(strings.Fields(v)); n > 1 {
+			p.multiWord++
+			p.words += n
+		} else {
+			p.words++
+		}
+		if _, ok := p.distinct[v]; ok {
+			p.duplicates = true
+		} else if len(p.distinct) < maxDistinct {
+			p.distinct[v] = struct{}{}
+		}
+	case time.Time:
+		v = v.UTC()
+		p.hasTime = p.hasTime || v.Hour() != 0 || v.Minute() != 0 || v.Second() != 0 ||
+			v.Nanosecond() != 0
+		p.hasDay = p.hasDay || v.Day() != 1
+		p.hasMonth = p.hasMonth || v.Month() != time.January
+	case geom.T:
+		switch v.(type) {
+		case *geom.Point, *geom.Polygon, *geom.MultiPolygon:
+		default:
+			p.unindexable = true
+		}
+	}
+}
+
+// valueType returns the type of a value and its Go value. The type of untyped RDF values is
+// inferred from their text. JSON strings are strings, unless they are datetimes, as JSON has
+// numbers and booleans but no datetimes.
+func valueType(v *api.Value) (types.TypeID, interface{}) {
+	val := gql.TypeValFrom(v)
+	switch val.Tid {
+	case types.DefaultID:
+		return inferType(val.Value.(string), true)
+	case types.StringID:
+		return inferType(val.Value.(string), false)
+	case types.DateTimeID, types.GeoID:
+		// Datetimes and geometries are binary encoded in the N-Quads.
+		if conv, err := types.Convert(types.Val{Tid: types.BinaryID, Value: val.Value},
+			val.Tid); err == nil {
+			return val.Tid, conv.Value
+		}
+	}
+	return val.Tid, val.Value
+}
+
+// inferType returns the type of a string and its value in that type. Numbers with leading zeros
+// or signs, like zip codes or phone numbers, are strings.
+func inferType(s string, all bool) (types.TypeID, interface{}) {
+	if all {
+		switch {
+		case s == "true" || s == "false":
+			return types.BoolID, s == "true"
+		case isNumber(s):
+			if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return types.IntID, i
+			}
+			if f, err := strconv.ParseFloat(s, 64); err == nil {
+				return types.FloatID, f
+			}
+		case strings.HasPrefix(s, "{"):
+			g, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(s)}, types.GeoID)
+			if err == nil {
+				return types.GeoID, g.Value
+			}
+		}
+	}
+	// ParseTime accepts a year alone, which is more likely to be a number or a code.
+	if len(s) >= len("2006-01-02") && s[0] >= '0' && s[0] <= '9' {
+		if t, err := types.ParseTime(s); err == nil {
+			return types.DateTimeID, t
+		}
+	}
+	return types.StringID, s
+}
+
+func isNumber(s string) bool {
+	if s == "" || s[0] == '+' || (len(s) > 1 && s[0] == '0' && s[1] != '.') {
+		return false
+	}
+	if s[0] == '-' {
+		s = s[1:]
+	}
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// schemaType returns the type of the predicate, and a warning if its values have other types.
+// Predicates with int and float values are floats, and predicates with values of other types
+// are strings. The type of predicates with both uids and values is the most frequent one.
+func (p *predicate) schemaType() (types.TypeID, string) {
+	uids := p.counts[types.UidID]
+	var tids []types.TypeID
+	scalars := 0
+	for tid, n := range p.counts {
+		if tid != types.UidID {
+			tids = append(tids, tid)
+			scalars += n
+		}
+	}
+
+	var tid types.TypeID
+	switch {
+	case uids >= scalars:
+		tid = types.UidID
+	case len(tids) == 1:
+		tid = tids[0]
+	case len(tids) == 2 && p.counts[types.IntID] > 0 && p.counts[types.FloatID] > 0:
+		tid = types.FloatID
+	default:
+		tid = types.StringID
+	}
+	if p.lang && tid != types.UidID {
+		tid = types.StringID
+	}
+
+	var mismatch int
+	for t, n := range p.counts {
+		if t != tid && !(tid == types.FloatID && t == types.IntID) {
+			mismatch += n
+		}
+	}
+	if mismatch == 0 {
+		return tid, ""
+	}
+	return tid, fmt.Sprintf("%d of the values aren't of type %s", mismatch, tid.Name())
+}
+
+// indexes returns the candidate tokenizers of the index of a predicate of a type. The strings
+// with five words or more on average are indexed for full-text search, the ones with more than
+// one word by term, the unique ones by hash and the others by exact value.
+func (p *predicate) indexes(tid types.TypeID) []string {
+	switch tid {
+	case types.IntID:
+		return []string{"int"}
+	case types.FloatID:
+		return []string{"float"}
+	case types.BoolID:
+		return []string{"bool"}
+	case types.GeoID:
+		if !p.unindexable {
+			return []string{"geo"}
+		}
+	case types.DateTimeID:
+		switch {
+		case p.hasTime:
+			return []string{"hour"}
+		case p.hasDay:
+			return []string{"day"}
+		case p.hasMonth:
+			return []string{"month"}
+		}
+		return []string{"year"}
+	case types.StringID:
+		switch {
+		case p.strs == 0:
+			return []string{"exact"}
+		case p.words >= 5*p.strs:
+			return []string{"fulltext"}
+		case p.multiWord > 0:
+			return []string{"exact", "term"}
+		case !p.duplicates && p.strs > 1:
+			return []string{"hash"}
+		}
+		return []string{"exact"}
+	}
+	return nil
+}
+
+// write writes the inferred schema, with a comment about the sampled values of each predicate
+// and the predicates whose values have different types.
+func (in *inferrer) write(w io.Writer, source string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Schema inferred from %d N-Quads of %s.\n", in.nquads, source)
+	fmt.Fprintf(&b, "# Review the types and the indexes before loading the data.\n")
+
+	for _, name := range in.sortedPreds() {
+		p := in.preds[name]
+		tid, warning := p.schemaType()
+		fmt.Fprintf(&b, "\n# %s\n", p.summary())
+		if warning != "" {
+			fmt.Fprintf(&b, "# Warning: %s.\n", warning)
+		}
+		typ := tid.Name()
+		if p.list {
+			typ = "[" + typ + "]"
+		}
+		fmt.Fprintf(&b, "<%s>: %s", name, typ)
+		if tokenizers := p.indexes(tid); len(tokenizers) > 0 {
+			fmt.Fprintf(&b, " @index(%s)", strings.Join(tokenizers, ", "))
+		}
+		if p.lang && tid == types.StringID {
+			b.WriteString(" @lang")
+		}
+		b.WriteString(" .\n")
+	}
+
+	typePreds := make(map[string]map[string]struct{})
+	untyped := 0
+	for _, s := range in.subjects {
+		if len(s.types) == 0 && len(s.preds) > 0 {
+			untyped++
+		}
+		for _, typ := range s.types {
+			if typePreds[typ] == nil {
+				typePreds[typ] = make(map[string]struct{})
+			}
+			for pred := range s.preds {
+				typePreds[typ][pred] = struct{}{}
+			}
+		}
+	}
+	typeNames := make([]string, 0, len(typePreds))
+	for typ := range typePreds {
+		typeNames = append(typeNames, typ)
+	}
+	sort.Strings(typeNames)
+	for _, typ := range typeNames {
+		fmt.Fprintf(&b, "\ntype %s {\n", quoteName(typ))
+		for _, pred := range sortedSet(typePreds[typ]) {
+			fmt.Fprintf(&b, "\t%s\n", quoteName(pred))
+		}
+		b.WriteString("}\n")
+	}
+	if untyped > 0 {
+		fmt.Fprintf(&b, "\n# %d of the %d sampled nodes have no dgraph.type.\n", untyped,
+			len(in.subjects))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// summary describes the values of the predicate, like "values: 120 (118 int, 2 string), nodes:
+// 100".
+func (p *predicate) summary() string {
+	var tids []types.TypeID
+	total := 0
+	for tid, n := range p.counts {
+		tids = append(tids, tid)
+		total += n
+	}
+	sort.Slice(tids, func(i, j int) bool {
+		if p.counts[tids[i]] != p.counts[tids[j]] {
+			return p.counts[tids[i]] > p.counts[tids[j]]
+		}
+		return tids[i] < tids[j]
+	})
+	counts := make([]string, len(tids))
+	for i, tid := range tids {
+		counts[i] = fmt.Sprintf("%d %s", p.counts[tid], tid.Name())
+	}
+	s := fmt.Sprintf("values: %d (%s), nodes: %d", total, strings.Join(counts, ", "),
+		len(p.values))
+	if p.strs > 0 && len(p.distinct) < maxDistinct {
+		s += fmt.Sprintf(", distinct strings: %d", len(p.distinct))
+	}
+	return s
+}
+
+// quoteName puts the name of a type or predicate between angle brackets if it isn't made of
+// letters, digits, dots and underscores only.
+func quoteName(name string) string {
+	for _, r := range name {
+		if r != '.' && r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') &&
+			!(r >= '0' && r <= '9') {
+			return "<" + name + ">"
+		}
+	}
+	return name
+}
+
+// sortedPreds returns the names of the predicates, sorted.
+func (in *inferrer) sortedPreds() []string {
+	names := make([]string, 0, len(in.preds))
+	for name := range in.preds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedSet returns the names in a set, sorted.
+func sortedSet(set map[string]struct{}) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
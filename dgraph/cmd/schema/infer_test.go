@@ -0,0 +1,301 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func TestInferType(t *testing.T) {
+	tests := []struct {
+		s    string
+		all  bool
+		tid  types.TypeID
+		want interface{}
+	}{
+		{"true", true, types.BoolID, true},
+		{"false", true, types.BoolID, false},
+		{"42", true, types.IntID, int64(42)},
+		{"-42", true, types.IntID, int64(-42)},
+		{"0", true, types.IntID, int64(0)},
+		{"0.5", true, types.FloatID, 0.5},
+		{"1e3", true, types.FloatID, 1000.0},
+		{"99999999999999999999", true, types.FloatID, 1e20},
+		{"02139", true, types.StringID, "02139"},
+		{"+33123456789", true, types.StringID, "+33123456789"},
+		{"-", true, types.StringID, "-"},
+		{"2006", true, types.IntID, int64(2006)},
+		{"2006-01-02", true, types.DateTimeID, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"alice", true, types.StringID, "alice"},
+		// JSON strings are only inferred to be datetimes.
+		{"42", false, types.StringID, "42"},
+		{"true", false, types.StringID, "true"},
+		{"2006-01-02", false, types.DateTimeID, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"{not geojson}", true, types.StringID, "{not geojson}"},
+	}
+	for _, tc := range tests {
+		tid, v := inferType(tc.s, tc.all)
+		require.Equal(t, tc.tid, tid, "type of %q", tc.s)
+		if tid == types.DateTimeID {
+			require.True(t, tc.want.(time.Time).Equal(v.(time.Time)), "value of %q", tc.s)
+			continue
+		}
+		require.Equal(t, tc.want, v, "value of %q", tc.s)
+	}
+
+	tid, _ := inferType(`{"type": "Point", "coordinates": [13.4, 52.5]}`, true)
+	require.Equal(t, types.GeoID, tid)
+}
+
+func TestSchemaType(t *testing.T) {
+	tests := []struct {
+		counts  map[types.TypeID]int
+		lang    bool
+		tid     types.TypeID
+		warning string
+	}{
+		{map[types.TypeID]int{types.IntID: 3}, false, types.IntID, ""},
+		{map[types.TypeID]int{types.IntID: 3, types.FloatID: 1}, false, types.FloatID, ""},
+		{map[types.TypeID]int{types.IntID: 3, types.StringID: 1}, false, types.StringID,
+			"3 of the values aren't of type string"},
+		{map[types.TypeID]int{types.IntID: 1, types.FloatID: 1, types.BoolID: 1}, false,
+			types.StringID, "3 of the values aren't of type string"},
+		{map[types.TypeID]int{types.UidID: 2, types.StringID: 2}, false, types.UidID,
+			"2 of the values aren't of type uid"},
+		{map[types.TypeID]int{types.UidID: 1, types.StringID: 2}, false, types.StringID,
+			"1 of the values aren't of type string"},
+		{map[types.TypeID]int{types.DateTimeID: 2}, true, types.StringID,
+			"2 of the values aren't of type string"},
+		{map[types.TypeID]int{types.UidID: 2}, true, types.UidID, ""},
+	}
+	for _, tc := range tests {
+		p := &predicate{counts: tc.counts, lang: tc.lang}
+		tid, warning := p.schemaType()
+		require.Equal(t, tc.tid, tid, "%v", tc.counts)
+		require.Equal(t, tc.warning, warning, "%v", tc.counts)
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	tests := []struct {
+		name string
+		p    predicate
+		tid  types.TypeID
+		want []string
+	}{
+		{"int", predicate{}, types.IntID, []string{"int"}},
+		{"float", predicate{}, types.FloatID, []string{"float"}},
+		{"bool", predicate{}, types.BoolID, []string{"bool"}},
+		{"uid", predicate{}, types.UidID, nil},
+		{"geo", predicate{}, types.GeoID, []string{"geo"}},
+		{"lines", predicate{unindexable: true}, types.GeoID, nil},
+		{"years", predicate{}, types.DateTimeID, []string{"year"}},
+		{"months", predicate{hasMonth: true}, types.DateTimeID, []string{"month"}},
+		{"days", predicate{hasDay: true, hasMonth: true}, types.DateTimeID, []string{"day"}},
+		{"times", predicate{hasTime: true}, types.DateTimeID, []string{"hour"}},
+		{"no strings", predicate{}, types.StringID, []string{"exact"}},
+		{"text", predicate{strs: 2, multiWord: 2, words: 12}, types.StringID,
+			[]string{"fulltext"}},
+		{"words", predicate{strs: 2, multiWord: 1, words: 3}, types.StringID,
+			[]string{"exact", "term"}},
+		{"unique", predicate{strs: 2, words: 2}, types.StringID, []string{"hash"}},
+		{"one string", predicate{strs: 1, words: 1}, types.StringID, []string{"exact"}},
+		{"duplicates", predicate{strs: 2, words: 2, duplicates: true}, types.StringID,
+			[]string{"exact"}},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, tc.p.indexes(tc.tid), tc.name)
+	}
+}
+
+// inferFile infers the schema of a sample file with the given name and data.
+func inferFile(t *testing.T, name, data string) (*inferrer, string) {
+	dir, err := ioutil.TempDir("", "infer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(file, []byte(data), 0644))
+
+	in := newInferrer()
+	require.NoError(t, sampleFile(in, file, 0))
+	var b strings.Builder
+	require.NoError(t, in.write(&b, name))
+	return in, b.String()
+}
+
+func TestInferRDF(t *testing.T) {
+	in, schema := inferFile(t, "sample.rdf", `
+_:alice <dgraph.type> "Person" .
+_:alice <name> "Alice" .
+_:alice <age> "30" .
+_:alice <height> "1.68" .
+_:alice <born> "1990-05-01" .
+_:alice <friend> _:bob .
+_:alice <friend> _:carol .
+_:alice <bio> "Likes long walks on the beach at night"@en .
+_:alice <zip> "02139" .
+_:bob <dgraph.type> "Person" .
+_:bob <name> "Bob" .
+_:bob <age> "31"^^<xs:int> .
+_:bob <height> "2" .
+_:bob <born> "1989-01-01T10:30:00Z" .
+_:bob <bio> "Bob"@en .
+_:bob <zip> "10001" .
+_:carol <name> "Carol" .
+_:carol <name this is not RDF .
+`)
+	require.Equal(t, 1, in.skipped)
+	// The line which can't be parsed is skipped, and Carol has no type.
+	require.Equal(t, `# Schema inferred from 17 N-Quads of sample.rdf.
+# Review the types and the indexes before loading the data.
+
+# values: 2 (2 int), nodes: 2
+<age>: int @index(int) .
+
+# values: 2 (2 string), nodes: 2, distinct strings: 2
+<bio>: string @index(exact, term) @lang .
+
+# values: 2 (2 datetime), nodes: 2
+<born>: datetime @index(hour) .
+
+# values: 2 (2 uid), nodes: 1
+<friend>: [uid] .
+
+# values: 2 (1 int, 1 float), nodes: 2
+<height>: float @index(float) .
+
+# values: 3 (3 string), nodes: 3, distinct strings: 3
+<name>: string @index(hash) .
+
+# values: 2 (1 int, 1 string), nodes: 2, distinct strings: 1
+# Warning: 1 of the values aren't of type string.
+<zip>: string @index(exact) .
+
+type Person {
+	age
+	bio
+	born
+	friend
+	height
+	name
+	zip
+}
+
+# 1 of the 3 sampled nodes have no dgraph.type.
+`, schema)
+}
+
+func TestInferJSON(t *testing.T) {
+	_, schema := inferFile(t, "sample.json", `[
+  {
+    "dgraph.type": "City",
+    "name": "Berlin",
+    "population": 3769495,
+    "area": 891.8,
+    "capital": true,
+    "founded": "1237-01-01",
+    "location": {"type": "Point", "coordinates": [13.405, 52.52]},
+    "tags": ["capital"],
+    "mayor": {"name": "Kai Wegner", "elected": "2023-04-27T12:00:00Z"}
+  },
+  {
+    "dgraph.type": "City",
+    "name": "Vienna",
+    "population": "1897491",
+    "area": 414,
+    "capital": true,
+    "founded": "0881-06-01",
+    "tags": ["capital", "danube"]
+  }
+]`)
+	// The population is a string as JSON strings aren't numbers, the tags are a list even with
+	// one value, and the mayor is a node without a type.
+	require.Equal(t, `# Schema inferred from 19 N-Quads of sample.json.
+# Review the types and the indexes before loading the data.
+
+# values: 2 (1 int, 1 float), nodes: 2
+<area>: float @index(float) .
+
+# values: 2 (2 bool), nodes: 2
+<capital>: bool @index(bool) .
+
+# values: 1 (1 datetime), nodes: 1
+<elected>: datetime @index(hour) .
+
+# values: 2 (2 datetime), nodes: 2
+<founded>: datetime @index(month) .
+
+# values: 1 (1 geo), nodes: 1
+<location>: geo @index(geo) .
+
+# values: 1 (1 uid), nodes: 1
+<mayor>: uid .
+
+# values: 3 (3 string), nodes: 3, distinct strings: 3
+<name>: string @index(exact, term) .
+
+# values: 2 (1 int, 1 string), nodes: 2, distinct strings: 1
+# Warning: 1 of the values aren't of type string.
+<population>: string @index(exact) .
+
+# values: 3 (3 string), nodes: 2, distinct strings: 2
+<tags>: [string] @index(exact) .
+
+type City {
+	area
+	capital
+	founded
+	location
+	mayor
+	name
+	population
+	tags
+}
+
+# 1 of the 3 sampled nodes have no dgraph.type.
+`, schema)
+}
+
+func TestInferSkipsJSON(t *testing.T) {
+	for _, name := range []string{"sample.json", "sample.jsonl"} {
+		data := `[
+  {"name": "Alice", "age": 30},
+  {"name": "Bob" "age": 31},
+  {"name": "Carol", "age": 32}
+]`
+		if name == "sample.jsonl" {
+			data = `{"name": "Alice", "age": 30}
+{"name": "Bob" "age": 31}
+{"name": "Carol", "age": 32}
+`
+		}
+		in, _ := inferFile(t, name, data)
+		// The object which can't be parsed is skipped, and the others are sampled.
+		require.Equal(t, 1, in.skipped, name)
+		require.Equal(t, 4, in.nquads, name)
+		require.Equal(t, map[types.TypeID]int{types.IntID: 2}, in.preds["age"].counts, name)
+	}
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+
+	"github.com/dgraph-io/dgraph/chunker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Schema is the sub-command used to work with schema files.
+var Schema x.SubCommand
+
+var inferOpt struct {
+	files, format, out string
+	sample             int
+}
+
+func init() {
+	Schema.Cmd = &cobra.Command{
+		Use:   "schema",
+		Short: "Run Dgraph schema tools",
+	}
+	Schema.EnvPrefix = "DGRAPH_SCHEMA"
+
+	infer := &cobra.Command{
+		Use:   "infer",
+		Short: "Infer a schema from a sample of the data to load",
+		Long: `
+infer reads a sample of RDF or JSON data files and writes a schema for them, to review and
+edit before loading the data with the bulk or live loader. Without a schema, the predicates
+are loaded as default values without indexes.
+
+The schema has the type of each predicate, whether it is a list, a uid edge or has values in
+several languages, candidate indexes and the types of the nodes, from their dgraph.type
+values. The type of untyped RDF values is inferred from their text: booleans, integers,
+floats, datetimes and GeoJSON geometries. Each predicate comes with a comment describing its
+sampled values, and a warning if they have different types.
+
+Usage examples:
+
+# Infer the schema of the first 100000 N-Quads of a file:
+$ dgraph schema infer -f data.rdf.gz -o data.schema
+
+# Infer the schema of all the JSON files of a directory, from their whole data:
+$ dgraph schema infer -f data/ --format json --sample 0
+		`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Schema.Conf).Stop()
+			if err := runInferCmd(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	flag := infer.Flags()
+	flag.StringVarP(&inferOpt.files, "files", "f", "",
+		"Location of *.rdf(.gz), *.json(.gz) or *.jsonl(.gz) file(s) to sample (required).")
+	flag.StringVarP(&inferOpt.format, "format", "", "",
+		"Specify file format (rdf, json or jsonl) instead of getting it from filename.")
+	flag.StringVarP(&inferOpt.out, "out", "o", "inferred.schema",
+		"The schema output file, or - to write it to stdout.")
+	flag.IntVarP(&inferOpt.sample, "sample", "", 100000, "Number of N-Quads to sample, "+
+		"split evenly between the files. If zero, all the N-Quads are read.")
+	_ = infer.MarkFlagRequired("files")
+
+	Schema.Cmd.AddCommand(infer)
+}
+
+func runInferCmd() error {
+	files := x.FindDataFiles(inferOpt.files, []string{".rdf", ".rdf.gz", ".json", ".json.gz",
+		".jsonl", ".jsonl.gz", ".ndjson", ".ndjson.gz"})
+	if len(files) == 0 {
+		return errors.Errorf("no data files found in %s", inferOpt.files)
+	}
+	limit := 0
+	if inferOpt.sample > 0 {
+		if limit = inferOpt.sample / len(files); limit == 0 {
+			limit = 1
+		}
+	}
+
+	in := newInferrer()
+	for _, file := range files {
+		if err := sampleFile(in, file, limit); err != nil {
+			return err
+		}
+	}
+
+	w := os.Stdout
+	if inferOpt.out != "-" {
+		f, err := os.Create(inferOpt.out)
+		if err != nil {
+			return errors.Wrapf(err, "while creating schema file %s", inferOpt.out)
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	if err := in.write(bw, inferOpt.files); err != nil {
+		return errors.Wrapf(err, "while writing schema file %s", inferOpt.out)
+	}
+	if err := bw.Flush(); err != nil {
+		return errors.Wrapf(err, "while writing schema file %s", inferOpt.out)
+	}
+	if inferOpt.out != "-" {
+		fmt.Fprintf(os.Stderr, "Inferred the schema of %d predicates from %d N-Quads in %s\n",
+			len(in.preds), in.nquads, inferOpt.out)
+	}
+	if in.skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Skipped %d lines or objects which couldn't be parsed\n", in.skipped)
+	}
+	if inferOpt.out != "-" {
+		return w.Close()
+	}
+	return nil
+}
+
+// sampleFile adds up to limit N-Quads of a file to the inferrer, or all of them if limit is zero.
+// The RDF lines and JSON objects which can't be parsed are skipped.
+func sampleFile(in *inferrer, file string, limit int) error {
+	loadType := chunker.DataFormat(file, inferOpt.format)
+	switch loadType {
+	case chunker.RdfFormat, chunker.JsonFormat, chunker.JsonLinesFormat:
+	case chunker.UnknownFormat:
+		return errors.Errorf("need --format=rdf, --format=json or --format=jsonl to read %s",
+			file)
+	default:
+		// The mappings of these formats already declare the type of each predicate.
+		return errors.Errorf("can't infer the schema of %s, only RDF and JSON files are "+
+			"supported", file)
+	}
+
+	r, cleanup := chunker.FileReader(file, "")
+	defer cleanup()
+	chunk := chunker.NewChunker(loadType, 1000)
+	nquads := chunk.NQuads()
+
+	// The N-Quads of the last parsed chunk past the limit are drained, so that parsing it
+	// doesn't block.
+	var count int64
+	done := make(chan struct{})
+	go func() {
+		for nqs := range nquads.Ch() {
+			for _, nq := range nqs {
+				if limit == 0 || atomic.LoadInt64(&count) < int64(limit) {
+					in.add(nq)
+					atomic.AddInt64(&count, 1)
+				}
+			}
+		}
+		close(done)
+	}()
+
+	var err error
+	for limit == 0 || atomic.LoadInt64(&count) < int64(limit) {
+		var chunkBuf *bytes.Buffer
+		chunkBuf, err = chunk.Chunk(r)
+		if chunkBuf != nil && chunkBuf.Len() > 0 {
+			if perr := chunk.Parse(chunkBuf); perr != nil {
+				lineErrs, ok := perr.(chunker.LineErrors)
+				if !ok {
+					err = perr
+					break
+				}
+				in.skipped += len(lineErrs)
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	nquads.Flush()
+	<-done
+	in.addHints(nquads.Metadata())
+
+	if err == io.EOF {
+		return nil
+	}
+	return errors.Wrapf(err, "while reading %s", file)
+}
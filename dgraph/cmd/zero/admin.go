@@ -0,0 +1,915 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const zeroAdminSchema = `
+	type MembershipState {
+		counter: Int
+		groups: [ClusterGroup]
+		zeros: [Member]
+		maxLeaseId: Int
+		maxTxnTs: Int
+		maxRaftId: Int
+		removed: [Member]
+		cid: String
+		license: License
+		pinned: [PinnedPredicate]
+
+		"""
+		The predicate move in progress, only known by the Zero leader.
+		"""
+		ongoingMove: PredicateMove
+
+		"""
+		Set if this cluster is, or was, the standby of a primary cluster.
+		"""
+		replication: Replication
+	}
+
+	type ClusterGroup {
+		id: Int
+		members: [Member]
+		tablets: [Tablet]
+		snapshotTs: Int
+		checksum: Int
+
+		"""
+		The tablets of a draining group are moved to the other groups, and no new tablets are
+		assigned to it.
+		"""
+		draining: Boolean
+	}
+
+	type Member {
+		id: Int
+		groupId: Int
+		addr: String
+		leader: Boolean
+		amDead: Boolean
+		lastUpdate: Int
+		clusterInfoOnly: Boolean
+		forceGroupId: Boolean
+	}
+
+	type Tablet {
+		groupId: Int
+		predicate: String
+		space: Int
+		readOnly: Boolean
+		moveTs: Int
+
+		"""
+		Set for the shards of a predicate sharded by uid ranges.
+		"""
+		startUid: Int
+		endUid: Int
+
+		"""
+		Queries and mutated edges per second, as reported by the group leader.
+		"""
+		readRate: Float
+		writeRate: Float
+
+		"""
+		The group the predicate is pinned to, if any.
+		"""
+		pinnedGroup: Int
+	}
+
+	type License {
+		user: String
+		maxNodes: Int
+		expiryTs: Int
+		enabled: Boolean
+	}
+
+	type PinnedPredicate {
+		predicate: String
+		groupId: Int
+	}
+
+	type Leases {
+		maxLeaseId: Int
+		maxTxnTs: Int
+		maxRaftId: Int
+	}
+
+	type PredicateMove {
+		predicate: String
+		sourceGroup: Int
+		destGroup: Int
+
+		"""
+		One of copy, catch-up or final.
+		"""
+		phase: String
+		round: Int
+		keysMoved: Int
+
+		"""
+		Unix time in seconds.
+		"""
+		startedAt: Int
+		finishedAt: Int
+
+		"""
+		The error which stopped the move, if any.
+		"""
+		error: String
+	}
+
+	type TabletMove {
+		predicate: String
+		srcGroup: Int
+		dstGroup: Int
+		reason: String
+	}
+
+	type Replication {
+		standby: Boolean
+
+		"""
+		Unix time in seconds at which the standby cluster was promoted.
+		"""
+		promotedAt: Int
+		groups: [ReplicatedGroup]
+	}
+
+	type ReplicatedGroup {
+		"""
+		The group of the primary cluster.
+		"""
+		groupId: Int
+
+		"""
+		The data of the group changed up to appliedTs has been applied by the standby.
+		"""
+		appliedTs: Int
+
+		"""
+		Unix time in seconds at which appliedTs was read on the primary, and at which the
+		standby applied it. The replication lag is the current time minus sentAt.
+		"""
+		sentAt: Int
+		appliedAt: Int
+		maxUid: Int
+	}
+
+	type Response {
+		code: String
+		message: String
+	}
+
+	input RemoveNodeInput {
+		nodeId: Int!
+
+		"""
+		Zero for a Zero node.
+		"""
+		groupId: Int!
+	}
+
+	type RemoveNodePayload {
+		response: Response
+	}
+
+	input MoveTabletInput {
+		tablet: String!
+		groupId: Int!
+	}
+
+	type MoveTabletPayload {
+		response: Response
+	}
+
+	input PinPredicateInput {
+		predicate: String!
+		groupId: Int!
+	}
+
+	type PinPredicatePayload {
+		response: Response
+	}
+
+	input UnpinPredicateInput {
+		predicate: String!
+	}
+
+	type UnpinPredicatePayload {
+		response: Response
+	}
+
+	input DrainGroupInput {
+		groupId: Int!
+
+		"""
+		Whether to set or unset the draining mode of the group. Defaults to true.
+		"""
+		enable: Boolean
+	}
+
+	type DrainGroupPayload {
+		response: Response
+	}
+
+	type PromotePayload {
+		response: Response
+	}
+
+	type Query {
+		state: MembershipState
+
+		"""
+		The tablets of the cluster, optionally only those of a group or predicate.
+		"""
+		tablets(groupId: Int, predicate: String): [Tablet]
+		leases: Leases
+
+		"""
+		The last predicate moves done by this Zero while being the leader, oldest first.
+		"""
+		moveHistory: [PredicateMove]
+
+		"""
+		The predicate moves the rebalancer would do next, with the given policy or else the one
+		Zero runs with.
+		"""
+		rebalancePlan(policy: String): [TabletMove]
+	}
+
+	type Mutation {
+
+		"""
+		Remove a dead node from the cluster. Ensure that the node is down, and never comes back.
+		"""
+		removeNode(input: RemoveNodeInput!): RemoveNodePayload
+
+		"""
+		Move a tablet to a group. Only allowed on the Zero leader.
+		"""
+		moveTablet(input: MoveTabletInput!): MoveTabletPayload
+
+		"""
+		Pin a predicate to a group. The rebalancer moves it there if needed, and keeps it there.
+		"""
+		pinPredicate(input: PinPredicateInput!): PinPredicatePayload
+		unpinPredicate(input: UnpinPredicateInput!): UnpinPredicatePayload
+
+		"""
+		Set (or unset) the draining mode of a group. The rebalancer moves the tablets of a
+		draining group to the other groups, and no new tablets are assigned to it.
+		"""
+		drainGroup(input: DrainGroupInput!): DrainGroupPayload
+
+		"""
+		Promote a standby cluster to a regular one, which accepts writes. Stop the replication
+		from the primary cluster before.
+		"""
+		promote: PromotePayload
+	}
+`
+
+// adminResolver resolves a query or mutation of the admin API to a value made of maps, slices
+// and scalars, which gets completed according to the selection set of the field.
+type adminResolver func(ctx context.Context, f schema.Field) (interface{}, error)
+
+// adminServer serves the GraphQL admin API of Zero at /admin.
+type adminServer struct {
+	st        *state
+	schema    schema.Schema
+	queries   map[string]adminResolver
+	mutations map[string]adminResolver
+}
+
+func newAdminServer(st *state) (*adminServer, error) {
+	sch, err := schema.FromString(zeroAdminSchema)
+	if err != nil {
+		return nil, err
+	}
+	as := &adminServer{st: st, schema: sch}
+	as.queries = map[string]adminResolver{
+		"state":         as.resolveState,
+		"tablets":       as.resolveTablets,
+		"leases":        as.resolveLeases,
+		"moveHistory":   as.resolveMoveHistory,
+		"rebalancePlan": as.resolveRebalancePlan,
+	}
+	as.mutations = map[string]adminResolver{
+		"removeNode":     as.resolveRemoveNode,
+		"moveTablet":     as.resolveMoveTablet,
+		"pinPredicate":   as.resolvePinPredicate,
+		"unpinPredicate": as.resolveUnpinPredicate,
+		"drainGroup":     as.resolveDrainGroup,
+		"promote":        as.resolvePromote,
+	}
+	return as, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (as *adminServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		return
+	}
+
+	var resp *schema.Response
+	if req, err := adminRequest(r); err != nil {
+		resp = schema.ErrorResponse(err)
+	} else {
+		resp = as.resolve(r.Context(), req)
+	}
+	if _, err := resp.WriteTo(w); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// adminRequest reads the GraphQL request, the same way as the /admin endpoint of Alpha does.
+func adminRequest(r *http.Request) (*schema.Request, error) {
+	req := &schema.Request{}
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables, ok := query["variables"]; ok {
+			d := json.NewDecoder(strings.NewReader(variables[0]))
+			d.UseNumber()
+			if err := d.Decode(&req.Variables); err != nil {
+				return nil, errors.Wrap(err, "Not a valid GraphQL request body")
+			}
+		}
+	case http.MethodPost:
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse media type")
+		}
+		switch mediaType {
+		case "application/json":
+			d := json.NewDecoder(r.Body)
+			d.UseNumber()
+			if err := d.Decode(req); err != nil {
+				return nil, errors.Wrap(err, "Not a valid GraphQL request body")
+			}
+		case "application/graphql":
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return nil, errors.Wrap(err, "Could not read GraphQL request body")
+			}
+			req.Query = string(body)
+		default:
+			return nil, errors.New("Unrecognised Content-Type.  Please use application/json or " +
+				"application/graphql for GraphQL requests")
+		}
+	default:
+		return nil, errors.New(
+			"Unrecognised request method.  Please use GET or POST for GraphQL requests")
+	}
+	return req, nil
+}
+
+// resolve resolves the operation of the request. Queries and mutations are resolved one after the
+// other, in the order of the request.
+func (as *adminServer) resolve(ctx context.Context, req *schema.Request) *schema.Response {
+	op, err := as.schema.Operation(req)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	if op.IsSubscription() {
+		return schema.ErrorResponse(errors.New("Subscriptions aren't supported by Zero"))
+	}
+
+	resp := &schema.Response{}
+	if op.IsMutation() {
+		for _, m := range op.Mutations() {
+			as.resolveField(ctx, resp, m, as.mutations, "Mutation")
+		}
+		return resp
+	}
+	for _, q := range op.Queries() {
+		if q.Name() == "__schema" || q.Name() == "__type" {
+			data, err := schema.Introspect(q)
+			resp.AddData(data)
+			if err != nil {
+				resp.WithError(err)
+			}
+			continue
+		}
+		as.resolveField(ctx, resp, q, as.queries, "Query")
+	}
+	return resp
+}
+
+func (as *adminServer) resolveField(ctx context.Context, resp *schema.Response, f schema.Field,
+	resolvers map[string]adminResolver, typeName string) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	writeKey(&buf, f.ResponseName())
+
+	var val interface{}
+	var err error
+	switch resolver, ok := resolvers[f.Name()]; {
+	case f.Name() == schema.Typename:
+		val = typeName
+	case !ok:
+		err = errors.Errorf("%s was not executed because no suitable resolver could be found",
+			f.Name())
+	default:
+		val, err = resolver(ctx, f)
+	}
+	if err != nil {
+		resp.WithError(schema.GQLWrapLocationf(err, f.Location(), "resolving %s failed",
+			f.Name()))
+		val = nil
+	}
+	if err := completeValue(&buf, f, val); err != nil {
+		resp.WithError(schema.GQLWrapLocationf(err, f.Location(), "completing %s failed",
+			f.Name()))
+		return
+	}
+	buf.WriteRune('}')
+	resp.AddData(buf.Bytes())
+}
+
+func writeKey(buf *bytes.Buffer, key string) {
+	x.Check2(buf.WriteString(strconv.Quote(key)))
+	x.Check2(buf.WriteRune(':'))
+}
+
+// completeValue writes the value as JSON, keeping only the fields selected by f in the objects
+// it's made of, in the order they were selected.
+func completeValue(buf *bytes.Buffer, f schema.Field, val interface{}) error {
+	switch v := val.(type) {
+	case nil:
+		x.Check2(buf.WriteString("null"))
+	case []interface{}:
+		x.Check2(buf.WriteRune('['))
+		for i, item := range v {
+			if i > 0 {
+				x.Check2(buf.WriteRune(','))
+			}
+			if err := completeValue(buf, f, item); err != nil {
+				return err
+			}
+		}
+		x.Check2(buf.WriteRune(']'))
+	case map[string]interface{}:
+		x.Check2(buf.WriteRune('{'))
+		var i int
+		for _, sel := range f.SelectionSet() {
+			if sel.Skip() || !sel.Include() {
+				continue
+			}
+			if i > 0 {
+				x.Check2(buf.WriteRune(','))
+			}
+			i++
+			writeKey(buf, sel.ResponseName())
+			if sel.Name() == schema.Typename {
+				x.Check2(buf.WriteString(strconv.Quote(f.Type().Name())))
+				continue
+			}
+			if err := completeValue(buf, sel, v[sel.Name()]); err != nil {
+				return err
+			}
+		}
+		x.Check2(buf.WriteRune('}'))
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		x.Check2(buf.Write(b))
+	}
+	return nil
+}
+
+// toValue converts the JSON encoding of v to maps, slices and scalars. Numbers are kept as
+// json.Number, so that uint64s keep their precision.
+func toValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var out interface{}
+	err = d.Decode(&out)
+	return out, err
+}
+
+// toUint64 converts a numeric argument to uint64.
+func toUint64(v interface{}) (uint64, error) {
+	switch n := v.(type) {
+	case int64:
+		return uint64(n), nil
+	case int:
+		return uint64(n), nil
+	case float64:
+		return uint64(n), nil
+	case json.Number:
+		return strconv.ParseUint(n.String(), 10, 64)
+	default:
+		return 0, errors.Errorf("Expected a number, got: %v", v)
+	}
+}
+
+func inputArg(f schema.Field) map[string]interface{} {
+	input, _ := f.ArgValue("input").(map[string]interface{})
+	return input
+}
+
+func uintArg(args map[string]interface{}, name string) (uint64, error) {
+	val, err := toUint64(args[name])
+	return val, errors.Wrapf(err, "while reading %s", name)
+}
+
+func success(format string, args ...interface{}) interface{} {
+	return map[string]interface{}{
+		"response": map[string]interface{}{
+			"code":    "Success",
+			"message": fmt.Sprintf(format, args...),
+		},
+	}
+}
+
+func tabletValue(tab *pb.Tablet, pinned map[string]uint32) (interface{}, error) {
+	val, err := toValue(tab)
+	if err != nil {
+		return nil, err
+	}
+	if gid, ok := pinned[tab.Predicate]; ok {
+		val.(map[string]interface{})["pinnedGroup"] = gid
+	}
+	return val, nil
+}
+
+func moveValue(move *pb.PredicateMove) interface{} {
+	if move == nil {
+		return nil
+	}
+	val := map[string]interface{}{
+		"predicate":   move.Predicate,
+		"sourceGroup": move.SourceGid,
+		"destGroup":   move.DestGid,
+		"phase":       move.Phase,
+		"round":       move.Round,
+		"keysMoved":   move.KeysMoved,
+		"startedAt":   move.StartedAt,
+	}
+	if move.FinishedAt > 0 {
+		val["finishedAt"] = move.FinishedAt
+	}
+	if len(move.Error) > 0 {
+		val["error"] = move.Error
+	}
+	return val
+}
+
+func (as *adminServer) membershipState(ctx context.Context) (*pb.MembershipState, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := as.st.node.WaitLinearizableRead(ctx); err != nil {
+		return nil, err
+	}
+	ms := as.st.zero.membershipState()
+	if ms == nil {
+		return nil, errors.New("No membership state found.")
+	}
+	return ms, nil
+}
+
+// resolveState returns the membership state. The maps of the state are turned into lists, since
+// GraphQL has no map type.
+func (as *adminServer) resolveState(ctx context.Context, f schema.Field) (interface{}, error) {
+	ms, err := as.membershipState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gids []uint32
+	for gid := range ms.Groups {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	var groups []interface{}
+	for _, gid := range gids {
+		group := ms.Groups[gid]
+		var members, tablets []interface{}
+		for _, m := range sortedMembers(group.Members) {
+			val, err := toValue(m)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, val)
+		}
+		for _, tab := range sortedTablets(group.Tablets) {
+			val, err := tabletValue(tab, ms.Pinned)
+			if err != nil {
+				return nil, err
+			}
+			tablets = append(tablets, val)
+		}
+		groups = append(groups, map[string]interface{}{
+			"id":         gid,
+			"members":    members,
+			"tablets":    tablets,
+			"snapshotTs": group.SnapshotTs,
+			"checksum":   group.Checksum,
+			"draining":   group.Draining,
+		})
+	}
+
+	zeros, err := toValue(sortedMembers(ms.Zeros))
+	if err != nil {
+		return nil, err
+	}
+	removed, err := toValue(ms.Removed)
+	if err != nil {
+		return nil, err
+	}
+	license, err := toValue(ms.License)
+	if err != nil {
+		return nil, err
+	}
+	var preds []string
+	for pred := range ms.Pinned {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	var pinned []interface{}
+	for _, pred := range preds {
+		pinned = append(pinned, map[string]interface{}{
+			"predicate": pred,
+			"groupId":   ms.Pinned[pred],
+		})
+	}
+	return map[string]interface{}{
+		"counter":     ms.Counter,
+		"groups":      groups,
+		"zeros":       zeros,
+		"maxLeaseId":  ms.MaxLeaseId,
+		"maxTxnTs":    ms.MaxTxnTs,
+		"maxRaftId":   ms.MaxRaftId,
+		"removed":     removed,
+		"cid":         ms.Cid,
+		"license":     license,
+		"pinned":      pinned,
+		"ongoingMove": moveValue(as.st.zero.ongoingMoveState()),
+		"replication": replicationValue(ms.Replication),
+	}, nil
+}
+
+func replicationValue(r *pb.ReplicationState) interface{} {
+	if r == nil {
+		return nil
+	}
+	var gids []uint32
+	for gid := range r.Groups {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	var groups []interface{}
+	for _, gid := range gids {
+		st := r.Groups[gid]
+		groups = append(groups, map[string]interface{}{
+			"groupId":   gid,
+			"appliedTs": st.AppliedTs,
+			"sentAt":    st.SentAt,
+			"appliedAt": st.AppliedAt,
+			"maxUid":    st.MaxUid,
+		})
+	}
+	return map[string]interface{}{
+		"standby":    r.Standby,
+		"promotedAt": r.PromotedAt,
+		"groups":     groups,
+	}
+}
+
+func sortedMembers(members map[uint64]*pb.Member) []*pb.Member {
+	out := make([]*pb.Member, 0, len(members))
+	for _, m := range members {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Id < out[j].Id })
+	return out
+}
+
+func sortedTablets(tablets map[string]*pb.Tablet) []*pb.Tablet {
+	out := make([]*pb.Tablet, 0, len(tablets))
+	for _, tab := range tablets {
+		out = append(out, tab)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Predicate != out[j].Predicate {
+			return out[i].Predicate < out[j].Predicate
+		}
+		return out[i].StartUid < out[j].StartUid
+	})
+	return out
+}
+
+func (as *adminServer) resolveTablets(ctx context.Context, f schema.Field) (interface{}, error) {
+	var gid uint64
+	if arg := f.ArgValue("groupId"); arg != nil {
+		var err error
+		if gid, err = toUint64(arg); err != nil {
+			return nil, err
+		}
+	}
+	pred, _ := f.ArgValue("predicate").(string)
+	ms, err := as.membershipState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tablets []*pb.Tablet
+	for id, group := range ms.Groups {
+		if gid > 0 && uint64(id) != gid {
+			continue
+		}
+		for _, tab := range group.Tablets {
+			if len(pred) == 0 || tab.Predicate == pred {
+				tablets = append(tablets, tab)
+			}
+		}
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		if tablets[i].Predicate != tablets[j].Predicate {
+			return tablets[i].Predicate < tablets[j].Predicate
+		}
+		return tablets[i].StartUid < tablets[j].StartUid
+	})
+	out := make([]interface{}, 0, len(tablets))
+	for _, tab := range tablets {
+		val, err := tabletValue(tab, ms.Pinned)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, val)
+	}
+	return out, nil
+}
+
+func (as *adminServer) resolveLeases(ctx context.Context, f schema.Field) (interface{}, error) {
+	ms, err := as.membershipState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"maxLeaseId": ms.MaxLeaseId,
+		"maxTxnTs":   ms.MaxTxnTs,
+		"maxRaftId":  ms.MaxRaftId,
+	}, nil
+}
+
+func (as *adminServer) resolveMoveHistory(ctx context.Context, f schema.Field) (interface{}, error) {
+	moves := as.st.zero.moveHistoryState()
+	out := make([]interface{}, 0, len(moves))
+	for _, move := range moves {
+		out = append(out, moveValue(move))
+	}
+	return out, nil
+}
+
+func (as *adminServer) resolveRebalancePlan(ctx context.Context,
+	f schema.Field) (interface{}, error) {
+	policy, _ := f.ArgValue("policy").(string)
+	if len(policy) == 0 {
+		policy = opts.rebalancePolicy
+	}
+	moves, err := as.st.zero.planRebalance(policy)
+	if err != nil {
+		return nil, err
+	}
+	if moves == nil {
+		moves = []tabletMove{}
+	}
+	return toValue(moves)
+}
+
+func (as *adminServer) resolveRemoveNode(ctx context.Context, f schema.Field) (interface{}, error) {
+	input := inputArg(f)
+	nodeId, err := uintArg(input, "nodeId")
+	if err != nil {
+		return nil, err
+	}
+	groupId, err := uintArg(input, "groupId")
+	if err != nil {
+		return nil, err
+	}
+	if err := as.st.zero.removeNode(ctx, nodeId, uint32(groupId)); err != nil {
+		return nil, err
+	}
+	return success("Removed node with group: %v, idx: %v", groupId, nodeId), nil
+}
+
+func (as *adminServer) resolveMoveTablet(ctx context.Context, f schema.Field) (interface{}, error) {
+	if !as.st.node.AmLeader() {
+		return nil, errors.New("This Zero server is not the leader. Re-run command on leader.")
+	}
+	input := inputArg(f)
+	tablet, _ := input["tablet"].(string)
+	groupId, err := uintArg(input, "groupId")
+	if err != nil {
+		return nil, err
+	}
+	dstGroup := uint32(groupId)
+	srcGroup, err := as.st.zero.checkTabletMove(tablet, dstGroup)
+	if err != nil {
+		return nil, err
+	}
+	if err := as.st.zero.movePredicate(tablet, srcGroup, dstGroup); err != nil {
+		return nil, err
+	}
+	return success("Predicate: [%s] moved from group [%d] to [%d]", tablet, srcGroup,
+		dstGroup), nil
+}
+
+func (as *adminServer) resolvePinPredicate(ctx context.Context,
+	f schema.Field) (interface{}, error) {
+	input := inputArg(f)
+	pred, _ := input["predicate"].(string)
+	groupId, err := uintArg(input, "groupId")
+	if err != nil {
+		return nil, err
+	}
+	if groupId == 0 {
+		return nil, errors.New("groupId must be greater than zero")
+	}
+	if err := as.st.zero.pinPredicate(ctx, pred, uint32(groupId)); err != nil {
+		return nil, err
+	}
+	return success("Predicate: [%s] pinned to group [%d]", pred, groupId), nil
+}
+
+func (as *adminServer) resolveUnpinPredicate(ctx context.Context,
+	f schema.Field) (interface{}, error) {
+	pred, _ := inputArg(f)["predicate"].(string)
+	if err := as.st.zero.pinPredicate(ctx, pred, 0); err != nil {
+		return nil, err
+	}
+	return success("Predicate: [%s] unpinned", pred), nil
+}
+
+func (as *adminServer) resolveDrainGroup(ctx context.Context, f schema.Field) (interface{}, error) {
+	input := inputArg(f)
+	groupId, err := uintArg(input, "groupId")
+	if err != nil {
+		return nil, err
+	}
+	enable := true
+	if val, ok := input["enable"].(bool); ok {
+		enable = val
+	}
+	if err := as.st.zero.drainGroup(ctx, uint32(groupId), enable); err != nil {
+		return nil, err
+	}
+	return success("Draining mode of group [%d] set to %v", groupId, enable), nil
+}
+
+func (as *adminServer) resolvePromote(ctx context.Context, f schema.Field) (interface{}, error) {
+	if !as.st.node.AmLeader() {
+		return nil, errors.New("This Zero server is not the leader. Re-run command on leader.")
+	}
+	if err := as.st.zero.promote(ctx); err != nil {
+		return nil, err
+	}
+	return success("Standby cluster promoted"), nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func testAdmin(t *testing.T, s *Server) *adminServer {
+	as, err := newAdminServer(&state{zero: s})
+	require.NoError(t, err)
+	return as
+}
+
+func resolveAdmin(t *testing.T, as *adminServer, query string) *schema.Response {
+	resp := as.resolve(context.Background(), &schema.Request{Query: query})
+	var buf bytes.Buffer
+	_, err := resp.WriteTo(&buf)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestAdminMoveHistory(t *testing.T) {
+	s := &Server{moveHistory: []*pb.PredicateMove{
+		{Predicate: "name", SourceGid: 1, DestGid: 2, Phase: "final", FinishedAt: 20},
+		{Predicate: "age", SourceGid: 2, DestGid: 1, Error: "group 1 is down"},
+	}}
+	resp := resolveAdmin(t, testAdmin(t, s),
+		`{ history: moveHistory { predicate destGroup error finishedAt __typename } }`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{"history": [
+		{"predicate": "name", "destGroup": 2, "error": null, "finishedAt": 20,
+			"__typename": "PredicateMove"},
+		{"predicate": "age", "destGroup": 1, "error": "group 1 is down", "finishedAt": null,
+			"__typename": "PredicateMove"}
+	]}`, resp.Data.String())
+}
+
+func TestAdminRebalancePlan(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{
+		Groups: map[uint32]*pb.Group{
+			1: {
+				Members: map[uint64]*pb.Member{1: {Id: 1, Leader: true}},
+				Tablets: map[string]*pb.Tablet{"name": {GroupId: 1, Predicate: "name"}},
+			},
+			2: {
+				Members: map[uint64]*pb.Member{2: {Id: 2, Leader: true}},
+			},
+		},
+		Pinned: map[string]uint32{"name": 2},
+	}}
+	resp := resolveAdmin(t, testAdmin(t, s),
+		`{ rebalancePlan(policy: "size") { predicate srcGroup dstGroup } }`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{"rebalancePlan": [{"predicate": "name", "srcGroup": 1, "dstGroup": 2}]}`,
+		resp.Data.String())
+
+	resp = resolveAdmin(t, testAdmin(t, s), `{ rebalancePlan(policy: "random") { predicate } }`)
+	require.Len(t, resp.Errors, 1)
+	require.Contains(t, resp.Errors[0].Message, "Unknown rebalance policy")
+	require.JSONEq(t, `{"rebalancePlan": null}`, resp.Data.String())
+}
+
+func TestAdminErrors(t *testing.T) {
+	as := testAdmin(t, &Server{})
+	resp := resolveAdmin(t, as, `mutation {
+		pinPredicate(input: {predicate: "name", groupId: 0}) { response { code } } }`)
+	require.Len(t, resp.Errors, 1)
+	require.Contains(t, resp.Errors[0].Message, "groupId must be greater than zero")
+
+	resp = resolveAdmin(t, as, `{ tablets(groupId: "one") { predicate } }`)
+	require.NotEmpty(t, resp.Errors)
+
+	resp = resolveAdmin(t, as, `{ __schema { queryType { name } } }`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{"__schema": {"queryType": {"name": "Query"}}}`, resp.Data.String())
+}
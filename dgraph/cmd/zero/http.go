@@ -18,6 +18,7 @@ package zero
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -164,9 +165,57 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	dstGroup := uint32(groupId)
-	knownGroups := st.zero.KnownGroups()
+	srcGroup, err := st.zero.checkTabletMove(tablet, dstGroup)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+
+	if err := st.zero.movePredicate(tablet, srcGroup, dstGroup); err != nil {
+		glog.Errorf("While moving predicate %s from %d -> %d. Error: %v",
+			tablet, srcGroup, dstGroup, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	_, err = fmt.Fprintf(w, "Predicate: [%s] moved from group [%d] to [%d]",
+		tablet, srcGroup, dstGroup)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// splitTablet can be used to split a predicate into uid range shards. It takes in tablet, the uid
+// at which to split it, and the group which should serve the uids from there onwards.
+func (st *state) splitTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	at, ok := intFromQueryParam(w, r, "at")
+	if !ok {
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+	dstGroup := uint32(groupId)
 	var isKnown bool
-	for _, grp := range knownGroups {
+	for _, grp := range st.zero.KnownGroups() {
 		if grp == dstGroup {
 			isKnown = true
 			break
@@ -179,31 +228,118 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tab := st.zero.ServingTablet(tablet)
-	if tab == nil {
+	if err := st.zero.splitTablet(tablet, at, dstGroup); err != nil {
+		glog.Errorf("While splitting predicate %s at %#x. Error: %v", tablet, at, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	_, err := fmt.Fprintf(w, "Predicate: [%s] split at uid [%#x]. Group [%d] serves the uids "+
+		"from there onwards", tablet, at, dstGroup)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// mergeTablet can be used to merge a uid range shard of a predicate into the previous one. It
+// takes in tablet and the uid at which the shard starts.
+func (st *state) mergeTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
 		w.WriteHeader(http.StatusBadRequest)
-		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("No tablet found for: %s", tablet))
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
 		return
 	}
 
-	srcGroup := tab.GroupId
-	if srcGroup == dstGroup {
-		w.WriteHeader(http.StatusInternalServerError)
-		x.SetStatus(w, x.ErrorInvalidRequest,
-			fmt.Sprintf("Tablet: [%s] is already being served by group: [%d]", tablet, srcGroup))
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	at, ok := intFromQueryParam(w, r, "at")
+	if !ok {
 		return
 	}
 
-	if err := st.zero.movePredicate(tablet, srcGroup, dstGroup); err != nil {
-		glog.Errorf("While moving predicate %s from %d -> %d. Error: %v",
-			tablet, srcGroup, dstGroup, err)
+	if err := st.zero.mergeTablet(tablet, at); err != nil {
+		glog.Errorf("While merging shard of predicate %s at %#x. Error: %v", tablet, at, err)
 		w.WriteHeader(http.StatusInternalServerError)
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
-	_, err := fmt.Fprintf(w, "Predicate: [%s] moved from group [%d] to [%d]",
-		tablet, srcGroup, dstGroup)
+	_, err := fmt.Fprintf(w, "Shard of predicate: [%s] starting at uid [%#x] merged", tablet, at)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// promote turns a standby cluster into a regular one, which accepts writes.
+func (st *state) promote(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := st.zero.promote(ctx); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	if _, err := fmt.Fprintf(w, "Standby cluster promoted"); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// rebalancePlan returns the predicate moves the rebalancer would do next, without doing them. It
+// takes an optional policy argument, defaulting to the one Zero runs with.
+func (st *state) rebalancePlan(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	policy := r.URL.Query().Get("policy")
+	if len(policy) == 0 {
+		policy = opts.rebalancePolicy
+	}
+	moves, err := st.zero.planRebalance(policy)
 	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	plan := struct {
+		Policy string       `json:"policy"`
+		Moves  []tabletMove `json:"moves"`
+	}{Policy: policy, Moves: moves}
+	if plan.Moves == nil {
+		plan.Moves = []tabletMove{}
+	}
+	if err := json.NewEncoder(w).Encode(plan); err != nil {
 		glog.Warningf("Error while writing response: %+v", err)
 	}
 }
@@ -224,6 +360,7 @@ func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorNoData, "No membership state found.")
 		return
 	}
+	mstate.OngoingMove = st.zero.ongoingMoveState()
 
 	m := jsonpb.Marshaler{}
 	if err := m.Marshal(w, mstate); err != nil {
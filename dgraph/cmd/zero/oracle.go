@@ -283,8 +283,9 @@ func (o *Oracle) MaxPending() uint64 {
 	return o.maxAssigned
 }
 
-// ErrConflict is returned when commit couldn't succeed due to conflicts.
-var ErrConflict = errors.New("Transaction conflict")
+// ErrConflict is returned when commit couldn't succeed due to conflicts. It is defined in x so
+// that the packages Zero depends on can return it.
+var ErrConflict = x.ErrConflict
 
 // proposeTxn proposes a txn update, and then updates src to reflect the state
 // of the commit after proposal is run.
@@ -362,7 +363,13 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 			if tablet == nil {
 				return errors.Errorf("Tablet for %s is nil", pred)
 			}
-			if tablet.GroupId != uint32(gid) {
+			if tablet.StartUid > 0 {
+				// The mutations on a sharded predicate are done in the groups serving the uids.
+				if err := checkShardedCommit(pred, s.shards(pred), uint32(gid),
+					src.StartTs); err != nil {
+					return err
+				}
+			} else if tablet.GroupId != uint32(gid) {
 				return errors.Errorf("Mutation done in group: %d. Predicate %s assigned to %d",
 					gid, pred, tablet.GroupId)
 			}
@@ -203,7 +203,7 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 		}
 		return nil
 	}
-	if !has && len(group.Members) >= n.server.NumReplicas {
+	if !has && !member.Learner && numVoters(group) >= n.server.NumReplicas {
 		// We shouldn't allow more members than the number of replicas.
 		return errors.Errorf("Group reached replication level. Can't add another member: %+v", member)
 	}
@@ -214,7 +214,7 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 	group.Members[member.Id] = member
 	// Increment nextGroup when we have enough replicas
 	if member.GroupId == n.server.nextGroup &&
-		len(group.Members) >= n.server.NumReplicas {
+		numVoters(group) >= n.server.NumReplicas {
 		n.server.nextGroup++
 	}
 	if member.Leader {
@@ -260,11 +260,13 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	if tablet.GroupId == 0 {
 		return errors.Errorf("Tablet group id is zero: %+v", tablet)
 	}
+	// The shards of a predicate, other than the first one, are stored under their own keys.
+	key := x.ShardTabletKey(tablet.Predicate, tablet.StartUid)
 	group := state.Groups[tablet.GroupId]
 	if tablet.Remove {
-		glog.Infof("Removing tablet for attr: [%v], gid: [%v]\n", tablet.Predicate, tablet.GroupId)
+		glog.Infof("Removing tablet for attr: [%v], gid: [%v]\n", key, tablet.GroupId)
 		if group != nil {
-			delete(group.Tablets, tablet.Predicate)
+			delete(group.Tablets, key)
 		}
 		return nil
 	}
@@ -276,10 +278,10 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	// There's a edge case that we're handling.
 	// Two servers ask to serve the same tablet, then we need to ensure that
 	// only the first one succeeds.
-	if prev := n.server.servingTablet(tablet.Predicate); prev != nil {
+	if prev := n.server.servingTablet(key); prev != nil {
 		if tablet.Force {
 			originalGroup := state.Groups[prev.GroupId]
-			delete(originalGroup.Tablets, tablet.Predicate)
+			delete(originalGroup.Tablets, key)
 		} else {
 			if prev.GroupId != tablet.GroupId {
 				glog.Infof(
@@ -290,7 +292,24 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 		}
 	}
 	tablet.Force = false
-	group.Tablets[tablet.Predicate] = tablet
+	group.Tablets[key] = tablet
+	return nil
+}
+
+func (n *node) handlePinProposal(pin *pb.PinPredicate) error {
+	n.server.AssertLock()
+	state := n.server.state
+	if pin.GroupId == 0 {
+		delete(state.Pinned, pin.Predicate)
+		return nil
+	}
+	if _, ok := state.Groups[pin.GroupId]; !ok {
+		return errors.Errorf("Unknown group %d to pin predicate %s", pin.GroupId, pin.Predicate)
+	}
+	if state.Pinned == nil {
+		state.Pinned = make(map[string]uint32)
+	}
+	state.Pinned[pin.Predicate] = pin.GroupId
 	return nil
 }
 
@@ -353,6 +372,40 @@ func (n *node) applyProposal(e raftpb.Entry) (string, error) {
 			return p.Key, err
 		}
 	}
+	for _, tablet := range p.Tablets {
+		if tablet.GroupId == 0 {
+			return p.Key, errors.Errorf("Tablet group id is zero: %+v", tablet)
+		}
+	}
+	for _, tablet := range p.Tablets {
+		if err := n.handleTabletProposal(tablet); err != nil {
+			span.Annotatef(nil, "While applying tablet proposal: %+v", err)
+			glog.Errorf("While applying tablet proposal: %+v", err)
+			return p.Key, err
+		}
+	}
+	if p.Pin != nil {
+		if err := n.handlePinProposal(p.Pin); err != nil {
+			return p.Key, err
+		}
+	}
+	if p.Replicated != nil {
+		if err := n.handleReplicated(p.Replicated); err != nil {
+			return p.Key, err
+		}
+	}
+	if p.Replication != nil {
+		if err := n.handleReplication(p.Replication); err != nil {
+			return p.Key, err
+		}
+	}
+	if p.Drain != nil {
+		group, ok := state.Groups[p.Drain.GroupId]
+		if !ok {
+			return p.Key, errors.Errorf("Unknown group %d to drain", p.Drain.GroupId)
+		}
+		group.Draining = p.Drain.Enable
+	}
 	if p.License != nil {
 		// Check that the number of nodes in the cluster should be less than MaxNodes, otherwise
 		// reject the proposal.
@@ -383,6 +436,11 @@ func (n *node) applyProposal(e raftpb.Entry) (string, error) {
 	if p.Txn != nil {
 		n.server.orc.updateCommitStatus(e.Index, p.Txn)
 	}
+	if p.TsCheckpoint != nil {
+		if err := n.server.storeTsCheckpoint(p.TsCheckpoint); err != nil {
+			return p.Key, errors.Wrapf(err, "while storing timestamp checkpoint")
+		}
+	}
 
 	return p.Key, nil
 }
@@ -517,6 +575,14 @@ func (n *node) initAndStartNode() error {
 				time.Sleep(3 * time.Second)
 			}
 
+			if opts.standby {
+				if err := n.proposeAndWait(context.Background(), &pb.ZeroProposal{
+					Replication: &pb.ReplicationState{Standby: true},
+				}); err != nil {
+					glog.Errorf("While proposing the standby mode: %v", err)
+				}
+			}
+
 			if err := n.proposeTrialLicense(); err != nil {
 				glog.Errorf("while proposing trial license to cluster: %v", err)
 			}
@@ -624,7 +690,7 @@ func (n *node) Run() {
 	// snapshot can cause select loop to block while deleting entries, so run
 	// it in goroutine
 	readStateCh := make(chan raft.ReadState, 100)
-	closer := y.NewCloser(5)
+	closer := y.NewCloser(6)
 	defer func() {
 		closer.SignalAndWait()
 		n.closer.Done()
@@ -635,6 +701,7 @@ func (n *node) Run() {
 	go n.updateEnterpriseState(closer)
 	go n.updateZeroMembershipPeriodically(closer)
 	go n.checkQuorum(closer)
+	go n.checkpointTimestamps(closer)
 	go n.RunReadIndexLoop(closer, readStateCh)
 	if opts.LudicrousMode {
 		closer.AddRunning(1)
@@ -0,0 +1,631 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// tabletMove is a move of a tablet planned by a rebalance policy.
+type tabletMove struct {
+	Predicate string `json:"predicate"`
+	SrcGroup  uint32 `json:"srcGroup"`
+	DstGroup  uint32 `json:"dstGroup"`
+	Reason    string `json:"reason"`
+}
+
+// clusterTablets is the view of the cluster given to the rebalance policies. Policies are free to
+// modify it while planning, since it's a copy of the membership state.
+type clusterTablets struct {
+	// groups holds the tablets served by each group, keyed like in the membership state.
+	groups map[uint32]map[string]*pb.Tablet
+	// targets holds the groups which can receive tablets, those with a leader which aren't
+	// draining.
+	targets map[uint32]bool
+	// draining holds the groups whose tablets should be moved to other groups.
+	draining map[uint32]bool
+	// pinned holds the groups the pinned predicates should be served by.
+	pinned map[string]uint32
+	// colocate holds the sets of predicates which should be served by the same group, because
+	// they are frequently traversed together or share a composite index.
+	colocate [][]string
+	// composite holds the predicates of the composite indexes, keyed by each of them. They must
+	// be served by the same group, so they are only moved together.
+	composite map[string][]string
+	// maxMoves is the max number of moves to plan.
+	maxMoves int
+}
+
+// movable returns true if the tablet can be moved by the rebalance policies. Reserved predicates
+// should always be in group 1, the shards of a sharded predicate are moved by splitting and
+// merging them, and pinned predicates are kept in their group, along with the predicates sharing
+// a composite index with them.
+func (c *clusterTablets) movable(tab *pb.Tablet) bool {
+	if x.IsReservedPredicate(tab.Predicate) || tab.StartUid != 0 {
+		return false
+	}
+	if _, pinned := c.pinned[tab.Predicate]; pinned {
+		return false
+	}
+	for _, pred := range c.composite[tab.Predicate] {
+		if _, pinned := c.pinned[pred]; pinned {
+			return false
+		}
+	}
+	return true
+}
+
+// move records the move of the predicate in the cluster, and returns it.
+func (c *clusterTablets) move(pred string, src, dst uint32, reason string) tabletMove {
+	tab := c.groups[src][pred]
+	delete(c.groups[src], pred)
+	tab.GroupId = dst
+	c.groups[dst][pred] = tab
+	return tabletMove{Predicate: pred, SrcGroup: src, DstGroup: dst, Reason: reason}
+}
+
+// groupOf returns the group serving the predicate, if it's movable.
+func (c *clusterTablets) groupOf(pred string) (uint32, *pb.Tablet) {
+	for gid, tablets := range c.groups {
+		if tab, ok := tablets[pred]; ok && c.movable(tab) {
+			return gid, tab
+		}
+	}
+	return 0, nil
+}
+
+// smallestTarget returns the group which can receive tablets using the least space.
+func (c *clusterTablets) smallestTarget() uint32 {
+	var dst uint32
+	var min int64
+	for gid, tablets := range c.groups {
+		if !c.targets[gid] {
+			continue
+		}
+		var size int64
+		for _, tab := range tablets {
+			size += tab.Space
+		}
+		if dst == 0 || size < min || (size == min && gid < dst) {
+			dst, min = gid, size
+		}
+	}
+	return dst
+}
+
+// enforce returns the moves serving the pinned predicates by their group, and moving the tablets
+// of the draining groups to the other ones. The predicates sharing a composite index with a
+// pinned predicate are moved along with it.
+func (c *clusterTablets) enforce() []tabletMove {
+	var moves []tabletMove
+	var preds []string
+	for pred := range c.pinned {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	for _, pred := range preds {
+		dst := c.pinned[pred]
+		if !c.targets[dst] {
+			continue
+		}
+		unit := c.composite[pred]
+		if len(unit) == 0 {
+			unit = []string{pred}
+		}
+		for _, p := range unit {
+			for gid, tablets := range c.groups {
+				if tab, ok := tablets[p]; ok && gid != dst && tab.StartUid == 0 {
+					moves = append(moves, c.move(p, gid, dst,
+						fmt.Sprintf("pinned to group %d", dst)))
+					break
+				}
+			}
+		}
+	}
+
+	var gids []uint32
+	for gid := range c.draining {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	for _, gid := range gids {
+		for _, unit := range c.units(gid) {
+			dst := c.smallestTarget()
+			if dst == 0 {
+				return moves
+			}
+			for _, pred := range unit {
+				moves = append(moves, c.move(pred, gid, dst, fmt.Sprintf("drain group %d", gid)))
+			}
+		}
+	}
+	return moves
+}
+
+// followerReads holds the read rates of the tablets reported by the followers of the groups, keyed
+// by their Raft ID. The group leaders only count the queries they serve themselves, so these are
+// added to the read rates of their tablets when planning the moves.
+type followerReads struct {
+	sync.Mutex
+	reports map[uint64]*pb.Group
+}
+
+// record keeps the read rates reported by a follower, and returns true if the update was one.
+// Once a member reports as the leader, its reads are counted in the rates of the tablets.
+func (f *followerReads) record(group *pb.Group) bool {
+	f.Lock()
+	defer f.Unlock()
+	for id, member := range group.Members {
+		if member.Leader {
+			delete(f.reports, id)
+			return false
+		}
+		if len(group.Tablets) == 0 {
+			return false
+		}
+		if f.reports == nil {
+			f.reports = make(map[uint64]*pb.Group)
+		}
+		f.reports[id] = group
+		return true
+	}
+	return false
+}
+
+// addTo adds the read rates reported by the followers of the group to its tablets. The reports
+// of members which have left the group are dropped.
+func (f *followerReads) addTo(gid uint32, group *pb.Group, tablets map[string]*pb.Tablet) {
+	f.Lock()
+	defer f.Unlock()
+	for id, report := range f.reports {
+		member, ok := report.Members[id]
+		if !ok || member.GroupId != gid {
+			continue
+		}
+		if current, ok := group.Members[id]; !ok || current.Leader {
+			delete(f.reports, id)
+			continue
+		}
+		for key, tab := range report.Tablets {
+			if dst, ok := tablets[key]; ok {
+				dst.ReadRate += tab.ReadRate
+			}
+		}
+	}
+}
+
+// rebalancePolicy plans the tablet moves balancing the groups of the cluster.
+type rebalancePolicy interface {
+	// Plan returns the moves to do, in order.
+	Plan(c *clusterTablets) []tabletMove
+}
+
+// rebalancePolicies holds the policies which can be set via --rebalance_policy.
+var rebalancePolicies = map[string]rebalancePolicy{
+	// size balances the disk space used by the groups.
+	"size": &balancePolicy{metric: "size", value: func(tab *pb.Tablet) float64 {
+		return float64(tab.Space)
+	}},
+	// load balances the queries and mutations served by the groups, as reported by the Alphas.
+	"load": &balancePolicy{metric: "load", value: func(tab *pb.Tablet) float64 {
+		return tab.ReadRate + tab.WriteRate
+	}},
+}
+
+// balancePolicy moves tablets from the group with the highest value of a metric to the one with
+// the lowest, after co-locating the predicates which should be served together.
+type balancePolicy struct {
+	metric string
+	value  func(tab *pb.Tablet) float64
+}
+
+// Plan implements rebalancePolicy. The predicates moved together are never split by maxMoves, so
+// a plan can have a few more moves than it.
+func (p *balancePolicy) Plan(c *clusterTablets) []tabletMove {
+	var moves []tabletMove
+	for _, unit := range p.colocate(c) {
+		if len(moves) >= c.maxMoves {
+			return moves
+		}
+		moves = append(moves, unit...)
+	}
+	for len(moves) < c.maxMoves {
+		unit, src, dst := p.chooseUnit(c)
+		if len(unit) == 0 {
+			break
+		}
+		for _, pred := range unit {
+			moves = append(moves, c.move(pred, src, dst,
+				fmt.Sprintf("balance %s of groups", p.metric)))
+		}
+	}
+	return moves
+}
+
+// colocate moves the predicates of each co-location set to the group serving the largest part of
+// the set. It returns the moves of each set.
+func (p *balancePolicy) colocate(c *clusterTablets) [][]tabletMove {
+	var units [][]tabletMove
+	for _, set := range c.colocate {
+		values := make(map[uint32]float64)
+		for _, pred := range set {
+			if gid, tab := c.groupOf(pred); tab != nil {
+				// Count the tablets too, so the set goes to the group serving most of them
+				// if the metric isn't known yet.
+				values[gid] += p.value(tab) + 1
+			}
+		}
+		if len(values) <= 1 {
+			continue
+		}
+		var dst uint32
+		for gid, v := range values {
+			if c.targets[gid] && (dst == 0 || v > values[dst] || (v == values[dst] && gid < dst)) {
+				dst = gid
+			}
+		}
+		if dst == 0 {
+			continue
+		}
+		reason := fmt.Sprintf("co-locate %s", strings.Join(set, ","))
+		var moves []tabletMove
+		for _, pred := range set {
+			if gid, tab := c.groupOf(pred); tab != nil && gid != dst {
+				moves = append(moves, c.move(pred, gid, dst, reason))
+			}
+		}
+		units = append(units, moves)
+	}
+	return units
+}
+
+// units returns the predicates of the group which can be moved, grouped with the ones they should
+// be co-located with.
+func (c *clusterTablets) units(gid uint32) [][]string {
+	inSet := make(map[string]bool)
+	var units [][]string
+	for _, set := range c.colocate {
+		var unit []string
+		for _, pred := range set {
+			if tab, ok := c.groups[gid][pred]; ok && c.movable(tab) && !inSet[pred] {
+				unit = append(unit, pred)
+				inSet[pred] = true
+			}
+		}
+		if len(unit) > 0 {
+			units = append(units, unit)
+		}
+	}
+	var preds []string
+	for pred, tab := range c.groups[gid] {
+		if c.movable(tab) && !inSet[pred] {
+			preds = append(preds, pred)
+		}
+	}
+	sort.Strings(preds)
+	for _, pred := range preds {
+		units = append(units, []string{pred})
+	}
+	return units
+}
+
+// chooseUnit returns the predicates to move from the group with the highest value of the metric
+// to the one with the lowest, or nothing if the groups are balanced.
+func (p *balancePolicy) chooseUnit(c *clusterTablets) (unit []string, src, dst uint32) {
+	if len(c.groups) <= 1 {
+		return nil, 0, 0
+	}
+
+	// Sort all groups by their values.
+	type kv struct {
+		gid   uint32
+		value float64
+	}
+	var groups []kv
+	for gid, tablets := range c.groups {
+		var value float64
+		for _, tab := range tablets {
+			value += p.value(tab)
+		}
+		groups = append(groups, kv{gid, value})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].value == groups[j].value {
+			return groups[i].gid < groups[j].gid
+		}
+		return groups[i].value < groups[j].value
+	})
+	glog.V(2).Infof("Groups sorted by %s: %+v", p.metric, groups)
+
+	// Don't move a tablet unless the destination group has a leader, which reports the sizes
+	// and load of its tablets, and isn't draining.
+	first := -1
+	for i, g := range groups {
+		if c.targets[g.gid] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil, 0, 0
+	}
+	dst = groups[first].gid
+	for last := len(groups) - 1; last > first; last-- {
+		src = groups[last].gid
+		diff := groups[last].value - groups[first].value
+		// We move a tablet only if the difference between both groups is at least 10% of the
+		// destination group.
+		if diff <= 0 || diff < 0.1*groups[first].value {
+			continue
+		}
+		// Find a unit as big as possible such that on moving it, the value of the destination
+		// group is less than or equal to the one of the source group.
+		var best float64
+		for _, u := range c.units(src) {
+			var value float64
+			for _, pred := range u {
+				value += p.value(c.groups[src][pred])
+			}
+			if value <= diff/2 && value > best {
+				unit, best = u, value
+			}
+		}
+		if len(unit) > 0 {
+			return unit, src, dst
+		}
+	}
+	return nil, 0, 0
+}
+
+// parseColocate parses the value of --colocate, sets of comma separated predicates separated by
+// semicolons.
+func parseColocate(str string) ([][]string, error) {
+	var sets [][]string
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(str, ";") {
+		var set []string
+		for _, pred := range strings.Split(s, ",") {
+			pred = strings.TrimSpace(pred)
+			if len(pred) == 0 {
+				continue
+			}
+			if seen[pred] {
+				return nil, errors.Errorf("Predicate %s is in more than one co-location set", pred)
+			}
+			seen[pred] = true
+			set = append(set, pred)
+		}
+		if len(set) > 1 {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
+}
+
+// compositeSets returns the sets of predicates sharing composite indexes, as reported by the
+// group leaders, keyed by each of their predicates.
+func compositeSets(groups map[uint32]*pb.Group) map[string][]string {
+	byKey := make(map[string][]string)
+	for _, group := range groups {
+		for _, tab := range group.Tablets {
+			if tab.Composite != "" && tab.StartUid == 0 {
+				byKey[tab.Composite] = append(byKey[tab.Composite], tab.Predicate)
+			}
+		}
+	}
+	sets := make(map[string][]string)
+	for _, preds := range byKey {
+		if len(preds) < 2 {
+			continue
+		}
+		sort.Strings(preds)
+		for _, pred := range preds {
+			sets[pred] = preds
+		}
+	}
+	return sets
+}
+
+// mergeSets returns the union of the sets of predicates, where the sets sharing a predicate are
+// merged into one.
+func mergeSets(sets ...[][]string) [][]string {
+	var merged [][]string
+	for _, ss := range sets {
+		for _, set := range ss {
+			unit := append([]string(nil), set...)
+			var rest [][]string
+			for _, m := range merged {
+				shared := false
+				for _, pred := range m {
+					shared = shared || x.HasString(unit, pred)
+				}
+				if !shared {
+					rest = append(rest, m)
+					continue
+				}
+				for _, pred := range m {
+					if !x.HasString(unit, pred) {
+						unit = append(unit, pred)
+					}
+				}
+			}
+			merged = append(rest, unit)
+		}
+	}
+	return merged
+}
+
+// planRebalance returns the tablet moves planned by the given policy for the current state.
+func (s *Server) planRebalance(policy string) ([]tabletMove, error) {
+	p, ok := rebalancePolicies[policy]
+	if !ok {
+		return nil, errors.Errorf("Unknown rebalance policy: %q", policy)
+	}
+
+	s.RLock()
+	if s.state == nil {
+		s.RUnlock()
+		return nil, nil
+	}
+	c := &clusterTablets{
+		groups:    make(map[uint32]map[string]*pb.Tablet),
+		targets:   make(map[uint32]bool),
+		draining:  make(map[uint32]bool),
+		pinned:    make(map[string]uint32),
+		composite: compositeSets(s.state.Groups),
+		maxMoves:  opts.rebalanceMaxMoves,
+	}
+	var composite [][]string
+	for pred, set := range c.composite {
+		if set[0] == pred {
+			composite = append(composite, set)
+		}
+	}
+	sort.Slice(composite, func(i, j int) bool { return composite[i][0] < composite[j][0] })
+	c.colocate = mergeSets(opts.colocate, composite)
+	for gid, group := range s.state.Groups {
+		tablets := make(map[string]*pb.Tablet)
+		for key, tab := range group.Tablets {
+			tc := *tab
+			tablets[key] = &tc
+		}
+		s.followerReads.addTo(gid, group, tablets)
+		c.groups[gid] = tablets
+		c.targets[gid] = s.hasLeader(gid) && !group.Draining
+		if group.Draining {
+			c.draining[gid] = true
+		}
+	}
+	for pred, gid := range s.state.Pinned {
+		c.pinned[pred] = gid
+	}
+	s.RUnlock()
+
+	// The pinned predicates and draining groups aren't subject to rebalance_max_moves.
+	moves := c.enforce()
+	return append(moves, p.Plan(c)...), nil
+}
+
+// compositePeers returns the predicates sharing a composite index with the predicate, itself
+// included, or nil if it isn't in any.
+func (s *Server) compositePeers(predicate string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	return compositeSets(s.state.GetGroups())[predicate]
+}
+
+// pinnedGroup returns the group the predicate is pinned to, or zero if it isn't pinned.
+func (s *Server) pinnedGroup(predicate string) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.state.GetPinned()[predicate]
+}
+
+// pinPredicate pins the predicate to the group, or unpins it if gid is zero. The rebalancer then
+// moves it to the group if needed, and keeps it there.
+func (s *Server) pinPredicate(ctx context.Context, predicate string, gid uint32) error {
+	if x.IsReservedPredicate(predicate) {
+		return errors.Errorf("Unable to pin reserved predicate %s", predicate)
+	}
+	if gid > 0 && !s.knownGroup(gid) {
+		return errors.Errorf("Group: [%d] is not a known group.", gid)
+	}
+	for _, peer := range s.compositePeers(predicate) {
+		if pinned := s.pinnedGroup(peer); gid > 0 && pinned > 0 && pinned != gid {
+			return errors.Errorf("Unable to pin predicate %s to group %d, predicate %s of the "+
+				"same composite index is pinned to group %d", predicate, gid, peer, pinned)
+		}
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		Pin: &pb.PinPredicate{Predicate: predicate, GroupId: gid},
+	}); err != nil {
+		return err
+	}
+	s.triggerRebalance()
+	return nil
+}
+
+// drainGroup sets or unsets the draining mode of the group. The rebalancer moves the tablets of a
+// draining group to the other groups, and no new tablets are assigned to it.
+func (s *Server) drainGroup(ctx context.Context, gid uint32, enable bool) error {
+	if !s.knownGroup(gid) {
+		return errors.Errorf("Group: [%d] is not a known group.", gid)
+	}
+	if gid == 1 && enable {
+		// The reserved predicates are always served by group 1.
+		return errors.New("Group 1 can't be drained, it serves the reserved predicates.")
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		Drain: &pb.DrainGroup{GroupId: gid, Enable: enable},
+	}); err != nil {
+		return err
+	}
+	s.triggerRebalance()
+	return nil
+}
+
+// groupForNewTablet returns the group a new tablet asked for by the group gid should be served
+// by instead, if gid is draining. It returns zero if gid can serve it.
+func (s *Server) groupForNewTablet(gid uint32) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	if group := s.state.Groups[gid]; group == nil || !group.Draining {
+		return 0
+	}
+	var dst uint32
+	var min int64
+	for id, group := range s.state.Groups {
+		if group.Draining || !s.hasLeader(id) {
+			continue
+		}
+		var size int64
+		for _, tab := range group.Tablets {
+			size += tab.Space
+		}
+		if dst == 0 || size < min || (size == min && id < dst) {
+			dst, min = id, size
+		}
+	}
+	return dst
+}
+
+func (s *Server) knownGroup(gid uint32) bool {
+	for _, g := range s.KnownGroups() {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// triggerRebalance makes the rebalancer run without waiting for its next interval.
+func (s *Server) triggerRebalance() {
+	select {
+	case s.rebalanceNow <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,283 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func testCluster(tablets ...*pb.Tablet) *clusterTablets {
+	c := &clusterTablets{
+		groups:   map[uint32]map[string]*pb.Tablet{1: {}, 2: {}},
+		targets:  map[uint32]bool{1: true, 2: true},
+		maxMoves: 1,
+	}
+	for _, tab := range tablets {
+		c.groups[tab.GroupId][tab.Predicate] = tab
+	}
+	return c
+}
+
+func TestSizePolicy(t *testing.T) {
+	c := testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "dgraph.type", Space: 1000},
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 300},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 100},
+		&pb.Tablet{GroupId: 2, Predicate: "friend", Space: 500},
+	)
+	moves := rebalancePolicies["size"].Plan(c)
+	require.Len(t, moves, 1)
+	require.Equal(t, "name", moves[0].Predicate)
+	require.Equal(t, uint32(1), moves[0].SrcGroup)
+	require.Equal(t, uint32(2), moves[0].DstGroup)
+
+	// The destination group needs a leader.
+	c = testCluster(&pb.Tablet{GroupId: 1, Predicate: "name", Space: 300})
+	c.targets[2] = false
+	require.Empty(t, rebalancePolicies["size"].Plan(c))
+}
+
+func TestLoadPolicy(t *testing.T) {
+	c := testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 10, ReadRate: 100},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 10, WriteRate: 40},
+		&pb.Tablet{GroupId: 2, Predicate: "friend", Space: 1000, ReadRate: 10},
+	)
+	c.maxMoves = 3
+	moves := rebalancePolicies["load"].Plan(c)
+	require.Len(t, moves, 1)
+	require.Equal(t, "age", moves[0].Predicate)
+	require.Equal(t, uint32(2), moves[0].DstGroup)
+
+	// The size policy moves the other way.
+	moves = rebalancePolicies["size"].Plan(testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 10},
+		&pb.Tablet{GroupId: 2, Predicate: "friend", Space: 1000},
+		&pb.Tablet{GroupId: 2, Predicate: "age", Space: 100},
+	))
+	require.Len(t, moves, 1)
+	require.Equal(t, "age", moves[0].Predicate)
+	require.Equal(t, uint32(1), moves[0].DstGroup)
+}
+
+func TestColocate(t *testing.T) {
+	c := testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 300},
+		&pb.Tablet{GroupId: 2, Predicate: "friend", Space: 500},
+		&pb.Tablet{GroupId: 2, Predicate: "age", Space: 100},
+	)
+	c.colocate = [][]string{{"name", "friend"}}
+	c.maxMoves = 5
+	moves := rebalancePolicies["size"].Plan(c)
+	require.Len(t, moves, 2)
+	require.Equal(t, "name", moves[0].Predicate)
+	require.Equal(t, uint32(2), moves[0].DstGroup)
+	require.Contains(t, moves[0].Reason, "co-locate")
+	// The co-located predicates are too big to be moved back, so age is.
+	require.Equal(t, "age", moves[1].Predicate)
+	require.Equal(t, uint32(1), moves[1].DstGroup)
+
+	// Co-located predicates are moved together, if they fit.
+	c = testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 100},
+		&pb.Tablet{GroupId: 1, Predicate: "friend", Space: 100},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 500},
+	)
+	c.colocate = [][]string{{"name", "friend"}}
+	c.maxMoves = 5
+	moves = rebalancePolicies["size"].Plan(c)
+	require.Len(t, moves, 2)
+	require.Equal(t, "name", moves[0].Predicate)
+	require.Equal(t, "friend", moves[1].Predicate)
+}
+
+func TestParseColocate(t *testing.T) {
+	sets, err := parseColocate("name, friend;title,director;single;")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"name", "friend"}, {"title", "director"}}, sets)
+
+	_, err = parseColocate("name,friend;name,age")
+	require.Error(t, err)
+
+	sets, err = parseColocate("")
+	require.NoError(t, err)
+	require.Empty(t, sets)
+}
+
+func TestEnforcePinnedAndDraining(t *testing.T) {
+	c := testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 300},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 100},
+		&pb.Tablet{GroupId: 2, Predicate: "friend", Space: 500},
+	)
+	c.groups[3] = map[string]*pb.Tablet{}
+	c.targets[3] = true
+	c.pinned = map[string]uint32{"friend": 1}
+	c.draining = map[uint32]bool{1: true}
+	c.targets[1] = false
+
+	// friend can't be moved to a draining group, and the tablets of group 1 go to the smallest
+	// group left, one after the other.
+	moves := c.enforce()
+	require.Len(t, moves, 2)
+	require.Equal(t, "age", moves[0].Predicate)
+	require.Equal(t, uint32(3), moves[0].DstGroup)
+	require.Equal(t, "name", moves[1].Predicate)
+	require.Equal(t, uint32(3), moves[1].DstGroup)
+	require.Contains(t, moves[1].Reason, "drain")
+
+	// Once the group isn't draining anymore, friend is moved back to it.
+	c.draining = nil
+	c.targets[1] = true
+	moves = c.enforce()
+	require.Len(t, moves, 1)
+	require.Equal(t, "friend", moves[0].Predicate)
+	require.Equal(t, uint32(1), moves[0].DstGroup)
+	require.Contains(t, moves[0].Reason, "pinned")
+
+	// The balancing policies leave pinned predicates alone.
+	c = testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "name", Space: 300},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 100},
+	)
+	c.pinned = map[string]uint32{"name": 1, "age": 1}
+	require.Empty(t, rebalancePolicies["size"].Plan(c))
+}
+
+func TestGroupForNewTablet(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {
+			Members:  map[uint64]*pb.Member{1: {Id: 1, Leader: true}},
+			Tablets:  map[string]*pb.Tablet{"name": {Space: 10}},
+			Draining: true,
+		},
+		2: {
+			Members: map[uint64]*pb.Member{2: {Id: 2, Leader: true}},
+			Tablets: map[string]*pb.Tablet{"age": {Space: 300}},
+		},
+		3: {
+			Members: map[uint64]*pb.Member{3: {Id: 3, Leader: true}},
+			Tablets: map[string]*pb.Tablet{"friend": {Space: 200}},
+		},
+	}}}
+	require.Equal(t, uint32(3), s.groupForNewTablet(1))
+	require.Zero(t, s.groupForNewTablet(2))
+}
+
+func TestCompositeSets(t *testing.T) {
+	groups := map[uint32]*pb.Group{
+		1: {Tablets: map[string]*pb.Tablet{
+			"city":   {Predicate: "city", Composite: "city"},
+			"name":   {Predicate: "name"},
+			"single": {Predicate: "single", Composite: "single"},
+		}},
+		2: {Tablets: map[string]*pb.Tablet{
+			"status": {Predicate: "status", Composite: "city"},
+		}},
+	}
+	sets := compositeSets(groups)
+	require.Equal(t, map[string][]string{
+		"city":   {"city", "status"},
+		"status": {"city", "status"},
+	}, sets)
+
+	merged := mergeSets([][]string{{"name", "friend"}, {"age", "title"}},
+		[][]string{{"city", "status"}, {"friend", "city"}})
+	require.Equal(t, [][]string{{"age", "title"}, {"friend", "city", "name", "status"}}, merged)
+}
+
+func TestCompositeMoves(t *testing.T) {
+	// The predicates of a composite index are moved together, even past maxMoves.
+	c := testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "city", Space: 100, Composite: "city"},
+		&pb.Tablet{GroupId: 1, Predicate: "status", Space: 100, Composite: "city"},
+		&pb.Tablet{GroupId: 1, Predicate: "age", Space: 500},
+	)
+	c.composite = compositeSets(map[uint32]*pb.Group{1: {Tablets: c.groups[1]}})
+	c.colocate = [][]string{{"city", "status"}}
+	moves := rebalancePolicies["size"].Plan(c)
+	require.Len(t, moves, 2)
+	require.Equal(t, "city", moves[0].Predicate)
+	require.Equal(t, "status", moves[1].Predicate)
+
+	// Pinning one of them moves both, and the balancing policies leave them alone.
+	c = testCluster(
+		&pb.Tablet{GroupId: 1, Predicate: "city", Space: 100, Composite: "city"},
+		&pb.Tablet{GroupId: 1, Predicate: "status", Space: 100, Composite: "city"},
+	)
+	c.composite = compositeSets(map[uint32]*pb.Group{1: {Tablets: c.groups[1]}})
+	c.colocate = [][]string{{"city", "status"}}
+	c.pinned = map[string]uint32{"status": 2}
+	moves = c.enforce()
+	require.Len(t, moves, 2)
+	require.Equal(t, "city", moves[0].Predicate)
+	require.Equal(t, uint32(2), moves[0].DstGroup)
+	require.Equal(t, "status", moves[1].Predicate)
+	require.Empty(t, rebalancePolicies["size"].Plan(c))
+}
+
+func TestFollowerReads(t *testing.T) {
+	follower := func(id uint64, leader bool, rates map[string]float64) *pb.Group {
+		g := &pb.Group{
+			Members: map[uint64]*pb.Member{id: {Id: id, GroupId: 1, Leader: leader}},
+			Tablets: make(map[string]*pb.Tablet),
+		}
+		for pred, rate := range rates {
+			g.Tablets[pred] = &pb.Tablet{GroupId: 1, Predicate: pred, ReadRate: rate}
+		}
+		return g
+	}
+	var f followerReads
+	require.True(t, f.record(follower(2, false, map[string]float64{"name": 10})))
+	require.True(t, f.record(follower(3, false, map[string]float64{"name": 5, "age": 1})))
+	// The leader reports its own tablets.
+	require.False(t, f.record(follower(1, true, map[string]float64{"name": 100})))
+
+	group := &pb.Group{Members: map[uint64]*pb.Member{
+		1: {Id: 1, Leader: true},
+		2: {Id: 2},
+		3: {Id: 3},
+	}}
+	tablets := map[string]*pb.Tablet{"name": {ReadRate: 100}}
+	f.addTo(1, group, tablets)
+	require.Equal(t, 115.0, tablets["name"].ReadRate)
+	// The reports only count for the group of the followers.
+	other := map[string]*pb.Tablet{"name": {}}
+	f.addTo(2, group, other)
+	require.Zero(t, other["name"].ReadRate)
+
+	// Once a follower becomes the leader or leaves the group, its reports are dropped.
+	group.Members[1].Leader = false
+	group.Members[2].Leader = true
+	delete(group.Members, 3)
+	tablets = map[string]*pb.Tablet{"name": {ReadRate: 100}}
+	f.addTo(1, group, tablets)
+	require.Equal(t, 100.0, tablets["name"].ReadRate)
+	require.Empty(t, f.reports)
+}
+
+func TestDrainGroupOne(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{Groups: map[uint32]*pb.Group{1: {}, 2: {}}}}
+	err := s.drainGroup(context.Background(), 1, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reserved predicates")
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+var errNotStandby = errors.New("This cluster is not a standby cluster")
+
+// handleReplicated records the progress of the replication of a primary group.
+func (n *node) handleReplicated(st *pb.ReplicationStatus) error {
+	n.server.AssertLock()
+	state := n.server.state
+	if !state.GetReplication().GetStandby() {
+		return errNotStandby
+	}
+	if state.Replication.Groups == nil {
+		state.Replication.Groups = make(map[uint32]*pb.ReplicationStatus)
+	}
+	if cur := state.Replication.Groups[st.GroupId]; cur != nil && cur.AppliedTs > st.AppliedTs {
+		// Keep the applied timestamp monotonic, if proposals race.
+		return nil
+	}
+	state.Replication.Groups[st.GroupId] = st
+	return nil
+}
+
+// handleReplication either marks the cluster as a standby cluster, or promotes it.
+func (n *node) handleReplication(r *pb.ReplicationState) error {
+	n.server.AssertLock()
+	state := n.server.state
+	if r.Standby {
+		if state.Replication == nil {
+			state.Replication = &pb.ReplicationState{}
+		}
+		state.Replication.Standby = true
+		return nil
+	}
+	if !state.GetReplication().GetStandby() {
+		return errNotStandby
+	}
+	state.Replication.Standby = false
+	state.Replication.PromotedAt = r.PromotedAt
+	return nil
+}
+
+// Replicated is called by the standby Alphas once they have applied a replication batch of a
+// primary group. Zero records the progress, and moves its leases past the ones of the primary.
+func (s *Server) Replicated(ctx context.Context, st *pb.ReplicationStatus) (*api.Payload, error) {
+	if !s.Node.AmLeader() {
+		return nil, errors.Errorf("Replication progress can only be recorded on the Zero leader.")
+	}
+	if !s.isStandby() {
+		return nil, errNotStandby
+	}
+	st.AppliedAt = time.Now().Unix()
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Replicated: st}); err != nil {
+		return nil, err
+	}
+	if err := s.bumpLeases(ctx); err != nil {
+		return nil, err
+	}
+	return &api.Payload{}, nil
+}
+
+func (s *Server) isStandby() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.state.GetReplication().GetStandby()
+}
+
+// minApplied returns the timestamp up to which all the primary groups have been replicated, and
+// the max uid leased by the primary.
+func minApplied(r *pb.ReplicationState) (ts, maxUid uint64) {
+	for _, st := range r.GetGroups() {
+		if ts == 0 || st.AppliedTs < ts {
+			ts = st.AppliedTs
+		}
+		if st.MaxUid > maxUid {
+			maxUid = st.MaxUid
+		}
+	}
+	return ts, maxUid
+}
+
+// bumpLeases leases timestamps and uids until the next ones handed out are past the ones of the
+// primary cluster, so the replicated data stays visible and new uids don't collide after the
+// standby is promoted.
+func (s *Server) bumpLeases(ctx context.Context) error {
+	s.RLock()
+	ts, maxUid := minApplied(s.state.GetReplication())
+	s.RUnlock()
+
+	s.leaseLock.Lock()
+	nextTs, nextUid := s.nextTxnTs, s.nextLeaseId
+	s.leaseLock.Unlock()
+
+	if ts >= nextTs {
+		if _, err := s.Timestamps(ctx, &pb.Num{Val: ts - nextTs + 1}); err != nil {
+			return errors.Wrapf(err, "while leasing timestamps past %d", ts)
+		}
+	}
+	if maxUid >= nextUid {
+		if _, err := s.AssignUids(ctx, &pb.Num{Val: maxUid - nextUid + 1}); err != nil {
+			return errors.Wrapf(err, "while leasing uids past %d", maxUid)
+		}
+	}
+	return nil
+}
+
+// promote turns the standby cluster into a regular one, which accepts writes. The primary
+// Alphas should be stopped, or their --replicate_to removed, before.
+func (s *Server) promote(ctx context.Context) error {
+	if !s.isStandby() {
+		return errNotStandby
+	}
+	if err := s.bumpLeases(ctx); err != nil {
+		return err
+	}
+	glog.Infof("Promoting the standby cluster.")
+	return s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		Replication: &pb.ReplicationState{PromotedAt: time.Now().Unix()},
+	})
+}
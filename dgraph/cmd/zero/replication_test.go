@@ -0,0 +1,62 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestReplicationProposals(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{}}
+	n := &node{server: s}
+	s.Lock()
+	defer s.Unlock()
+
+	// Only a standby cluster records the replication progress, or can be promoted.
+	require.Error(t, n.handleReplicated(&pb.ReplicationStatus{GroupId: 1, AppliedTs: 10}))
+	require.Error(t, n.handleReplication(&pb.ReplicationState{PromotedAt: 100}))
+
+	require.NoError(t, n.handleReplication(&pb.ReplicationState{Standby: true}))
+	require.NoError(t, n.handleReplicated(&pb.ReplicationStatus{GroupId: 1, AppliedTs: 10}))
+	require.NoError(t, n.handleReplicated(&pb.ReplicationStatus{GroupId: 2, AppliedTs: 20}))
+	// The applied timestamp of a group never goes back.
+	require.NoError(t, n.handleReplicated(&pb.ReplicationStatus{GroupId: 1, AppliedTs: 5}))
+	require.Equal(t, uint64(10), s.state.Replication.Groups[1].AppliedTs)
+
+	require.NoError(t, n.handleReplication(&pb.ReplicationState{PromotedAt: 100}))
+	require.False(t, s.state.Replication.Standby)
+	require.Equal(t, int64(100), s.state.Replication.PromotedAt)
+	require.Error(t, n.handleReplicated(&pb.ReplicationStatus{GroupId: 1, AppliedTs: 30}))
+}
+
+func TestMinApplied(t *testing.T) {
+	ts, maxUid := minApplied(nil)
+	require.Zero(t, ts)
+	require.Zero(t, maxUid)
+
+	ts, maxUid = minApplied(&pb.ReplicationState{Groups: map[uint32]*pb.ReplicationStatus{
+		1: {AppliedTs: 30, MaxUid: 1000},
+		2: {AppliedTs: 20, MaxUid: 3000},
+		3: {AppliedTs: 40, MaxUid: 2000},
+	}})
+	require.Equal(t, uint64(20), ts)
+	require.Equal(t, uint64(3000), maxUid)
+}
@@ -54,6 +54,11 @@ type options struct {
 	peer              string
 	w                 string
 	rebalanceInterval time.Duration
+	shardThreshold    int64
+	rebalancePolicy   string
+	rebalanceMaxMoves int
+	colocate          [][]string
+	standby           bool
 	LudicrousMode     bool
 }
 
@@ -89,6 +94,18 @@ instances to achieve high-availability.
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.String("rebalance_policy", "size", "Policy deciding which predicates to move to "+
+		"balance the groups. Options are size, balancing the disk space used by the groups, and "+
+		"load, balancing the queries and mutations they serve.")
+	flag.Int("rebalance_max_moves", 1, "Max number of predicates moved per rebalance_interval.")
+	flag.String("colocate", "", "Sets of predicates frequently traversed together, which the "+
+		"rebalancer keeps in the same group. Predicates of a set are separated by commas, and "+
+		"sets by semicolons. E.g. name,friend;title,director.")
+	flag.Int64("shard_threshold_mb", 0, "Size in MB above which the rebalancer splits a "+
+		"predicate into uid range shards served by different groups. 0 disables sharding.")
+	flag.Bool("standby", false, "Create the cluster as the standby of a primary cluster, which "+
+		"replicates it via --replicate_to of its Alphas. The standby rejects writes until "+
+		"promoted. Only used when the cluster is created.")
 	flag.Bool("telemetry", true, "Send anonymous telemetry data to Dgraph devs.")
 	flag.Bool("enable_sentry", true, "Turn on/off sending events to Sentry. (default on)")
 
@@ -115,7 +132,7 @@ type state struct {
 	zero *Server
 }
 
-func (st *state) serveGRPC(l net.Listener, store *raftwal.DiskStorage) {
+func (st *state) serveGRPC(l net.Listener, store *raftwal.DiskStorage, kv *badger.DB) {
 	x.RegisterExporters(Zero.Conf, "dgraph.zero")
 
 	s := grpc.NewServer(
@@ -133,7 +150,7 @@ func (st *state) serveGRPC(l net.Listener, store *raftwal.DiskStorage) {
 	st.rs = conn.NewRaftServer(m)
 
 	st.node = &node{Node: m, ctx: context.Background(), closer: y.NewCloser(1)}
-	st.zero = &Server{NumReplicas: opts.numReplicas, Node: st.node}
+	st.zero = &Server{NumReplicas: opts.numReplicas, Node: st.node, kv: kv}
 	st.zero.Init()
 	st.node.server = st.zero
 
@@ -181,6 +198,10 @@ func run() {
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		shardThreshold:    Zero.Conf.GetInt64("shard_threshold_mb") << 20,
+		rebalancePolicy:   Zero.Conf.GetString("rebalance_policy"),
+		rebalanceMaxMoves: Zero.Conf.GetInt("rebalance_max_moves"),
+		standby:           Zero.Conf.GetBool("standby"),
 		LudicrousMode:     Zero.Conf.GetBool("ludicrous_mode"),
 	}
 
@@ -193,6 +214,19 @@ func run() {
 			opts.numReplicas)
 	}
 
+	if _, ok := rebalancePolicies[opts.rebalancePolicy]; !ok {
+		log.Fatalf("ERROR: Unknown rebalance policy: %s", opts.rebalancePolicy)
+	}
+	if opts.rebalanceMaxMoves < 1 {
+		log.Fatalf("ERROR: rebalance_max_moves must be at least 1. Found: %d",
+			opts.rebalanceMaxMoves)
+	}
+	colocate, err := parseColocate(Zero.Conf.GetString("colocate"))
+	if err != nil {
+		log.Fatalf("ERROR: While parsing colocate: %v", err)
+	}
+	opts.colocate = colocate
+
 	if Zero.Conf.GetBool("expose_trace") {
 		// TODO: Remove this once we get rid of event logs.
 		trace.AuthRequest = func(req *http.Request) (any, sensitive bool) {
@@ -238,7 +272,7 @@ func run() {
 
 	// Initialize the servers.
 	var st state
-	st.serveGRPC(grpcListener, store)
+	st.serveGRPC(grpcListener, store, kv)
 	st.serveHTTP(httpListener)
 
 	// Apply enterprise license if one was given.
@@ -252,8 +286,15 @@ func run() {
 	http.HandleFunc("/state", st.getState)
 	http.HandleFunc("/removeNode", st.removeNode)
 	http.HandleFunc("/moveTablet", st.moveTablet)
+	http.HandleFunc("/splitTablet", st.splitTablet)
+	http.HandleFunc("/mergeTablet", st.mergeTablet)
+	http.HandleFunc("/rebalancePlan", st.rebalancePlan)
+	http.HandleFunc("/promote", st.promote)
 	http.HandleFunc("/assign", st.assign)
 	http.HandleFunc("/enterpriseLicense", st.applyEnterpriseLicense)
+	adminServer, err := newAdminServer(&st)
+	x.Check(err)
+	http.Handle("/admin", adminServer)
 	zpages.Handle(http.DefaultServeMux, "/z")
 
 	// This must be here. It does not work if placed before Grpc init.
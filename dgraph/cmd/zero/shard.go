@@ -0,0 +1,396 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	otrace "go.opencensus.io/trace"
+)
+
+/*
+A predicate too large for a single group can be sharded by uid ranges. Each shard is a tablet
+serving the uids [StartUid, EndUid) of the predicate, where the first shard starts at uid 1 and
+an EndUid of zero means the range is unbounded. The first shard is stored under the predicate in
+the tablets of its group, and the other ones under x.ShardTabletKey.
+
+The data of a uid is kept in the group serving its shard, along with the index, reverse and count
+entries of that uid. So queries starting from a list of uids are split among the groups, and
+the ones evaluating a function or a reverse edge are sent to all of them.
+
+Split:
+• Zero blocks commits on the predicate and leases a timestamp T.
+• Zero tells the source group to send the uid range to the destination group as of T. The
+  destination merges it with the data it already holds for the predicate.
+• Zero proposes the new shards, with a MoveTs of T. Txns that started before T can no longer
+  commit on the predicate, since they could have written the range in the source group.
+• Zero tells the source group to delete the range, once it knows it no longer serves it.
+
+Merge works the same way, moving the range of a shard to the group serving the previous one.
+*/
+
+// shards returns the tablets of the shards of the predicate sorted by their start uid, or nil
+// if the predicate isn't sharded.
+func (s *Server) shards(pred string) []*pb.Tablet {
+	s.RLock()
+	defer s.RUnlock()
+	return s.servingShards(pred)
+}
+
+func (s *Server) servingShards(pred string) []*pb.Tablet {
+	s.AssertRLock()
+
+	var shards []*pb.Tablet
+	for _, group := range s.state.Groups {
+		for _, tab := range group.Tablets {
+			if tab.Predicate == pred && tab.StartUid > 0 {
+				shards = append(shards, tab)
+			}
+		}
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].StartUid < shards[j].StartUid
+	})
+	return shards
+}
+
+// checkShardedCommit checks that a txn which started at startTs and mutated a predicate sharded
+// into the given shards in group gid can be committed.
+func checkShardedCommit(pred string, shards []*pb.Tablet, gid uint32, startTs uint64) error {
+	var served bool
+	for _, tab := range shards {
+		if tab.GroupId == gid {
+			served = true
+		}
+		if startTs < tab.MoveTs {
+			return errors.Errorf("Txn started at %d, before a uid range of predicate %s was "+
+				"moved at %d", startTs, pred, tab.MoveTs)
+		}
+	}
+	if !served {
+		return errors.Errorf("Mutation done in group: %d. No shard of predicate %s assigned to it",
+			gid, pred)
+	}
+	return nil
+}
+
+// splitTablet splits the shard of the predicate serving the uid at, so that the uids from at
+// onwards are served by dstGroup. A predicate which isn't sharded becomes sharded.
+func (s *Server) splitTablet(predicate string, at uint64, dstGroup uint32) error {
+	s.moveOngoing <- struct{}{}
+	defer func() {
+		<-s.moveOngoing
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	defer cancel()
+
+	ctx, span := otrace.StartSpan(ctx, "Zero.SplitTablet")
+	defer span.End()
+
+	if x.IsReservedPredicate(predicate) {
+		return errors.Errorf("Unable to split reserved predicate %s", predicate)
+	}
+	if at <= 1 {
+		return errors.Errorf("Unable to split predicate %s at uid %#x", predicate, at)
+	}
+	if err := s.checkMoveLeader(ctx); err != nil {
+		return err
+	}
+
+	tab := s.ServingTablet(predicate)
+	if tab == nil {
+		return errors.Errorf("Tablet to be split: [%v] is not being served", predicate)
+	}
+	shard := proto.Clone(tab).(*pb.Tablet)
+	shard.StartUid = 1
+	if shards := s.shards(predicate); len(shards) > 0 {
+		if shard = x.ShardFor(shards, at); shard == nil {
+			return errors.Errorf("No shard of predicate %s serves uid %#x", predicate, at)
+		}
+		shard = proto.Clone(shard).(*pb.Tablet)
+	}
+	if shard.StartUid == at {
+		return errors.Errorf("A shard of predicate %s already starts at uid %#x", predicate, at)
+	}
+
+	// The new shard sizes are only estimates until the Alphas report them.
+	left := proto.Clone(shard).(*pb.Tablet)
+	left.EndUid = at
+	left.Space = shard.Space / 2
+	left.Force = true
+	right := proto.Clone(shard).(*pb.Tablet)
+	right.StartUid = at
+	right.GroupId = dstGroup
+	right.Space = shard.Space - left.Space
+	right.Force = true
+
+	msg := fmt.Sprintf("Going to split predicate: [%v] at uid %#x. Group %d serves [%#x, %#x)",
+		predicate, at, dstGroup, right.StartUid, right.EndUid)
+	glog.Info(msg)
+	span.Annotate(nil, msg)
+
+	tablets := func(moveTs uint64) []*pb.Tablet {
+		right.MoveTs = x.Max(right.MoveTs, moveTs)
+		return []*pb.Tablet{left, right}
+	}
+	if shard.GroupId == dstGroup {
+		// Nothing to move, the shard is just split in two.
+		p := &pb.ZeroProposal{Tablets: tablets(0)}
+		return s.Node.proposeAndWait(ctx, p)
+	}
+	return s.moveUidRange(ctx, predicate, shard.GroupId, dstGroup, at, shard.EndUid, tablets)
+}
+
+// mergeTablet merges the shard of the predicate starting at the uid at into the previous one.
+// If only one shard is left, the predicate is no longer sharded.
+func (s *Server) mergeTablet(predicate string, at uint64) error {
+	s.moveOngoing <- struct{}{}
+	defer func() {
+		<-s.moveOngoing
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	defer cancel()
+
+	ctx, span := otrace.StartSpan(ctx, "Zero.MergeTablet")
+	defer span.End()
+
+	if err := s.checkMoveLeader(ctx); err != nil {
+		return err
+	}
+
+	shards := s.shards(predicate)
+	idx := sort.Search(len(shards), func(i int) bool {
+		return shards[i].StartUid >= at
+	})
+	if idx == 0 || idx == len(shards) || shards[idx].StartUid != at {
+		return errors.Errorf("No shard of predicate %s to merge starts at uid %#x",
+			predicate, at)
+	}
+	prev, shard := shards[idx-1], shards[idx]
+
+	merged := proto.Clone(prev).(*pb.Tablet)
+	merged.EndUid = shard.EndUid
+	merged.Space += shard.Space
+	merged.Force = true
+	if len(shards) == 2 {
+		merged.StartUid, merged.EndUid = 0, 0
+	}
+	removed := &pb.Tablet{
+		GroupId:   shard.GroupId,
+		Predicate: predicate,
+		StartUid:  shard.StartUid,
+		Remove:    true,
+	}
+
+	msg := fmt.Sprintf("Going to merge shard [%#x, %#x) of predicate: [%v] into group %d",
+		shard.StartUid, shard.EndUid, predicate, prev.GroupId)
+	glog.Info(msg)
+	span.Annotate(nil, msg)
+
+	tablets := func(moveTs uint64) []*pb.Tablet {
+		merged.MoveTs = x.Max(merged.MoveTs, moveTs)
+		return []*pb.Tablet{removed, merged}
+	}
+	if shard.GroupId == prev.GroupId {
+		p := &pb.ZeroProposal{Tablets: tablets(0)}
+		return s.Node.proposeAndWait(ctx, p)
+	}
+	return s.moveUidRange(ctx, predicate, shard.GroupId, prev.GroupId, shard.StartUid,
+		shard.EndUid, tablets)
+}
+
+// checkMoveLeader ensures that this Zero is connected to the rest of the Zero group, and is the
+// leader.
+func (s *Server) checkMoveLeader(ctx context.Context) error {
+	if _, err := s.latestMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "unable to reach quorum")
+	}
+	if !s.Node.AmLeader() {
+		return errors.Errorf("I am not the Zero leader")
+	}
+	return nil
+}
+
+// moveUidRange moves the uids [start, end) of the predicate from srcGroup to dstGroup, and
+// proposes the tablets returned for the timestamp of the move. Commits on the predicate are
+// blocked until the range has been deleted from srcGroup.
+func (s *Server) moveUidRange(ctx context.Context, predicate string, srcGroup, dstGroup uint32,
+	start, end uint64, tablets func(moveTs uint64) []*pb.Tablet) (rerr error) {
+	span := otrace.FromContext(ctx)
+
+	unblock := s.blockTablet(predicate)
+	defer unblock()
+
+	ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil || ids.StartId == 0 {
+		return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+	}
+
+	pl := s.Leader(srcGroup)
+	if pl == nil {
+		return errors.Errorf("No healthy connection found to leader of group %d", srcGroup)
+	}
+	wc := pb.NewWorkerClient(pl.Get())
+	in := &pb.MovePredicatePayload{
+		Predicate: predicate,
+		SourceGid: srcGroup,
+		DestGid:   dstGroup,
+		TxnTs:     ids.StartId,
+		StartUid:  start,
+		EndUid:    end,
+	}
+
+	move := &pb.PredicateMove{
+		Predicate: fmt.Sprintf("%s[%#x, %#x)", predicate, start, end),
+		SourceGid: srcGroup,
+		DestGid:   dstGroup,
+		Phase:     "final",
+		StartedAt: time.Now().Unix(),
+	}
+	s.setOngoingMove(move)
+	defer func() {
+		s.finishMove(move, rerr)
+	}()
+
+	span.Annotatef(nil, "Starting uid range move: %+v", in)
+	glog.Infof("Starting uid range move: %+v", in)
+	payload, err := wc.MovePredicate(ctx, in)
+	if err != nil {
+		return errors.Wrapf(err, "while calling MovePredicate")
+	}
+	if count, err := strconv.ParseUint(string(payload.GetData()), 10, 64); err == nil {
+		move.KeysMoved = count
+		s.setOngoingMove(move)
+	}
+
+	p := &pb.ZeroProposal{Tablets: tablets(in.TxnTs)}
+	msg := fmt.Sprintf("Uid range move at Alpha done. Now proposing: %+v", p)
+	span.Annotate(nil, msg)
+	glog.Info(msg)
+	if err := s.Node.proposeAndWait(ctx, p); err != nil {
+		return errors.Wrapf(err, "while proposing tablets. Proposal: %+v", p)
+	}
+
+	// Same as for a predicate move, the source group only deletes the range once it knows that
+	// it no longer serves it.
+	checksums := s.groupChecksums()
+	in.ExpectedChecksum = checksums[in.SourceGid]
+	in.DestGid = 0
+	if _, err := wc.MovePredicate(ctx, in); err != nil {
+		msg = fmt.Sprintf("While deleting uid range [%#x, %#x) of predicate [%v] in group %d. "+
+			"Error: %v", start, end, predicate, srcGroup, err)
+		span.Annotate(nil, msg)
+		glog.Warningf(msg)
+	}
+	return nil
+}
+
+// rebalanceShards merges the adjacent shards of a predicate which have become small, or else
+// splits a tablet above the shard threshold, moving half of it to the smallest group.
+func (s *Server) rebalanceShards() error {
+	if opts.shardThreshold <= 0 {
+		return nil
+	}
+	if predicate, at := s.chooseMerge(); len(predicate) > 0 {
+		return s.mergeTablet(predicate, at)
+	}
+	if predicate, at, dstGroup := s.chooseSplit(); len(predicate) > 0 {
+		return s.splitTablet(predicate, at, dstGroup)
+	}
+	return nil
+}
+
+func (s *Server) chooseMerge() (predicate string, at uint64) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil || !s.Node.AmLeader() {
+		return
+	}
+
+	seen := make(map[string]struct{})
+	for _, group := range s.state.Groups {
+		for _, tab := range group.Tablets {
+			if tab.StartUid == 0 {
+				continue
+			}
+			if _, ok := seen[tab.Predicate]; ok {
+				continue
+			}
+			seen[tab.Predicate] = struct{}{}
+
+			// Only merge well below the threshold, so that the merged shard isn't split again.
+			shards := s.servingShards(tab.Predicate)
+			for i := 1; i < len(shards); i++ {
+				if shards[i-1].Space+shards[i].Space < opts.shardThreshold/2 {
+					return tab.Predicate, shards[i].StartUid
+				}
+			}
+		}
+	}
+	return
+}
+
+func (s *Server) chooseSplit() (predicate string, at uint64, dstGroup uint32) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil || !s.Node.AmLeader() || len(s.state.Groups) <= 1 {
+		return
+	}
+
+	// Find the largest tablet above the threshold, and the smallest group.
+	var largest *pb.Tablet
+	var minSize int64
+	for gid, group := range s.state.Groups {
+		var size int64
+		for _, tab := range group.Tablets {
+			size += tab.Space
+			if x.IsReservedPredicate(tab.Predicate) || tab.Space <= opts.shardThreshold {
+				continue
+			}
+			if largest == nil || tab.Space > largest.Space {
+				largest = tab
+			}
+		}
+		if dstGroup == 0 || size < minSize {
+			dstGroup, minSize = gid, size
+		}
+	}
+	if largest == nil || largest.GroupId == dstGroup || !s.hasLeader(dstGroup) {
+		return "", 0, 0
+	}
+
+	// Uids are assigned in increasing order, so split the range of uids assigned so far in half.
+	start, end := x.Max(largest.StartUid, 1), largest.EndUid
+	if end == 0 {
+		end = s.state.MaxLeaseId + 1
+	}
+	if end <= start+1 {
+		return "", 0, 0
+	}
+	return largest.Predicate, start + (end-start)/2, dstGroup
+}
@@ -19,12 +19,14 @@ package zero
 import (
 	"context"
 	"fmt"
-	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
 	humanize "github.com/dustin/go-humanize"
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	otrace "go.opencensus.io/trace"
@@ -32,6 +34,14 @@ import (
 
 const (
 	predicateMoveTimeout = 20 * time.Minute
+	// maxMoveCatchUpRounds is the max number of rounds catching up with the commits done while
+	// copying the predicate, before blocking commits on it for the final round.
+	maxMoveCatchUpRounds = 5
+	// moveCatchUpKeys is the number of keys sent in a round below which the next round is the
+	// final one.
+	moveCatchUpKeys = 1000
+	// maxMoveHistory is the number of finished predicate moves kept in the move history.
+	maxMoveHistory = 100
 )
 
 /*
@@ -42,9 +52,12 @@ Design change:
 
 Move:
 • Dgraph zero would decide that G1 should not serve P, G2 should serve it.
+• Zero would tell G1 to move P to G2 at a timestamp T1 (Endpoint: Zero → G1). Commits on P
+  continue in G1 meanwhile.
+• Zero would then tell G1 to send the keys of P changed after T1, as of a new timestamp T2. This
+  is repeated until few keys change during a round.
 • Zero would propose that G1 is read-only for predicate P. This would propagate to the cluster.
-
-• Zero would tell G1 to move P to G2 (Endpoint: Zero → G1)
+  Zero would tell G1 to send the keys changed since the last round one final time.
 
 This would trigger G1 to get latest state. Wait for it.
 • G1 would propose this state to it’s followers.
@@ -61,21 +74,61 @@ This would trigger G1 to get latest state. Wait for it.
 //  TODO: Have a event log for everything.
 func (s *Server) rebalanceTablets() {
 	ticker := time.NewTicker(opts.rebalanceInterval)
-	for range ticker.C {
-		predicate, srcGroup, dstGroup := s.chooseTablet()
-		if len(predicate) == 0 {
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.rebalanceNow:
+		}
+		if !s.Node.AmLeader() {
 			continue
 		}
-		if err := s.movePredicate(predicate, srcGroup, dstGroup); err != nil {
+		moves, err := s.planRebalance(opts.rebalancePolicy)
+		if err != nil {
 			glog.Errorln(err)
+			continue
+		}
+		if len(moves) == 0 {
+			if err := s.rebalanceShards(); err != nil {
+				glog.Errorln(err)
+			}
+			continue
+		}
+		for _, move := range moves {
+			glog.Infof("Rebalancer moving predicate: %+v", move)
+			if err := s.movePredicate(move.Predicate, move.SrcGroup, move.DstGroup); err != nil {
+				glog.Errorln(err)
+				break
+			}
 		}
 	}
 }
 
+// checkTabletMove checks that the tablet can be moved to dstGroup, and returns the group serving it.
+func (s *Server) checkTabletMove(tablet string, dstGroup uint32) (uint32, error) {
+	if !s.knownGroup(dstGroup) {
+		return 0, errors.Errorf("Group: [%d] is not a known group.", dstGroup)
+	}
+	tab := s.ServingTablet(tablet)
+	if tab == nil {
+		return 0, errors.Errorf("No tablet found for: %s", tablet)
+	}
+	if tab.GroupId == dstGroup {
+		return 0, errors.Errorf("Tablet: [%s] is already being served by group: [%d]",
+			tablet, dstGroup)
+	}
+	// Moving a predicate of a composite index alone would split the index between groups.
+	if peers := s.compositePeers(tablet); len(peers) > 1 {
+		return 0, errors.Errorf("Unable to move predicate %s alone, it shares a composite index "+
+			"with %s. Pin it to group %d instead to move them all.", tablet,
+			strings.Join(peers, ", "), dstGroup)
+	}
+	return tab.GroupId, nil
+}
+
 // movePredicate is the main entry point for move predicate logic. This Zero must remain the leader
 // for the entire duration of predicate move. If this Zero stops being the leader, the final
 // proposal of reassigning the tablet to the destination would fail automatically.
-func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) error {
+func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) (rerr error) {
 	s.moveOngoing <- struct{}{}
 	defer func() {
 		<-s.moveOngoing
@@ -103,22 +156,18 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	if tab == nil {
 		return errors.Errorf("Tablet to be moved: [%v] is not being served", predicate)
 	}
+	if tab.StartUid > 0 {
+		return errors.Errorf("Unable to move sharded predicate %s. Split or merge its shards "+
+			"instead", predicate)
+	}
+	if gid := s.pinnedGroup(predicate); gid > 0 && gid != dstGroup {
+		return errors.Errorf("Unable to move predicate %s pinned to group %d", predicate, gid)
+	}
 	msg := fmt.Sprintf("Going to move predicate: [%v], size: [%v] from group %d to %d\n", predicate,
 		humanize.Bytes(uint64(tab.Space)), srcGroup, dstGroup)
 	glog.Info(msg)
 	span.Annotate([]otrace.Attribute{otrace.StringAttribute("tablet", predicate)}, msg)
 
-	// Block all commits on this predicate. Keep them blocked until we return from this function.
-	unblock := s.blockTablet(predicate)
-	defer unblock()
-
-	// Get a new timestamp, beyond which we are sure that no new txns would be committed for this
-	// predicate. Source Alpha leader must reach this timestamp before streaming the data.
-	ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
-	if err != nil || ids.StartId == 0 {
-		return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
-	}
-
 	// Get connection to leader of source group.
 	pl := s.Leader(srcGroup)
 	if pl == nil {
@@ -129,12 +178,69 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		Predicate: predicate,
 		SourceGid: srcGroup,
 		DestGid:   dstGroup,
-		TxnTs:     ids.StartId,
 	}
-	span.Annotatef(nil, "Starting move: %+v", in)
-	glog.Infof("Starting move: %+v", in)
-	if _, err := wc.MovePredicate(ctx, in); err != nil {
-		return errors.Wrapf(err, "while calling MovePredicate")
+
+	move := &pb.PredicateMove{
+		Predicate: predicate,
+		SourceGid: srcGroup,
+		DestGid:   dstGroup,
+		StartedAt: time.Now().Unix(),
+	}
+	defer func() {
+		s.finishMove(move, rerr)
+	}()
+
+	// The first round copies the whole predicate and the next ones the keys changed since the
+	// previous round. Commits on the predicate are only blocked for the final round, which should
+	// have few keys left to send, until the destination group serves the predicate.
+	var unblock func()
+	defer func() {
+		if unblock != nil {
+			unblock()
+		}
+	}()
+	for round := 0; ; round++ {
+		final := round > maxMoveCatchUpRounds || (round > 0 && move.KeysMoved < moveCatchUpKeys)
+		switch {
+		case final:
+			move.Phase = "final"
+			unblock = s.blockTablet(predicate)
+		case round == 0:
+			move.Phase = "copy"
+		default:
+			move.Phase = "catch-up"
+		}
+
+		// Get a new timestamp. In the final round, we are sure that no new txns would be committed
+		// for this predicate beyond it. Source Alpha leader must reach this timestamp before
+		// streaming the data.
+		ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+		if err != nil || ids.StartId == 0 {
+			return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+		}
+		in.SinceTs = in.TxnTs
+		in.TxnTs = ids.StartId
+
+		move.Round = uint32(round)
+		move.KeysMoved = 0
+		s.setOngoingMove(move)
+		span.Annotatef(nil, "Starting move round: %+v", in)
+		glog.Infof("Starting %s round %d of move: %+v", move.Phase, round, in)
+		payload, err := wc.MovePredicate(ctx, in)
+		if err != nil {
+			return errors.Wrapf(err, "while calling MovePredicate")
+		}
+		// Older Alphas don't report the number of keys sent. They send the whole predicate in
+		// every round, so we go straight to the final round.
+		count, err := strconv.ParseUint(string(payload.GetData()), 10, 64)
+		if err != nil {
+			count = 0
+		}
+		move.KeysMoved = count
+		s.setOngoingMove(move)
+		if final {
+			break
+		}
 	}
 
 	p := &pb.ZeroProposal{}
@@ -144,6 +250,7 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		Space:     tab.Space,
 		Force:     true,
 		MoveTs:    in.TxnTs,
+		Composite: tab.Composite,
 	}
 	msg = fmt.Sprintf("Move at Alpha done. Now proposing: %+v", p)
 	span.Annotate(nil, msg)
@@ -151,6 +258,10 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	if err := s.Node.proposeAndWait(ctx, p); err != nil {
 		return errors.Wrapf(err, "while proposing tablet reassignment. Proposal: %+v", p)
 	}
+	// The commits on the predicate can go on in the destination group, since their start
+	// timestamps are checked against the MoveTs of the tablet.
+	unblock()
+	unblock = nil
 	msg = fmt.Sprintf("Predicate move done for: [%v] from group %d to %d\n",
 		predicate, srcGroup, dstGroup)
 	glog.Info(msg)
@@ -177,70 +288,41 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	return nil
 }
 
-func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
-	s.RLock()
-	defer s.RUnlock()
-	if s.state == nil {
-		return
+// finishMove records the predicate move in the move history, along with the error which stopped
+// it if any, and clears the ongoing move.
+func (s *Server) finishMove(move *pb.PredicateMove, err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.ongoingMove = nil
+	done := proto.Clone(move).(*pb.PredicateMove)
+	done.FinishedAt = time.Now().Unix()
+	if err != nil {
+		done.Error = err.Error()
 	}
-	numGroups := len(s.state.Groups)
-	if !s.Node.AmLeader() || numGroups <= 1 {
-		return
+	s.moveHistory = append(s.moveHistory, done)
+	if len(s.moveHistory) > maxMoveHistory {
+		s.moveHistory = s.moveHistory[len(s.moveHistory)-maxMoveHistory:]
 	}
+}
 
-	// Sort all groups by their sizes.
-	type kv struct {
-		gid  uint32
-		size int64 // in bytes
+// moveHistoryState returns the last predicate moves done by this Zero, oldest first.
+func (s *Server) moveHistoryState() []*pb.PredicateMove {
+	s.RLock()
+	defer s.RUnlock()
+	moves := make([]*pb.PredicateMove, 0, len(s.moveHistory))
+	for _, move := range s.moveHistory {
+		moves = append(moves, proto.Clone(move).(*pb.PredicateMove))
 	}
-	var groups []kv
-	for k, v := range s.state.Groups {
-		space := int64(0)
-		for _, tab := range v.Tablets {
-			space += tab.Space
-		}
-		groups = append(groups, kv{k, space})
-	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].size < groups[j].size
-	})
-
-	glog.Infof("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
-		srcGroup = groups[lastGroup].gid
-		dstGroup = groups[0].gid
-		sizeDiff := groups[lastGroup].size - groups[0].size
-		glog.Infof("size_diff %v\n", sizeDiff)
-		// Don't move a node unless you receive atleast one update regarding tablet size.
-		// Tablet size would have come up with leader update.
-		if !s.hasLeader(dstGroup) {
-			return
-		}
-		// We move the predicate only if the difference between size of both machines is
-		// atleast 10% of src group.
-		if float64(sizeDiff) < 0.1*float64(groups[0].size) {
-			continue
-		}
-
-		// Try to find a predicate which we can move.
-		size := int64(0)
-		group := s.state.Groups[srcGroup]
-		for _, tab := range group.Tablets {
-			// Reserved predicates should always be in group 1 so do not re-balance them.
-			if x.IsReservedPredicate(tab.Predicate) {
-				continue
-			}
+	return moves
+}
 
-			// Finds a tablet as big a possible such that on moving it dstGroup's size is
-			// less than or equal to srcGroup.
-			if tab.Space <= sizeDiff/2 && tab.Space > size {
-				predicate = tab.Predicate
-				size = tab.Space
-			}
-		}
-		if len(predicate) > 0 {
-			return
-		}
+// setOngoingMove records the progress of the ongoing predicate move, reported via /state.
+func (s *Server) setOngoingMove(move *pb.PredicateMove) {
+	s.Lock()
+	defer s.Unlock()
+	if move == nil {
+		s.ongoingMove = nil
+		return
 	}
-	return
+	s.ongoingMove = proto.Clone(move).(*pb.PredicateMove)
 }
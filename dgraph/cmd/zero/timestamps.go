@@ -0,0 +1,244 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/y"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// tsCheckpointInterval is how often the leader records the max assigned timestamp together with
+// the current time. It bounds the precision with which a time can be mapped to a timestamp.
+const tsCheckpointInterval = time.Minute
+
+// tsCheckpointPrefix is the prefix of the keys holding the checkpoints in the WAL store. It can't
+// collide with the keys of the raft log, which start with the big endian raft ID.
+var tsCheckpointPrefix = []byte("tsckpt")
+
+func tsCheckpointKey(t int64) []byte {
+	key := make([]byte, len(tsCheckpointPrefix)+8)
+	copy(key, tsCheckpointPrefix)
+	binary.BigEndian.PutUint64(key[len(tsCheckpointPrefix):], uint64(t))
+	return key
+}
+
+// historyHorizon tracks the oldest start of the history retention windows the Alphas ask for.
+// Every Alpha with a retention window asks for its start once per tsCheckpointInterval, so the
+// oldest one asked during two intervals covers all of them.
+type historyHorizon struct {
+	sync.Mutex
+	cur, prev int64 // The oldest times asked during the current and previous intervals.
+	pruned    int64 // The time before which the checkpoints have been pruned.
+}
+
+func (h *historyHorizon) observe(t int64) {
+	h.Lock()
+	defer h.Unlock()
+	if h.cur == 0 || t < h.cur {
+		h.cur = t
+	}
+}
+
+// advance starts a new interval, and returns the horizon to prune the checkpoints to, or zero if
+// it hasn't advanced or isn't known yet.
+func (h *historyHorizon) advance() int64 {
+	h.Lock()
+	defer h.Unlock()
+	horizon := h.cur
+	if h.prev < horizon {
+		horizon = h.prev
+	}
+	h.prev, h.cur = h.cur, 0
+	if horizon <= h.pruned {
+		return 0
+	}
+	h.pruned = horizon
+	return horizon
+}
+
+// reset forgets the horizons asked so far, when this node stops being the leader.
+func (h *historyHorizon) reset() {
+	h.Lock()
+	defer h.Unlock()
+	h.cur, h.prev = 0, 0
+}
+
+// checkpointTimestamps periodically proposes a checkpoint of the max assigned timestamp, as long
+// as this node is the leader and new timestamps have been assigned since the last one. The
+// checkpoints are applied by all the Zeros, so any of them can map a time to a timestamp. Once
+// the history horizon of the Alphas advances, the checkpoints older than it are pruned.
+func (n *node) checkpointTimestamps(closer *y.Closer) {
+	defer closer.Done()
+	ticker := time.NewTicker(tsCheckpointInterval)
+	defer ticker.Stop()
+
+	var lastTs uint64
+	for {
+		select {
+		case <-ticker.C:
+			if !n.AmLeader() {
+				lastTs = 0
+				n.server.horizon.reset()
+				continue
+			}
+			if horizon := n.server.horizon.advance(); horizon > 0 {
+				cp := &pb.TimestampCheckpoint{Time: horizon, Horizon: true}
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				if err := n.proposeAndWait(ctx, &pb.ZeroProposal{TsCheckpoint: cp}); err != nil {
+					glog.Warningf("While pruning timestamp checkpoints before %d: %v",
+						horizon, err)
+				}
+				cancel()
+			}
+			ts := n.server.orc.MaxPending()
+			if ts == 0 || ts == lastTs {
+				continue
+			}
+			cp := &pb.TimestampCheckpoint{Ts: ts, Time: time.Now().Unix()}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			err := n.proposeAndWait(ctx, &pb.ZeroProposal{TsCheckpoint: cp})
+			cancel()
+			if err != nil {
+				glog.Warningf("While proposing timestamp checkpoint %+v: %v", cp, err)
+				continue
+			}
+			lastTs = ts
+		case <-closer.HasBeenClosed():
+			return
+		}
+	}
+}
+
+// storeTsCheckpoint persists the checkpoint in the WAL store. If it's a horizon, the checkpoints
+// before it are pruned instead.
+func (s *Server) storeTsCheckpoint(cp *pb.TimestampCheckpoint) error {
+	if s.kv == nil {
+		return nil
+	}
+	if cp.Horizon {
+		return s.pruneTsCheckpoints(cp.Time)
+	}
+	var val [8]byte
+	binary.BigEndian.PutUint64(val[:], cp.Ts)
+	return s.kv.Update(func(txn *badger.Txn) error {
+		return txn.Set(tsCheckpointKey(cp.Time), val[:])
+	})
+}
+
+// pruneTsCheckpoints deletes the checkpoints recorded before the given time, except the latest of
+// them, which is still needed to map that time to a timestamp.
+func (s *Server) pruneTsCheckpoints(horizon int64) error {
+	var keys [][]byte
+	err := s.kv.View(func(txn *badger.Txn) error {
+		iopt := badger.DefaultIteratorOptions
+		iopt.PrefetchValues = false
+		iopt.Reverse = true
+		iopt.Prefix = tsCheckpointPrefix
+		itr := txn.NewIterator(iopt)
+		defer itr.Close()
+
+		itr.Seek(tsCheckpointKey(horizon))
+		if !itr.Valid() {
+			return nil
+		}
+		// Keep the checkpoint at or before the horizon.
+		for itr.Next(); itr.Valid(); itr.Next() {
+			keys = append(keys, itr.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	wb := s.kv.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+	glog.V(2).Infof("Pruned %d timestamp checkpoints before %s", len(keys),
+		time.Unix(horizon, 0).UTC().Format(time.RFC3339))
+	return nil
+}
+
+// TimestampAt returns the latest checkpoint recorded at or before the requested time. Reading
+// at the returned timestamp shows the data as it was at the time of the checkpoint, which is at
+// most tsCheckpointInterval before the requested time.
+func (s *Server) TimestampAt(ctx context.Context,
+	req *pb.TimestampCheckpoint) (*pb.TimestampCheckpoint, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if req.Time <= 0 {
+		return nil, errors.Errorf("Invalid time: %d", req.Time)
+	}
+	if req.Time > time.Now().Unix() {
+		return nil, errors.Errorf("Time %s is in the future",
+			time.Unix(req.Time, 0).UTC().Format(time.RFC3339))
+	}
+	if s.kv == nil {
+		return nil, errors.New("Timestamp checkpoints aren't available")
+	}
+	if req.Horizon {
+		s.horizon.observe(req.Time)
+	}
+
+	var cp *pb.TimestampCheckpoint
+	err := s.kv.View(func(txn *badger.Txn) error {
+		iopt := badger.DefaultIteratorOptions
+		iopt.Reverse = true
+		iopt.Prefix = tsCheckpointPrefix
+		itr := txn.NewIterator(iopt)
+		defer itr.Close()
+
+		itr.Seek(tsCheckpointKey(req.Time))
+		if !itr.Valid() {
+			return nil
+		}
+		item := itr.Item()
+		key := item.Key()
+		cp = &pb.TimestampCheckpoint{
+			Time: int64(binary.BigEndian.Uint64(key[len(tsCheckpointPrefix):])),
+		}
+		return item.Value(func(val []byte) error {
+			cp.Ts = binary.BigEndian.Uint64(val)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while looking up timestamp checkpoint")
+	}
+	if cp == nil {
+		return nil, errors.Errorf("No timestamp was recorded at or before %s",
+			time.Unix(req.Time, 0).UTC().Format(time.RFC3339))
+	}
+	return cp, nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestTimestampAt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zero")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kv, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	defer kv.Close()
+
+	s := &Server{kv: kv}
+	now := time.Now().Unix()
+	for i, ts := range []uint64{10, 20, 30} {
+		cp := &pb.TimestampCheckpoint{Ts: ts, Time: now - int64(300-60*i)}
+		require.NoError(t, s.storeTsCheckpoint(cp))
+	}
+
+	timestampAt := func(t int64) (uint64, error) {
+		cp, err := s.TimestampAt(context.Background(), &pb.TimestampCheckpoint{Time: t})
+		return cp.GetTs(), err
+	}
+
+	ts, err := timestampAt(now - 240)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), ts)
+	ts, err = timestampAt(now - 200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), ts)
+	ts, err = timestampAt(now)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), ts)
+
+	_, err = timestampAt(now - 400)
+	require.Contains(t, err.Error(), "No timestamp was recorded")
+	_, err = timestampAt(now + 3600)
+	require.Contains(t, err.Error(), "in the future")
+}
+
+func TestPruneTsCheckpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zero")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kv, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	defer kv.Close()
+
+	s := &Server{kv: kv}
+	now := time.Now().Unix()
+	for i, ts := range []uint64{10, 20, 30, 40} {
+		cp := &pb.TimestampCheckpoint{Ts: ts, Time: now - int64(300-60*i)}
+		require.NoError(t, s.storeTsCheckpoint(cp))
+	}
+	timestampAt := func(t int64) (uint64, error) {
+		cp, err := s.TimestampAt(context.Background(), &pb.TimestampCheckpoint{Time: t})
+		return cp.GetTs(), err
+	}
+
+	// The checkpoint at the horizon is kept, so the horizon can still be mapped.
+	horizon := now - 200
+	require.NoError(t, s.storeTsCheckpoint(&pb.TimestampCheckpoint{Time: horizon, Horizon: true}))
+	ts, err := timestampAt(horizon)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), ts)
+	_, err = timestampAt(now - 290)
+	require.Contains(t, err.Error(), "No timestamp was recorded")
+	ts, err = timestampAt(now)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), ts)
+}
+
+func TestHistoryHorizon(t *testing.T) {
+	var h historyHorizon
+	h.observe(100)
+	// The horizons asked during the previous interval aren't known yet.
+	require.Zero(t, h.advance())
+
+	h.observe(120)
+	h.observe(110)
+	require.Equal(t, int64(100), h.advance())
+	// It doesn't go back, nor prunes twice up to the same horizon.
+	h.observe(90)
+	require.Zero(t, h.advance())
+	h.observe(130)
+	require.Zero(t, h.advance())
+	h.observe(140)
+	require.Equal(t, int64(130), h.advance())
+}
@@ -26,6 +26,7 @@ import (
 
 	otrace "go.opencensus.io/trace"
 
+	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/badger/v2/y"
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/conn"
@@ -70,6 +71,16 @@ type Server struct {
 
 	moveOngoing    chan struct{}
 	blockCommitsOn *sync.Map
+
+	kv      *badger.DB     // The WAL store, which also holds the timestamp checkpoints.
+	horizon historyHorizon // The start of the history retention windows of the Alphas.
+
+	followerReads followerReads // The read rates of the tablets served by the followers.
+
+	ongoingMove *pb.PredicateMove   // Progress of the ongoing predicate move, if any.
+	moveHistory []*pb.PredicateMove // The last predicate moves done while being the leader.
+
+	rebalanceNow chan struct{} // Used to trigger the rebalancer before its next interval.
 }
 
 // Init initializes the zero server.
@@ -90,6 +101,7 @@ func (s *Server) Init() {
 	s.closer = y.NewCloser(2) // grpc and http
 	s.blockCommitsOn = new(sync.Map)
 	s.moveOngoing = make(chan struct{}, 1)
+	s.rebalanceNow = make(chan struct{}, 1)
 
 	go s.rebalanceTablets()
 }
@@ -249,6 +261,16 @@ func (s *Server) membershipState() *pb.MembershipState {
 	return proto.Clone(s.state).(*pb.MembershipState)
 }
 
+// ongoingMoveState returns the progress of the ongoing predicate move, or nil if there's none.
+func (s *Server) ongoingMoveState() *pb.PredicateMove {
+	s.RLock()
+	defer s.RUnlock()
+	if s.ongoingMove == nil {
+		return nil
+	}
+	return proto.Clone(s.ongoingMove).(*pb.PredicateMove)
+}
+
 func (s *Server) groupChecksums() map[uint32]uint64 {
 	s.RLock()
 	defer s.RUnlock()
@@ -373,10 +395,14 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			continue
 		}
 
-		s := float64(srcTablet.Space)
-		d := float64(dstTablet.Space)
-		if dstTablet.Remove || (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1) {
+		if dstTablet.Remove || changedByTenPercent(float64(srcTablet.Space),
+			float64(dstTablet.Space)) || changedByTenPercent(srcTablet.ReadRate,
+			dstTablet.ReadRate) || changedByTenPercent(srcTablet.WriteRate, dstTablet.WriteRate) ||
+			srcTablet.Composite != dstTablet.Composite {
 			dstTablet.Force = false
+			// Alphas only report the sizes. The uid range of a shard could have been changed
+			// since the Alpha learnt about it, so keep the one we know about.
+			dstTablet.StartUid, dstTablet.EndUid = srcTablet.StartUid, srcTablet.EndUid
 			proposal := &pb.ZeroProposal{
 				Tablet: dstTablet,
 			}
@@ -386,6 +412,11 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 	return res, nil
 }
 
+// changedByTenPercent returns true if d differs from s by more than 10% of s.
+func changedByTenPercent(s, d float64) bool {
+	return (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1)
+}
+
 // removeNode removes the given node from the given group.
 // It's the user's responsibility to ensure that node doesn't come back again
 // before calling the api.
@@ -408,6 +439,40 @@ func (s *Server) removeNode(ctx context.Context, nodeId uint64, groupId uint32)
 	return s.Node.proposeAndWait(ctx, zp)
 }
 
+// numVoters returns the number of members of the group which aren't learners.
+func numVoters(group *pb.Group) int {
+	var n int
+	for _, m := range group.GetMembers() {
+		if !m.Learner {
+			n++
+		}
+	}
+	return n
+}
+
+// learnerProposal assigns the learner to the group it asks for, or else to the group with the
+// fewest learners. Learners can only join groups with voters, which they replicate.
+func learnerProposal(state *pb.MembershipState, m *pb.Member,
+	proposal *pb.ZeroProposal) (*pb.ZeroProposal, error) {
+	if m.GroupId == 0 {
+		var min int
+		for gid, group := range state.Groups {
+			if numVoters(group) == 0 {
+				continue
+			}
+			learners := len(group.Members) - numVoters(group)
+			if m.GroupId == 0 || learners < min || (learners == min && gid < m.GroupId) {
+				m.GroupId, min = gid, learners
+			}
+		}
+	}
+	if numVoters(state.Groups[m.GroupId]) == 0 {
+		return nil, errors.Errorf("NO_VOTERS: No group with voters for learner: %+v", m)
+	}
+	proposal.Member = m
+	return proposal, nil
+}
+
 // Connect is used by Alpha nodes to connect the very first time with group zero.
 func (s *Server) Connect(ctx context.Context,
 	m *pb.Member) (resp *pb.ConnectionState, err error) {
@@ -479,7 +544,7 @@ func (s *Server) Connect(ctx context.Context,
 	// Create a connection and check validity of the address by doing an Echo.
 	conn.GetPools().Connect(m.Addr)
 
-	createProposal := func() *pb.ZeroProposal {
+	createProposal := func() (*pb.ZeroProposal, error) {
 		s.Lock()
 		defer s.Unlock()
 
@@ -487,7 +552,7 @@ func (s *Server) Connect(ctx context.Context,
 		// Check if we already have this member.
 		for _, group := range s.state.Groups {
 			if _, has := group.Members[m.Id]; has {
-				return nil
+				return nil, nil
 			}
 		}
 		if m.Id == 0 {
@@ -495,40 +560,44 @@ func (s *Server) Connect(ctx context.Context,
 			proposal.MaxRaftId = m.Id
 		}
 
+		if m.Learner {
+			return learnerProposal(s.state, m, proposal)
+		}
+
 		// We don't have this member. So, let's see if it has preference for a group.
 		if m.GroupId > 0 {
 			group, has := s.state.Groups[m.GroupId]
 			if !has {
 				// We don't have this group. Add the server to this group.
 				proposal.Member = m
-				return proposal
+				return proposal, nil
 			}
 
 			if _, has := group.Members[m.Id]; has {
 				proposal.Member = m // Update in case some fields have changed, like address.
-				return proposal
+				return proposal, nil
 			}
 
 			// We don't have this server in the list.
-			if len(group.Members) < s.NumReplicas {
+			if numVoters(group) < s.NumReplicas {
 				// We need more servers here, so let's add it.
 				proposal.Member = m
-				return proposal
+				return proposal, nil
 			} else if m.ForceGroupId {
 				// If the group ID was taken from the group_id file, force the member
 				// to be in this group even if the group is at capacity. This should
 				// not happen if users properly initialize a cluster after a bulk load.
 				proposal.Member = m
-				return proposal
+				return proposal, nil
 			}
 			// Already have plenty of servers serving this group.
 		}
 		// Let's assign this server to a new group.
 		for gid, group := range s.state.Groups {
-			if len(group.Members) < s.NumReplicas {
+			if numVoters(group) < s.NumReplicas {
 				m.GroupId = gid
 				proposal.Member = m
-				return proposal
+				return proposal, nil
 			}
 		}
 		// We either don't have any groups, or don't have any groups which need another member.
@@ -536,10 +605,13 @@ func (s *Server) Connect(ctx context.Context,
 		// We shouldn't increase nextGroup here as we don't know whether we have enough
 		// replicas until proposal is committed and can cause issues due to race.
 		proposal.Member = m
-		return proposal
+		return proposal, nil
 	}
 
-	proposal := createProposal()
+	proposal, err := createProposal()
+	if err != nil {
+		return &emptyConnectionState, err
+	}
 	if proposal == nil {
 		return &pb.ConnectionState{
 			State: ms, Member: m,
@@ -595,7 +667,8 @@ func (s *Server) ShouldServe(
 	var proposal pb.ZeroProposal
 	// Multiple Groups might be assigned to same tablet, so during proposal we will check again.
 	tablet.Force = false
-	if x.IsReservedPredicate(tablet.Predicate) {
+	switch {
+	case x.IsReservedPredicate(tablet.Predicate):
 		// Force all the reserved predicates to be allocated to group 1.
 		// This is to make it easier to stream ACL updates to all alpha servers
 		// since they only need to open one pipeline to receive updates for all
@@ -603,6 +676,12 @@ func (s *Server) ShouldServe(
 		// This will also make it easier to restore the reserved predicates after
 		// a DropAll operation.
 		tablet.GroupId = 1
+	case s.pinnedGroup(tablet.Predicate) > 0:
+		tablet.GroupId = s.pinnedGroup(tablet.Predicate)
+	default:
+		if gid := s.groupForNewTablet(tablet.GroupId); gid > 0 {
+			tablet.GroupId = gid
+		}
 	}
 	proposal.Tablet = tablet
 	if err := s.Node.proposeAndWait(ctx, &proposal); err != nil && err != errTabletAlreadyServed {
@@ -648,6 +727,10 @@ func (s *Server) UpdateMembership(ctx context.Context, group *pb.Group) (*api.Pa
 	if len(group.Members) == 0 {
 		return &api.Payload{Data: []byte("OK")}, nil
 	}
+	if s.followerReads.record(group) {
+		// The tablets of a follower only carry its read rates, they aren't the ones it serves.
+		return &api.Payload{Data: []byte("OK")}, nil
+	}
 	select {
 	case s.moveOngoing <- struct{}{}:
 	default:
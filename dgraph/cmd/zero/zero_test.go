@@ -35,3 +35,34 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(context.TODO(), 1, 2)
 	require.Error(t, err)
 }
+
+func TestLearnerProposal(t *testing.T) {
+	state := &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {Members: map[uint64]*pb.Member{
+			1: {Id: 1},
+			2: {Id: 2, Learner: true},
+		}},
+		2: {Members: map[uint64]*pb.Member{3: {Id: 3}}},
+		3: {Members: map[uint64]*pb.Member{4: {Id: 4, Learner: true}}},
+	}}
+	require.Equal(t, 1, numVoters(state.Groups[1]))
+
+	// The learner goes to the group with voters and the fewest learners.
+	m := &pb.Member{Id: 5, Learner: true}
+	proposal, err := learnerProposal(state, m, &pb.ZeroProposal{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), proposal.Member.GroupId)
+
+	m = &pb.Member{Id: 5, GroupId: 1, Learner: true}
+	proposal, err = learnerProposal(state, m, &pb.ZeroProposal{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), proposal.Member.GroupId)
+
+	// A group without voters, or which doesn't exist, can't take a learner.
+	_, err = learnerProposal(state, &pb.Member{Id: 5, GroupId: 3, Learner: true},
+		&pb.ZeroProposal{})
+	require.Error(t, err)
+	_, err = learnerProposal(state, &pb.Member{Id: 5, GroupId: 4, Learner: true},
+		&pb.ZeroProposal{})
+	require.Error(t, err)
+}
@@ -153,6 +153,9 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if !isMutationAllowed(ctx) {
 		return nil, errors.Errorf("No mutations allowed by server.")
 	}
+	if err := worker.CheckStandby(); err != nil {
+		return nil, err
+	}
 	if err := isAlterAllowed(ctx); err != nil {
 		glog.Warningf("Alter denied with error: %v\n", err)
 		return nil, err
@@ -309,6 +312,9 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	if !isMutationAllowed(ctx) {
 		return errors.Errorf("no mutations allowed")
 	}
+	if err := worker.CheckStandby(); err != nil {
+		return err
+	}
 
 	// update mutations from the query results before assigning UIDs
 	updateMutations(qc)
@@ -754,6 +760,58 @@ func (s *Server) State(ctx context.Context) (*api.Response, error) {
 	return &api.Response{Json: jsonState.Bytes()}, nil
 }
 
+// QueryUids runs the query, without ACL checks, and returns the sorted uids of all the nodes in
+// its response, along with the timestamp it was run at. Only the nodes whose uid is asked for by
+// the query are returned. It's used to export the nodes returned by a query.
+func QueryUids(ctx context.Context, q string) (*pb.List, uint64, error) {
+	resp, err := (&Server{}).doQuery(ctx, &api.Request{Query: q, ReadOnly: true}, NoAuthorize)
+	if err != nil {
+		return nil, 0, err
+	}
+	var data interface{}
+	if err := json.Unmarshal(resp.Json, &data); err != nil {
+		return nil, 0, errors.Wrapf(err, "while reading the response of the query")
+	}
+
+	var uids []uint64
+	var collect func(v interface{}) error
+	collect = func(v interface{}) error {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, val := range v {
+				if s, ok := val.(string); ok && key == "uid" {
+					uid, err := strconv.ParseUint(s, 0, 64)
+					if err != nil {
+						return errors.Wrapf(err, "while parsing uid %s of the query response", s)
+					}
+					uids = append(uids, uid)
+				} else if err := collect(val); err != nil {
+					return err
+				}
+			}
+		case []interface{}:
+			for _, val := range v {
+				if err := collect(val); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := collect(data); err != nil {
+		return nil, 0, err
+	}
+
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	list := &pb.List{}
+	for i, uid := range uids {
+		if i == 0 || uid != uids[i-1] {
+			list.Uids = append(list.Uids, uid)
+		}
+	}
+	return list, resp.Txn.GetStartTs(), nil
+}
+
 // Query handles queries or mutations
 func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
 	auth := ctx.Value(Authorize)
@@ -904,6 +962,13 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		qr.Cache = worker.NoCache
 	}
 
+	if qc.req.StartTs > 0 {
+		// The timestamp given by the client might be too old to read at, if it's a time-travel
+		// query or the transaction outlived the history retention window.
+		if err := worker.ValidateRetainedTs(qc.req.StartTs); err != nil {
+			return resp, err
+		}
+	}
 	if qc.req.StartTs == 0 {
 		assignTimestampStart := time.Now()
 		qc.req.StartTs = worker.State.GetTimestamp(qc.req.ReadOnly)
@@ -1328,15 +1393,26 @@ func formatTypes(typeList []*pb.TypeUpdate) []map[string]interface{} {
 	for _, typ := range typeList {
 		typeMap := make(map[string]interface{})
 		typeMap["name"] = typ.TypeName
-		fields := make([]map[string]string, len(typ.Fields))
+		fields := make([]map[string]interface{}, len(typ.Fields))
 
 		for i, field := range typ.Fields {
-			m := make(map[string]string, 1)
+			m := make(map[string]interface{}, 1)
 			m["name"] = field.Predicate
+			if field.Constraint != nil {
+				m["constraint"] = field.Constraint
+			}
 			fields[i] = m
 		}
 		typeMap["fields"] = fields
 
+		if len(typ.CompositeIndexes) > 0 {
+			composites := make([][]string, len(typ.CompositeIndexes))
+			for i, ci := range typ.CompositeIndexes {
+				composites[i] = ci.Predicates
+			}
+			typeMap["composite_indexes"] = composites
+		}
+
 		res = append(res, typeMap)
 	}
 	return res
@@ -0,0 +1,185 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Backup is the sub-command used to manage the backups at a location.
+var Backup x.SubCommand
+
+var catalogOpt struct {
+	location, backupId string
+	keep               int
+	olderThan          time.Duration
+	dryRun             bool
+}
+
+func initBackupCatalog() {
+	Backup.Cmd = &cobra.Command{
+		Use:   "backup",
+		Short: "Manage the Dgraph (EE) backups at a location",
+		Long: `
+backup lists, verifies and prunes the backups stored at a location.
+
+Each full backup starts a backup series, which the incremental backups taken after it
+belong to. A series can be restored up to any of its backups, as long as none of the
+backups before it are missing.
+
+The --location flag indicates a source URI with Dgraph backup objects. This URI supports all
+the schemes used for backup.
+
+Usage examples:
+
+# List the backup series, with the size and the groups of each backup:
+$ dgraph backup list -l /var/backups/dgraph
+
+# Check the checksums of the files of the latest series:
+$ dgraph backup verify -l s3://s3.us-west-2.amazonaws.com/srfrog/dgraph
+
+# Delete all but the last 3 series, among the ones older than 30 days:
+$ dgraph backup prune -l /var/backups/dgraph --keep 3 --older_than 720h
+		`,
+	}
+	Backup.Cmd.PersistentFlags().StringVarP(&catalogOpt.location, "location", "l", "",
+		"Sets the source location URI (required).")
+	_ = Backup.Cmd.MarkPersistentFlagRequired("location")
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the backup series at a location",
+		Args:  cobra.NoArgs,
+		Run:   runCatalogCmd(runBackupListCmd),
+	}
+
+	verify := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify that a backup series can be restored",
+		Long: `
+verify checks that a backup series starts with a full backup and has no missing backups,
+and reads all its files to check their sizes and checksums against the manifests. The
+files of backups taken before checksums were recorded are only checked to exist.
+		`,
+		Args: cobra.NoArgs,
+		Run:  runCatalogCmd(runBackupVerifyCmd),
+	}
+	verify.Flags().StringVarP(&catalogOpt.backupId, "backup_id", "", "", "The ID of the "+
+		"backup series to verify. If empty, the latest series is verified.")
+
+	prune := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete the old backup series at a location",
+		Long: `
+prune deletes whole backup series, from the full backup to the last incremental backup,
+keeping the latest --keep series and the series more recent than --older_than.
+		`,
+		Args: cobra.NoArgs,
+		Run:  runCatalogCmd(runBackupPruneCmd),
+	}
+	flag := prune.Flags()
+	flag.IntVarP(&catalogOpt.keep, "keep", "", 1, "Number of most recent series to keep. "+
+		"The latest series is always kept.")
+	flag.DurationVarP(&catalogOpt.olderThan, "older_than", "", 0, "Only delete the series "+
+		"whose last backup is older than this. If zero, the age of the series is ignored.")
+	flag.BoolVarP(&catalogOpt.dryRun, "dry_run", "", false, "Only print the series "+
+		"which would be deleted.")
+
+	Backup.Cmd.AddCommand(list, verify, prune)
+}
+
+func runCatalogCmd(fn func() error) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		defer x.StartProfile(Backup.Conf).Stop()
+		if err := fn(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func runBackupListCmd() error {
+	series, err := worker.ListBackupSeries(catalogOpt.location)
+	if err != nil {
+		return errors.Wrapf(err, "while listing backups")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, s := range series {
+		fmt.Fprintf(w, "Series %s: %d backups, %s\n", s.BackupId, len(s.Manifests),
+			humanize.IBytes(s.Size()))
+		fmt.Fprintf(w, "  Num\tType\tSince\tTime\tSize\tGroups\tEncrypted\tPath\n")
+		for _, m := range s.Manifests {
+			taken := "-"
+			if t, err := m.Time(); err == nil {
+				taken = t.Format(time.RFC3339)
+			}
+			var gids []int
+			for gid := range m.Groups {
+				gids = append(gids, int(gid))
+			}
+			sort.Ints(gids)
+			size := "-"
+			if len(m.Files) > 0 {
+				size = humanize.IBytes(m.Size())
+			}
+			fmt.Fprintf(w, "  %d\t%s\t%d\t%s\t%s\t%v\t%v\t%s\n", m.BackupNum, m.Type,
+				m.Since, taken, size, gids, m.Encrypted, m.Path)
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Flush()
+}
+
+func runBackupVerifyCmd() error {
+	if err := worker.VerifyBackupSeries(catalogOpt.location, catalogOpt.backupId); err != nil {
+		return errors.Wrapf(err, "while verifying backups")
+	}
+	fmt.Println("Backup series verified OK.")
+	return nil
+}
+
+func runBackupPruneCmd() error {
+	var before time.Time
+	if catalogOpt.olderThan > 0 {
+		before = time.Now().UTC().Add(-catalogOpt.olderThan)
+	}
+	pruned, err := worker.PruneBackups(catalogOpt.location, catalogOpt.keep, before,
+		catalogOpt.dryRun)
+	if err != nil {
+		return errors.Wrapf(err, "while pruning backups")
+	}
+
+	verb := "Deleted"
+	if catalogOpt.dryRun {
+		verb = "Would delete"
+	}
+	for _, s := range pruned {
+		fmt.Printf("%s series %s: %d backups, %s\n", verb, s.BackupId, len(s.Manifests),
+			humanize.IBytes(s.Size()))
+	}
+	if len(pruned) == 0 {
+		fmt.Println("No backup series to delete.")
+	}
+	return nil
+}
@@ -35,11 +35,13 @@ var LsBackup x.SubCommand
 var opt struct {
 	backupId, location, pdir, zero, keyfile string
 	forceZero                               bool
+	backupNum, untilTs                      uint64
 }
 
 func init() {
 	initRestore()
 	initBackupLs()
+	initBackupCatalog()
 }
 
 func initRestore() {
@@ -68,6 +70,10 @@ Source URI parts:
 
 The --posting flag sets the posting list parent dir to store the loaded backup files.
 
+By default, the latest backup series is restored up to its last backup. The --backup_id flag
+selects another series, and the --backup_num or --until_ts flags restore a series only up to
+one of its backups, which gives the state of the data at the time that backup was taken.
+
 Using the --zero flag will use a Dgraph Zero address to update the start timestamp using
 the restored version. Otherwise, the timestamp must be manually updated through Zero's HTTP
 'assign' command.
@@ -87,6 +93,9 @@ $ dgraph restore -p /var/db/dgraph -l s3://s3.us-west-2.amazonaws.com/srfrog/dgr
 # Restore from dir and update Ts:
 $ dgraph restore -p . -l /var/backups/dgraph -z localhost:5080
 
+# Restore the data as of the last backup taken at or before timestamp 23000:
+$ dgraph restore -p . -l /var/backups/dgraph -z localhost:5080 --until_ts 23000
+
 		`,
 		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -106,6 +115,11 @@ $ dgraph restore -p . -l /var/backups/dgraph -z localhost:5080
 	flag.StringVarP(&opt.zero, "zero", "z", "", "gRPC address for Dgraph zero. ex: localhost:5080")
 	flag.StringVarP(&opt.backupId, "backup_id", "", "", "The ID of the backup series to "+
 		"restore. If empty, it will restore the latest series.")
+	flag.Uint64VarP(&opt.backupNum, "backup_num", "", 0, "The number of the last backup of "+
+		"the series to restore. If zero, all the backups of the series are restored.")
+	flag.Uint64VarP(&opt.untilTs, "until_ts", "", 0, "Restore the series up to the last "+
+		"backup taken at or before this timestamp. If --backup_id is empty, the series is the "+
+		"one of that backup.")
 	flag.StringVarP(&opt.keyfile, "keyfile", "k", "", "Key file to decrypt the backup. "+
 		"The same key is also used to re-encrypt the restored data.")
 	flag.BoolVarP(&opt.forceZero, "force_zero", "", true, "If false, no connection to "+
@@ -194,8 +208,22 @@ func runRestoreCmd() error {
 		zc = pb.NewZeroClient(zero)
 	}
 
+	if opt.untilTs > 0 {
+		if opt.backupNum > 0 {
+			return errors.Errorf("Only one of --backup_num and --until_ts can be set")
+		}
+		var err error
+		opt.backupId, opt.backupNum, err = worker.BackupAt(opt.location, opt.backupId,
+			opt.untilTs)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Restoring backup series %s up to backup %d\n", opt.backupId, opt.backupNum)
+	}
+
 	start = time.Now()
-	result := worker.RunRestore(opt.pdir, opt.location, opt.backupId, opt.keyfile)
+	result := worker.RunRestore(opt.pdir, opt.location, opt.backupId, opt.backupNum,
+		opt.keyfile)
 	if result.Err != nil {
 		return result.Err
 	}
@@ -36,6 +36,9 @@ require (
 	github.com/google/uuid v1.0.0
 	github.com/gorilla/websocket v1.4.1
 	github.com/graph-gophers/graphql-transport-ws v0.0.0-20190611222414-40c048432299
+	github.com/jonas-p/go-shp v0.1.1
+	github.com/lib/pq v1.3.0
+	github.com/linkedin/goavro/v2 v2.9.8
 	github.com/minio/minio-go v0.0.0-20181109183348-774475480ffe
 	github.com/mitchellh/panicwrap v1.0.0
 	github.com/paulmach/go.geojson v0.0.0-20170327170536-40612a87147b
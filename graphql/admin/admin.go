@@ -192,7 +192,25 @@ const (
 	}
 
 	input ExportInput {
+		"""
+		Data format for the export: 'rdf' (the default), 'json', 'jsonld', 'graphml' or 'csv'.
+		"""
 		format: String
+
+		"""
+		Only export these predicates, along with the fields of the given types.
+		"""
+		predicates: [String]
+
+		"""
+		Only export the nodes of these types, and their fields.
+		"""
+		types: [String]
+
+		"""
+		Only export the nodes returned by this DQL query. The query must ask for their uids.
+		"""
+		query: String
 	}
 
 	type Response {
@@ -244,8 +262,9 @@ const (
 		updateGQLSchema(input: UpdateGQLSchemaInput!) : UpdateGQLSchemaPayload
 
 		"""
-		Starts an export of all data in the cluster.  Export format should be 'rdf' (the default
-		if no format is given), or 'json'.
+		Starts an export of the data in the cluster.  Export format should be 'rdf' (the default
+		if no format is given), 'json', 'jsonld', 'graphml' or 'csv'.  The export can be
+		restricted to some predicates, types, or to the nodes returned by a query.
 		See : https://docs.dgraph.io/deploy/#export-database
 		"""
 		export(input: ExportInput!): ExportPayload
@@ -34,6 +34,24 @@ type backupInput struct {
 	SessionToken string
 	Anonymous    bool
 	ForceFull    bool
+	predicateFilterInput
+}
+
+// predicateFilterInput selects the predicates and types to back up or restore.
+type predicateFilterInput struct {
+	IncludePredicates []string
+	ExcludePredicates []string
+	IncludeTypes      []string
+	ExcludeTypes      []string
+}
+
+func (f *predicateFilterInput) filter() *pb.PredicateFilter {
+	return &pb.PredicateFilter{
+		IncludePredicates: f.IncludePredicates,
+		ExcludePredicates: f.ExcludePredicates,
+		IncludeTypes:      f.IncludeTypes,
+		ExcludeTypes:      f.ExcludeTypes,
+	}
 }
 
 func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -50,6 +68,7 @@ func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
+		Filter:       input.filter(),
 	}, input.ForceFull)
 
 	if err != nil {
@@ -50,6 +50,27 @@ const adminTypes = `
 		Force a full backup instead of an incremental backup.
 		"""	
 		forceFull: Boolean
+
+		"""
+		Predicates to back up. Only the given predicates, and the fields of the given types,
+		are backed up if any predicates or types are included.
+		"""
+		includePredicates: [String]
+
+		"""
+		Types to back up, along with their fields.
+		"""
+		includeTypes: [String]
+
+		"""
+		Predicates to leave out. Exclusions take precedence over inclusions.
+		"""
+		excludePredicates: [String]
+
+		"""
+		Types to leave out, along with their fields.
+		"""
+		excludeTypes: [String]
 	}
 
 	type BackupPayload {
@@ -68,6 +89,12 @@ const adminTypes = `
 		"""
 		backupId: String!
 
+		"""
+		Number of the last backup of the series to restore. If it's not set, the series is
+		restored up to its last backup.
+		"""
+		backupNum: Int
+
 		"""
 		Path to the key file needed to decrypt the backup. This file should be accessible
 		by all alphas in the group. The backup will be written using the encryption key
@@ -94,6 +121,31 @@ const adminTypes = `
 		Set to true to allow backing up to S3 or Minio bucket that requires no credentials.
 		"""	
 		anonymous: Boolean
+
+		"""
+		Predicates to restore. Only the given predicates, and the fields of the given types,
+		are restored if any predicates or types are included.
+		"""
+		includePredicates: [String]
+
+		"""
+		Types to restore, along with their fields.
+		"""
+		includeTypes: [String]
+
+		"""
+		Predicates to leave out. Exclusions take precedence over inclusions.
+		"""
+		excludePredicates: [String]
+
+		"""
+		Types to leave out, along with their fields.
+
+		If any predicates or types are included or excluded, only the selected ones are
+		restored, into the running cluster. Their current data is replaced by the data in
+		the backup, and the rest of the data is left untouched.
+		"""
+		excludeTypes: [String]
 	}
 
 	type RestorePayload {
@@ -20,15 +20,20 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
 type exportInput struct {
-	Format string
+	Format     string
+	Predicates []string
+	Types      []string
+	Query      string
 }
 
 func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -48,7 +53,17 @@ func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		}
 	}
 
-	err = worker.ExportOverNetwork(context.Background(), format)
+	req := &pb.ExportRequest{
+		Format:     format,
+		Predicates: input.Predicates,
+		Types:      input.Types,
+	}
+	if input.Query != "" {
+		if req.Uids, req.ReadTs, err = edgraph.QueryUids(ctx, input.Query); err != nil {
+			return emptyResult(m, err), false
+		}
+	}
+	err = worker.ExportOverNetwork(context.Background(), req)
 	if err != nil {
 		return emptyResult(m, err), false
 	}
@@ -29,11 +29,13 @@ import (
 type restoreInput struct {
 	Location     string
 	BackupId     string
+	BackupNum    uint64
 	KeyFile      string
 	AccessKey    string
 	SecretKey    string
 	SessionToken string
 	Anonymous    bool
+	predicateFilterInput
 }
 
 func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -46,11 +48,13 @@ func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
 	req := pb.RestoreRequest{
 		Location:     input.Location,
 		BackupId:     input.BackupId,
+		BackupNum:    input.BackupNum,
 		KeyFile:      input.KeyFile,
 		AccessKey:    input.AccessKey,
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
+		Filter:       input.filter(),
 	}
 	err = worker.ProcessRestoreRequest(context.Background(), &req)
 	if err != nil {
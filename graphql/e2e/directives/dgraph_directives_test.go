@@ -65,13 +65,15 @@ func TestSchema_WithDgraphDirectives(t *testing.T) {
 		"type": "string",
 		"index": true,
 		"tokenizer": ["trigram", "hash"],
-		"upsert": true
+		"upsert": true,
+		"unique": true
 	}, {
 		"predicate": "User.name",
 		"type": "string",
 		"index": true,
 		"tokenizer": ["hash"],
-		"upsert": true
+		"upsert": true,
+		"unique": true
 	}, {
 		"predicate": "appears_in",
 		"type": "string",
@@ -199,7 +201,8 @@ func TestSchema_WithDgraphDirectives(t *testing.T) {
 		"type": "string",
 		"index": true,
 		"tokenizer": ["hash"],
-		"upsert": true
+		"upsert": true,
+		"unique": true
 	}, {
 		"predicate": "People.name",
 		"type": "string"
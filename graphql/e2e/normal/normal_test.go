@@ -172,19 +172,22 @@ func TestSchema_Normal(t *testing.T) {
 			"type": "string",
 			"index": true,
 			"tokenizer": ["trigram", "hash"],
-			"upsert": true
+			"upsert": true,
+			"unique": true
 		}, {
 			"predicate": "User.name",
 			"type": "string",
 			"index": true,
 			"tokenizer": ["hash"],
-			"upsert": true
+			"upsert": true,
+			"unique": true
 		}, {
 			"predicate": "People.xid",
 			"type": "string",
 			"index": true,
 			"tokenizer": ["hash"],
-			"upsert": true
+			"upsert": true,
+			"unique": true
 		}, {
 			"predicate": "People.name",
 			"type": "string"
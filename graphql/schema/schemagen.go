@@ -367,7 +367,7 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string) string {
 					search := f.Directives.ForName(searchDirective)
 					id := f.Directives.ForName(idDirective)
 					if id != nil {
-						upsertStr = "@upsert "
+						upsertStr = "@upsert @unique "
 						indexes = append(indexes, "hash")
 					}
 
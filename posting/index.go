@@ -103,9 +103,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 
 	// Create a value token -> uid edge.
 	edge := &pb.DirectedEdge{
-		ValueId: uid,
-		Attr:    attr,
-		Op:      info.op,
+		ValueId:   uid,
+		Attr:      attr,
+		Op:        info.op,
+		ExpiresAt: info.edge.ExpiresAt,
 	}
 
 	for _, token := range tokens {
@@ -181,11 +182,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 	if err := plist.addMutation(ctx, txn, edge); err != nil {
 		return err
@@ -216,11 +218,12 @@ func (txn *Txn) addReverseAndCountMutation(ctx context.Context, t *pb.DirectedEd
 	x.AssertTrue(plist != nil)
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	cp, err := txn.addReverseMutationHelper(ctx, plist, hasCountIndex, edge)
@@ -400,6 +403,90 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 	return val, found, emptyCountParams, nil
 }
 
+// CompositeToken returns the token under which an entity with the given values is stored
+// in the composite index. vals can hold values for a prefix of the predicates of the index,
+// in which case the returned token is a prefix of the tokens of all matching entities.
+func CompositeToken(ci *pb.CompositeIndex, vals []types.Val) (string, error) {
+	x.AssertTrue(len(vals) <= len(ci.Predicates))
+	encoded := make([]string, 0, len(vals))
+	for i, val := range vals {
+		typ, err := schema.State().TypeOf(ci.Predicates[i])
+		if err != nil {
+			return "", err
+		}
+		sv, err := types.Convert(val, typ)
+		if err != nil {
+			return "", err
+		}
+		out := types.Val{Tid: types.BinaryID}
+		if err := types.Marshal(sv, &out); err != nil {
+			return "", err
+		}
+		encoded = append(encoded, string(out.Value.([]byte)))
+	}
+	return tok.EncodeCompositeToken(schema.CompositeIndexName(ci), encoded), nil
+}
+
+// compositeTokens returns the current token of the entity in each of the given composite
+// indexes. The token is empty if the entity is missing a value for any of the predicates.
+func (txn *Txn) compositeTokens(indexes []*pb.CompositeIndex, uid uint64) ([]string, error) {
+	tokens := make([]string, len(indexes))
+	for i, ci := range indexes {
+		vals := make([]types.Val, 0, len(ci.Predicates))
+		for _, pred := range ci.Predicates {
+			pl, err := txn.Get(x.DataKey(pred, uid))
+			if err != nil {
+				return nil, err
+			}
+			val, err := pl.Value(txn.StartTs)
+			if err == ErrNoValue {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, val)
+		}
+		if len(vals) < len(ci.Predicates) {
+			continue
+		}
+		token, err := CompositeToken(ci, vals)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+// updateCompositeIndexes moves the entity from its old token to its new token in every
+// composite index where the two differ.
+func (txn *Txn) updateCompositeIndexes(ctx context.Context, indexes []*pb.CompositeIndex,
+	uid uint64, before, after []string) error {
+	for i, ci := range indexes {
+		if before[i] == after[i] {
+			continue
+		}
+		edge := &pb.DirectedEdge{
+			ValueId: uid,
+			Attr:    ci.Predicates[0],
+		}
+		if before[i] != "" {
+			edge.Op = pb.DirectedEdge_DEL
+			if err := txn.addIndexMutation(ctx, edge, before[i]); err != nil {
+				return err
+			}
+		}
+		if after[i] != "" {
+			edge.Op = pb.DirectedEdge_SET
+			if err := txn.addIndexMutation(ctx, edge, after[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // AddMutationWithIndex is addMutation with support for indexing. It also
 // supports reverse edges.
 func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
@@ -408,6 +495,31 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	var composites []*pb.CompositeIndex
+	if pstore != nil {
+		composites = schema.State().CompositeIndexes(edge.Attr)
+	}
+	if len(composites) == 0 {
+		return l.addMutationWithIndex(ctx, edge, txn)
+	}
+
+	// Composite indexes depend on the values of other predicates of the entity, so we
+	// compare the tokens before and after the mutation instead of the changed value.
+	before, err := txn.compositeTokens(composites, edge.Entity)
+	if err != nil {
+		return err
+	}
+	if err := l.addMutationWithIndex(ctx, edge, txn); err != nil {
+		return err
+	}
+	after, err := txn.compositeTokens(composites, edge.Entity)
+	if err != nil {
+		return err
+	}
+	return txn.updateCompositeIndexes(ctx, composites, edge.Entity, before, after)
+}
+
+func (l *List) addMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
@@ -697,6 +809,9 @@ type IndexRebuild struct {
 	StartTs       uint64
 	OldSchema     *pb.SchemaUpdate
 	CurrentSchema *pb.SchemaUpdate
+	// InPlace builds the indexes of CurrentSchema over the current ones, which are used until
+	// the build is done, instead of dropping them. OldSchema must be nil.
+	InPlace bool
 }
 
 type indexOp int
@@ -712,6 +827,9 @@ const (
 func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	// Copy the current schema.
 	querySchema := *rb.CurrentSchema
+	if rb.InPlace {
+		return &querySchema
+	}
 	info := rb.needsTokIndexRebuild()
 
 	// Compute old.Tokenizer minus info.tokenizersToDelete.
@@ -741,6 +859,9 @@ func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 
 // DropIndexes drops the indexes that need to be rebuilt.
 func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
+	if rb.InPlace {
+		return nil
+	}
 	if err := dropTokIndexes(ctx, rb); err != nil {
 		return err
 	}
@@ -771,7 +892,60 @@ func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
-	return rebuildCountIndex(ctx, rb)
+	if err := rebuildCountIndex(ctx, rb); err != nil {
+		return err
+	}
+	if rb.InPlace {
+		return rb.deleteStaleEntries()
+	}
+	return nil
+}
+
+// deleteStaleEntries writes empty posting lists over the index entries left from before an
+// in-place rebuild. The rebuild writes every entry of the data at StartTs, so the entries last
+// written before are of values the data doesn't hold anymore.
+func (rb *IndexRebuild) deleteStaleEntries() error {
+	pk := x.ParsedKey{Attr: rb.Attr}
+	var prefixes [][]byte
+	if rb.CurrentSchema.Directive == pb.SchemaUpdate_INDEX {
+		tokenizers, err := tok.GetTokenizers(rb.CurrentSchema.Tokenizer)
+		if err != nil {
+			return err
+		}
+		for _, tokenizer := range tokenizers {
+			prefixes = append(prefixes, append(pk.IndexPrefix(), tokenizer.Identifier()))
+			if tokenizer.Name() == "exact" {
+				langTok := tok.GetTokenizerForLang(tokenizer, "en")
+				prefixes = append(prefixes, append(pk.IndexPrefix(), langTok.Identifier()))
+			}
+		}
+	}
+	if rb.CurrentSchema.Directive == pb.SchemaUpdate_REVERSE {
+		prefixes = append(prefixes, pk.ReversePrefix())
+	}
+	if rb.CurrentSchema.Count {
+		prefixes = append(prefixes, pk.CountPrefix(false), pk.CountPrefix(true))
+	}
+
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	writer := NewTxnWriter(pstore)
+	for _, prefix := range prefixes {
+		itr := txn.NewIterator(badger.IteratorOptions{Prefix: prefix})
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			item := itr.Item()
+			if item.Version() >= rb.StartTs || item.UserMeta()&BitEmptyPosting > 0 {
+				continue
+			}
+			if err := writer.SetAt(item.KeyCopy(nil), nil, BitEmptyPosting,
+				rb.StartTs); err != nil {
+				itr.Close()
+				return err
+			}
+		}
+		itr.Close()
+	}
+	return writer.Flush()
 }
 
 type indexRebuildInfo struct {
@@ -917,6 +1091,7 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 				Tid:   types.TypeID(p.ValType),
 			}
 			edge.Lang = string(p.LangTag)
+			edge.ExpiresAt = p.ExpiresAt
 
 			for {
 				err := txn.addIndexMutations(ctx, &indexMutationInfo{
@@ -1080,6 +1255,7 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 			edge.Op = pb.DirectedEdge_SET
 			edge.Facets = pp.Facets
 			edge.Label = pp.Label
+			edge.ExpiresAt = pp.ExpiresAt
 
 			for {
 				// we only need to build reverse index here.
@@ -1097,6 +1273,53 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 	return builder.Run(ctx)
 }
 
+// DropCompositeIndex deletes all the entries of the given composite index.
+func DropCompositeIndex(ci *pb.CompositeIndex) error {
+	glog.Infof("Deleting composite index %s", schema.CompositeIndexName(ci))
+	prefix := x.IndexKey(ci.Predicates[0], tok.EncodeCompositeToken(
+		schema.CompositeIndexName(ci), nil))
+	if err := pstore.DropPrefix(prefix); err != nil {
+		return err
+	}
+
+	// Also delete all the parts of any list that has been split into multiple parts.
+	// Such keys have a different prefix (the last byte is set to 1).
+	prefix[0] = x.ByteSplit
+	return pstore.DropPrefix(prefix)
+}
+
+// RebuildCompositeIndex builds the given composite index from the data of its predicates.
+func RebuildCompositeIndex(ctx context.Context, ci *pb.CompositeIndex, startTs uint64) error {
+	if err := DropCompositeIndex(ci); err != nil {
+		return err
+	}
+
+	glog.Infof("Rebuilding composite index %s", schema.CompositeIndexName(ci))
+	attr := ci.Predicates[0]
+	pk := x.ParsedKey{Attr: attr}
+	builder := rebuilder{attr: attr, prefix: pk.DataPrefix(), startTs: startTs}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+		tokens, err := txn.compositeTokens([]*pb.CompositeIndex{ci}, uid)
+		if err != nil {
+			return err
+		}
+		if tokens[0] == "" {
+			return nil
+		}
+		edge := &pb.DirectedEdge{ValueId: uid, Attr: attr, Op: pb.DirectedEdge_SET}
+		for {
+			err := txn.addIndexMutation(ctx, edge, tokens[0])
+			switch err {
+			case ErrRetry:
+				time.Sleep(10 * time.Millisecond)
+			default:
+				return err
+			}
+		}
+	}
+	return builder.Run(ctx)
+}
+
 // needsListTypeRebuild returns true if the schema changed from a scalar to a
 // list. It returns true if the index can be left as is.
 func (rb *IndexRebuild) needsListTypeRebuild() (bool, error) {
@@ -1185,6 +1408,12 @@ func DeletePredicate(ctx context.Context, attr string) error {
 	if err := pstore.DropPrefix(prefix); err != nil {
 		return err
 	}
+	// Entries in composite indexes that include this predicate are no longer valid.
+	for _, ci := range schema.State().CompositeIndexes(attr) {
+		if err := DropCompositeIndex(ci); err != nil {
+			return err
+		}
+	}
 
 	return schema.State().Delete(attr)
 }
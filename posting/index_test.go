@@ -365,6 +365,41 @@ func TestRebuildTokIndexWithDeletion(t *testing.T) {
 	require.Len(t, idxVals, 0)
 }
 
+func TestRebuildTokIndexInPlace(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("ingested: string @index(exact) ."), 1))
+	su, _ := schema.State().Get(context.Background(), "ingested")
+	key := func(value string) []byte {
+		return x.IndexKey("ingested", string([]byte{0x2})+value)
+	}
+	indexUids := func(value string, readTs uint64) []uint64 {
+		l, err := GetNoStore(key(value), readTs)
+		require.NoError(t, err)
+		return uids(l, readTs)
+	}
+
+	addEdgeToValue(t, "ingested", 1, "old", 1, 2)
+	rb := IndexRebuild{Attr: "ingested", StartTs: 3, CurrentSchema: &su}
+	require.NoError(t, rb.DropIndexes(context.Background()))
+	require.NoError(t, rb.BuildIndexes(context.Background()))
+	require.Equal(t, []uint64{1}, indexUids("old", 3))
+
+	// The keys ingested replace the value of node 1 and add node 2.
+	addEdgeToValue(t, "ingested", 1, "new", 4, 5)
+	addEdgeToValue(t, "ingested", 2, "other", 4, 5)
+
+	rb = IndexRebuild{Attr: "ingested", StartTs: 6, CurrentSchema: &su, InPlace: true}
+	require.Equal(t, su.Tokenizer, rb.GetQuerySchema().Tokenizer)
+	require.NoError(t, rb.DropIndexes(context.Background()))
+	require.Equal(t, []uint64{1}, indexUids("old", 6))
+
+	require.NoError(t, rb.BuildIndexes(context.Background()))
+	require.Empty(t, indexUids("old", 7))
+	require.Equal(t, []uint64{1}, indexUids("new", 7))
+	require.Equal(t, []uint64{2}, indexUids("other", 7))
+	// The reads from before the rebuild still see the index as it was.
+	require.Equal(t, []uint64{1}, indexUids("old", 5))
+}
+
 func TestRebuildReverseEdges(t *testing.T) {
 	addEdgeToUID(t, "friend", 1, 23, uint64(10), uint64(11))
 	addEdgeToUID(t, "friend", 1, 24, uint64(12), uint64(13))
@@ -532,3 +567,76 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+const compositeSchemaVal = `
+city: string @index(exact) .
+status: string @index(exact) .
+type Place {
+	city
+	status
+} @index(composite: [city, status])
+`
+
+func setCompositeSchema(t *testing.T) *pb.CompositeIndex {
+	require.NoError(t, schema.ParseBytes([]byte(compositeSchemaVal), 1))
+	result, err := schema.Parse(compositeSchemaVal)
+	require.NoError(t, err)
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, *typ)
+	}
+	cis := schema.State().CompositeIndexes("status")
+	require.Len(t, cis, 1)
+	return cis[0]
+}
+
+func compositeUids(t *testing.T, ci *pb.CompositeIndex, readTs uint64, city,
+	status string) []uint64 {
+	token, err := CompositeToken(ci, []types.Val{
+		{Tid: types.StringID, Value: []byte(city)},
+		{Tid: types.StringID, Value: []byte(status)},
+	})
+	require.NoError(t, err)
+	l, err := GetNoStore(x.IndexKey("city", token), readTs)
+	require.NoError(t, err)
+	return uids(l, readTs)
+}
+
+func setCompositeValue(t *testing.T, attr string, uid uint64, value string,
+	startTs, commitTs uint64) {
+	l, err := GetNoStore(x.DataKey(attr, uid), startTs)
+	require.NoError(t, err)
+	edge := &pb.DirectedEdge{Value: []byte(value), Attr: attr, Entity: uid}
+	addMutation(t, l, edge, Set, startTs, commitTs, true)
+}
+
+func TestCompositeIndexMaintenance(t *testing.T) {
+	ci := setCompositeSchema(t)
+	defer func() { require.NoError(t, schema.ParseBytes([]byte(""), 1)) }()
+
+	// The entity is only indexed once it has values for all the predicates.
+	setCompositeValue(t, "city", 50, "Paris", 1, 2)
+	require.Empty(t, compositeUids(t, ci, 3, "Paris", "open"))
+	setCompositeValue(t, "status", 50, "open", 3, 4)
+	require.Equal(t, []uint64{50}, compositeUids(t, ci, 5, "Paris", "open"))
+
+	// Updating any of the values moves the entity to its new token.
+	setCompositeValue(t, "status", 50, "closed", 5, 6)
+	require.Empty(t, compositeUids(t, ci, 7, "Paris", "open"))
+	require.Equal(t, []uint64{50}, compositeUids(t, ci, 7, "Paris", "closed"))
+	setCompositeValue(t, "city", 50, "Rome", 7, 8)
+	require.Empty(t, compositeUids(t, ci, 9, "Paris", "closed"))
+	require.Equal(t, []uint64{50}, compositeUids(t, ci, 9, "Rome", "closed"))
+}
+
+func TestRebuildCompositeIndex(t *testing.T) {
+	ci := setCompositeSchema(t)
+	defer func() { require.NoError(t, schema.ParseBytes([]byte(""), 1)) }()
+
+	addEdgeToValue(t, "city", 60, "Oslo", 1, 2)
+	addEdgeToValue(t, "status", 60, "open", 3, 4)
+	addEdgeToValue(t, "city", 61, "Oslo", 5, 6)
+	require.Empty(t, compositeUids(t, ci, 7, "Oslo", "open"))
+
+	require.NoError(t, RebuildCompositeIndex(context.Background(), ci, 7))
+	require.Equal(t, []uint64{60}, compositeUids(t, ci, 8, "Oslo", "open"))
+}
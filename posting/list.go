@@ -23,6 +23,7 @@ import (
 	"log"
 	"math"
 	"sort"
+	"time"
 
 	"github.com/dgryski/go-farm"
 	"github.com/pkg/errors"
@@ -30,7 +31,6 @@ import (
 	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/codec"
-	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
@@ -313,10 +313,44 @@ func NewPosting(t *pb.DirectedEdge) *pb.Posting {
 		Label:       t.Label,
 		Op:          op,
 		Facets:      t.Facets,
+		ExpiresAt:   t.ExpiresAt,
 	}
 	return p
 }
 
+// isExpired returns whether the posting has an expiry time which isn't after now, given as
+// unix time in seconds. Nothing has expired if now is zero.
+func isExpired(p *pb.Posting, now uint64) bool {
+	return p.ExpiresAt > 0 && p.ExpiresAt <= now
+}
+
+// expiryHorizon returns the time before which expired postings are removed by rollups. Those
+// which expired within the history retention window are kept for the reads at its timestamps.
+func expiryHorizon() uint64 {
+	return uint64(time.Now().Add(-x.WorkerConfig.HistoryRetention).Unix())
+}
+
+// hasExpiredPostings returns whether any of the postings has expired before the expiry horizon.
+func hasExpiredPostings(postings []*pb.Posting) bool {
+	now := expiryHorizon()
+	for _, p := range postings {
+		if isExpired(p, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExpiringPostings returns whether any of the postings has an expiry time.
+func hasExpiringPostings(postings []*pb.Posting) bool {
+	for _, p := range postings {
+		if p.ExpiresAt > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func hasDeleteAll(mpost *pb.Posting) bool {
 	return mpost.Op == Del && bytes.Equal(mpost.Value, []byte(x.Star)) && len(mpost.LangTag) == 0
 }
@@ -430,7 +464,7 @@ func (l *List) addMutationInternal(ctx context.Context, txn *Txn, t *pb.Directed
 	l.AssertLock()
 
 	if txn.ShouldAbort() {
-		return zero.ErrConflict
+		return x.ErrConflict
 	}
 
 	getKey := func(key []byte, uid uint64) uint64 {
@@ -474,14 +508,15 @@ func (l *List) addMutationInternal(ctx context.Context, txn *Txn, t *pb.Directed
 	switch {
 	case schema.State().HasNoConflict(t.Attr):
 		break
-	case schema.State().HasUpsert(t.Attr):
+	case schema.State().HasUpsert(t.Attr) || schema.State().IsUnique(t.Attr):
 		// Consider checking to see if a email id is unique. A user adds:
 		// <uid> <email> "email@email.org", and there's a string equal tokenizer
 		// and upsert directive on the schema.
 		// Then keys are "<email> <uid>" and "<email> email@email.org"
 		// The first key won't conflict, because two different UIDs can try to
 		// get the same email id. But, the second key would. Thus, we ensure
-		// that two users don't set the same email id.
+		// that two users don't set the same email id. The @unique directive relies
+		// on the same keys to abort concurrent inserts of the same value.
 		conflictKey = getKey(l.key, 0)
 
 	case pk.IsData() && schema.State().IsList(t.Attr):
@@ -621,8 +656,26 @@ func (l *List) pickPostings(readTs uint64) (uint64, []*pb.Posting) {
 }
 
 func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *pb.Posting) error) error {
+	// Expired postings are skipped, as if they had been deleted. Whether they had expired is
+	// judged at the time of readTs, so that reads at older timestamps see the values which were
+	// live at the time.
+	return l.iterateAt(readTs, afterUid, o.readTime(readTs), f)
+}
+
+// iterateAt iterates over the postings at readTs, skipping those which had expired at the given
+// unix time. They are removed from disk the next time the list is rolled up after the expiry
+// horizon has passed them.
+func (l *List) iterateAt(readTs, afterUid, now uint64, f func(obj *pb.Posting) error) error {
 	l.AssertRLock()
 
+	visit := f
+	f = func(p *pb.Posting) error {
+		if isExpired(p, now) {
+			return nil
+		}
+		return visit(p)
+	}
+
 	deleteBelowTs, mposts := l.pickPostings(readTs)
 	if readTs < l.minTs {
 		return errors.Errorf("readTs: %d less than minTs: %d for key: %q", readTs, l.minTs, l.key)
@@ -916,7 +969,7 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 		initializeSplit()
 	}
 
-	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+	err := l.iterateAt(readTs, 0, expiryHorizon(), func(p *pb.Posting) error {
 		if p.Uid > endUid && split {
 			plist.Pack = enc.Done()
 			out.parts[startUid] = plist
@@ -926,7 +979,8 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 		}
 
 		enc.Add(p.Uid)
-		if p.Facets != nil || p.PostingType != pb.Posting_REF || len(p.Label) != 0 {
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || len(p.Label) != 0 ||
+			p.ExpiresAt > 0 {
 			plist.Postings = append(plist.Postings, p)
 		}
 		return nil
@@ -991,7 +1045,8 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 	// Use approximate length for initial capacity.
 	res := make([]uint64, 0, len(l.mutationMap)+codec.ApproxLen(l.plist.Pack))
 	out := &pb.List{}
-	if len(l.mutationMap) == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 {
+	if len(l.mutationMap) == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 &&
+		!hasExpiringPostings(l.plist.Postings) {
 		if opt.ReadTs < l.minTs {
 			l.RUnlock()
 			return out, ErrTsTooOld
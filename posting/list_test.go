@@ -24,13 +24,16 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dgraph-io/dgraph/codec"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
@@ -1367,3 +1370,108 @@ func BenchmarkAddMutations(b *testing.B) {
 		}
 	}
 }
+
+func TestExpiredPostings(t *testing.T) {
+	key := x.DataKey("ttl", 1)
+	ol, err := getNew(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpiresAt: now - 1}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3, ExpiresAt: now + 3600}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 4}, Set, txn)
+	ol.commitMutation(1, 2)
+
+	uids, err := ol.Uids(ListOptions{ReadTs: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, uids.Uids)
+
+	// The expired posting is dropped by the rollup. The expiring one keeps its expiry time.
+	ol.RLock()
+	out, err := ol.rollup(math.MaxUint64, false)
+	ol.RUnlock()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, codec.Decode(out.plist.Pack, 0))
+	require.Len(t, out.plist.Postings, 1)
+	require.Equal(t, uint64(3), out.plist.Postings[0].Uid)
+	require.Equal(t, now+3600, out.plist.Postings[0].ExpiresAt)
+}
+
+func TestExpiredPostingsAtReadTs(t *testing.T) {
+	saved := o
+	o = new(oracle)
+	o.init()
+	defer func() { o = saved }()
+
+	// The timestamp 10 was seen 200 seconds ago, 20 was seen 50 seconds ago, and the max assigned
+	// one is 30.
+	now := time.Now()
+	o.recordTime(10, now.Add(-200*time.Second))
+	o.recordTime(20, now.Add(-50*time.Second))
+	o.ProcessDelta(&pb.OracleDelta{MaxAssigned: 30})
+
+	key := x.DataKey("ttl_history", 1)
+	ol, err := getNew(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	txn := &Txn{StartTs: 1}
+	expiresAt := uint64(now.Add(-100 * time.Second).Unix())
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpiresAt: expiresAt}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3}, Set, txn)
+	ol.commitMutation(1, 2)
+
+	readUids := func(readTs uint64) []uint64 {
+		uids, err := ol.Uids(ListOptions{ReadTs: readTs})
+		require.NoError(t, err)
+		return uids.Uids
+	}
+	// The value expired between the times of the timestamps 10 and 20. The time of the
+	// timestamp 5 isn't known, so the expiry isn't applied.
+	require.Equal(t, []uint64{2, 3}, readUids(5))
+	require.Equal(t, []uint64{2, 3}, readUids(15))
+	require.Equal(t, []uint64{3}, readUids(25))
+	require.Equal(t, []uint64{3}, readUids(30))
+
+	// Rollups keep the values which expired within the history retention window.
+	x.WorkerConfig.HistoryRetention = time.Hour
+	defer func() { x.WorkerConfig.HistoryRetention = 0 }()
+	ol.RLock()
+	out, err := ol.rollup(math.MaxUint64, false)
+	ol.RUnlock()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, codec.Decode(out.plist.Pack, 0))
+	require.False(t, hasExpiredPostings(out.plist.Postings))
+
+	x.WorkerConfig.HistoryRetention = 0
+	ol.RLock()
+	out, err = ol.rollup(math.MaxUint64, false)
+	ol.RUnlock()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, codec.Decode(out.plist.Pack, 0))
+}
+
+func TestReadTime(t *testing.T) {
+	saved := o
+	o = new(oracle)
+	o.init()
+	defer func() { o = saved }()
+
+	start := time.Unix(1000, 0)
+	o.recordTime(10, start)
+	// Samples are taken at most once a second.
+	o.recordTime(12, start.Add(500*time.Millisecond))
+	o.recordTime(20, start.Add(5*time.Second))
+	atomic.StoreUint64(&o.maxAssigned, 40)
+
+	require.Equal(t, uint64(0), o.readTime(5))
+	require.Equal(t, uint64(1000), o.readTime(10))
+	require.Equal(t, uint64(1000), o.readTime(15))
+	require.Equal(t, uint64(1005), o.readTime(20))
+	require.Equal(t, uint64(1005), o.readTime(35))
+	require.InDelta(t, time.Now().Unix(), o.readTime(40), 1)
+
+	// The samples older than the history retention window and its margin are dropped.
+	o.recordTime(30, start.Add(20*time.Minute))
+	require.Equal(t, uint64(0), o.readTime(20))
+	require.Equal(t, uint64(2200), o.readTime(35))
+}
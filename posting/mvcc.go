@@ -264,10 +264,11 @@ func ReadPostingList(key []byte, it *badger.Iterator) (*List, error) {
 	l.key = key
 	l.plist = new(pb.PostingList)
 
-	// We use the following block of code to trigger incremental rollup on this key.
+	// We use the following block of code to trigger incremental rollup on this key. Lists
+	// holding expired postings are also rolled up so that those postings get removed.
 	deltaCount := 0
 	defer func() {
-		if deltaCount > 0 {
+		if deltaCount > 0 || hasExpiredPostings(l.plist.Postings) {
 			IncrRollup.addKeyToBatch(key)
 		}
 	}()
@@ -19,6 +19,7 @@ package posting
 import (
 	"context"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -108,8 +109,27 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// Samples of the max assigned timestamp and the time at which it was seen, oldest first. They
+	// tell the time at which the data was read by a query at an older timestamp.
+	timeLock sync.RWMutex
+	times    []tsTime
+}
+
+// tsTime is a timestamp and the unix time in seconds at which it was seen.
+type tsTime struct {
+	ts   uint64
+	unix uint64
 }
 
+const (
+	// maxTimeSamples bounds the number of samples kept for the history retention window. They
+	// are taken once a second, or less often if the window is long.
+	maxTimeSamples = 100000
+	// timeSamplesMargin is how long the samples are kept beyond the history retention window.
+	timeSamplesMargin = 10 * time.Minute
+)
+
 func (o *oracle) init() {
 	o.waiters = make(map[uint64][]chan struct{})
 	o.pendingTxns = make(map[uint64]*Txn)
@@ -240,6 +260,51 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	x.AssertTrue(atomic.CompareAndSwapUint64(&o.maxAssigned, curMax, delta.MaxAssigned))
 	ostats.Record(context.Background(),
 		x.MaxAssignedTs.M(int64(delta.MaxAssigned))) // Can't access o.MaxAssigned without atomics.
+	o.recordTime(delta.MaxAssigned, time.Now())
+}
+
+// recordTime samples the time at which the max assigned timestamp was seen.
+func (o *oracle) recordTime(ts uint64, now time.Time) {
+	retention := x.WorkerConfig.HistoryRetention
+	interval := uint64(1)
+	if secs := uint64(retention.Seconds()) / maxTimeSamples; secs > interval {
+		interval = secs
+	}
+
+	o.timeLock.Lock()
+	defer o.timeLock.Unlock()
+	unix := uint64(now.Unix())
+	if n := len(o.times); n > 0 && (unix < o.times[n-1].unix+interval || ts <= o.times[n-1].ts) {
+		return
+	}
+	o.times = append(o.times, tsTime{ts: ts, unix: unix})
+
+	cutoff := uint64(now.Add(-retention - timeSamplesMargin).Unix())
+	if idx := sort.Search(len(o.times), func(i int) bool {
+		return o.times[i].unix >= cutoff
+	}); idx > 0 {
+		o.times = append(o.times[:0], o.times[idx:]...)
+	}
+}
+
+// readTime returns the unix time in seconds at which readTs was the latest timestamp, to tell
+// which postings had expired for a read at it. Reads at older timestamps than the max assigned
+// one get the time of the last sample not after readTs, so they see the values which were live
+// at that time. If readTs is older than all the samples, like when reading before this Alpha
+// started, the time isn't known and zero is returned.
+func (o *oracle) readTime(readTs uint64) uint64 {
+	if readTs >= o.MaxAssigned() {
+		return uint64(time.Now().Unix())
+	}
+	o.timeLock.RLock()
+	defer o.timeLock.RUnlock()
+	idx := sort.Search(len(o.times), func(i int) bool {
+		return o.times[i].ts > readTs
+	})
+	if idx == 0 {
+		return 0
+	}
+	return o.times[idx-1].unix
 }
 
 func (o *oracle) ResetTxns() {
@@ -52,7 +52,7 @@ func (x DirectedEdge_Op) String() string {
 }
 
 func (DirectedEdge_Op) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{19, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{26, 0}
 }
 
 type Mutations_DropOp int32
@@ -83,7 +83,7 @@ func (x Mutations_DropOp) String() string {
 }
 
 func (Mutations_DropOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{20, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{27, 0}
 }
 
 // HintType represents a hint that will be passed along the mutation and used
@@ -116,7 +116,7 @@ func (x Metadata_HintType) String() string {
 }
 
 func (Metadata_HintType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{21, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{28, 0}
 }
 
 type Posting_ValType int32
@@ -168,7 +168,7 @@ func (x Posting_ValType) String() string {
 }
 
 func (Posting_ValType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{26, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{33, 0}
 }
 
 type Posting_PostingType int32
@@ -196,7 +196,7 @@ func (x Posting_PostingType) String() string {
 }
 
 func (Posting_PostingType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{26, 1}
+	return fileDescriptor_f80abaa17e25ccc8, []int{33, 1}
 }
 
 type SchemaUpdate_Directive int32
@@ -227,7 +227,7 @@ func (x SchemaUpdate_Directive) String() string {
 }
 
 func (SchemaUpdate_Directive) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{39, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{46, 0}
 }
 
 type BackupKey_KeyType int32
@@ -270,7 +270,7 @@ func (x BackupKey_KeyType) String() string {
 }
 
 func (BackupKey_KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{57, 0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{68, 0}
 }
 
 type List struct {
@@ -962,10 +962,12 @@ func (m *SortResult) GetUidMatrix() []*List {
 }
 
 type RaftContext struct {
-	Id                   uint64   `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
-	Group                uint32   `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	SnapshotTs           uint64   `protobuf:"varint,4,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	Id         uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group      uint32 `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	Addr       string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	SnapshotTs uint64 `protobuf:"varint,4,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	// Set by a node joining its group as a non-voting learner.
+	IsLearner            bool     `protobuf:"varint,5,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1032,18 +1034,28 @@ func (m *RaftContext) GetSnapshotTs() uint64 {
 	return 0
 }
 
+func (m *RaftContext) GetIsLearner() bool {
+	if m != nil {
+		return m.IsLearner
+	}
+	return false
+}
+
 // Member stores information about RAFT group member for a single RAFT node.
 // Note that each server can be serving multiple RAFT groups. Each group would have
 // one RAFT node per server serving that group.
 type Member struct {
-	Id                   uint64   `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
-	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	Leader               bool     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	AmDead               bool     `protobuf:"varint,5,opt,name=am_dead,json=amDead,proto3" json:"amDead,omitempty"`
-	LastUpdate           uint64   `protobuf:"varint,6,opt,name=last_update,json=lastUpdate,proto3" json:"lastUpdate,omitempty"`
-	ClusterInfoOnly      bool     `protobuf:"varint,13,opt,name=cluster_info_only,json=clusterInfoOnly,proto3" json:"clusterInfoOnly,omitempty"`
-	ForceGroupId         bool     `protobuf:"varint,14,opt,name=force_group_id,json=forceGroupId,proto3" json:"forceGroupId,omitempty"`
+	Id              uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId         uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	Addr            string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Leader          bool   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	AmDead          bool   `protobuf:"varint,5,opt,name=am_dead,json=amDead,proto3" json:"amDead,omitempty"`
+	LastUpdate      uint64 `protobuf:"varint,6,opt,name=last_update,json=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	ClusterInfoOnly bool   `protobuf:"varint,13,opt,name=cluster_info_only,json=clusterInfoOnly,proto3" json:"clusterInfoOnly,omitempty"`
+	ForceGroupId    bool   `protobuf:"varint,14,opt,name=force_group_id,json=forceGroupId,proto3" json:"forceGroupId,omitempty"`
+	// A learner receives the Raft log of its group without voting, and never becomes the
+	// leader. It doesn't count towards the replication factor.
+	Learner              bool     `protobuf:"varint,7,opt,name=learner,proto3" json:"learner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1138,14 +1150,24 @@ func (m *Member) GetForceGroupId() bool {
 	return false
 }
 
+func (m *Member) GetLearner() bool {
+	if m != nil {
+		return m.Learner
+	}
+	return false
+}
+
 type Group struct {
-	Members              map[uint64]*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Tablets              map[string]*Tablet `protobuf:"bytes,2,rep,name=tablets,proto3" json:"tablets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	SnapshotTs           uint64             `protobuf:"varint,3,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
-	Checksum             uint64             `protobuf:"varint,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Members    map[uint64]*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tablets    map[string]*Tablet `protobuf:"bytes,2,rep,name=tablets,proto3" json:"tablets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SnapshotTs uint64             `protobuf:"varint,3,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	Checksum   uint64             `protobuf:"varint,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// The tablets of a draining group are moved to other groups, and no new ones are
+	// assigned to it.
+	Draining             bool     `protobuf:"varint,5,opt,name=draining,proto3" json:"draining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Group) Reset()         { *m = Group{} }
@@ -1209,6 +1231,13 @@ func (m *Group) GetChecksum() uint64 {
 	return 0
 }
 
+func (m *Group) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type License struct {
 	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	MaxNodes             uint64   `protobuf:"varint,2,opt,name=maxNodes,proto3" json:"maxNodes,omitempty"`
@@ -1280,17 +1309,93 @@ func (m *License) GetEnabled() bool {
 	return false
 }
 
+// TimestampCheckpoint maps a wall clock time to the max timestamp assigned by Zero at that time.
+type TimestampCheckpoint struct {
+	Ts   uint64 `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Time int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Set by the Alphas asking for the start of their history retention window. In a
+	// proposal, it prunes the checkpoints which aren't needed to map that time anymore.
+	Horizon              bool     `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimestampCheckpoint) Reset()         { *m = TimestampCheckpoint{} }
+func (m *TimestampCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TimestampCheckpoint) ProtoMessage()    {}
+func (*TimestampCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{14}
+}
+func (m *TimestampCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimestampCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimestampCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimestampCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimestampCheckpoint.Merge(m, src)
+}
+func (m *TimestampCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimestampCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimestampCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimestampCheckpoint proto.InternalMessageInfo
+
+func (m *TimestampCheckpoint) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *TimestampCheckpoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *TimestampCheckpoint) GetHorizon() bool {
+	if m != nil {
+		return m.Horizon
+	}
+	return false
+}
+
 type ZeroProposal struct {
-	SnapshotTs           map[uint32]uint64 `protobuf:"bytes,1,rep,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Member               *Member           `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	Tablet               *Tablet           `protobuf:"bytes,3,opt,name=tablet,proto3" json:"tablet,omitempty"`
-	MaxLeaseId           uint64            `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
-	MaxTxnTs             uint64            `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
-	MaxRaftId            uint64            `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
-	Txn                  *api.TxnContext   `protobuf:"bytes,7,opt,name=txn,proto3" json:"txn,omitempty"`
-	Key                  string            `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
-	Cid                  string            `protobuf:"bytes,9,opt,name=cid,proto3" json:"cid,omitempty"`
-	License              *License          `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
+	SnapshotTs   map[uint32]uint64    `protobuf:"bytes,1,rep,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Member       *Member              `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Tablet       *Tablet              `protobuf:"bytes,3,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	MaxLeaseId   uint64               `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
+	MaxTxnTs     uint64               `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
+	MaxRaftId    uint64               `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
+	Txn          *api.TxnContext      `protobuf:"bytes,7,opt,name=txn,proto3" json:"txn,omitempty"`
+	Key          string               `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	Cid          string               `protobuf:"bytes,9,opt,name=cid,proto3" json:"cid,omitempty"`
+	License      *License             `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
+	TsCheckpoint *TimestampCheckpoint `protobuf:"bytes,11,opt,name=ts_checkpoint,json=tsCheckpoint,proto3" json:"ts_checkpoint,omitempty"`
+	// Applied together, used while splitting or merging shards.
+	Tablets []*Tablet `protobuf:"bytes,12,rep,name=tablets,proto3" json:"tablets,omitempty"`
+	// Pins a predicate to a group, or unpins it.
+	Pin   *PinPredicate `protobuf:"bytes,13,opt,name=pin,proto3" json:"pin,omitempty"`
+	Drain *DrainGroup   `protobuf:"bytes,14,opt,name=drain,proto3" json:"drain,omitempty"`
+	// Records the replication status of a primary group.
+	Replicated *ReplicationStatus `protobuf:"bytes,15,opt,name=replicated,proto3" json:"replicated,omitempty"`
+	// Sets the standby mode of the cluster.
+	Replication          *ReplicationState `protobuf:"bytes,16,opt,name=replication,proto3" json:"replication,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1300,7 +1405,7 @@ func (m *ZeroProposal) Reset()         { *m = ZeroProposal{} }
 func (m *ZeroProposal) String() string { return proto.CompactTextString(m) }
 func (*ZeroProposal) ProtoMessage()    {}
 func (*ZeroProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{14}
+	return fileDescriptor_f80abaa17e25ccc8, []int{15}
 }
 func (m *ZeroProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,29 +1504,77 @@ func (m *ZeroProposal) GetLicense() *License {
 	return nil
 }
 
+func (m *ZeroProposal) GetTsCheckpoint() *TimestampCheckpoint {
+	if m != nil {
+		return m.TsCheckpoint
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetTablets() []*Tablet {
+	if m != nil {
+		return m.Tablets
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetPin() *PinPredicate {
+	if m != nil {
+		return m.Pin
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetDrain() *DrainGroup {
+	if m != nil {
+		return m.Drain
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetReplicated() *ReplicationStatus {
+	if m != nil {
+		return m.Replicated
+	}
+	return nil
+}
+
+func (m *ZeroProposal) GetReplication() *ReplicationState {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
 type MembershipState struct {
-	Counter              uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
-	Groups               map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Zeros                map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros,proto3" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MaxLeaseId           uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
-	MaxTxnTs             uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
-	MaxRaftId            uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
-	Removed              []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
-	Cid                  string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
-	License              *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Counter    uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	Groups     map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Zeros      map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros,proto3" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxLeaseId uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
+	MaxTxnTs   uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
+	MaxRaftId  uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
+	Removed    []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
+	Cid        string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
+	License    *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
+	// Only set in the response of /state by the Zero leader while a predicate is being moved.
+	OngoingMove *PredicateMove `protobuf:"bytes,10,opt,name=ongoing_move,json=ongoingMove,proto3" json:"ongoing_move,omitempty"`
+	// Predicates pinned to a group, which the rebalancer keeps there.
+	Pinned map[string]uint32 `protobuf:"bytes,11,rep,name=pinned,proto3" json:"pinned,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Set for the standby of a primary cluster.
+	Replication          *ReplicationState `protobuf:"bytes,12,opt,name=replication,proto3" json:"replication,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MembershipState) Reset()         { *m = MembershipState{} }
 func (m *MembershipState) String() string { return proto.CompactTextString(m) }
 func (*MembershipState) ProtoMessage()    {}
 func (*MembershipState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{15}
+	return fileDescriptor_f80abaa17e25ccc8, []int{16}
 }
 func (m *MembershipState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1513,96 +1666,56 @@ func (m *MembershipState) GetLicense() *License {
 	return nil
 }
 
-type ConnectionState struct {
-	Member               *Member          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	State                *MembershipState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	MaxPending           uint64           `protobuf:"varint,3,opt,name=max_pending,json=maxPending,proto3" json:"max_pending,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *ConnectionState) Reset()         { *m = ConnectionState{} }
-func (m *ConnectionState) String() string { return proto.CompactTextString(m) }
-func (*ConnectionState) ProtoMessage()    {}
-func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{16}
-}
-func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ConnectionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ConnectionState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ConnectionState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectionState.Merge(m, src)
-}
-func (m *ConnectionState) XXX_Size() int {
-	return m.Size()
-}
-func (m *ConnectionState) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectionState.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
-
-func (m *ConnectionState) GetMember() *Member {
+func (m *MembershipState) GetOngoingMove() *PredicateMove {
 	if m != nil {
-		return m.Member
+		return m.OngoingMove
 	}
 	return nil
 }
 
-func (m *ConnectionState) GetState() *MembershipState {
+func (m *MembershipState) GetPinned() map[string]uint32 {
 	if m != nil {
-		return m.State
+		return m.Pinned
 	}
 	return nil
 }
 
-func (m *ConnectionState) GetMaxPending() uint64 {
+func (m *MembershipState) GetReplication() *ReplicationState {
 	if m != nil {
-		return m.MaxPending
+		return m.Replication
 	}
-	return 0
+	return nil
 }
 
-type HealthInfo struct {
-	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Group                string   `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
-	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
-	Uptime               int64    `protobuf:"varint,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	LastEcho             int64    `protobuf:"varint,7,opt,name=lastEcho,proto3" json:"lastEcho,omitempty"`
-	Ongoing              []string `protobuf:"bytes,8,rep,name=ongoing,proto3" json:"ongoing,omitempty"`
-	Indexing             []string `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
+// PredicateMove describes the progress of a predicate move.
+type PredicateMove struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	SourceGid uint32 `protobuf:"varint,2,opt,name=source_gid,json=sourceGid,proto3" json:"source_gid,omitempty"`
+	DestGid   uint32 `protobuf:"varint,3,opt,name=dest_gid,json=destGid,proto3" json:"dest_gid,omitempty"`
+	Phase     string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Round     uint32 `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	KeysMoved uint64 `protobuf:"varint,6,opt,name=keys_moved,json=keysMoved,proto3" json:"keys_moved,omitempty"`
+	StartedAt int64  `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Set once the move is over, along with the error which stopped it if any.
+	FinishedAt           int64    `protobuf:"varint,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HealthInfo) Reset()         { *m = HealthInfo{} }
-func (m *HealthInfo) String() string { return proto.CompactTextString(m) }
-func (*HealthInfo) ProtoMessage()    {}
-func (*HealthInfo) Descriptor() ([]byte, []int) {
+func (m *PredicateMove) Reset()         { *m = PredicateMove{} }
+func (m *PredicateMove) String() string { return proto.CompactTextString(m) }
+func (*PredicateMove) ProtoMessage()    {}
+func (*PredicateMove) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{17}
 }
-func (m *HealthInfo) XXX_Unmarshal(b []byte) error {
+func (m *PredicateMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HealthInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PredicateMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HealthInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PredicateMove.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1612,106 +1725,102 @@ func (m *HealthInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *HealthInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthInfo.Merge(m, src)
+func (m *PredicateMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredicateMove.Merge(m, src)
 }
-func (m *HealthInfo) XXX_Size() int {
+func (m *PredicateMove) XXX_Size() int {
 	return m.Size()
 }
-func (m *HealthInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthInfo.DiscardUnknown(m)
+func (m *PredicateMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredicateMove.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthInfo proto.InternalMessageInfo
+var xxx_messageInfo_PredicateMove proto.InternalMessageInfo
 
-func (m *HealthInfo) GetInstance() string {
+func (m *PredicateMove) GetPredicate() string {
 	if m != nil {
-		return m.Instance
+		return m.Predicate
 	}
 	return ""
 }
 
-func (m *HealthInfo) GetAddress() string {
+func (m *PredicateMove) GetSourceGid() uint32 {
 	if m != nil {
-		return m.Address
+		return m.SourceGid
 	}
-	return ""
+	return 0
 }
 
-func (m *HealthInfo) GetStatus() string {
+func (m *PredicateMove) GetDestGid() uint32 {
 	if m != nil {
-		return m.Status
+		return m.DestGid
 	}
-	return ""
+	return 0
 }
 
-func (m *HealthInfo) GetGroup() string {
+func (m *PredicateMove) GetPhase() string {
 	if m != nil {
-		return m.Group
+		return m.Phase
 	}
 	return ""
 }
 
-func (m *HealthInfo) GetVersion() string {
+func (m *PredicateMove) GetRound() uint32 {
 	if m != nil {
-		return m.Version
+		return m.Round
 	}
-	return ""
+	return 0
 }
 
-func (m *HealthInfo) GetUptime() int64 {
+func (m *PredicateMove) GetKeysMoved() uint64 {
 	if m != nil {
-		return m.Uptime
+		return m.KeysMoved
 	}
 	return 0
 }
 
-func (m *HealthInfo) GetLastEcho() int64 {
+func (m *PredicateMove) GetStartedAt() int64 {
 	if m != nil {
-		return m.LastEcho
+		return m.StartedAt
 	}
 	return 0
 }
 
-func (m *HealthInfo) GetOngoing() []string {
+func (m *PredicateMove) GetFinishedAt() int64 {
 	if m != nil {
-		return m.Ongoing
+		return m.FinishedAt
 	}
-	return nil
+	return 0
 }
 
-func (m *HealthInfo) GetIndexing() []string {
+func (m *PredicateMove) GetError() string {
 	if m != nil {
-		return m.Indexing
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type Tablet struct {
-	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
-	Predicate            string   `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	Space                int64    `protobuf:"varint,7,opt,name=space,proto3" json:"space,omitempty"`
-	Remove               bool     `protobuf:"varint,8,opt,name=remove,proto3" json:"remove,omitempty"`
-	ReadOnly             bool     `protobuf:"varint,9,opt,name=read_only,json=readOnly,proto3" json:"readOnly,omitempty"`
-	MoveTs               uint64   `protobuf:"varint,10,opt,name=move_ts,json=moveTs,proto3" json:"moveTs,omitempty"`
+// PinPredicate pins a predicate to a group, or unpins it if group_id is zero.
+type PinPredicate struct {
+	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tablet) Reset()         { *m = Tablet{} }
-func (m *Tablet) String() string { return proto.CompactTextString(m) }
-func (*Tablet) ProtoMessage()    {}
-func (*Tablet) Descriptor() ([]byte, []int) {
+func (m *PinPredicate) Reset()         { *m = PinPredicate{} }
+func (m *PinPredicate) String() string { return proto.CompactTextString(m) }
+func (*PinPredicate) ProtoMessage()    {}
+func (*PinPredicate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{18}
 }
-func (m *Tablet) XXX_Unmarshal(b []byte) error {
+func (m *PinPredicate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Tablet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PinPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Tablet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PinPredicate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1721,94 +1830,123 @@ func (m *Tablet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Tablet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tablet.Merge(m, src)
+func (m *PinPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinPredicate.Merge(m, src)
 }
-func (m *Tablet) XXX_Size() int {
+func (m *PinPredicate) XXX_Size() int {
 	return m.Size()
 }
-func (m *Tablet) XXX_DiscardUnknown() {
-	xxx_messageInfo_Tablet.DiscardUnknown(m)
+func (m *PinPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinPredicate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Tablet proto.InternalMessageInfo
-
-func (m *Tablet) GetGroupId() uint32 {
-	if m != nil {
-		return m.GroupId
-	}
-	return 0
-}
+var xxx_messageInfo_PinPredicate proto.InternalMessageInfo
 
-func (m *Tablet) GetPredicate() string {
+func (m *PinPredicate) GetPredicate() string {
 	if m != nil {
 		return m.Predicate
 	}
 	return ""
 }
 
-func (m *Tablet) GetForce() bool {
-	if m != nil {
-		return m.Force
-	}
-	return false
-}
-
-func (m *Tablet) GetSpace() int64 {
+func (m *PinPredicate) GetGroupId() uint32 {
 	if m != nil {
-		return m.Space
+		return m.GroupId
 	}
 	return 0
 }
 
-func (m *Tablet) GetRemove() bool {
-	if m != nil {
-		return m.Remove
-	}
-	return false
+// DrainGroup sets or unsets the draining mode of a group.
+type DrainGroup struct {
+	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Enable               bool     `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tablet) GetReadOnly() bool {
-	if m != nil {
-		return m.ReadOnly
-	}
-	return false
+func (m *DrainGroup) Reset()         { *m = DrainGroup{} }
+func (m *DrainGroup) String() string { return proto.CompactTextString(m) }
+func (*DrainGroup) ProtoMessage()    {}
+func (*DrainGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{19}
 }
-
-func (m *Tablet) GetMoveTs() uint64 {
-	if m != nil {
-		return m.MoveTs
+func (m *DrainGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainGroup.Merge(m, src)
+}
+func (m *DrainGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainGroup proto.InternalMessageInfo
+
+func (m *DrainGroup) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
 	}
 	return 0
 }
 
-type DirectedEdge struct {
-	Entity               uint64          `protobuf:"fixed64,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	Attr                 string          `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
-	Value                []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	ValueType            Posting_ValType `protobuf:"varint,4,opt,name=value_type,json=valueType,proto3,enum=pb.Posting_ValType" json:"value_type,omitempty"`
-	ValueId              uint64          `protobuf:"fixed64,5,opt,name=value_id,json=valueId,proto3" json:"value_id,omitempty"`
-	Label                string          `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
-	Lang                 string          `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`
-	Op                   DirectedEdge_Op `protobuf:"varint,8,opt,name=op,proto3,enum=pb.DirectedEdge_Op" json:"op,omitempty"`
-	Facets               []*api.Facet    `protobuf:"bytes,9,rep,name=facets,proto3" json:"facets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *DrainGroup) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
 }
 
-func (m *DirectedEdge) Reset()         { *m = DirectedEdge{} }
-func (m *DirectedEdge) String() string { return proto.CompactTextString(m) }
-func (*DirectedEdge) ProtoMessage()    {}
-func (*DirectedEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{19}
+// ReplicationBatch is sent by the leader of a group of a primary cluster to an Alpha of
+// its standby cluster, with the keys the group changed since the previous batch.
+type ReplicationBatch struct {
+	// The group of the primary cluster.
+	GroupId uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The keys changed in (since_ts, up_to_ts], as complete posting lists.
+	SinceTs uint64   `protobuf:"varint,2,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	UpToTs  uint64   `protobuf:"varint,3,opt,name=up_to_ts,json=upToTs,proto3" json:"up_to_ts,omitempty"`
+	Kv      []*pb.KV `protobuf:"bytes,4,rep,name=kv,proto3" json:"kv,omitempty"`
+	// The max uid leased by the primary cluster.
+	MaxUid uint64 `protobuf:"varint,5,opt,name=max_uid,json=maxUid,proto3" json:"max_uid,omitempty"`
+	// Unix time in seconds at which up_to_ts was read.
+	SentAt int64 `protobuf:"varint,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	// True if the batch was forwarded to the leader of a standby group.
+	Forwarded bool `protobuf:"varint,7,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	// The drops applied by the primary group in (since_ts, up_to_ts], sent in the first
+	// batch.
+	Drops                []*Mutations `protobuf:"bytes,8,rep,name=drops,proto3" json:"drops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *DirectedEdge) XXX_Unmarshal(b []byte) error {
+
+func (m *ReplicationBatch) Reset()         { *m = ReplicationBatch{} }
+func (m *ReplicationBatch) String() string { return proto.CompactTextString(m) }
+func (*ReplicationBatch) ProtoMessage()    {}
+func (*ReplicationBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{20}
+}
+func (m *ReplicationBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DirectedEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DirectedEdge.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1818,107 +1956,99 @@ func (m *DirectedEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DirectedEdge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DirectedEdge.Merge(m, src)
+func (m *ReplicationBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationBatch.Merge(m, src)
 }
-func (m *DirectedEdge) XXX_Size() int {
+func (m *ReplicationBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *DirectedEdge) XXX_DiscardUnknown() {
-	xxx_messageInfo_DirectedEdge.DiscardUnknown(m)
+func (m *ReplicationBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DirectedEdge proto.InternalMessageInfo
+var xxx_messageInfo_ReplicationBatch proto.InternalMessageInfo
 
-func (m *DirectedEdge) GetEntity() uint64 {
+func (m *ReplicationBatch) GetGroupId() uint32 {
 	if m != nil {
-		return m.Entity
+		return m.GroupId
 	}
 	return 0
 }
 
-func (m *DirectedEdge) GetAttr() string {
+func (m *ReplicationBatch) GetSinceTs() uint64 {
 	if m != nil {
-		return m.Attr
+		return m.SinceTs
 	}
-	return ""
+	return 0
 }
 
-func (m *DirectedEdge) GetValue() []byte {
+func (m *ReplicationBatch) GetUpToTs() uint64 {
 	if m != nil {
-		return m.Value
+		return m.UpToTs
 	}
-	return nil
+	return 0
 }
 
-func (m *DirectedEdge) GetValueType() Posting_ValType {
+func (m *ReplicationBatch) GetKv() []*pb.KV {
 	if m != nil {
-		return m.ValueType
+		return m.Kv
 	}
-	return Posting_DEFAULT
+	return nil
 }
 
-func (m *DirectedEdge) GetValueId() uint64 {
+func (m *ReplicationBatch) GetMaxUid() uint64 {
 	if m != nil {
-		return m.ValueId
+		return m.MaxUid
 	}
 	return 0
 }
 
-func (m *DirectedEdge) GetLabel() string {
-	if m != nil {
-		return m.Label
-	}
-	return ""
-}
-
-func (m *DirectedEdge) GetLang() string {
+func (m *ReplicationBatch) GetSentAt() int64 {
 	if m != nil {
-		return m.Lang
+		return m.SentAt
 	}
-	return ""
+	return 0
 }
 
-func (m *DirectedEdge) GetOp() DirectedEdge_Op {
+func (m *ReplicationBatch) GetForwarded() bool {
 	if m != nil {
-		return m.Op
+		return m.Forwarded
 	}
-	return DirectedEdge_SET
+	return false
 }
 
-func (m *DirectedEdge) GetFacets() []*api.Facet {
+func (m *ReplicationBatch) GetDrops() []*Mutations {
 	if m != nil {
-		return m.Facets
+		return m.Drops
 	}
 	return nil
 }
 
-type Mutations struct {
-	GroupId              uint32           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	StartTs              uint64           `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	Edges                []*DirectedEdge  `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
-	Schema               []*SchemaUpdate  `protobuf:"bytes,4,rep,name=schema,proto3" json:"schema,omitempty"`
-	Types                []*TypeUpdate    `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
-	DropOp               Mutations_DropOp `protobuf:"varint,7,opt,name=drop_op,json=dropOp,proto3,enum=pb.Mutations_DropOp" json:"drop_op,omitempty"`
-	DropValue            string           `protobuf:"bytes,8,opt,name=drop_value,json=dropValue,proto3" json:"drop_value,omitempty"`
-	Metadata             *Metadata        `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// ReplicationStatus is the progress of the replication of a primary group by the standby.
+type ReplicationStatus struct {
+	GroupId uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	// The keys of the group changed up to applied_ts have been applied by the standby.
+	AppliedTs            uint64   `protobuf:"varint,2,opt,name=applied_ts,json=appliedTs,proto3" json:"appliedTs,omitempty"`
+	SentAt               int64    `protobuf:"varint,3,opt,name=sent_at,json=sentAt,proto3" json:"sentAt,omitempty"`
+	AppliedAt            int64    `protobuf:"varint,4,opt,name=applied_at,json=appliedAt,proto3" json:"appliedAt,omitempty"`
+	MaxUid               uint64   `protobuf:"varint,5,opt,name=max_uid,json=maxUid,proto3" json:"maxUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Mutations) Reset()         { *m = Mutations{} }
-func (m *Mutations) String() string { return proto.CompactTextString(m) }
-func (*Mutations) ProtoMessage()    {}
-func (*Mutations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{20}
+func (m *ReplicationStatus) Reset()         { *m = ReplicationStatus{} }
+func (m *ReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatus) ProtoMessage()    {}
+func (*ReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{21}
 }
-func (m *Mutations) XXX_Unmarshal(b []byte) error {
+func (m *ReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Mutations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Mutations.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1928,94 +2058,76 @@ func (m *Mutations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Mutations) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Mutations.Merge(m, src)
+func (m *ReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatus.Merge(m, src)
 }
-func (m *Mutations) XXX_Size() int {
+func (m *ReplicationStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *Mutations) XXX_DiscardUnknown() {
-	xxx_messageInfo_Mutations.DiscardUnknown(m)
+func (m *ReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Mutations proto.InternalMessageInfo
+var xxx_messageInfo_ReplicationStatus proto.InternalMessageInfo
 
-func (m *Mutations) GetGroupId() uint32 {
+func (m *ReplicationStatus) GetGroupId() uint32 {
 	if m != nil {
 		return m.GroupId
 	}
 	return 0
 }
 
-func (m *Mutations) GetStartTs() uint64 {
+func (m *ReplicationStatus) GetAppliedTs() uint64 {
 	if m != nil {
-		return m.StartTs
+		return m.AppliedTs
 	}
 	return 0
 }
 
-func (m *Mutations) GetEdges() []*DirectedEdge {
-	if m != nil {
-		return m.Edges
-	}
-	return nil
-}
-
-func (m *Mutations) GetSchema() []*SchemaUpdate {
-	if m != nil {
-		return m.Schema
-	}
-	return nil
-}
-
-func (m *Mutations) GetTypes() []*TypeUpdate {
-	if m != nil {
-		return m.Types
-	}
-	return nil
-}
-
-func (m *Mutations) GetDropOp() Mutations_DropOp {
+func (m *ReplicationStatus) GetSentAt() int64 {
 	if m != nil {
-		return m.DropOp
+		return m.SentAt
 	}
-	return Mutations_NONE
+	return 0
 }
 
-func (m *Mutations) GetDropValue() string {
+func (m *ReplicationStatus) GetAppliedAt() int64 {
 	if m != nil {
-		return m.DropValue
+		return m.AppliedAt
 	}
-	return ""
+	return 0
 }
 
-func (m *Mutations) GetMetadata() *Metadata {
+func (m *ReplicationStatus) GetMaxUid() uint64 {
 	if m != nil {
-		return m.Metadata
+		return m.MaxUid
 	}
-	return nil
+	return 0
 }
 
-type Metadata struct {
-	// Map of predicates to their hints.
-	PredHints            map[string]Metadata_HintType `protobuf:"bytes,1,rep,name=pred_hints,json=predHints,proto3" json:"pred_hints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Metadata_HintType"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+// ReplicationState is the replication state of a standby cluster.
+type ReplicationState struct {
+	Standby bool `protobuf:"varint,1,opt,name=standby,proto3" json:"standby,omitempty"`
+	// Primary group ID -> Replication status.
+	Groups               map[uint32]*ReplicationStatus `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PromotedAt           int64                         `protobuf:"varint,3,opt,name=promoted_at,json=promotedAt,proto3" json:"promotedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *Metadata) Reset()         { *m = Metadata{} }
-func (m *Metadata) String() string { return proto.CompactTextString(m) }
-func (*Metadata) ProtoMessage()    {}
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{21}
+func (m *ReplicationState) Reset()         { *m = ReplicationState{} }
+func (m *ReplicationState) String() string { return proto.CompactTextString(m) }
+func (*ReplicationState) ProtoMessage()    {}
+func (*ReplicationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{22}
 }
-func (m *Metadata) XXX_Unmarshal(b []byte) error {
+func (m *ReplicationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2025,50 +2137,60 @@ func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Metadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Metadata.Merge(m, src)
+func (m *ReplicationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationState.Merge(m, src)
 }
-func (m *Metadata) XXX_Size() int {
+func (m *ReplicationState) XXX_Size() int {
 	return m.Size()
 }
-func (m *Metadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_Metadata.DiscardUnknown(m)
+func (m *ReplicationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Metadata proto.InternalMessageInfo
+var xxx_messageInfo_ReplicationState proto.InternalMessageInfo
 
-func (m *Metadata) GetPredHints() map[string]Metadata_HintType {
+func (m *ReplicationState) GetStandby() bool {
 	if m != nil {
-		return m.PredHints
+		return m.Standby
+	}
+	return false
+}
+
+func (m *ReplicationState) GetGroups() map[uint32]*ReplicationStatus {
+	if m != nil {
+		return m.Groups
 	}
 	return nil
 }
 
-type Snapshot struct {
-	Context *RaftContext `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Index   uint64       `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	ReadTs  uint64       `protobuf:"varint,3,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	// done is used to indicate that snapshot stream was a success.
-	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
-	// since_ts stores the ts of the last snapshot to support diff snap updates.
-	SinceTs              uint64   `protobuf:"varint,5,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReplicationState) GetPromotedAt() int64 {
+	if m != nil {
+		return m.PromotedAt
+	}
+	return 0
 }
 
-func (m *Snapshot) Reset()         { *m = Snapshot{} }
-func (m *Snapshot) String() string { return proto.CompactTextString(m) }
-func (*Snapshot) ProtoMessage()    {}
-func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{22}
+type ConnectionState struct {
+	Member               *Member          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	State                *MembershipState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	MaxPending           uint64           `protobuf:"varint,3,opt,name=max_pending,json=maxPending,proto3" json:"max_pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+
+func (m *ConnectionState) Reset()         { *m = ConnectionState{} }
+func (m *ConnectionState) String() string { return proto.CompactTextString(m) }
+func (*ConnectionState) ProtoMessage()    {}
+func (*ConnectionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{23}
+}
+func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConnectionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConnectionState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2078,82 +2200,66 @@ func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Snapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Snapshot.Merge(m, src)
+func (m *ConnectionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionState.Merge(m, src)
 }
-func (m *Snapshot) XXX_Size() int {
+func (m *ConnectionState) XXX_Size() int {
 	return m.Size()
 }
-func (m *Snapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+func (m *ConnectionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 
-func (m *Snapshot) GetContext() *RaftContext {
+func (m *ConnectionState) GetMember() *Member {
 	if m != nil {
-		return m.Context
+		return m.Member
 	}
 	return nil
 }
 
-func (m *Snapshot) GetIndex() uint64 {
+func (m *ConnectionState) GetState() *MembershipState {
 	if m != nil {
-		return m.Index
+		return m.State
 	}
-	return 0
+	return nil
 }
 
-func (m *Snapshot) GetReadTs() uint64 {
+func (m *ConnectionState) GetMaxPending() uint64 {
 	if m != nil {
-		return m.ReadTs
+		return m.MaxPending
 	}
 	return 0
 }
 
-func (m *Snapshot) GetDone() bool {
-	if m != nil {
-		return m.Done
-	}
-	return false
+type HealthInfo struct {
+	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Group                string   `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Uptime               int64    `protobuf:"varint,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	LastEcho             int64    `protobuf:"varint,7,opt,name=lastEcho,proto3" json:"lastEcho,omitempty"`
+	Ongoing              []string `protobuf:"bytes,8,rep,name=ongoing,proto3" json:"ongoing,omitempty"`
+	Indexing             []string `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Snapshot) GetSinceTs() uint64 {
-	if m != nil {
-		return m.SinceTs
-	}
-	return 0
-}
-
-type RestoreRequest struct {
-	GroupId   uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	RestoreTs uint64 `protobuf:"varint,2,opt,name=restore_ts,json=restoreTs,proto3" json:"restore_ts,omitempty"`
-	Location  string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	BackupId  string `protobuf:"bytes,4,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
-	// Credentials when using a minio or S3 bucket as the backup location.
-	AccessKey    string `protobuf:"bytes,5,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
-	SecretKey    string `protobuf:"bytes,6,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	SessionToken string `protobuf:"bytes,7,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	Anonymous    bool   `protobuf:"varint,8,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
-	// Info needed to process encrypted backups.
-	KeyFile              string   `protobuf:"bytes,9,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
-func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
-func (*RestoreRequest) ProtoMessage()    {}
-func (*RestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{23}
+func (m *HealthInfo) Reset()         { *m = HealthInfo{} }
+func (m *HealthInfo) String() string { return proto.CompactTextString(m) }
+func (*HealthInfo) ProtoMessage()    {}
+func (*HealthInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{24}
 }
-func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
+func (m *HealthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HealthInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HealthInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2163,109 +2269,116 @@ func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *RestoreRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreRequest.Merge(m, src)
+func (m *HealthInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthInfo.Merge(m, src)
 }
-func (m *RestoreRequest) XXX_Size() int {
+func (m *HealthInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *RestoreRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
+func (m *HealthInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
+var xxx_messageInfo_HealthInfo proto.InternalMessageInfo
 
-func (m *RestoreRequest) GetGroupId() uint32 {
+func (m *HealthInfo) GetInstance() string {
 	if m != nil {
-		return m.GroupId
+		return m.Instance
 	}
-	return 0
+	return ""
 }
 
-func (m *RestoreRequest) GetRestoreTs() uint64 {
+func (m *HealthInfo) GetAddress() string {
 	if m != nil {
-		return m.RestoreTs
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *RestoreRequest) GetLocation() string {
+func (m *HealthInfo) GetStatus() string {
 	if m != nil {
-		return m.Location
+		return m.Status
 	}
 	return ""
 }
 
-func (m *RestoreRequest) GetBackupId() string {
+func (m *HealthInfo) GetGroup() string {
 	if m != nil {
-		return m.BackupId
+		return m.Group
 	}
 	return ""
 }
 
-func (m *RestoreRequest) GetAccessKey() string {
+func (m *HealthInfo) GetVersion() string {
 	if m != nil {
-		return m.AccessKey
+		return m.Version
 	}
 	return ""
 }
 
-func (m *RestoreRequest) GetSecretKey() string {
+func (m *HealthInfo) GetUptime() int64 {
 	if m != nil {
-		return m.SecretKey
+		return m.Uptime
 	}
-	return ""
+	return 0
 }
 
-func (m *RestoreRequest) GetSessionToken() string {
+func (m *HealthInfo) GetLastEcho() int64 {
 	if m != nil {
-		return m.SessionToken
+		return m.LastEcho
 	}
-	return ""
+	return 0
 }
 
-func (m *RestoreRequest) GetAnonymous() bool {
+func (m *HealthInfo) GetOngoing() []string {
 	if m != nil {
-		return m.Anonymous
+		return m.Ongoing
 	}
-	return false
+	return nil
 }
 
-func (m *RestoreRequest) GetKeyFile() string {
+func (m *HealthInfo) GetIndexing() []string {
 	if m != nil {
-		return m.KeyFile
+		return m.Indexing
 	}
-	return ""
+	return nil
 }
 
-type Proposal struct {
-	Mutations            *Mutations       `protobuf:"bytes,2,opt,name=mutations,proto3" json:"mutations,omitempty"`
-	Kv                   []*pb.KV         `protobuf:"bytes,4,rep,name=kv,proto3" json:"kv,omitempty"`
-	State                *MembershipState `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	CleanPredicate       string           `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
-	Key                  string           `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
-	Delta                *OracleDelta     `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
-	Snapshot             *Snapshot        `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Index                uint64           `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
-	ExpectedChecksum     uint64           `protobuf:"varint,11,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
-	Restore              *RestoreRequest  `protobuf:"bytes,12,opt,name=restore,proto3" json:"restore,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type Tablet struct {
+	GroupId   uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	Predicate string `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Force     bool   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	Space     int64  `protobuf:"varint,7,opt,name=space,proto3" json:"space,omitempty"`
+	Remove    bool   `protobuf:"varint,8,opt,name=remove,proto3" json:"remove,omitempty"`
+	ReadOnly  bool   `protobuf:"varint,9,opt,name=read_only,json=readOnly,proto3" json:"readOnly,omitempty"`
+	MoveTs    uint64 `protobuf:"varint,10,opt,name=move_ts,json=moveTs,proto3" json:"moveTs,omitempty"`
+	// Set if the predicate is sharded by uid ranges. The tablet then serves the
+	// uids in [start_uid, end_uid). An end_uid of zero means the range is unbounded.
+	StartUid uint64 `protobuf:"varint,11,opt,name=start_uid,json=startUid,proto3" json:"startUid,omitempty"`
+	EndUid   uint64 `protobuf:"varint,12,opt,name=end_uid,json=endUid,proto3" json:"endUid,omitempty"`
+	// Queries and mutated edges per second on the tablet, as reported by the group leader.
+	ReadRate  float64 `protobuf:"fixed64,13,opt,name=read_rate,json=readRate,proto3" json:"readRate,omitempty"`
+	WriteRate float64 `protobuf:"fixed64,14,opt,name=write_rate,json=writeRate,proto3" json:"writeRate,omitempty"`
+	// Set by the group leader if the predicate is in a composite index. The tablets with the
+	// same composite key must be served by the same group.
+	Composite            string   `protobuf:"bytes,15,opt,name=composite,proto3" json:"composite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Proposal) Reset()         { *m = Proposal{} }
-func (m *Proposal) String() string { return proto.CompactTextString(m) }
-func (*Proposal) ProtoMessage()    {}
-func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{24}
+func (m *Tablet) Reset()         { *m = Tablet{} }
+func (m *Tablet) String() string { return proto.CompactTextString(m) }
+func (*Tablet) ProtoMessage()    {}
+func (*Tablet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{25}
 }
-func (m *Proposal) XXX_Unmarshal(b []byte) error {
+func (m *Tablet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Tablet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Tablet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2275,109 +2388,131 @@ func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proposal.Merge(m, src)
+func (m *Tablet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tablet.Merge(m, src)
 }
-func (m *Proposal) XXX_Size() int {
+func (m *Tablet) XXX_Size() int {
 	return m.Size()
 }
-func (m *Proposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Proposal.DiscardUnknown(m)
+func (m *Tablet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tablet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Proposal proto.InternalMessageInfo
+var xxx_messageInfo_Tablet proto.InternalMessageInfo
 
-func (m *Proposal) GetMutations() *Mutations {
+func (m *Tablet) GetGroupId() uint32 {
 	if m != nil {
-		return m.Mutations
+		return m.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (m *Proposal) GetKv() []*pb.KV {
+func (m *Tablet) GetPredicate() string {
 	if m != nil {
-		return m.Kv
+		return m.Predicate
 	}
-	return nil
+	return ""
 }
 
-func (m *Proposal) GetState() *MembershipState {
+func (m *Tablet) GetForce() bool {
 	if m != nil {
-		return m.State
+		return m.Force
 	}
-	return nil
+	return false
 }
 
-func (m *Proposal) GetCleanPredicate() string {
+func (m *Tablet) GetSpace() int64 {
 	if m != nil {
-		return m.CleanPredicate
+		return m.Space
 	}
-	return ""
+	return 0
 }
 
-func (m *Proposal) GetKey() string {
+func (m *Tablet) GetRemove() bool {
 	if m != nil {
-		return m.Key
+		return m.Remove
 	}
-	return ""
+	return false
 }
 
-func (m *Proposal) GetDelta() *OracleDelta {
+func (m *Tablet) GetReadOnly() bool {
 	if m != nil {
-		return m.Delta
+		return m.ReadOnly
 	}
-	return nil
+	return false
 }
 
-func (m *Proposal) GetSnapshot() *Snapshot {
+func (m *Tablet) GetMoveTs() uint64 {
 	if m != nil {
-		return m.Snapshot
+		return m.MoveTs
 	}
-	return nil
+	return 0
 }
 
-func (m *Proposal) GetIndex() uint64 {
+func (m *Tablet) GetStartUid() uint64 {
 	if m != nil {
-		return m.Index
+		return m.StartUid
 	}
 	return 0
 }
 
-func (m *Proposal) GetExpectedChecksum() uint64 {
+func (m *Tablet) GetEndUid() uint64 {
 	if m != nil {
-		return m.ExpectedChecksum
+		return m.EndUid
 	}
 	return 0
 }
 
-func (m *Proposal) GetRestore() *RestoreRequest {
+func (m *Tablet) GetReadRate() float64 {
 	if m != nil {
-		return m.Restore
+		return m.ReadRate
 	}
-	return nil
+	return 0
 }
 
-type KVS struct {
-	Kv []*pb.KV `protobuf:"bytes,1,rep,name=kv,proto3" json:"kv,omitempty"`
-	// done used to indicate if the stream of KVS is over.
-	Done                 bool     `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+func (m *Tablet) GetWriteRate() float64 {
+	if m != nil {
+		return m.WriteRate
+	}
+	return 0
+}
+
+func (m *Tablet) GetComposite() string {
+	if m != nil {
+		return m.Composite
+	}
+	return ""
+}
+
+type DirectedEdge struct {
+	Entity    uint64          `protobuf:"fixed64,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Attr      string          `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
+	Value     []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValueType Posting_ValType `protobuf:"varint,4,opt,name=value_type,json=valueType,proto3,enum=pb.Posting_ValType" json:"value_type,omitempty"`
+	ValueId   uint64          `protobuf:"fixed64,5,opt,name=value_id,json=valueId,proto3" json:"value_id,omitempty"`
+	Label     string          `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	Lang      string          `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`
+	Op        DirectedEdge_Op `protobuf:"varint,8,opt,name=op,proto3,enum=pb.DirectedEdge_Op" json:"op,omitempty"`
+	Facets    []*api.Facet    `protobuf:"bytes,9,rep,name=facets,proto3" json:"facets,omitempty"`
+	// Unix time in seconds after which the edge expires. Zero means it never expires.
+	ExpiresAt            uint64   `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *KVS) Reset()         { *m = KVS{} }
-func (m *KVS) String() string { return proto.CompactTextString(m) }
-func (*KVS) ProtoMessage()    {}
-func (*KVS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{25}
+func (m *DirectedEdge) Reset()         { *m = DirectedEdge{} }
+func (m *DirectedEdge) String() string { return proto.CompactTextString(m) }
+func (*DirectedEdge) ProtoMessage()    {}
+func (*DirectedEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{26}
 }
-func (m *KVS) XXX_Unmarshal(b []byte) error {
+func (m *DirectedEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DirectedEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KVS.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DirectedEdge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2387,183 +2522,117 @@ func (m *KVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *KVS) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KVS.Merge(m, src)
+func (m *DirectedEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectedEdge.Merge(m, src)
 }
-func (m *KVS) XXX_Size() int {
+func (m *DirectedEdge) XXX_Size() int {
 	return m.Size()
 }
-func (m *KVS) XXX_DiscardUnknown() {
-	xxx_messageInfo_KVS.DiscardUnknown(m)
+func (m *DirectedEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectedEdge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KVS proto.InternalMessageInfo
+var xxx_messageInfo_DirectedEdge proto.InternalMessageInfo
 
-func (m *KVS) GetKv() []*pb.KV {
+func (m *DirectedEdge) GetEntity() uint64 {
 	if m != nil {
-		return m.Kv
+		return m.Entity
 	}
-	return nil
+	return 0
 }
 
-func (m *KVS) GetDone() bool {
+func (m *DirectedEdge) GetAttr() string {
 	if m != nil {
-		return m.Done
+		return m.Attr
 	}
-	return false
+	return ""
 }
 
-// Posting messages.
-type Posting struct {
-	Uid         uint64              `protobuf:"fixed64,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Value       []byte              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ValType     Posting_ValType     `protobuf:"varint,3,opt,name=val_type,json=valType,proto3,enum=pb.Posting_ValType" json:"val_type,omitempty"`
-	PostingType Posting_PostingType `protobuf:"varint,4,opt,name=posting_type,json=postingType,proto3,enum=pb.Posting_PostingType" json:"posting_type,omitempty"`
-	LangTag     []byte              `protobuf:"bytes,5,opt,name=lang_tag,json=langTag,proto3" json:"lang_tag,omitempty"`
-	Label       string              `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
-	Facets      []*api.Facet        `protobuf:"bytes,9,rep,name=facets,proto3" json:"facets,omitempty"`
-	// TODO: op is only used temporarily. See if we can remove it from here.
-	Op                   uint32   `protobuf:"varint,12,opt,name=op,proto3" json:"op,omitempty"`
-	StartTs              uint64   `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	CommitTs             uint64   `protobuf:"varint,14,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DirectedEdge) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *Posting) Reset()         { *m = Posting{} }
-func (m *Posting) String() string { return proto.CompactTextString(m) }
-func (*Posting) ProtoMessage()    {}
-func (*Posting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{26}
-}
-func (m *Posting) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Posting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Posting.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Posting) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Posting.Merge(m, src)
-}
-func (m *Posting) XXX_Size() int {
-	return m.Size()
-}
-func (m *Posting) XXX_DiscardUnknown() {
-	xxx_messageInfo_Posting.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Posting proto.InternalMessageInfo
-
-func (m *Posting) GetUid() uint64 {
-	if m != nil {
-		return m.Uid
-	}
-	return 0
-}
-
-func (m *Posting) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
-}
-
-func (m *Posting) GetValType() Posting_ValType {
+func (m *DirectedEdge) GetValueType() Posting_ValType {
 	if m != nil {
-		return m.ValType
+		return m.ValueType
 	}
 	return Posting_DEFAULT
 }
 
-func (m *Posting) GetPostingType() Posting_PostingType {
-	if m != nil {
-		return m.PostingType
-	}
-	return Posting_REF
-}
-
-func (m *Posting) GetLangTag() []byte {
+func (m *DirectedEdge) GetValueId() uint64 {
 	if m != nil {
-		return m.LangTag
+		return m.ValueId
 	}
-	return nil
+	return 0
 }
 
-func (m *Posting) GetLabel() string {
+func (m *DirectedEdge) GetLabel() string {
 	if m != nil {
 		return m.Label
 	}
 	return ""
 }
 
-func (m *Posting) GetFacets() []*api.Facet {
+func (m *DirectedEdge) GetLang() string {
 	if m != nil {
-		return m.Facets
+		return m.Lang
 	}
-	return nil
+	return ""
 }
 
-func (m *Posting) GetOp() uint32 {
+func (m *DirectedEdge) GetOp() DirectedEdge_Op {
 	if m != nil {
 		return m.Op
 	}
-	return 0
+	return DirectedEdge_SET
 }
 
-func (m *Posting) GetStartTs() uint64 {
+func (m *DirectedEdge) GetFacets() []*api.Facet {
 	if m != nil {
-		return m.StartTs
+		return m.Facets
 	}
-	return 0
+	return nil
 }
 
-func (m *Posting) GetCommitTs() uint64 {
+func (m *DirectedEdge) GetExpiresAt() uint64 {
 	if m != nil {
-		return m.CommitTs
+		return m.ExpiresAt
 	}
 	return 0
 }
 
-type UidBlock struct {
-	Base uint64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
-	// deltas contains the deltas encoded with Varints. We don't store deltas as a list of integers,
-	// because when the PB is brought to memory, Go would always use 8-bytes per integer. Instead,
-	// storing it as a byte slice is a lot cheaper in memory.
-	Deltas []byte `protobuf:"bytes,2,opt,name=deltas,proto3" json:"deltas,omitempty"`
-	// num_uids is the number of UIDs in the block. We are including this because we want to
-	// switch encoding to groupvarint encoding. Current avaialble open source version implements
-	// encoding and decoding for uint32. To use that, we create different blocks for different 32-bit
-	// MSB base uids. That is, if the 32 MSBs are different, we will create a new block irrespective
-	// of whether the block is filled with the block_size or not.
-	// Default Blocksize is 256 so uint32 would be sufficient.
-	NumUids              uint32   `protobuf:"varint,3,opt,name=num_uids,json=numUids,proto3" json:"num_uids,omitempty"`
+type Mutations struct {
+	GroupId   uint32           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StartTs   uint64           `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	Edges     []*DirectedEdge  `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Schema    []*SchemaUpdate  `protobuf:"bytes,4,rep,name=schema,proto3" json:"schema,omitempty"`
+	Types     []*TypeUpdate    `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	DropOp    Mutations_DropOp `protobuf:"varint,7,opt,name=drop_op,json=dropOp,proto3,enum=pb.Mutations_DropOp" json:"drop_op,omitempty"`
+	DropValue string           `protobuf:"bytes,8,opt,name=drop_value,json=dropValue,proto3" json:"drop_value,omitempty"`
+	Metadata  *Metadata        `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reindex rebuilds the indexes of the predicates in schema over the current ones,
+	// which stay in use until done, instead of dropping them first.
+	Reindex              bool     `protobuf:"varint,10,opt,name=reindex,proto3" json:"reindex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UidBlock) Reset()         { *m = UidBlock{} }
-func (m *UidBlock) String() string { return proto.CompactTextString(m) }
-func (*UidBlock) ProtoMessage()    {}
-func (*UidBlock) Descriptor() ([]byte, []int) {
+func (m *Mutations) Reset()         { *m = Mutations{} }
+func (m *Mutations) String() string { return proto.CompactTextString(m) }
+func (*Mutations) ProtoMessage()    {}
+func (*Mutations) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{27}
 }
-func (m *UidBlock) XXX_Unmarshal(b []byte) error {
+func (m *Mutations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UidBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Mutations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UidBlock.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Mutations.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2573,59 +2642,101 @@ func (m *UidBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UidBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UidBlock.Merge(m, src)
+func (m *Mutations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mutations.Merge(m, src)
 }
-func (m *UidBlock) XXX_Size() int {
+func (m *Mutations) XXX_Size() int {
 	return m.Size()
 }
-func (m *UidBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_UidBlock.DiscardUnknown(m)
+func (m *Mutations) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mutations.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UidBlock proto.InternalMessageInfo
+var xxx_messageInfo_Mutations proto.InternalMessageInfo
 
-func (m *UidBlock) GetBase() uint64 {
+func (m *Mutations) GetGroupId() uint32 {
 	if m != nil {
-		return m.Base
+		return m.GroupId
 	}
 	return 0
 }
 
-func (m *UidBlock) GetDeltas() []byte {
+func (m *Mutations) GetStartTs() uint64 {
 	if m != nil {
-		return m.Deltas
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *Mutations) GetEdges() []*DirectedEdge {
+	if m != nil {
+		return m.Edges
 	}
 	return nil
 }
 
-func (m *UidBlock) GetNumUids() uint32 {
+func (m *Mutations) GetSchema() []*SchemaUpdate {
 	if m != nil {
-		return m.NumUids
+		return m.Schema
 	}
-	return 0
+	return nil
 }
 
-type UidPack struct {
-	BlockSize            uint32      `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
-	Blocks               []*UidBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *Mutations) GetTypes() []*TypeUpdate {
+	if m != nil {
+		return m.Types
+	}
+	return nil
 }
 
-func (m *UidPack) Reset()         { *m = UidPack{} }
-func (m *UidPack) String() string { return proto.CompactTextString(m) }
-func (*UidPack) ProtoMessage()    {}
-func (*UidPack) Descriptor() ([]byte, []int) {
+func (m *Mutations) GetDropOp() Mutations_DropOp {
+	if m != nil {
+		return m.DropOp
+	}
+	return Mutations_NONE
+}
+
+func (m *Mutations) GetDropValue() string {
+	if m != nil {
+		return m.DropValue
+	}
+	return ""
+}
+
+func (m *Mutations) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Mutations) GetReindex() bool {
+	if m != nil {
+		return m.Reindex
+	}
+	return false
+}
+
+type Metadata struct {
+	// Map of predicates to their hints.
+	PredHints            map[string]Metadata_HintType `protobuf:"bytes,1,rep,name=pred_hints,json=predHints,proto3" json:"pred_hints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Metadata_HintType"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{28}
 }
-func (m *UidPack) XXX_Unmarshal(b []byte) error {
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UidPack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UidPack.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2635,54 +2746,50 @@ func (m *UidPack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UidPack) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UidPack.Merge(m, src)
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
 }
-func (m *UidPack) XXX_Size() int {
+func (m *Metadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *UidPack) XXX_DiscardUnknown() {
-	xxx_messageInfo_UidPack.DiscardUnknown(m)
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UidPack proto.InternalMessageInfo
-
-func (m *UidPack) GetBlockSize() uint32 {
-	if m != nil {
-		return m.BlockSize
-	}
-	return 0
-}
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
 
-func (m *UidPack) GetBlocks() []*UidBlock {
+func (m *Metadata) GetPredHints() map[string]Metadata_HintType {
 	if m != nil {
-		return m.Blocks
+		return m.PredHints
 	}
 	return nil
 }
 
-type PostingList struct {
-	Pack                 *UidPack   `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
-	Postings             []*Posting `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"`
-	CommitTs             uint64     `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	Splits               []uint64   `protobuf:"varint,4,rep,packed,name=splits,proto3" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type Snapshot struct {
+	Context *RaftContext `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Index   uint64       `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	ReadTs  uint64       `protobuf:"varint,3,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	// done is used to indicate that snapshot stream was a success.
+	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	// since_ts stores the ts of the last snapshot to support diff snap updates.
+	SinceTs              uint64   `protobuf:"varint,5,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostingList) Reset()         { *m = PostingList{} }
-func (m *PostingList) String() string { return proto.CompactTextString(m) }
-func (*PostingList) ProtoMessage()    {}
-func (*PostingList) Descriptor() ([]byte, []int) {
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{29}
 }
-func (m *PostingList) XXX_Unmarshal(b []byte) error {
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostingList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2692,66 +2799,85 @@ func (m *PostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PostingList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostingList.Merge(m, src)
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
 }
-func (m *PostingList) XXX_Size() int {
+func (m *Snapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostingList) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostingList.DiscardUnknown(m)
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostingList proto.InternalMessageInfo
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 
-func (m *PostingList) GetPack() *UidPack {
+func (m *Snapshot) GetContext() *RaftContext {
 	if m != nil {
-		return m.Pack
+		return m.Context
 	}
 	return nil
 }
 
-func (m *PostingList) GetPostings() []*Posting {
+func (m *Snapshot) GetIndex() uint64 {
 	if m != nil {
-		return m.Postings
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-func (m *PostingList) GetCommitTs() uint64 {
+func (m *Snapshot) GetReadTs() uint64 {
 	if m != nil {
-		return m.CommitTs
+		return m.ReadTs
 	}
 	return 0
 }
 
-func (m *PostingList) GetSplits() []uint64 {
+func (m *Snapshot) GetDone() bool {
 	if m != nil {
-		return m.Splits
+		return m.Done
 	}
-	return nil
+	return false
 }
 
-type FacetParam struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Alias                string   `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Snapshot) GetSinceTs() uint64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
 }
 
-func (m *FacetParam) Reset()         { *m = FacetParam{} }
-func (m *FacetParam) String() string { return proto.CompactTextString(m) }
-func (*FacetParam) ProtoMessage()    {}
-func (*FacetParam) Descriptor() ([]byte, []int) {
+type RestoreRequest struct {
+	GroupId      uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RestoreTs    uint64 `protobuf:"varint,2,opt,name=restore_ts,json=restoreTs,proto3" json:"restore_ts,omitempty"`
+	Location     string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	BackupId     string `protobuf:"bytes,4,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	AccessKey    string `protobuf:"bytes,5,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey    string `protobuf:"bytes,6,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	SessionToken string `protobuf:"bytes,7,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Anonymous    bool   `protobuf:"varint,8,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	KeyFile      string `protobuf:"bytes,9,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// If set, the series is only restored up to the backup with this number.
+	BackupNum uint64 `protobuf:"varint,10,opt,name=backup_num,json=backupNum,proto3" json:"backup_num,omitempty"`
+	// If set, only the selected predicates and types are restored, into the running
+	// cluster. The rest of the data is left as it is.
+	Filter               *PredicateFilter `protobuf:"bytes,11,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()    {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{30}
 }
-func (m *FacetParam) XXX_Unmarshal(b []byte) error {
+func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FacetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FacetParam.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2761,106 +2887,125 @@ func (m *FacetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *FacetParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FacetParam.Merge(m, src)
+func (m *RestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRequest.Merge(m, src)
 }
-func (m *FacetParam) XXX_Size() int {
+func (m *RestoreRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FacetParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_FacetParam.DiscardUnknown(m)
+func (m *RestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FacetParam proto.InternalMessageInfo
+var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
 
-func (m *FacetParam) GetKey() string {
+func (m *RestoreRequest) GetGroupId() uint32 {
 	if m != nil {
-		return m.Key
+		return m.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (m *FacetParam) GetAlias() string {
+func (m *RestoreRequest) GetRestoreTs() uint64 {
 	if m != nil {
-		return m.Alias
+		return m.RestoreTs
 	}
-	return ""
+	return 0
 }
 
-type FacetParams struct {
-	AllKeys              bool          `protobuf:"varint,1,opt,name=all_keys,json=allKeys,proto3" json:"all_keys,omitempty"`
-	Param                []*FacetParam `protobuf:"bytes,2,rep,name=param,proto3" json:"param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *RestoreRequest) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
 }
 
-func (m *FacetParams) Reset()         { *m = FacetParams{} }
-func (m *FacetParams) String() string { return proto.CompactTextString(m) }
-func (*FacetParams) ProtoMessage()    {}
-func (*FacetParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{31}
-}
-func (m *FacetParams) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *RestoreRequest) GetBackupId() string {
+	if m != nil {
+		return m.BackupId
+	}
+	return ""
 }
-func (m *FacetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_FacetParams.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *RestoreRequest) GetAccessKey() string {
+	if m != nil {
+		return m.AccessKey
 	}
+	return ""
 }
-func (m *FacetParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FacetParams.Merge(m, src)
+
+func (m *RestoreRequest) GetSecretKey() string {
+	if m != nil {
+		return m.SecretKey
+	}
+	return ""
 }
-func (m *FacetParams) XXX_Size() int {
-	return m.Size()
+
+func (m *RestoreRequest) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
 }
-func (m *FacetParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_FacetParams.DiscardUnknown(m)
+
+func (m *RestoreRequest) GetAnonymous() bool {
+	if m != nil {
+		return m.Anonymous
+	}
+	return false
 }
 
-var xxx_messageInfo_FacetParams proto.InternalMessageInfo
+func (m *RestoreRequest) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
 
-func (m *FacetParams) GetAllKeys() bool {
+func (m *RestoreRequest) GetBackupNum() uint64 {
 	if m != nil {
-		return m.AllKeys
+		return m.BackupNum
 	}
-	return false
+	return 0
 }
 
-func (m *FacetParams) GetParam() []*FacetParam {
+func (m *RestoreRequest) GetFilter() *PredicateFilter {
 	if m != nil {
-		return m.Param
+		return m.Filter
 	}
 	return nil
 }
 
-type Facets struct {
-	Facets               []*api.Facet `protobuf:"bytes,1,rep,name=facets,proto3" json:"facets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type Proposal struct {
+	Mutations        *Mutations       `protobuf:"bytes,2,opt,name=mutations,proto3" json:"mutations,omitempty"`
+	Kv               []*pb.KV         `protobuf:"bytes,4,rep,name=kv,proto3" json:"kv,omitempty"`
+	State            *MembershipState `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	CleanPredicate   string           `protobuf:"bytes,6,opt,name=clean_predicate,json=cleanPredicate,proto3" json:"clean_predicate,omitempty"`
+	Key              string           `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
+	Delta            *OracleDelta     `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
+	Snapshot         *Snapshot        `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Index            uint64           `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
+	ExpectedChecksum uint64           `protobuf:"varint,11,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+	Restore          *RestoreRequest  `protobuf:"bytes,12,opt,name=restore,proto3" json:"restore,omitempty"`
+	// Delete the uid range of a predicate which was moved to other group.
+	CleanShard           *Tablet  `protobuf:"bytes,13,opt,name=clean_shard,json=cleanShard,proto3" json:"clean_shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Facets) Reset()         { *m = Facets{} }
-func (m *Facets) String() string { return proto.CompactTextString(m) }
-func (*Facets) ProtoMessage()    {}
-func (*Facets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{32}
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{31}
 }
-func (m *Facets) XXX_Unmarshal(b []byte) error {
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Facets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Facets.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2870,223 +3015,124 @@ func (m *Facets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Facets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Facets.Merge(m, src)
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
 }
-func (m *Facets) XXX_Size() int {
+func (m *Proposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *Facets) XXX_DiscardUnknown() {
-	xxx_messageInfo_Facets.DiscardUnknown(m)
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Facets proto.InternalMessageInfo
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
-func (m *Facets) GetFacets() []*api.Facet {
+func (m *Proposal) GetMutations() *Mutations {
 	if m != nil {
-		return m.Facets
+		return m.Mutations
 	}
 	return nil
 }
 
-type FacetsList struct {
-	FacetsList           []*Facets `protobuf:"bytes,1,rep,name=facets_list,json=facetsList,proto3" json:"facets_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *Proposal) GetKv() []*pb.KV {
+	if m != nil {
+		return m.Kv
+	}
+	return nil
 }
 
-func (m *FacetsList) Reset()         { *m = FacetsList{} }
-func (m *FacetsList) String() string { return proto.CompactTextString(m) }
-func (*FacetsList) ProtoMessage()    {}
-func (*FacetsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{33}
-}
-func (m *FacetsList) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *FacetsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_FacetsList.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Proposal) GetState() *MembershipState {
+	if m != nil {
+		return m.State
 	}
-}
-func (m *FacetsList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FacetsList.Merge(m, src)
-}
-func (m *FacetsList) XXX_Size() int {
-	return m.Size()
-}
-func (m *FacetsList) XXX_DiscardUnknown() {
-	xxx_messageInfo_FacetsList.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_FacetsList proto.InternalMessageInfo
-
-func (m *FacetsList) GetFacetsList() []*Facets {
+func (m *Proposal) GetCleanPredicate() string {
 	if m != nil {
-		return m.FacetsList
+		return m.CleanPredicate
 	}
-	return nil
+	return ""
 }
 
-type Function struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Args                 []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Function) Reset()         { *m = Function{} }
-func (m *Function) String() string { return proto.CompactTextString(m) }
-func (*Function) ProtoMessage()    {}
-func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{34}
-}
-func (m *Function) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Function) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Function.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Function) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Function.Merge(m, src)
-}
-func (m *Function) XXX_Size() int {
-	return m.Size()
-}
-func (m *Function) XXX_DiscardUnknown() {
-	xxx_messageInfo_Function.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Function proto.InternalMessageInfo
-
-func (m *Function) GetName() string {
+func (m *Proposal) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-func (m *Function) GetKey() string {
+func (m *Proposal) GetDelta() *OracleDelta {
 	if m != nil {
-		return m.Key
+		return m.Delta
 	}
-	return ""
+	return nil
 }
 
-func (m *Function) GetArgs() []string {
+func (m *Proposal) GetSnapshot() *Snapshot {
 	if m != nil {
-		return m.Args
+		return m.Snapshot
 	}
 	return nil
 }
 
-// Op and Children are internal nodes and Func on leaves.
-type FilterTree struct {
-	Op                   string        `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
-	Children             []*FilterTree `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
-	Func                 *Function     `protobuf:"bytes,3,opt,name=func,proto3" json:"func,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *FilterTree) Reset()         { *m = FilterTree{} }
-func (m *FilterTree) String() string { return proto.CompactTextString(m) }
-func (*FilterTree) ProtoMessage()    {}
-func (*FilterTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{35}
-}
-func (m *FilterTree) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *FilterTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_FilterTree.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Proposal) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
+	return 0
 }
-func (m *FilterTree) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FilterTree.Merge(m, src)
-}
-func (m *FilterTree) XXX_Size() int {
-	return m.Size()
-}
-func (m *FilterTree) XXX_DiscardUnknown() {
-	xxx_messageInfo_FilterTree.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_FilterTree proto.InternalMessageInfo
 
-func (m *FilterTree) GetOp() string {
+func (m *Proposal) GetExpectedChecksum() uint64 {
 	if m != nil {
-		return m.Op
+		return m.ExpectedChecksum
 	}
-	return ""
+	return 0
 }
 
-func (m *FilterTree) GetChildren() []*FilterTree {
+func (m *Proposal) GetRestore() *RestoreRequest {
 	if m != nil {
-		return m.Children
+		return m.Restore
 	}
 	return nil
 }
 
-func (m *FilterTree) GetFunc() *Function {
+func (m *Proposal) GetCleanShard() *Tablet {
 	if m != nil {
-		return m.Func
+		return m.CleanShard
 	}
 	return nil
 }
 
-// Schema messages.
-type SchemaRequest struct {
-	GroupId    uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Predicates []string `protobuf:"bytes,2,rep,name=predicates,proto3" json:"predicates,omitempty"`
-	// fields can be on of type, index, reverse or tokenizer
-	Fields               []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
-	Types                []string `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+type KVS struct {
+	Kv []*pb.KV `protobuf:"bytes,1,rep,name=kv,proto3" json:"kv,omitempty"`
+	// done used to indicate if the stream of KVS is over.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// since_ts is set if only the keys changed after it are streamed, on top of an earlier copy.
+	SinceTs uint64 `protobuf:"varint,3,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	// merge is set if the keys are merged with the data held for the predicate, when a uid
+	// range of it is moved.
+	Merge bool `protobuf:"varint,4,opt,name=merge,proto3" json:"merge,omitempty"`
+	// ingest is set if the keys are loaded by an incremental bulk load. They are merged with
+	// the data held for the predicate, and its indexes are rebuilt afterwards.
+	Ingest               bool     `protobuf:"varint,5,opt,name=ingest,proto3" json:"ingest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SchemaRequest) Reset()         { *m = SchemaRequest{} }
-func (m *SchemaRequest) String() string { return proto.CompactTextString(m) }
-func (*SchemaRequest) ProtoMessage()    {}
-func (*SchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{36}
+func (m *KVS) Reset()         { *m = KVS{} }
+func (m *KVS) String() string { return proto.CompactTextString(m) }
+func (*KVS) ProtoMessage()    {}
+func (*KVS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{32}
 }
-func (m *SchemaRequest) XXX_Unmarshal(b []byte) error {
+func (m *KVS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchemaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KVS.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3096,74 +3142,85 @@ func (m *SchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SchemaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchemaRequest.Merge(m, src)
+func (m *KVS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVS.Merge(m, src)
 }
-func (m *SchemaRequest) XXX_Size() int {
+func (m *KVS) XXX_Size() int {
 	return m.Size()
 }
-func (m *SchemaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchemaRequest.DiscardUnknown(m)
+func (m *KVS) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVS.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchemaRequest proto.InternalMessageInfo
+var xxx_messageInfo_KVS proto.InternalMessageInfo
 
-func (m *SchemaRequest) GetGroupId() uint32 {
+func (m *KVS) GetKv() []*pb.KV {
 	if m != nil {
-		return m.GroupId
+		return m.Kv
 	}
-	return 0
+	return nil
 }
 
-func (m *SchemaRequest) GetPredicates() []string {
+func (m *KVS) GetDone() bool {
 	if m != nil {
-		return m.Predicates
+		return m.Done
 	}
-	return nil
+	return false
 }
 
-func (m *SchemaRequest) GetFields() []string {
+func (m *KVS) GetSinceTs() uint64 {
 	if m != nil {
-		return m.Fields
+		return m.SinceTs
 	}
-	return nil
+	return 0
 }
 
-func (m *SchemaRequest) GetTypes() []string {
+func (m *KVS) GetMerge() bool {
 	if m != nil {
-		return m.Types
+		return m.Merge
 	}
-	return nil
+	return false
 }
 
-type SchemaNode struct {
-	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Index                bool     `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Tokenizer            []string `protobuf:"bytes,4,rep,name=tokenizer,proto3" json:"tokenizer,omitempty"`
-	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Count                bool     `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
-	List                 bool     `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
-	Upsert               bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
-	Lang                 bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
-	NoConflict           bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+func (m *KVS) GetIngest() bool {
+	if m != nil {
+		return m.Ingest
+	}
+	return false
+}
+
+// Posting messages.
+type Posting struct {
+	Uid         uint64              `protobuf:"fixed64,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Value       []byte              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ValType     Posting_ValType     `protobuf:"varint,3,opt,name=val_type,json=valType,proto3,enum=pb.Posting_ValType" json:"val_type,omitempty"`
+	PostingType Posting_PostingType `protobuf:"varint,4,opt,name=posting_type,json=postingType,proto3,enum=pb.Posting_PostingType" json:"posting_type,omitempty"`
+	LangTag     []byte              `protobuf:"bytes,5,opt,name=lang_tag,json=langTag,proto3" json:"lang_tag,omitempty"`
+	Label       string              `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	Facets      []*api.Facet        `protobuf:"bytes,9,rep,name=facets,proto3" json:"facets,omitempty"`
+	// TODO: op is only used temporarily. See if we can remove it from here.
+	Op       uint32 `protobuf:"varint,12,opt,name=op,proto3" json:"op,omitempty"`
+	StartTs  uint64 `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs uint64 `protobuf:"varint,14,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	// Unix time in seconds after which the posting expires. Zero means it never expires.
+	ExpiresAt            uint64   `protobuf:"varint,15,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SchemaNode) Reset()         { *m = SchemaNode{} }
-func (m *SchemaNode) String() string { return proto.CompactTextString(m) }
-func (*SchemaNode) ProtoMessage()    {}
-func (*SchemaNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{37}
+func (m *Posting) Reset()         { *m = Posting{} }
+func (m *Posting) String() string { return proto.CompactTextString(m) }
+func (*Posting) ProtoMessage()    {}
+func (*Posting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{33}
 }
-func (m *SchemaNode) XXX_Unmarshal(b []byte) error {
+func (m *Posting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SchemaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Posting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchemaNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Posting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3173,107 +3230,125 @@ func (m *SchemaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SchemaNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchemaNode.Merge(m, src)
+func (m *Posting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Posting.Merge(m, src)
 }
-func (m *SchemaNode) XXX_Size() int {
+func (m *Posting) XXX_Size() int {
 	return m.Size()
 }
-func (m *SchemaNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchemaNode.DiscardUnknown(m)
+func (m *Posting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Posting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchemaNode proto.InternalMessageInfo
+var xxx_messageInfo_Posting proto.InternalMessageInfo
 
-func (m *SchemaNode) GetPredicate() string {
+func (m *Posting) GetUid() uint64 {
 	if m != nil {
-		return m.Predicate
+		return m.Uid
 	}
-	return ""
+	return 0
 }
 
-func (m *SchemaNode) GetType() string {
+func (m *Posting) GetValue() []byte {
 	if m != nil {
-		return m.Type
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (m *SchemaNode) GetIndex() bool {
+func (m *Posting) GetValType() Posting_ValType {
 	if m != nil {
-		return m.Index
+		return m.ValType
 	}
-	return false
+	return Posting_DEFAULT
 }
 
-func (m *SchemaNode) GetTokenizer() []string {
+func (m *Posting) GetPostingType() Posting_PostingType {
 	if m != nil {
-		return m.Tokenizer
+		return m.PostingType
 	}
-	return nil
+	return Posting_REF
 }
 
-func (m *SchemaNode) GetReverse() bool {
+func (m *Posting) GetLangTag() []byte {
 	if m != nil {
-		return m.Reverse
+		return m.LangTag
 	}
-	return false
+	return nil
 }
 
-func (m *SchemaNode) GetCount() bool {
+func (m *Posting) GetLabel() string {
 	if m != nil {
-		return m.Count
+		return m.Label
 	}
-	return false
+	return ""
 }
 
-func (m *SchemaNode) GetList() bool {
+func (m *Posting) GetFacets() []*api.Facet {
 	if m != nil {
-		return m.List
+		return m.Facets
 	}
-	return false
+	return nil
 }
 
-func (m *SchemaNode) GetUpsert() bool {
+func (m *Posting) GetOp() uint32 {
 	if m != nil {
-		return m.Upsert
+		return m.Op
 	}
-	return false
+	return 0
 }
 
-func (m *SchemaNode) GetLang() bool {
+func (m *Posting) GetStartTs() uint64 {
 	if m != nil {
-		return m.Lang
+		return m.StartTs
 	}
-	return false
+	return 0
 }
 
-func (m *SchemaNode) GetNoConflict() bool {
+func (m *Posting) GetCommitTs() uint64 {
 	if m != nil {
-		return m.NoConflict
+		return m.CommitTs
 	}
-	return false
+	return 0
 }
 
-type SchemaResult struct {
-	Schema               []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *Posting) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *SchemaResult) Reset()         { *m = SchemaResult{} }
-func (m *SchemaResult) String() string { return proto.CompactTextString(m) }
-func (*SchemaResult) ProtoMessage()    {}
-func (*SchemaResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{38}
+type UidBlock struct {
+	Base uint64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	// deltas contains the deltas encoded with Varints. We don't store deltas as a list of integers,
+	// because when the PB is brought to memory, Go would always use 8-bytes per integer. Instead,
+	// storing it as a byte slice is a lot cheaper in memory.
+	Deltas []byte `protobuf:"bytes,2,opt,name=deltas,proto3" json:"deltas,omitempty"`
+	// num_uids is the number of UIDs in the block. We are including this because we want to
+	// switch encoding to groupvarint encoding. Current avaialble open source version implements
+	// encoding and decoding for uint32. To use that, we create different blocks for different 32-bit
+	// MSB base uids. That is, if the 32 MSBs are different, we will create a new block irrespective
+	// of whether the block is filled with the block_size or not.
+	// Default Blocksize is 256 so uint32 would be sufficient.
+	NumUids              uint32   `protobuf:"varint,3,opt,name=num_uids,json=numUids,proto3" json:"num_uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *SchemaResult) XXX_Unmarshal(b []byte) error {
+
+func (m *UidBlock) Reset()         { *m = UidBlock{} }
+func (m *UidBlock) String() string { return proto.CompactTextString(m) }
+func (*UidBlock) ProtoMessage()    {}
+func (*UidBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{34}
+}
+func (m *UidBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SchemaResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UidBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchemaResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UidBlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3283,59 +3358,59 @@ func (m *SchemaResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SchemaResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchemaResult.Merge(m, src)
+func (m *UidBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UidBlock.Merge(m, src)
 }
-func (m *SchemaResult) XXX_Size() int {
+func (m *UidBlock) XXX_Size() int {
 	return m.Size()
 }
-func (m *SchemaResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchemaResult.DiscardUnknown(m)
+func (m *UidBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_UidBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchemaResult proto.InternalMessageInfo
+var xxx_messageInfo_UidBlock proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *SchemaResult) GetSchema() []*SchemaNode {
+func (m *UidBlock) GetBase() uint64 {
 	if m != nil {
-		return m.Schema
+		return m.Base
+	}
+	return 0
+}
+
+func (m *UidBlock) GetDeltas() []byte {
+	if m != nil {
+		return m.Deltas
 	}
 	return nil
 }
 
-type SchemaUpdate struct {
-	Predicate string                 `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	ValueType Posting_ValType        `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=pb.Posting_ValType" json:"value_type,omitempty"`
-	Directive SchemaUpdate_Directive `protobuf:"varint,3,opt,name=directive,proto3,enum=pb.SchemaUpdate_Directive" json:"directive,omitempty"`
-	Tokenizer []string               `protobuf:"bytes,4,rep,name=tokenizer,proto3" json:"tokenizer,omitempty"`
-	Count     bool                   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	List      bool                   `protobuf:"varint,6,opt,name=list,proto3" json:"list,omitempty"`
-	Upsert    bool                   `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
-	Lang      bool                   `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
-	// Fields required for type system.
-	NonNullable     bool `protobuf:"varint,10,opt,name=non_nullable,json=nonNullable,proto3" json:"non_nullable,omitempty"`
-	NonNullableList bool `protobuf:"varint,11,opt,name=non_nullable_list,json=nonNullableList,proto3" json:"non_nullable_list,omitempty"`
-	// If value_type is OBJECT, then this represents an object type with a
-	// custom name. This field stores said name.
-	ObjectTypeName       string   `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
-	NoConflict           bool     `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UidBlock) GetNumUids() uint32 {
+	if m != nil {
+		return m.NumUids
+	}
+	return 0
 }
 
-func (m *SchemaUpdate) Reset()         { *m = SchemaUpdate{} }
-func (m *SchemaUpdate) String() string { return proto.CompactTextString(m) }
-func (*SchemaUpdate) ProtoMessage()    {}
-func (*SchemaUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{39}
+type UidPack struct {
+	BlockSize            uint32      `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	Blocks               []*UidBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *SchemaUpdate) XXX_Unmarshal(b []byte) error {
+
+func (m *UidPack) Reset()         { *m = UidPack{} }
+func (m *UidPack) String() string { return proto.CompactTextString(m) }
+func (*UidPack) ProtoMessage()    {}
+func (*UidPack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{35}
+}
+func (m *UidPack) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SchemaUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UidPack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchemaUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UidPack.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3345,122 +3420,123 @@ func (m *SchemaUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SchemaUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchemaUpdate.Merge(m, src)
+func (m *UidPack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UidPack.Merge(m, src)
 }
-func (m *SchemaUpdate) XXX_Size() int {
+func (m *UidPack) XXX_Size() int {
 	return m.Size()
 }
-func (m *SchemaUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchemaUpdate.DiscardUnknown(m)
+func (m *UidPack) XXX_DiscardUnknown() {
+	xxx_messageInfo_UidPack.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchemaUpdate proto.InternalMessageInfo
+var xxx_messageInfo_UidPack proto.InternalMessageInfo
 
-func (m *SchemaUpdate) GetPredicate() string {
+func (m *UidPack) GetBlockSize() uint32 {
 	if m != nil {
-		return m.Predicate
+		return m.BlockSize
 	}
-	return ""
+	return 0
 }
 
-func (m *SchemaUpdate) GetValueType() Posting_ValType {
+func (m *UidPack) GetBlocks() []*UidBlock {
 	if m != nil {
-		return m.ValueType
+		return m.Blocks
 	}
-	return Posting_DEFAULT
+	return nil
 }
 
-func (m *SchemaUpdate) GetDirective() SchemaUpdate_Directive {
-	if m != nil {
-		return m.Directive
-	}
-	return SchemaUpdate_NONE
+type PostingList struct {
+	Pack                 *UidPack   `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	Postings             []*Posting `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"`
+	CommitTs             uint64     `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Splits               []uint64   `protobuf:"varint,4,rep,packed,name=splits,proto3" json:"splits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *SchemaUpdate) GetTokenizer() []string {
-	if m != nil {
-		return m.Tokenizer
-	}
-	return nil
+func (m *PostingList) Reset()         { *m = PostingList{} }
+func (m *PostingList) String() string { return proto.CompactTextString(m) }
+func (*PostingList) ProtoMessage()    {}
+func (*PostingList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{36}
 }
-
-func (m *SchemaUpdate) GetCount() bool {
-	if m != nil {
-		return m.Count
-	}
-	return false
+func (m *PostingList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SchemaUpdate) GetList() bool {
-	if m != nil {
-		return m.List
+func (m *PostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostingList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
 }
-
-func (m *SchemaUpdate) GetUpsert() bool {
-	if m != nil {
-		return m.Upsert
-	}
-	return false
+func (m *PostingList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostingList.Merge(m, src)
 }
-
-func (m *SchemaUpdate) GetLang() bool {
-	if m != nil {
-		return m.Lang
-	}
-	return false
+func (m *PostingList) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostingList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostingList.DiscardUnknown(m)
 }
 
-func (m *SchemaUpdate) GetNonNullable() bool {
+var xxx_messageInfo_PostingList proto.InternalMessageInfo
+
+func (m *PostingList) GetPack() *UidPack {
 	if m != nil {
-		return m.NonNullable
+		return m.Pack
 	}
-	return false
+	return nil
 }
 
-func (m *SchemaUpdate) GetNonNullableList() bool {
+func (m *PostingList) GetPostings() []*Posting {
 	if m != nil {
-		return m.NonNullableList
+		return m.Postings
 	}
-	return false
+	return nil
 }
 
-func (m *SchemaUpdate) GetObjectTypeName() string {
+func (m *PostingList) GetCommitTs() uint64 {
 	if m != nil {
-		return m.ObjectTypeName
+		return m.CommitTs
 	}
-	return ""
+	return 0
 }
 
-func (m *SchemaUpdate) GetNoConflict() bool {
+func (m *PostingList) GetSplits() []uint64 {
 	if m != nil {
-		return m.NoConflict
+		return m.Splits
 	}
-	return false
+	return nil
 }
 
-type TypeUpdate struct {
-	TypeName             string          `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	Fields               []*SchemaUpdate `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type FacetParam struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Alias                string   `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TypeUpdate) Reset()         { *m = TypeUpdate{} }
-func (m *TypeUpdate) String() string { return proto.CompactTextString(m) }
-func (*TypeUpdate) ProtoMessage()    {}
-func (*TypeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{40}
+func (m *FacetParam) Reset()         { *m = FacetParam{} }
+func (m *FacetParam) String() string { return proto.CompactTextString(m) }
+func (*FacetParam) ProtoMessage()    {}
+func (*FacetParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{37}
 }
-func (m *TypeUpdate) XXX_Unmarshal(b []byte) error {
+func (m *FacetParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TypeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FacetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TypeUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FacetParam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3470,51 +3546,52 @@ func (m *TypeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TypeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TypeUpdate.Merge(m, src)
+func (m *FacetParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetParam.Merge(m, src)
 }
-func (m *TypeUpdate) XXX_Size() int {
+func (m *FacetParam) XXX_Size() int {
 	return m.Size()
 }
-func (m *TypeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_TypeUpdate.DiscardUnknown(m)
+func (m *FacetParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetParam.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TypeUpdate proto.InternalMessageInfo
+var xxx_messageInfo_FacetParam proto.InternalMessageInfo
 
-func (m *TypeUpdate) GetTypeName() string {
+func (m *FacetParam) GetKey() string {
 	if m != nil {
-		return m.TypeName
+		return m.Key
 	}
 	return ""
 }
 
-func (m *TypeUpdate) GetFields() []*SchemaUpdate {
+func (m *FacetParam) GetAlias() string {
 	if m != nil {
-		return m.Fields
+		return m.Alias
 	}
-	return nil
+	return ""
 }
 
-type MapHeader struct {
-	PartitionKeys        [][]byte `protobuf:"bytes,1,rep,name=partition_keys,json=partitionKeys,proto3" json:"partition_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FacetParams struct {
+	AllKeys              bool          `protobuf:"varint,1,opt,name=all_keys,json=allKeys,proto3" json:"all_keys,omitempty"`
+	Param                []*FacetParam `protobuf:"bytes,2,rep,name=param,proto3" json:"param,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *MapHeader) Reset()         { *m = MapHeader{} }
-func (m *MapHeader) String() string { return proto.CompactTextString(m) }
-func (*MapHeader) ProtoMessage()    {}
-func (*MapHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{41}
+func (m *FacetParams) Reset()         { *m = FacetParams{} }
+func (m *FacetParams) String() string { return proto.CompactTextString(m) }
+func (*FacetParams) ProtoMessage()    {}
+func (*FacetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{38}
 }
-func (m *MapHeader) XXX_Unmarshal(b []byte) error {
+func (m *FacetParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FacetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FacetParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3524,48 +3601,51 @@ func (m *MapHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapHeader.Merge(m, src)
+func (m *FacetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetParams.Merge(m, src)
 }
-func (m *MapHeader) XXX_Size() int {
+func (m *FacetParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapHeader.DiscardUnknown(m)
+func (m *FacetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapHeader proto.InternalMessageInfo
+var xxx_messageInfo_FacetParams proto.InternalMessageInfo
 
-func (m *MapHeader) GetPartitionKeys() [][]byte {
+func (m *FacetParams) GetAllKeys() bool {
 	if m != nil {
-		return m.PartitionKeys
+		return m.AllKeys
+	}
+	return false
+}
+
+func (m *FacetParams) GetParam() []*FacetParam {
+	if m != nil {
+		return m.Param
 	}
 	return nil
 }
 
-// Bulk loader proto.
-type MapEntry struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Only one should be set.
-	Uid                  uint64   `protobuf:"fixed64,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Posting              *Posting `protobuf:"bytes,3,opt,name=posting,proto3" json:"posting,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Facets struct {
+	Facets               []*api.Facet `protobuf:"bytes,1,rep,name=facets,proto3" json:"facets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *MapEntry) Reset()         { *m = MapEntry{} }
-func (m *MapEntry) String() string { return proto.CompactTextString(m) }
-func (*MapEntry) ProtoMessage()    {}
-func (*MapEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{42}
+func (m *Facets) Reset()         { *m = Facets{} }
+func (m *Facets) String() string { return proto.CompactTextString(m) }
+func (*Facets) ProtoMessage()    {}
+func (*Facets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{39}
 }
-func (m *MapEntry) XXX_Unmarshal(b []byte) error {
+func (m *Facets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Facets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Facets.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3575,62 +3655,93 @@ func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapEntry.Merge(m, src)
+func (m *Facets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Facets.Merge(m, src)
 }
-func (m *MapEntry) XXX_Size() int {
+func (m *Facets) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapEntry.DiscardUnknown(m)
+func (m *Facets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Facets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapEntry proto.InternalMessageInfo
+var xxx_messageInfo_Facets proto.InternalMessageInfo
 
-func (m *MapEntry) GetKey() []byte {
+func (m *Facets) GetFacets() []*api.Facet {
 	if m != nil {
-		return m.Key
+		return m.Facets
 	}
 	return nil
 }
 
-func (m *MapEntry) GetUid() uint64 {
-	if m != nil {
-		return m.Uid
+type FacetsList struct {
+	FacetsList           []*Facets `protobuf:"bytes,1,rep,name=facets_list,json=facetsList,proto3" json:"facets_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FacetsList) Reset()         { *m = FacetsList{} }
+func (m *FacetsList) String() string { return proto.CompactTextString(m) }
+func (*FacetsList) ProtoMessage()    {}
+func (*FacetsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{40}
+}
+func (m *FacetsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FacetsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FacetsList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *FacetsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetsList.Merge(m, src)
+}
+func (m *FacetsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *FacetsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetsList.DiscardUnknown(m)
 }
 
-func (m *MapEntry) GetPosting() *Posting {
+var xxx_messageInfo_FacetsList proto.InternalMessageInfo
+
+func (m *FacetsList) GetFacetsList() []*Facets {
 	if m != nil {
-		return m.Posting
+		return m.FacetsList
 	}
 	return nil
 }
 
-type MovePredicatePayload struct {
-	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
-	SourceGid            uint32   `protobuf:"varint,2,opt,name=source_gid,json=sourceGid,proto3" json:"source_gid,omitempty"`
-	DestGid              uint32   `protobuf:"varint,3,opt,name=dest_gid,json=destGid,proto3" json:"dest_gid,omitempty"`
-	TxnTs                uint64   `protobuf:"varint,4,opt,name=txn_ts,json=txnTs,proto3" json:"txn_ts,omitempty"`
-	ExpectedChecksum     uint64   `protobuf:"varint,5,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+type Function struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Args                 []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MovePredicatePayload) Reset()         { *m = MovePredicatePayload{} }
-func (m *MovePredicatePayload) String() string { return proto.CompactTextString(m) }
-func (*MovePredicatePayload) ProtoMessage()    {}
-func (*MovePredicatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{43}
+func (m *Function) Reset()         { *m = Function{} }
+func (m *Function) String() string { return proto.CompactTextString(m) }
+func (*Function) ProtoMessage()    {}
+func (*Function) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{41}
 }
-func (m *MovePredicatePayload) XXX_Unmarshal(b []byte) error {
+func (m *Function) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MovePredicatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Function) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MovePredicatePayload.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Function.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3640,73 +3751,61 @@ func (m *MovePredicatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MovePredicatePayload) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MovePredicatePayload.Merge(m, src)
+func (m *Function) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function.Merge(m, src)
 }
-func (m *MovePredicatePayload) XXX_Size() int {
+func (m *Function) XXX_Size() int {
 	return m.Size()
 }
-func (m *MovePredicatePayload) XXX_DiscardUnknown() {
-	xxx_messageInfo_MovePredicatePayload.DiscardUnknown(m)
+func (m *Function) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MovePredicatePayload proto.InternalMessageInfo
+var xxx_messageInfo_Function proto.InternalMessageInfo
 
-func (m *MovePredicatePayload) GetPredicate() string {
+func (m *Function) GetName() string {
 	if m != nil {
-		return m.Predicate
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MovePredicatePayload) GetSourceGid() uint32 {
+func (m *Function) GetKey() string {
 	if m != nil {
-		return m.SourceGid
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *MovePredicatePayload) GetDestGid() uint32 {
+func (m *Function) GetArgs() []string {
 	if m != nil {
-		return m.DestGid
+		return m.Args
 	}
-	return 0
+	return nil
 }
 
-func (m *MovePredicatePayload) GetTxnTs() uint64 {
-	if m != nil {
-		return m.TxnTs
-	}
-	return 0
+// Op and Children are internal nodes and Func on leaves.
+type FilterTree struct {
+	Op                   string        `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Children             []*FilterTree `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	Func                 *Function     `protobuf:"bytes,3,opt,name=func,proto3" json:"func,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *MovePredicatePayload) GetExpectedChecksum() uint64 {
-	if m != nil {
-		return m.ExpectedChecksum
-	}
-	return 0
-}
-
-type TxnStatus struct {
-	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	CommitTs             uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TxnStatus) Reset()         { *m = TxnStatus{} }
-func (m *TxnStatus) String() string { return proto.CompactTextString(m) }
-func (*TxnStatus) ProtoMessage()    {}
-func (*TxnStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{44}
+func (m *FilterTree) Reset()         { *m = FilterTree{} }
+func (m *FilterTree) String() string { return proto.CompactTextString(m) }
+func (*FilterTree) ProtoMessage()    {}
+func (*FilterTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{42}
 }
-func (m *TxnStatus) XXX_Unmarshal(b []byte) error {
+func (m *FilterTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxnStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FilterTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxnStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FilterTree.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3716,53 +3815,63 @@ func (m *TxnStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TxnStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxnStatus.Merge(m, src)
+func (m *FilterTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterTree.Merge(m, src)
 }
-func (m *TxnStatus) XXX_Size() int {
+func (m *FilterTree) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxnStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxnStatus.DiscardUnknown(m)
+func (m *FilterTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterTree.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxnStatus proto.InternalMessageInfo
+var xxx_messageInfo_FilterTree proto.InternalMessageInfo
 
-func (m *TxnStatus) GetStartTs() uint64 {
+func (m *FilterTree) GetOp() string {
 	if m != nil {
-		return m.StartTs
+		return m.Op
 	}
-	return 0
+	return ""
 }
 
-func (m *TxnStatus) GetCommitTs() uint64 {
+func (m *FilterTree) GetChildren() []*FilterTree {
 	if m != nil {
-		return m.CommitTs
+		return m.Children
 	}
-	return 0
+	return nil
 }
 
-type OracleDelta struct {
-	Txns                 []*TxnStatus      `protobuf:"bytes,1,rep,name=txns,proto3" json:"txns,omitempty"`
-	MaxAssigned          uint64            `protobuf:"varint,2,opt,name=max_assigned,json=maxAssigned,proto3" json:"max_assigned,omitempty"`
-	GroupChecksums       map[uint32]uint64 `protobuf:"bytes,3,rep,name=group_checksums,json=groupChecksums,proto3" json:"group_checksums,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *FilterTree) GetFunc() *Function {
+	if m != nil {
+		return m.Func
+	}
+	return nil
 }
 
-func (m *OracleDelta) Reset()         { *m = OracleDelta{} }
-func (m *OracleDelta) String() string { return proto.CompactTextString(m) }
-func (*OracleDelta) ProtoMessage()    {}
-func (*OracleDelta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+// Schema messages.
+type SchemaRequest struct {
+	GroupId    uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Predicates []string `protobuf:"bytes,2,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// fields can be on of type, index, reverse or tokenizer
+	Fields               []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Types                []string `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *OracleDelta) XXX_Unmarshal(b []byte) error {
+
+func (m *SchemaRequest) Reset()         { *m = SchemaRequest{} }
+func (m *SchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*SchemaRequest) ProtoMessage()    {}
+func (*SchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{43}
+}
+func (m *SchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleDelta.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SchemaRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3772,58 +3881,76 @@ func (m *OracleDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OracleDelta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleDelta.Merge(m, src)
+func (m *SchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaRequest.Merge(m, src)
 }
-func (m *OracleDelta) XXX_Size() int {
+func (m *SchemaRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleDelta) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleDelta.DiscardUnknown(m)
+func (m *SchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleDelta proto.InternalMessageInfo
+var xxx_messageInfo_SchemaRequest proto.InternalMessageInfo
 
-func (m *OracleDelta) GetTxns() []*TxnStatus {
+func (m *SchemaRequest) GetGroupId() uint32 {
 	if m != nil {
-		return m.Txns
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *SchemaRequest) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
 	}
 	return nil
 }
 
-func (m *OracleDelta) GetMaxAssigned() uint64 {
+func (m *SchemaRequest) GetFields() []string {
 	if m != nil {
-		return m.MaxAssigned
+		return m.Fields
 	}
-	return 0
+	return nil
 }
 
-func (m *OracleDelta) GetGroupChecksums() map[uint32]uint64 {
+func (m *SchemaRequest) GetTypes() []string {
 	if m != nil {
-		return m.GroupChecksums
+		return m.Types
 	}
 	return nil
 }
 
-type TxnTimestamps struct {
-	Ts                   []uint64 `protobuf:"varint,1,rep,packed,name=ts,proto3" json:"ts,omitempty"`
+type SchemaNode struct {
+	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Index                bool     `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Tokenizer            []string `protobuf:"bytes,4,rep,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Count                bool     `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	List                 bool     `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
+	Upsert               bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	Lang                 bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
+	NoConflict           bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Unique               bool     `protobuf:"varint,11,opt,name=unique,proto3" json:"unique,omitempty"`
+	Ttl                  string   `protobuf:"bytes,12,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxnTimestamps) Reset()         { *m = TxnTimestamps{} }
-func (m *TxnTimestamps) String() string { return proto.CompactTextString(m) }
-func (*TxnTimestamps) ProtoMessage()    {}
-func (*TxnTimestamps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{46}
+func (m *SchemaNode) Reset()         { *m = SchemaNode{} }
+func (m *SchemaNode) String() string { return proto.CompactTextString(m) }
+func (*SchemaNode) ProtoMessage()    {}
+func (*SchemaNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{44}
 }
-func (m *TxnTimestamps) XXX_Unmarshal(b []byte) error {
+func (m *SchemaNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxnTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SchemaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxnTimestamps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SchemaNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3833,92 +3960,123 @@ func (m *TxnTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *TxnTimestamps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxnTimestamps.Merge(m, src)
+func (m *SchemaNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaNode.Merge(m, src)
 }
-func (m *TxnTimestamps) XXX_Size() int {
+func (m *SchemaNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxnTimestamps) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxnTimestamps.DiscardUnknown(m)
+func (m *SchemaNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxnTimestamps proto.InternalMessageInfo
+var xxx_messageInfo_SchemaNode proto.InternalMessageInfo
 
-func (m *TxnTimestamps) GetTs() []uint64 {
+func (m *SchemaNode) GetPredicate() string {
 	if m != nil {
-		return m.Ts
+		return m.Predicate
 	}
-	return nil
+	return ""
 }
 
-type PeerResponse struct {
-	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SchemaNode) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *PeerResponse) Reset()         { *m = PeerResponse{} }
-func (m *PeerResponse) String() string { return proto.CompactTextString(m) }
-func (*PeerResponse) ProtoMessage()    {}
-func (*PeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{47}
-}
-func (m *PeerResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *SchemaNode) GetIndex() bool {
+	if m != nil {
+		return m.Index
+	}
+	return false
 }
-func (m *PeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_PeerResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *SchemaNode) GetTokenizer() []string {
+	if m != nil {
+		return m.Tokenizer
 	}
+	return nil
 }
-func (m *PeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PeerResponse.Merge(m, src)
+
+func (m *SchemaNode) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
 }
-func (m *PeerResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *SchemaNode) GetCount() bool {
+	if m != nil {
+		return m.Count
+	}
+	return false
 }
-func (m *PeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PeerResponse.DiscardUnknown(m)
+
+func (m *SchemaNode) GetList() bool {
+	if m != nil {
+		return m.List
+	}
+	return false
 }
 
-var xxx_messageInfo_PeerResponse proto.InternalMessageInfo
+func (m *SchemaNode) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
 
-func (m *PeerResponse) GetStatus() bool {
+func (m *SchemaNode) GetLang() bool {
 	if m != nil {
-		return m.Status
+		return m.Lang
 	}
 	return false
 }
 
-type RaftBatch struct {
-	Context              *RaftContext `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Payload              *api.Payload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *SchemaNode) GetNoConflict() bool {
+	if m != nil {
+		return m.NoConflict
+	}
+	return false
 }
 
-func (m *RaftBatch) Reset()         { *m = RaftBatch{} }
-func (m *RaftBatch) String() string { return proto.CompactTextString(m) }
-func (*RaftBatch) ProtoMessage()    {}
-func (*RaftBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{48}
+func (m *SchemaNode) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
 }
-func (m *RaftBatch) XXX_Unmarshal(b []byte) error {
+
+func (m *SchemaNode) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+type SchemaResult struct {
+	Schema []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
+	// The types requested by name, which all the groups hold.
+	Types                []*TypeUpdate `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SchemaResult) Reset()         { *m = SchemaResult{} }
+func (m *SchemaResult) String() string { return proto.CompactTextString(m) }
+func (*SchemaResult) ProtoMessage()    {}
+func (*SchemaResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+}
+func (m *SchemaResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SchemaResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftBatch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SchemaResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3928,51 +4086,73 @@ func (m *RaftBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RaftBatch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftBatch.Merge(m, src)
+func (m *SchemaResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaResult.Merge(m, src)
 }
-func (m *RaftBatch) XXX_Size() int {
+func (m *SchemaResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftBatch) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftBatch.DiscardUnknown(m)
+func (m *SchemaResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftBatch proto.InternalMessageInfo
+var xxx_messageInfo_SchemaResult proto.InternalMessageInfo
 
-func (m *RaftBatch) GetContext() *RaftContext {
+// Deprecated: Do not use.
+func (m *SchemaResult) GetSchema() []*SchemaNode {
 	if m != nil {
-		return m.Context
+		return m.Schema
 	}
 	return nil
 }
 
-func (m *RaftBatch) GetPayload() *api.Payload {
+func (m *SchemaResult) GetTypes() []*TypeUpdate {
 	if m != nil {
-		return m.Payload
+		return m.Types
 	}
 	return nil
 }
 
-type SubscriptionRequest struct {
-	Prefixes             [][]byte `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+type SchemaUpdate struct {
+	Predicate string                 `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	ValueType Posting_ValType        `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=pb.Posting_ValType" json:"value_type,omitempty"`
+	Directive SchemaUpdate_Directive `protobuf:"varint,3,opt,name=directive,proto3,enum=pb.SchemaUpdate_Directive" json:"directive,omitempty"`
+	Tokenizer []string               `protobuf:"bytes,4,rep,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	Count     bool                   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	List      bool                   `protobuf:"varint,6,opt,name=list,proto3" json:"list,omitempty"`
+	Upsert    bool                   `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	Lang      bool                   `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
+	// Fields required for type system.
+	NonNullable     bool `protobuf:"varint,10,opt,name=non_nullable,json=nonNullable,proto3" json:"non_nullable,omitempty"`
+	NonNullableList bool `protobuf:"varint,11,opt,name=non_nullable_list,json=nonNullableList,proto3" json:"non_nullable_list,omitempty"`
+	// If value_type is OBJECT, then this represents an object type with a
+	// custom name. This field stores said name.
+	ObjectTypeName string `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
+	NoConflict     bool   `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	// If unique is set, no two nodes can have the same value for this predicate.
+	Unique bool `protobuf:"varint,14,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Constraint restricts the values of the field for the nodes of a type. It's only
+	// set for the fields of a type update.
+	Constraint *FieldConstraint `protobuf:"bytes,15,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	// Time to live of the values of the predicate, in seconds. Zero means they don't expire.
+	Ttl                  uint64   `protobuf:"varint,16,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscriptionRequest) Reset()         { *m = SubscriptionRequest{} }
-func (m *SubscriptionRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscriptionRequest) ProtoMessage()    {}
-func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{49}
+func (m *SchemaUpdate) Reset()         { *m = SchemaUpdate{} }
+func (m *SchemaUpdate) String() string { return proto.CompactTextString(m) }
+func (*SchemaUpdate) ProtoMessage()    {}
+func (*SchemaUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{46}
 }
-func (m *SubscriptionRequest) XXX_Unmarshal(b []byte) error {
+func (m *SchemaUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SchemaUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubscriptionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SchemaUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3982,93 +4162,144 @@ func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SubscriptionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscriptionRequest.Merge(m, src)
+func (m *SchemaUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaUpdate.Merge(m, src)
 }
-func (m *SubscriptionRequest) XXX_Size() int {
+func (m *SchemaUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubscriptionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscriptionRequest.DiscardUnknown(m)
+func (m *SchemaUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscriptionRequest proto.InternalMessageInfo
+var xxx_messageInfo_SchemaUpdate proto.InternalMessageInfo
 
-func (m *SubscriptionRequest) GetPrefixes() [][]byte {
+func (m *SchemaUpdate) GetPredicate() string {
 	if m != nil {
-		return m.Prefixes
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *SchemaUpdate) GetValueType() Posting_ValType {
+	if m != nil {
+		return m.ValueType
+	}
+	return Posting_DEFAULT
+}
+
+func (m *SchemaUpdate) GetDirective() SchemaUpdate_Directive {
+	if m != nil {
+		return m.Directive
+	}
+	return SchemaUpdate_NONE
+}
+
+func (m *SchemaUpdate) GetTokenizer() []string {
+	if m != nil {
+		return m.Tokenizer
 	}
 	return nil
 }
 
-type SubscriptionResponse struct {
-	Kvs                  *pb.KVList `protobuf:"bytes,1,opt,name=kvs,proto3" json:"kvs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *SchemaUpdate) GetCount() bool {
+	if m != nil {
+		return m.Count
+	}
+	return false
 }
 
-func (m *SubscriptionResponse) Reset()         { *m = SubscriptionResponse{} }
-func (m *SubscriptionResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscriptionResponse) ProtoMessage()    {}
-func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{50}
+func (m *SchemaUpdate) GetList() bool {
+	if m != nil {
+		return m.List
+	}
+	return false
 }
-func (m *SubscriptionResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *SchemaUpdate) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
 }
-func (m *SubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SubscriptionResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *SchemaUpdate) GetLang() bool {
+	if m != nil {
+		return m.Lang
 	}
+	return false
 }
-func (m *SubscriptionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscriptionResponse.Merge(m, src)
+
+func (m *SchemaUpdate) GetNonNullable() bool {
+	if m != nil {
+		return m.NonNullable
+	}
+	return false
 }
-func (m *SubscriptionResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *SchemaUpdate) GetNonNullableList() bool {
+	if m != nil {
+		return m.NonNullableList
+	}
+	return false
 }
-func (m *SubscriptionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscriptionResponse.DiscardUnknown(m)
+
+func (m *SchemaUpdate) GetObjectTypeName() string {
+	if m != nil {
+		return m.ObjectTypeName
+	}
+	return ""
 }
 
-var xxx_messageInfo_SubscriptionResponse proto.InternalMessageInfo
+func (m *SchemaUpdate) GetNoConflict() bool {
+	if m != nil {
+		return m.NoConflict
+	}
+	return false
+}
 
-func (m *SubscriptionResponse) GetKvs() *pb.KVList {
+func (m *SchemaUpdate) GetUnique() bool {
 	if m != nil {
-		return m.Kvs
+		return m.Unique
+	}
+	return false
+}
+
+func (m *SchemaUpdate) GetConstraint() *FieldConstraint {
+	if m != nil {
+		return m.Constraint
 	}
 	return nil
 }
 
-type Num struct {
-	Val                  uint64   `protobuf:"varint,1,opt,name=val,proto3" json:"val,omitempty"`
-	ReadOnly             bool     `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
-	Forwarded            bool     `protobuf:"varint,3,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SchemaUpdate) GetTtl() uint64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
 }
 
-func (m *Num) Reset()         { *m = Num{} }
-func (m *Num) String() string { return proto.CompactTextString(m) }
-func (*Num) ProtoMessage()    {}
-func (*Num) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{51}
+type TypeUpdate struct {
+	TypeName             string            `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Fields               []*SchemaUpdate   `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	CompositeIndexes     []*CompositeIndex `protobuf:"bytes,3,rep,name=composite_indexes,json=compositeIndexes,proto3" json:"composite_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *Num) XXX_Unmarshal(b []byte) error {
+
+func (m *TypeUpdate) Reset()         { *m = TypeUpdate{} }
+func (m *TypeUpdate) String() string { return proto.CompactTextString(m) }
+func (*TypeUpdate) ProtoMessage()    {}
+func (*TypeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{47}
+}
+func (m *TypeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Num) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TypeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Num.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TypeUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4078,61 +4309,60 @@ func (m *Num) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Num) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Num.Merge(m, src)
+func (m *TypeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeUpdate.Merge(m, src)
 }
-func (m *Num) XXX_Size() int {
+func (m *TypeUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *Num) XXX_DiscardUnknown() {
-	xxx_messageInfo_Num.DiscardUnknown(m)
+func (m *TypeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Num proto.InternalMessageInfo
+var xxx_messageInfo_TypeUpdate proto.InternalMessageInfo
 
-func (m *Num) GetVal() uint64 {
+func (m *TypeUpdate) GetTypeName() string {
 	if m != nil {
-		return m.Val
+		return m.TypeName
 	}
-	return 0
+	return ""
 }
 
-func (m *Num) GetReadOnly() bool {
+func (m *TypeUpdate) GetFields() []*SchemaUpdate {
 	if m != nil {
-		return m.ReadOnly
+		return m.Fields
 	}
-	return false
+	return nil
 }
 
-func (m *Num) GetForwarded() bool {
+func (m *TypeUpdate) GetCompositeIndexes() []*CompositeIndex {
 	if m != nil {
-		return m.Forwarded
+		return m.CompositeIndexes
 	}
-	return false
+	return nil
 }
 
-type AssignedIds struct {
-	StartId uint64 `protobuf:"varint,1,opt,name=startId,proto3" json:"startId,omitempty"`
-	EndId   uint64 `protobuf:"varint,2,opt,name=endId,proto3" json:"endId,omitempty"`
-	// The following is used for read only transactions.
-	ReadOnly             uint64   `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+// CompositeIndex indexes the combined values of several scalar predicates of a type.
+// The index is stored under the first predicate in the list.
+type CompositeIndex struct {
+	Predicates           []string `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignedIds) Reset()         { *m = AssignedIds{} }
-func (m *AssignedIds) String() string { return proto.CompactTextString(m) }
-func (*AssignedIds) ProtoMessage()    {}
-func (*AssignedIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{52}
+func (m *CompositeIndex) Reset()         { *m = CompositeIndex{} }
+func (m *CompositeIndex) String() string { return proto.CompactTextString(m) }
+func (*CompositeIndex) ProtoMessage()    {}
+func (*CompositeIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{48}
 }
-func (m *AssignedIds) XXX_Unmarshal(b []byte) error {
+func (m *CompositeIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignedIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompositeIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignedIds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompositeIndex.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4142,59 +4372,135 @@ func (m *AssignedIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AssignedIds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignedIds.Merge(m, src)
+func (m *CompositeIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeIndex.Merge(m, src)
 }
-func (m *AssignedIds) XXX_Size() int {
+func (m *CompositeIndex) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignedIds) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignedIds.DiscardUnknown(m)
+func (m *CompositeIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeIndex.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignedIds proto.InternalMessageInfo
+var xxx_messageInfo_CompositeIndex proto.InternalMessageInfo
 
-func (m *AssignedIds) GetStartId() uint64 {
+func (m *CompositeIndex) GetPredicates() []string {
 	if m != nil {
-		return m.StartId
+		return m.Predicates
 	}
-	return 0
+	return nil
 }
 
-func (m *AssignedIds) GetEndId() uint64 {
+// FieldConstraint restricts the values of a field of a type.
+type FieldConstraint struct {
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// Bounds of the allowed values, in the string form of the predicate type. An
+	// empty string means there's no bound.
+	Min   string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max   string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3" json:"regex,omitempty"`
+	// Bounds of the number of values. Zero means there's no bound.
+	MinCount             uint64   `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	MaxCount             uint64   `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{49}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
+func (m *FieldConstraint) GetRequired() bool {
 	if m != nil {
-		return m.EndId
+		return m.Required
+	}
+	return false
+}
+
+func (m *FieldConstraint) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
 	}
 	return 0
 }
 
-func (m *AssignedIds) GetReadOnly() uint64 {
+func (m *FieldConstraint) GetMaxCount() uint64 {
 	if m != nil {
-		return m.ReadOnly
+		return m.MaxCount
 	}
 	return 0
 }
 
-type SnapshotMeta struct {
-	ClientTs             uint64   `protobuf:"varint,1,opt,name=client_ts,json=clientTs,proto3" json:"client_ts,omitempty"`
-	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+type MapHeader struct {
+	PartitionKeys        [][]byte `protobuf:"bytes,1,rep,name=partition_keys,json=partitionKeys,proto3" json:"partition_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
-func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
-func (*SnapshotMeta) ProtoMessage()    {}
-func (*SnapshotMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{53}
+func (m *MapHeader) Reset()         { *m = MapHeader{} }
+func (m *MapHeader) String() string { return proto.CompactTextString(m) }
+func (*MapHeader) ProtoMessage()    {}
+func (*MapHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{50}
 }
-func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
+func (m *MapHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SnapshotMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SnapshotMeta.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4204,54 +4510,48 @@ func (m *SnapshotMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SnapshotMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SnapshotMeta.Merge(m, src)
+func (m *MapHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapHeader.Merge(m, src)
 }
-func (m *SnapshotMeta) XXX_Size() int {
+func (m *MapHeader) XXX_Size() int {
 	return m.Size()
 }
-func (m *SnapshotMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_SnapshotMeta.DiscardUnknown(m)
+func (m *MapHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapHeader.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SnapshotMeta proto.InternalMessageInfo
-
-func (m *SnapshotMeta) GetClientTs() uint64 {
-	if m != nil {
-		return m.ClientTs
-	}
-	return 0
-}
+var xxx_messageInfo_MapHeader proto.InternalMessageInfo
 
-func (m *SnapshotMeta) GetGroupId() uint32 {
+func (m *MapHeader) GetPartitionKeys() [][]byte {
 	if m != nil {
-		return m.GroupId
+		return m.PartitionKeys
 	}
-	return 0
+	return nil
 }
 
-// Status describes a general status response.
-// code: 0 = success, 0 != failure.
-type Status struct {
-	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+// Bulk loader proto.
+type MapEntry struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Only one should be set.
+	Uid                  uint64   `protobuf:"fixed64,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Posting              *Posting `protobuf:"bytes,3,opt,name=posting,proto3" json:"posting,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Status) Reset()         { *m = Status{} }
-func (m *Status) String() string { return proto.CompactTextString(m) }
-func (*Status) ProtoMessage()    {}
-func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{54}
+func (m *MapEntry) Reset()         { *m = MapEntry{} }
+func (m *MapEntry) String() string { return proto.CompactTextString(m) }
+func (*MapEntry) ProtoMessage()    {}
+func (*MapEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{51}
 }
-func (m *Status) XXX_Unmarshal(b []byte) error {
+func (m *MapEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Status.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4261,64 +4561,67 @@ func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Status.Merge(m, src)
+func (m *MapEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapEntry.Merge(m, src)
 }
-func (m *Status) XXX_Size() int {
+func (m *MapEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_Status.DiscardUnknown(m)
+func (m *MapEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Status proto.InternalMessageInfo
+var xxx_messageInfo_MapEntry proto.InternalMessageInfo
 
-func (m *Status) GetCode() int32 {
+func (m *MapEntry) GetKey() []byte {
 	if m != nil {
-		return m.Code
+		return m.Key
+	}
+	return nil
+}
+
+func (m *MapEntry) GetUid() uint64 {
+	if m != nil {
+		return m.Uid
 	}
 	return 0
 }
 
-func (m *Status) GetMsg() string {
+func (m *MapEntry) GetPosting() *Posting {
 	if m != nil {
-		return m.Msg
+		return m.Posting
 	}
-	return ""
+	return nil
 }
 
-type BackupRequest struct {
-	ReadTs       uint64 `protobuf:"varint,1,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	SinceTs      uint64 `protobuf:"varint,2,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
-	GroupId      uint32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UnixTs       string `protobuf:"bytes,4,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
-	Destination  string `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
-	AccessKey    string `protobuf:"bytes,6,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
-	SecretKey    string `protobuf:"bytes,7,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	SessionToken string `protobuf:"bytes,8,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	// True if no credentials should be used to access the S3 or minio bucket.
-	// For example, when using a bucket with a public policy.
-	Anonymous bool `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
-	// The predicates to backup. All other predicates present in the group (e.g
-	// stale data from a predicate move) will be ignored.
-	Predicates           []string `protobuf:"bytes,10,rep,name=predicates,proto3" json:"predicates,omitempty"`
+type MovePredicatePayload struct {
+	Predicate        string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	SourceGid        uint32 `protobuf:"varint,2,opt,name=source_gid,json=sourceGid,proto3" json:"source_gid,omitempty"`
+	DestGid          uint32 `protobuf:"varint,3,opt,name=dest_gid,json=destGid,proto3" json:"dest_gid,omitempty"`
+	TxnTs            uint64 `protobuf:"varint,4,opt,name=txn_ts,json=txnTs,proto3" json:"txn_ts,omitempty"`
+	ExpectedChecksum uint64 `protobuf:"varint,5,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+	// If set, only the keys changed after since_ts are sent, to catch up with an earlier move.
+	SinceTs uint64 `protobuf:"varint,6,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	// If set, only the uid range [start_uid, end_uid) of a sharded predicate is moved.
+	StartUid             uint64   `protobuf:"varint,7,opt,name=start_uid,json=startUid,proto3" json:"start_uid,omitempty"`
+	EndUid               uint64   `protobuf:"varint,8,opt,name=end_uid,json=endUid,proto3" json:"end_uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
-func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
-func (*BackupRequest) ProtoMessage()    {}
-func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{55}
+func (m *MovePredicatePayload) Reset()         { *m = MovePredicatePayload{} }
+func (m *MovePredicatePayload) String() string { return proto.CompactTextString(m) }
+func (*MovePredicatePayload) ProtoMessage()    {}
+func (*MovePredicatePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{52}
 }
-func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+func (m *MovePredicatePayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MovePredicatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MovePredicatePayload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4328,110 +4631,94 @@ func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *BackupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BackupRequest.Merge(m, src)
+func (m *MovePredicatePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePredicatePayload.Merge(m, src)
 }
-func (m *BackupRequest) XXX_Size() int {
+func (m *MovePredicatePayload) XXX_Size() int {
 	return m.Size()
 }
-func (m *BackupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+func (m *MovePredicatePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePredicatePayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+var xxx_messageInfo_MovePredicatePayload proto.InternalMessageInfo
 
-func (m *BackupRequest) GetReadTs() uint64 {
+func (m *MovePredicatePayload) GetPredicate() string {
 	if m != nil {
-		return m.ReadTs
+		return m.Predicate
 	}
-	return 0
+	return ""
 }
 
-func (m *BackupRequest) GetSinceTs() uint64 {
+func (m *MovePredicatePayload) GetSourceGid() uint32 {
 	if m != nil {
-		return m.SinceTs
+		return m.SourceGid
 	}
 	return 0
 }
 
-func (m *BackupRequest) GetGroupId() uint32 {
+func (m *MovePredicatePayload) GetDestGid() uint32 {
 	if m != nil {
-		return m.GroupId
+		return m.DestGid
 	}
 	return 0
 }
 
-func (m *BackupRequest) GetUnixTs() string {
+func (m *MovePredicatePayload) GetTxnTs() uint64 {
 	if m != nil {
-		return m.UnixTs
+		return m.TxnTs
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupRequest) GetDestination() string {
+func (m *MovePredicatePayload) GetExpectedChecksum() uint64 {
 	if m != nil {
-		return m.Destination
+		return m.ExpectedChecksum
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupRequest) GetAccessKey() string {
+func (m *MovePredicatePayload) GetSinceTs() uint64 {
 	if m != nil {
-		return m.AccessKey
+		return m.SinceTs
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupRequest) GetSecretKey() string {
+func (m *MovePredicatePayload) GetStartUid() uint64 {
 	if m != nil {
-		return m.SecretKey
+		return m.StartUid
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupRequest) GetSessionToken() string {
+func (m *MovePredicatePayload) GetEndUid() uint64 {
 	if m != nil {
-		return m.SessionToken
+		return m.EndUid
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupRequest) GetAnonymous() bool {
-	if m != nil {
-		return m.Anonymous
-	}
-	return false
-}
-
-func (m *BackupRequest) GetPredicates() []string {
-	if m != nil {
-		return m.Predicates
-	}
-	return nil
-}
-
-type ExportRequest struct {
-	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ReadTs               uint64   `protobuf:"varint,2,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	UnixTs               int64    `protobuf:"varint,3,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
-	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+type TxnStatus struct {
+	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs             uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
-func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
-func (*ExportRequest) ProtoMessage()    {}
-func (*ExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{56}
+func (m *TxnStatus) Reset()         { *m = TxnStatus{} }
+func (m *TxnStatus) String() string { return proto.CompactTextString(m) }
+func (*TxnStatus) ProtoMessage()    {}
+func (*TxnStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{53}
 }
-func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+func (m *TxnStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4441,71 +4728,53 @@ func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ExportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportRequest.Merge(m, src)
+func (m *TxnStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnStatus.Merge(m, src)
 }
-func (m *ExportRequest) XXX_Size() int {
+func (m *TxnStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+func (m *TxnStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
-
-func (m *ExportRequest) GetGroupId() uint32 {
-	if m != nil {
-		return m.GroupId
-	}
-	return 0
-}
+var xxx_messageInfo_TxnStatus proto.InternalMessageInfo
 
-func (m *ExportRequest) GetReadTs() uint64 {
+func (m *TxnStatus) GetStartTs() uint64 {
 	if m != nil {
-		return m.ReadTs
+		return m.StartTs
 	}
 	return 0
 }
 
-func (m *ExportRequest) GetUnixTs() int64 {
+func (m *TxnStatus) GetCommitTs() uint64 {
 	if m != nil {
-		return m.UnixTs
+		return m.CommitTs
 	}
 	return 0
 }
 
-func (m *ExportRequest) GetFormat() string {
-	if m != nil {
-		return m.Format
-	}
-	return ""
-}
-
-// A key stored in the format used for writing backups.
-type BackupKey struct {
-	Type                 BackupKey_KeyType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BackupKey_KeyType" json:"type,omitempty"`
-	Attr                 string            `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
-	Uid                  uint64            `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
-	StartUid             uint64            `protobuf:"varint,4,opt,name=start_uid,json=startUid,proto3" json:"start_uid,omitempty"`
-	Term                 string            `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
-	Count                uint32            `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+type OracleDelta struct {
+	Txns                 []*TxnStatus      `protobuf:"bytes,1,rep,name=txns,proto3" json:"txns,omitempty"`
+	MaxAssigned          uint64            `protobuf:"varint,2,opt,name=max_assigned,json=maxAssigned,proto3" json:"max_assigned,omitempty"`
+	GroupChecksums       map[uint32]uint64 `protobuf:"bytes,3,rep,name=group_checksums,json=groupChecksums,proto3" json:"group_checksums,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *BackupKey) Reset()         { *m = BackupKey{} }
-func (m *BackupKey) String() string { return proto.CompactTextString(m) }
-func (*BackupKey) ProtoMessage()    {}
-func (*BackupKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{57}
+func (m *OracleDelta) Reset()         { *m = OracleDelta{} }
+func (m *OracleDelta) String() string { return proto.CompactTextString(m) }
+func (*OracleDelta) ProtoMessage()    {}
+func (*OracleDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{54}
 }
-func (m *BackupKey) XXX_Unmarshal(b []byte) error {
+func (m *OracleDelta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BackupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BackupKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleDelta.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4515,83 +4784,105 @@ func (m *BackupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *BackupKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BackupKey.Merge(m, src)
+func (m *OracleDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDelta.Merge(m, src)
 }
-func (m *BackupKey) XXX_Size() int {
+func (m *OracleDelta) XXX_Size() int {
 	return m.Size()
 }
-func (m *BackupKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_BackupKey.DiscardUnknown(m)
+func (m *OracleDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDelta.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BackupKey proto.InternalMessageInfo
+var xxx_messageInfo_OracleDelta proto.InternalMessageInfo
 
-func (m *BackupKey) GetType() BackupKey_KeyType {
+func (m *OracleDelta) GetTxns() []*TxnStatus {
 	if m != nil {
-		return m.Type
+		return m.Txns
 	}
-	return BackupKey_UNKNOWN
+	return nil
 }
 
-func (m *BackupKey) GetAttr() string {
+func (m *OracleDelta) GetMaxAssigned() uint64 {
 	if m != nil {
-		return m.Attr
+		return m.MaxAssigned
 	}
-	return ""
+	return 0
 }
 
-func (m *BackupKey) GetUid() uint64 {
+func (m *OracleDelta) GetGroupChecksums() map[uint32]uint64 {
 	if m != nil {
-		return m.Uid
+		return m.GroupChecksums
 	}
-	return 0
+	return nil
 }
 
-func (m *BackupKey) GetStartUid() uint64 {
-	if m != nil {
-		return m.StartUid
-	}
-	return 0
+type TxnTimestamps struct {
+	Ts                   []uint64 `protobuf:"varint,1,rep,packed,name=ts,proto3" json:"ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BackupKey) GetTerm() string {
-	if m != nil {
-		return m.Term
+func (m *TxnTimestamps) Reset()         { *m = TxnTimestamps{} }
+func (m *TxnTimestamps) String() string { return proto.CompactTextString(m) }
+func (*TxnTimestamps) ProtoMessage()    {}
+func (*TxnTimestamps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{55}
+}
+func (m *TxnTimestamps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnTimestamps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *TxnTimestamps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnTimestamps.Merge(m, src)
+}
+func (m *TxnTimestamps) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnTimestamps) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnTimestamps.DiscardUnknown(m)
 }
 
-func (m *BackupKey) GetCount() uint32 {
+var xxx_messageInfo_TxnTimestamps proto.InternalMessageInfo
+
+func (m *TxnTimestamps) GetTs() []uint64 {
 	if m != nil {
-		return m.Count
+		return m.Ts
 	}
-	return 0
+	return nil
 }
 
-// A posting list stored in the format used for writing backups.
-type BackupPostingList struct {
-	Uids                 []uint64   `protobuf:"varint,1,rep,packed,name=uids,proto3" json:"uids,omitempty"`
-	Postings             []*Posting `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"`
-	CommitTs             uint64     `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
-	Splits               []uint64   `protobuf:"varint,4,rep,packed,name=splits,proto3" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type PeerResponse struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BackupPostingList) Reset()         { *m = BackupPostingList{} }
-func (m *BackupPostingList) String() string { return proto.CompactTextString(m) }
-func (*BackupPostingList) ProtoMessage()    {}
-func (*BackupPostingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{58}
+func (m *PeerResponse) Reset()         { *m = PeerResponse{} }
+func (m *PeerResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerResponse) ProtoMessage()    {}
+func (*PeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{56}
 }
-func (m *BackupPostingList) XXX_Unmarshal(b []byte) error {
+func (m *PeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BackupPostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BackupPostingList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4601,1239 +4892,1400 @@ func (m *BackupPostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *BackupPostingList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BackupPostingList.Merge(m, src)
+func (m *PeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerResponse.Merge(m, src)
 }
-func (m *BackupPostingList) XXX_Size() int {
+func (m *PeerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BackupPostingList) XXX_DiscardUnknown() {
-	xxx_messageInfo_BackupPostingList.DiscardUnknown(m)
+func (m *PeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BackupPostingList proto.InternalMessageInfo
+var xxx_messageInfo_PeerResponse proto.InternalMessageInfo
 
-func (m *BackupPostingList) GetUids() []uint64 {
+func (m *PeerResponse) GetStatus() bool {
 	if m != nil {
-		return m.Uids
+		return m.Status
 	}
-	return nil
+	return false
 }
 
-func (m *BackupPostingList) GetPostings() []*Posting {
-	if m != nil {
-		return m.Postings
-	}
-	return nil
+type RaftBatch struct {
+	Context              *RaftContext `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Payload              *api.Payload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *BackupPostingList) GetCommitTs() uint64 {
-	if m != nil {
-		return m.CommitTs
-	}
-	return 0
+func (m *RaftBatch) Reset()         { *m = RaftBatch{} }
+func (m *RaftBatch) String() string { return proto.CompactTextString(m) }
+func (*RaftBatch) ProtoMessage()    {}
+func (*RaftBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{57}
 }
-
-func (m *BackupPostingList) GetSplits() []uint64 {
-	if m != nil {
-		return m.Splits
-	}
-	return nil
+func (m *RaftBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
-	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
-	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
-	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
-	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
-	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
-	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
-	proto.RegisterType((*List)(nil), "pb.List")
-	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
-	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
-	proto.RegisterType((*Query)(nil), "pb.Query")
-	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
-	proto.RegisterType((*LangList)(nil), "pb.LangList")
-	proto.RegisterType((*Result)(nil), "pb.Result")
-	proto.RegisterType((*Order)(nil), "pb.Order")
-	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
-	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
-	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
-	proto.RegisterType((*Member)(nil), "pb.Member")
-	proto.RegisterType((*Group)(nil), "pb.Group")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
-	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
-	proto.RegisterType((*License)(nil), "pb.License")
-	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
-	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
-	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
-	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
-	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
-	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
-	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
-	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
-	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
-	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
-	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
-	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
-	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
-	proto.RegisterType((*KVS)(nil), "pb.KVS")
-	proto.RegisterType((*Posting)(nil), "pb.Posting")
-	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
-	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
-	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
-	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
-	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
-	proto.RegisterType((*Facets)(nil), "pb.Facets")
-	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
-	proto.RegisterType((*Function)(nil), "pb.Function")
-	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
-	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
-	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
-	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
-	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
-	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
-	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
-	proto.RegisterType((*MapEntry)(nil), "pb.MapEntry")
-	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
-	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
-	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
-	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
-	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
-	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
-	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
-	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
-	proto.RegisterType((*Num)(nil), "pb.Num")
-	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
-	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
-	proto.RegisterType((*Status)(nil), "pb.Status")
-	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
-	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
-	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
-	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
+func (m *RaftBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
-
-var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 4393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0x1b, 0xd7,
-	0x76, 0x9a, 0xe1, 0xd7, 0xcc, 0x21, 0x29, 0xd3, 0x37, 0x8e, 0xc3, 0xc8, 0x89, 0xa5, 0x4c, 0xe2,
-	0x44, 0x89, 0x63, 0xd9, 0x51, 0x5e, 0xd1, 0x24, 0x0f, 0x05, 0xaa, 0x0f, 0xda, 0x56, 0x2c, 0x51,
-	0x7a, 0x43, 0xca, 0xe9, 0xcb, 0xa2, 0xc4, 0x70, 0xe6, 0x8a, 0x9a, 0xa7, 0xe1, 0xcc, 0x74, 0x66,
-	0xa8, 0x52, 0x59, 0xb5, 0x28, 0xda, 0x55, 0x8b, 0x2e, 0xba, 0x79, 0xab, 0xbe, 0xae, 0xbb, 0x29,
-	0xd0, 0x55, 0xd1, 0x75, 0x17, 0x45, 0x57, 0xfd, 0x05, 0x6e, 0x91, 0x76, 0x65, 0xa0, 0xab, 0x16,
-	0x5d, 0x16, 0xc5, 0x39, 0xf7, 0xce, 0x17, 0x4d, 0xdb, 0xc9, 0x03, 0xde, 0x8a, 0xf7, 0x9c, 0x73,
-	0xbf, 0xe6, 0x7c, 0x9f, 0x73, 0x09, 0x5a, 0x38, 0xde, 0x0a, 0xa3, 0x20, 0x09, 0x98, 0x1a, 0x8e,
-	0xd7, 0x74, 0x2b, 0x74, 0x05, 0xb8, 0xf6, 0xc9, 0xc4, 0x4d, 0xce, 0x67, 0xe3, 0x2d, 0x3b, 0x98,
-	0xde, 0x77, 0x26, 0x91, 0x15, 0x9e, 0xdf, 0x73, 0x83, 0xfb, 0x63, 0xcb, 0x99, 0xf0, 0xe8, 0xfe,
-	0xe5, 0xf6, 0xfd, 0x70, 0x7c, 0x3f, 0x5d, 0xba, 0x76, 0xaf, 0x30, 0x77, 0x12, 0x4c, 0x82, 0xfb,
-	0x84, 0x1e, 0xcf, 0xce, 0x08, 0x22, 0x80, 0x46, 0x62, 0xba, 0xb1, 0x06, 0xd5, 0x43, 0x37, 0x4e,
-	0x18, 0x83, 0xea, 0xcc, 0x75, 0xe2, 0xae, 0xb2, 0x51, 0xd9, 0xac, 0x9b, 0x34, 0x36, 0x8e, 0x40,
-	0x1f, 0x5a, 0xf1, 0xc5, 0x53, 0xcb, 0x9b, 0x71, 0xd6, 0x81, 0xca, 0xa5, 0xe5, 0x75, 0x95, 0x0d,
-	0x65, 0xb3, 0x65, 0xe2, 0x90, 0x6d, 0x81, 0x76, 0x69, 0x79, 0xa3, 0xe4, 0x2a, 0xe4, 0x5d, 0x75,
-	0x43, 0xd9, 0x5c, 0xdd, 0x7e, 0x63, 0x2b, 0x1c, 0x6f, 0x9d, 0x04, 0x71, 0xe2, 0xfa, 0x93, 0xad,
-	0xa7, 0x96, 0x37, 0xbc, 0x0a, 0xb9, 0xd9, 0xb8, 0x14, 0x03, 0xe3, 0x18, 0x9a, 0x83, 0xc8, 0x7e,
-	0x38, 0xf3, 0xed, 0xc4, 0x0d, 0x7c, 0x3c, 0xd1, 0xb7, 0xa6, 0x9c, 0x76, 0xd4, 0x4d, 0x1a, 0x23,
-	0xce, 0x8a, 0x26, 0x71, 0xb7, 0xb2, 0x51, 0x41, 0x1c, 0x8e, 0x59, 0x17, 0x1a, 0x6e, 0xbc, 0x17,
-	0xcc, 0xfc, 0xa4, 0x5b, 0xdd, 0x50, 0x36, 0x35, 0x33, 0x05, 0x8d, 0x5f, 0x55, 0xa0, 0xf6, 0xb3,
-	0x19, 0x8f, 0xae, 0x68, 0x5d, 0x92, 0x44, 0xe9, 0x5e, 0x38, 0x66, 0x37, 0xa0, 0xe6, 0x59, 0xfe,
-	0x24, 0xee, 0xaa, 0xb4, 0x99, 0x00, 0xd8, 0x2d, 0xd0, 0xad, 0xb3, 0x84, 0x47, 0xa3, 0x99, 0xeb,
-	0x74, 0x2b, 0x1b, 0xca, 0x66, 0xdd, 0xd4, 0x08, 0x71, 0xea, 0x3a, 0xec, 0x6d, 0xd0, 0x9c, 0x60,
-	0x64, 0x17, 0xcf, 0x72, 0x02, 0x3a, 0x8b, 0xbd, 0x0f, 0xda, 0xcc, 0x75, 0x46, 0x9e, 0x1b, 0x27,
-	0xdd, 0xda, 0x86, 0xb2, 0xd9, 0xdc, 0xd6, 0xf0, 0x63, 0x91, 0x77, 0x66, 0x63, 0xe6, 0x3a, 0xc4,
-	0xc4, 0x4f, 0x40, 0x8b, 0x23, 0x7b, 0x74, 0x36, 0xf3, 0xed, 0x6e, 0x9d, 0x26, 0x5d, 0xc3, 0x49,
-	0x85, 0xaf, 0x36, 0x1b, 0xb1, 0x00, 0xf0, 0xb3, 0x22, 0x7e, 0xc9, 0xa3, 0x98, 0x77, 0x1b, 0xe2,
-	0x28, 0x09, 0xb2, 0x07, 0xd0, 0x3c, 0xb3, 0x6c, 0x9e, 0x8c, 0x42, 0x2b, 0xb2, 0xa6, 0x5d, 0x2d,
-	0xdf, 0xe8, 0x21, 0xa2, 0x4f, 0x10, 0x1b, 0x9b, 0x70, 0x96, 0x01, 0xec, 0x73, 0x68, 0x13, 0x14,
-	0x8f, 0xce, 0x5c, 0x2f, 0xe1, 0x51, 0x57, 0xa7, 0x35, 0xab, 0xb4, 0x86, 0x30, 0xc3, 0x88, 0x73,
-	0xb3, 0x25, 0x26, 0x09, 0x0c, 0x7b, 0x17, 0x80, 0xcf, 0x43, 0xcb, 0x77, 0x46, 0x96, 0xe7, 0x75,
-	0x81, 0xee, 0xa0, 0x0b, 0xcc, 0x8e, 0xe7, 0xb1, 0xb7, 0xf0, 0x7e, 0x96, 0x33, 0x4a, 0xe2, 0x6e,
-	0x7b, 0x43, 0xd9, 0xac, 0x9a, 0x75, 0x04, 0x87, 0x31, 0xf2, 0xd5, 0xb6, 0xec, 0x73, 0xde, 0x5d,
-	0xdd, 0x50, 0x36, 0x6b, 0xa6, 0x00, 0x10, 0x7b, 0xe6, 0x46, 0x71, 0xd2, 0xbd, 0x26, 0xb0, 0x04,
-	0x18, 0xdb, 0xa0, 0x93, 0xf6, 0x10, 0x77, 0xee, 0x40, 0xfd, 0x12, 0x01, 0xa1, 0x64, 0xcd, 0xed,
-	0x36, 0x5e, 0x2f, 0x53, 0x30, 0x53, 0x12, 0x8d, 0xdb, 0xa0, 0x1d, 0x5a, 0xfe, 0x24, 0xd5, 0x4a,
-	0x14, 0x1b, 0x2d, 0xd0, 0x4d, 0x1a, 0x1b, 0xbf, 0x54, 0xa1, 0x6e, 0xf2, 0x78, 0xe6, 0x25, 0xec,
-	0x23, 0x00, 0x14, 0xca, 0xd4, 0x4a, 0x22, 0x77, 0x2e, 0x77, 0xcd, 0xc5, 0xa2, 0xcf, 0x5c, 0xe7,
-	0x88, 0x48, 0xec, 0x01, 0xb4, 0x68, 0xf7, 0x74, 0xaa, 0x9a, 0x5f, 0x20, 0xbb, 0x9f, 0xd9, 0xa4,
-	0x29, 0x72, 0xc5, 0x4d, 0xa8, 0x93, 0x1e, 0x08, 0x5d, 0x6c, 0x9b, 0x12, 0x62, 0x77, 0x60, 0xd5,
-	0xf5, 0x13, 0x94, 0x93, 0x9d, 0x8c, 0x1c, 0x1e, 0xa7, 0x8a, 0xd2, 0xce, 0xb0, 0xfb, 0x3c, 0x4e,
-	0xd8, 0x67, 0x20, 0x98, 0x9d, 0x1e, 0x58, 0xa3, 0x03, 0x57, 0x33, 0x21, 0xc6, 0xe2, 0x44, 0x9a,
-	0x23, 0x4f, 0xbc, 0x07, 0x4d, 0xfc, 0xbe, 0x74, 0x45, 0x9d, 0x56, 0xb4, 0xe8, 0x6b, 0x24, 0x3b,
-	0x4c, 0xc0, 0x09, 0x72, 0x3a, 0xb2, 0x06, 0x95, 0x51, 0x28, 0x0f, 0x8d, 0x8d, 0x1e, 0xd4, 0x8e,
-	0x23, 0x87, 0x47, 0x4b, 0xed, 0x81, 0x41, 0xd5, 0xe1, 0xb1, 0x4d, 0xa6, 0xaa, 0x99, 0x34, 0xce,
-	0x6d, 0xa4, 0x52, 0xb0, 0x11, 0xe3, 0xaf, 0x15, 0x68, 0x0e, 0x82, 0x28, 0x39, 0xe2, 0x71, 0x6c,
-	0x4d, 0x38, 0x5b, 0x87, 0x5a, 0x80, 0xdb, 0x4a, 0x0e, 0xeb, 0x78, 0x27, 0x3a, 0xc7, 0x14, 0xf8,
-	0x05, 0x39, 0xa8, 0x2f, 0x97, 0x03, 0xea, 0x0e, 0x59, 0x57, 0x45, 0xea, 0x0e, 0xd9, 0xd6, 0x4d,
-	0xa8, 0x07, 0x67, 0x67, 0x31, 0x17, 0xbc, 0xac, 0x99, 0x12, 0x7a, 0xa9, 0x0a, 0x1a, 0xbf, 0x05,
-	0x80, 0xf7, 0xfb, 0x91, 0x5a, 0x60, 0x9c, 0x43, 0xd3, 0xb4, 0xce, 0x92, 0xbd, 0xc0, 0x4f, 0xf8,
-	0x3c, 0x61, 0xab, 0xa0, 0xba, 0x0e, 0xb1, 0xa8, 0x6e, 0xaa, 0xae, 0x83, 0x97, 0x9b, 0x44, 0xc1,
-	0x2c, 0x24, 0x0e, 0xb5, 0x4d, 0x01, 0x10, 0x2b, 0x1d, 0x27, 0xa2, 0x1b, 0x23, 0x2b, 0x1d, 0x27,
-	0x62, 0xeb, 0xd0, 0x8c, 0x7d, 0x2b, 0x8c, 0xcf, 0x83, 0x04, 0x2f, 0x57, 0xa5, 0xcb, 0x41, 0x8a,
-	0x1a, 0xc6, 0xc6, 0x7f, 0xa9, 0x50, 0x3f, 0xe2, 0xd3, 0x31, 0x8f, 0x5e, 0x38, 0xe5, 0x01, 0x68,
-	0xb4, 0xf1, 0xc8, 0x75, 0xc4, 0x41, 0xbb, 0x6f, 0x3e, 0x7f, 0xb6, 0x7e, 0x9d, 0x70, 0x07, 0xce,
-	0xa7, 0xc1, 0xd4, 0x4d, 0xf8, 0x34, 0x4c, 0xae, 0xcc, 0x86, 0x44, 0x2d, 0xbd, 0xc1, 0x4d, 0xa8,
-	0x7b, 0xdc, 0x42, 0x99, 0x08, 0xf5, 0x93, 0x10, 0xbb, 0x07, 0x0d, 0x6b, 0x3a, 0x72, 0xb8, 0xe5,
-	0x90, 0x97, 0xd2, 0x76, 0x6f, 0x3c, 0x7f, 0xb6, 0xde, 0xb1, 0xa6, 0xfb, 0xdc, 0x2a, 0xee, 0x5d,
-	0x17, 0x18, 0xf6, 0x25, 0xea, 0x5c, 0x9c, 0x8c, 0x66, 0xa1, 0x63, 0x25, 0x9c, 0x7c, 0x56, 0x75,
-	0xb7, 0xfb, 0xfc, 0xd9, 0xfa, 0x0d, 0x44, 0x9f, 0x12, 0xb6, 0xb0, 0x0c, 0x72, 0x2c, 0x3b, 0x80,
-	0xeb, 0xb6, 0x37, 0x8b, 0xd1, 0x95, 0xba, 0xfe, 0x59, 0x30, 0x0a, 0x7c, 0xef, 0x8a, 0xc4, 0xa4,
-	0xed, 0xbe, 0xfb, 0xfc, 0xd9, 0xfa, 0xdb, 0x92, 0x78, 0xe0, 0x9f, 0x05, 0xc7, 0xbe, 0x77, 0x55,
-	0xd8, 0xe5, 0xda, 0x02, 0x89, 0xfd, 0x2e, 0xac, 0x9e, 0x05, 0x91, 0xcd, 0x47, 0x19, 0x63, 0x56,
-	0x69, 0x9f, 0xb5, 0xe7, 0xcf, 0xd6, 0x6f, 0x12, 0xe5, 0xd1, 0x0b, 0xdc, 0x69, 0x15, 0xf1, 0xc6,
-	0x3f, 0xa8, 0x50, 0xa3, 0x31, 0x7b, 0x00, 0x8d, 0x29, 0x31, 0x3e, 0xf5, 0x32, 0x37, 0x51, 0x13,
-	0x88, 0xb6, 0x25, 0x24, 0x12, 0xf7, 0xfc, 0x24, 0xba, 0x32, 0xd3, 0x69, 0xb8, 0x22, 0xb1, 0xc6,
-	0x1e, 0x4f, 0x62, 0xa9, 0xb9, 0x85, 0x15, 0x43, 0x41, 0x90, 0x2b, 0xe4, 0xb4, 0x45, 0xf1, 0x57,
-	0x16, 0xc5, 0xcf, 0xd6, 0x40, 0xb3, 0xcf, 0xb9, 0x7d, 0x11, 0xcf, 0xa6, 0x52, 0x39, 0x32, 0x78,
-	0xed, 0x21, 0xb4, 0x8a, 0xf7, 0xc0, 0xb8, 0x7a, 0xc1, 0xaf, 0x48, 0x41, 0xaa, 0x26, 0x0e, 0xd9,
-	0x06, 0xd4, 0xc8, 0x13, 0x91, 0x7a, 0x34, 0xb7, 0x01, 0xaf, 0x23, 0x96, 0x98, 0x82, 0xf0, 0x95,
-	0xfa, 0x85, 0x82, 0xfb, 0x14, 0x6f, 0x57, 0xdc, 0x47, 0x7f, 0xf9, 0x3e, 0x62, 0x49, 0x61, 0x1f,
-	0x23, 0x80, 0xc6, 0xa1, 0x6b, 0x73, 0x3f, 0xa6, 0xe8, 0x3b, 0x8b, 0x79, 0xe6, 0x35, 0x70, 0x8c,
-	0x9f, 0x32, 0xb5, 0xe6, 0xfd, 0xc0, 0xe1, 0x31, 0xed, 0x53, 0x35, 0x33, 0x18, 0x69, 0x7c, 0x1e,
-	0xba, 0xd1, 0xd5, 0x50, 0x30, 0xa1, 0x62, 0x66, 0x30, 0x86, 0x37, 0xee, 0xe3, 0x61, 0x4e, 0x1a,
-	0x49, 0x25, 0x68, 0xfc, 0x4d, 0x05, 0x5a, 0xdf, 0xf2, 0x28, 0x38, 0x89, 0x82, 0x30, 0x88, 0x2d,
-	0x8f, 0xed, 0x94, 0xd9, 0x29, 0xc4, 0xb6, 0x81, 0xb7, 0x2d, 0x4e, 0xdb, 0x1a, 0x64, 0xfc, 0x15,
-	0xe2, 0x28, 0x32, 0xdc, 0x80, 0xba, 0x10, 0xe7, 0x12, 0x9e, 0x49, 0x0a, 0xce, 0x11, 0x02, 0xa4,
-	0xbb, 0x96, 0xf9, 0x21, 0x29, 0xec, 0x36, 0xc0, 0xd4, 0x9a, 0x1f, 0x72, 0x2b, 0xe6, 0x07, 0x4e,
-	0x6a, 0xd7, 0x39, 0x46, 0x72, 0x63, 0x38, 0xf7, 0x87, 0x31, 0xd9, 0x97, 0xe0, 0x06, 0xc1, 0xec,
-	0x1d, 0xd0, 0xa7, 0xd6, 0x1c, 0x1d, 0xcc, 0x81, 0x23, 0x2c, 0xc9, 0xcc, 0x11, 0xec, 0x3d, 0xa8,
-	0x24, 0x73, 0x9f, 0xbc, 0x35, 0x06, 0x73, 0xcc, 0xed, 0x86, 0x73, 0x5f, 0xba, 0x22, 0x13, 0x69,
-	0xa9, 0x04, 0xb5, 0x5c, 0x82, 0x1d, 0xa8, 0xd8, 0xae, 0x43, 0xd1, 0x5c, 0x37, 0x71, 0xc8, 0xee,
-	0x40, 0xc3, 0x13, 0xd2, 0xa2, 0x88, 0xdd, 0xdc, 0x6e, 0x0a, 0x47, 0x47, 0x28, 0x33, 0xa5, 0xad,
-	0xfd, 0x0e, 0x5c, 0x5b, 0x60, 0x57, 0x51, 0x3f, 0xda, 0x62, 0xf7, 0x1b, 0x45, 0xfd, 0xa8, 0x16,
-	0x75, 0xe2, 0xdf, 0x2a, 0x70, 0x4d, 0x2a, 0xe9, 0xb9, 0x1b, 0x0e, 0x12, 0xb4, 0xf7, 0x2e, 0x34,
-	0xc8, 0x5b, 0x4b, 0xfd, 0xa8, 0x9a, 0x29, 0xc8, 0x7e, 0x1b, 0xea, 0x64, 0xb8, 0xa9, 0xfd, 0xac,
-	0xe7, 0xcc, 0xcf, 0x96, 0x0b, 0x7b, 0x92, 0x92, 0x93, 0xd3, 0xd9, 0x4f, 0xa0, 0xf6, 0x1d, 0x8f,
-	0x02, 0x11, 0x7d, 0x9a, 0xdb, 0xb7, 0x97, 0xad, 0x43, 0x15, 0x90, 0xcb, 0xc4, 0xe4, 0xdf, 0xa0,
-	0x8c, 0x3e, 0xc0, 0x78, 0x33, 0x0d, 0x2e, 0xb9, 0xd3, 0x6d, 0xd0, 0x8d, 0x8a, 0x6a, 0x94, 0x92,
-	0x52, 0xa1, 0x68, 0x4b, 0x85, 0xa2, 0xbf, 0x42, 0x28, 0xfb, 0xd0, 0x2c, 0x70, 0x61, 0x89, 0x40,
-	0xd6, 0xcb, 0x06, 0xab, 0x67, 0x7e, 0xa8, 0x68, 0xf7, 0xfb, 0x00, 0x39, 0x4f, 0x7e, 0x5d, 0xef,
-	0x61, 0xfc, 0xb1, 0x02, 0xd7, 0xf6, 0x02, 0xdf, 0xe7, 0x94, 0x95, 0x0a, 0x09, 0xe7, 0x46, 0xa4,
-	0xbc, 0xd4, 0x88, 0x3e, 0x86, 0x5a, 0x8c, 0x93, 0xe5, 0xee, 0x6f, 0x2c, 0x11, 0x99, 0x29, 0x66,
-	0xa0, 0x97, 0x9c, 0x5a, 0xf3, 0x51, 0xc8, 0x7d, 0xc7, 0xf5, 0x27, 0xa9, 0x97, 0x9c, 0x5a, 0xf3,
-	0x13, 0x81, 0x31, 0xfe, 0x47, 0x01, 0x78, 0xcc, 0x2d, 0x2f, 0x39, 0xc7, 0x48, 0x80, 0x72, 0x73,
-	0xfd, 0x38, 0xb1, 0x7c, 0x3b, 0xad, 0x09, 0x32, 0x18, 0x95, 0x0f, 0xc3, 0x1e, 0x8f, 0x85, 0x13,
-	0xd2, 0xcd, 0x14, 0xc4, 0x40, 0x88, 0xc7, 0xcd, 0x62, 0x19, 0x1e, 0x25, 0x94, 0x07, 0xf3, 0x2a,
-	0xa1, 0x65, 0x30, 0xef, 0x42, 0x03, 0x73, 0x6c, 0x37, 0xf0, 0x49, 0x35, 0x74, 0x33, 0x05, 0x71,
-	0x9f, 0x59, 0x98, 0xb8, 0x53, 0x11, 0x04, 0x2b, 0xa6, 0x84, 0xf0, 0x56, 0x18, 0xf4, 0x7a, 0xf6,
-	0x79, 0x40, 0xc6, 0x5b, 0x31, 0x33, 0x18, 0x77, 0x0b, 0xfc, 0x49, 0x80, 0x5f, 0xa7, 0x51, 0xfe,
-	0x94, 0x82, 0xe2, 0x5b, 0x1c, 0x3e, 0x47, 0x92, 0x4e, 0xa4, 0x0c, 0x36, 0xfe, 0x48, 0x85, 0xba,
-	0x70, 0x3b, 0xa5, 0x5c, 0x40, 0xf9, 0x41, 0xb9, 0xc0, 0x3b, 0xa0, 0x87, 0x11, 0x77, 0x5c, 0x3b,
-	0x95, 0x81, 0x6e, 0xe6, 0x08, 0x4a, 0xc2, 0x31, 0x2c, 0x12, 0x2f, 0x34, 0x53, 0x00, 0x88, 0x8d,
-	0x43, 0xcb, 0xe6, 0xf2, 0xfe, 0x02, 0xc0, 0x0f, 0x16, 0x1a, 0x4d, 0x9a, 0xac, 0x99, 0x12, 0x62,
-	0x9f, 0x83, 0x4e, 0x49, 0x17, 0xc5, 0x73, 0x9d, 0xe2, 0xf0, 0xcd, 0xe7, 0xcf, 0xd6, 0x19, 0x22,
-	0x17, 0x02, 0xb9, 0x96, 0xe2, 0x30, 0xed, 0xc0, 0xc5, 0xe8, 0xbe, 0x81, 0x72, 0x08, 0x4a, 0x3b,
-	0x10, 0x35, 0x8c, 0x8b, 0x69, 0x87, 0xc0, 0x18, 0x7f, 0xab, 0x42, 0x6b, 0xdf, 0x8d, 0xb8, 0x9d,
-	0x70, 0xa7, 0xe7, 0x4c, 0xe8, 0x32, 0xdc, 0x4f, 0xdc, 0xe4, 0x4a, 0x26, 0x4a, 0x12, 0xca, 0xf2,
-	0x58, 0xb5, 0x5c, 0xd7, 0x09, 0x05, 0xaf, 0x50, 0x29, 0x2a, 0x00, 0xb6, 0x0d, 0x20, 0x32, 0x7c,
-	0x2a, 0x47, 0xab, 0x2f, 0x2f, 0x47, 0x75, 0x9a, 0x86, 0x43, 0x2c, 0xf7, 0xc4, 0x1a, 0x57, 0x64,
-	0x4b, 0x75, 0xaa, 0x55, 0x67, 0xe8, 0x44, 0x28, 0x31, 0x1e, 0x73, 0x8f, 0xb4, 0x81, 0x12, 0xe3,
-	0x31, 0xf7, 0xb2, 0x72, 0xa4, 0x21, 0xae, 0x83, 0x63, 0xf6, 0x3e, 0xa8, 0x41, 0x48, 0x3c, 0x94,
-	0x07, 0x16, 0x3f, 0x6c, 0xeb, 0x38, 0x34, 0xd5, 0x20, 0x44, 0xd3, 0x12, 0xb5, 0x17, 0x69, 0x03,
-	0x9a, 0x16, 0x06, 0x00, 0xaa, 0x04, 0x4c, 0x49, 0x31, 0x6e, 0x82, 0x7a, 0x1c, 0xb2, 0x06, 0x54,
-	0x06, 0xbd, 0x61, 0x67, 0x05, 0x07, 0xfb, 0xbd, 0xc3, 0x8e, 0x62, 0x7c, 0xaf, 0x82, 0x7e, 0x34,
-	0x4b, 0x2c, 0x34, 0xd4, 0x18, 0xef, 0x5c, 0x56, 0x99, 0x5c, 0x37, 0xde, 0x06, 0x2d, 0x4e, 0xac,
-	0x88, 0x82, 0xa8, 0x70, 0xe9, 0x0d, 0x82, 0x87, 0x31, 0xfb, 0x10, 0x6a, 0xdc, 0x99, 0xf0, 0xd4,
-	0xd3, 0x76, 0x16, 0xef, 0x69, 0x0a, 0x32, 0xdb, 0x84, 0x7a, 0x6c, 0x9f, 0xf3, 0xa9, 0xd5, 0xad,
-	0xe6, 0x13, 0x07, 0x84, 0x11, 0x69, 0x9f, 0x29, 0xe9, 0xec, 0x03, 0xa8, 0x21, 0xa7, 0x63, 0x59,
-	0xa7, 0x50, 0x65, 0x83, 0x4c, 0x95, 0xd3, 0x04, 0x11, 0xf5, 0xc2, 0x89, 0x82, 0x70, 0x14, 0x84,
-	0xc4, 0xb3, 0xd5, 0xed, 0x1b, 0xe4, 0x30, 0xd2, 0xaf, 0xd9, 0xda, 0x8f, 0x82, 0xf0, 0x38, 0x34,
-	0xeb, 0x0e, 0xfd, 0x62, 0x49, 0x4a, 0xd3, 0x85, 0x7c, 0x85, 0x87, 0xd5, 0x11, 0x23, 0x5a, 0x10,
-	0x9b, 0xa0, 0x4d, 0x79, 0x62, 0x39, 0x56, 0x62, 0x49, 0x47, 0xdb, 0x12, 0xfe, 0x47, 0xe0, 0xcc,
-	0x8c, 0x6a, 0xdc, 0x87, 0xba, 0xd8, 0x9a, 0x69, 0x50, 0xed, 0x1f, 0xf7, 0x7b, 0x82, 0xa1, 0x3b,
-	0x87, 0x87, 0x1d, 0x05, 0x51, 0xfb, 0x3b, 0xc3, 0x9d, 0x8e, 0x8a, 0xa3, 0xe1, 0xcf, 0x4f, 0x7a,
-	0x9d, 0x8a, 0xf1, 0x2f, 0x0a, 0x68, 0xe9, 0x3e, 0xec, 0x2b, 0x00, 0xb4, 0xa9, 0xd1, 0xb9, 0xeb,
-	0x67, 0xf9, 0xc8, 0xad, 0xe2, 0x49, 0x5b, 0x27, 0x11, 0x77, 0x1e, 0x23, 0x55, 0x44, 0x26, 0x32,
-	0x41, 0x82, 0xd7, 0x06, 0xb0, 0x5a, 0x26, 0x2e, 0x49, 0xcc, 0xee, 0x16, 0x5d, 0xf4, 0xea, 0xf6,
-	0x9b, 0xa5, 0xad, 0x71, 0x25, 0x29, 0x6a, 0xc1, 0x5b, 0xdf, 0x03, 0x2d, 0x45, 0xb3, 0x26, 0x34,
-	0xf6, 0x7b, 0x0f, 0x77, 0x4e, 0x0f, 0x51, 0x49, 0x00, 0xea, 0x83, 0x83, 0xfe, 0xa3, 0xc3, 0x9e,
-	0xf8, 0xac, 0xc3, 0x83, 0xc1, 0xb0, 0xa3, 0x1a, 0x7f, 0xa5, 0x80, 0x96, 0x86, 0x7f, 0xf6, 0x31,
-	0xc6, 0x6d, 0xca, 0x32, 0xa4, 0x5b, 0xa7, 0x4e, 0x42, 0xa1, 0x0e, 0x32, 0x53, 0x3a, 0x2a, 0x3d,
-	0x79, 0xa9, 0x34, 0x21, 0x20, 0xa0, 0x58, 0x85, 0x55, 0x4a, 0x8d, 0x00, 0x2c, 0x28, 0x03, 0x9f,
-	0xcb, 0xfc, 0x8e, 0xc6, 0xa4, 0x83, 0xae, 0x6f, 0x93, 0x27, 0xa8, 0x49, 0x1d, 0x44, 0x78, 0x18,
-	0x1b, 0xbf, 0x52, 0x61, 0xd5, 0xe4, 0x71, 0x12, 0x44, 0xdc, 0xe4, 0x7f, 0x30, 0xc3, 0x2a, 0xf9,
-	0x15, 0xca, 0xfc, 0x2e, 0x40, 0x24, 0x26, 0xe7, 0xea, 0xac, 0x4b, 0x8c, 0xc8, 0xb0, 0xbd, 0xc0,
-	0x26, 0x2d, 0x92, 0x8e, 0x3f, 0x83, 0xd9, 0x2d, 0xd0, 0xc7, 0x96, 0x7d, 0x21, 0xb6, 0x15, 0xee,
-	0x5f, 0x13, 0x08, 0xb1, 0xaf, 0x65, 0xdb, 0x3c, 0x8e, 0x47, 0x28, 0x14, 0x11, 0x04, 0x74, 0x81,
-	0x79, 0xc2, 0xaf, 0x90, 0x1c, 0x73, 0x3b, 0xe2, 0x09, 0x91, 0x85, 0xf1, 0xeb, 0x02, 0x83, 0xe4,
-	0xf7, 0xa1, 0x1d, 0xf3, 0x18, 0x03, 0xc6, 0x28, 0x09, 0x2e, 0xb8, 0x2f, 0x3d, 0x41, 0x4b, 0x22,
-	0x87, 0x88, 0x43, 0x1f, 0x6d, 0xf9, 0x81, 0x7f, 0x35, 0x0d, 0x66, 0xb1, 0x74, 0xae, 0x39, 0x02,
-	0xbf, 0xf9, 0x82, 0x5f, 0x8d, 0xce, 0x5c, 0x8f, 0xcb, 0xc4, 0xae, 0x71, 0xc1, 0xaf, 0x1e, 0xba,
-	0x1e, 0x37, 0xfe, 0x4f, 0x05, 0x2d, 0xcb, 0x8a, 0xef, 0x82, 0x3e, 0x4d, 0xed, 0x44, 0x46, 0xdb,
-	0x76, 0xc9, 0x78, 0xcc, 0x9c, 0xce, 0xde, 0x05, 0xf5, 0xe2, 0x52, 0xda, 0x6c, 0x7b, 0x4b, 0x34,
-	0x06, 0xc3, 0xf1, 0xf6, 0xd6, 0x93, 0xa7, 0xa6, 0x7a, 0x71, 0x99, 0x47, 0xed, 0xda, 0x6b, 0xa3,
-	0xf6, 0x47, 0x70, 0xcd, 0xf6, 0xb8, 0xe5, 0x8f, 0xf2, 0x30, 0x23, 0xb8, 0xb0, 0x4a, 0xe8, 0x93,
-	0x2c, 0xd6, 0x48, 0xb5, 0x6e, 0xe4, 0x6a, 0x7d, 0x07, 0x6a, 0x0e, 0xf7, 0x12, 0xab, 0xd8, 0xb1,
-	0x3a, 0x8e, 0x2c, 0xdb, 0xe3, 0xfb, 0x88, 0x36, 0x05, 0x15, 0xad, 0x38, 0xcd, 0xdc, 0x8b, 0x56,
-	0x9c, 0x2a, 0xac, 0x99, 0x51, 0x73, 0x7d, 0x84, 0xa2, 0x3e, 0xde, 0x85, 0xeb, 0x7c, 0x1e, 0x92,
-	0xeb, 0x1a, 0x65, 0x55, 0x56, 0x93, 0x66, 0x74, 0x52, 0xc2, 0x9e, 0xc4, 0xb3, 0x4f, 0x51, 0x79,
-	0x49, 0x69, 0xba, 0x2d, 0x3a, 0x8b, 0x91, 0xf6, 0x97, 0xd4, 0xd0, 0x4c, 0xa7, 0x18, 0x5f, 0x40,
-	0xe5, 0xc9, 0xd3, 0x81, 0xe4, 0xa6, 0xf2, 0x32, 0x6e, 0xa6, 0x7a, 0xaf, 0xe6, 0x7a, 0x6f, 0xfc,
-	0x6f, 0x05, 0x1a, 0x32, 0xd2, 0x20, 0x67, 0x66, 0x59, 0xc9, 0x8f, 0xc3, 0x72, 0xa6, 0x9d, 0x85,
-	0xac, 0x62, 0xff, 0xb4, 0xf2, 0xfa, 0xfe, 0x29, 0xfb, 0x0a, 0x5a, 0xa1, 0xa0, 0x15, 0x83, 0xdc,
-	0x5b, 0xc5, 0x35, 0xf2, 0x97, 0xd6, 0x35, 0xc3, 0x1c, 0x40, 0xad, 0xa3, 0xe6, 0x52, 0x62, 0x4d,
-	0x48, 0x09, 0x5a, 0x66, 0x03, 0xe1, 0xa1, 0x35, 0x79, 0x49, 0xa8, 0xfb, 0x01, 0x11, 0x8b, 0xad,
-	0x52, 0xe8, 0x6b, 0x91, 0xe1, 0x62, 0x94, 0x2b, 0x06, 0xa0, 0x76, 0x39, 0x00, 0xdd, 0x02, 0xdd,
-	0x0e, 0xa6, 0x53, 0x97, 0x68, 0xab, 0xb2, 0x24, 0x26, 0xc4, 0x30, 0x36, 0xfe, 0x4c, 0x81, 0x86,
-	0xfc, 0xda, 0x17, 0xdc, 0xdb, 0xee, 0x41, 0x7f, 0xc7, 0xfc, 0x79, 0x47, 0x41, 0xf7, 0x7d, 0xd0,
-	0x1f, 0x76, 0x54, 0xa6, 0x43, 0xed, 0xe1, 0xe1, 0xf1, 0xce, 0xb0, 0x53, 0x41, 0x97, 0xb7, 0x7b,
-	0x7c, 0x7c, 0xd8, 0xa9, 0xb2, 0x16, 0x68, 0xfb, 0x3b, 0xc3, 0xde, 0xf0, 0xe0, 0xa8, 0xd7, 0xa9,
-	0xe1, 0xdc, 0x47, 0xbd, 0xe3, 0x4e, 0x1d, 0x07, 0xa7, 0x07, 0xfb, 0x9d, 0x06, 0xd2, 0x4f, 0x76,
-	0x06, 0x83, 0x6f, 0x8e, 0xcd, 0xfd, 0x8e, 0x46, 0x6e, 0x73, 0x68, 0x1e, 0xf4, 0x1f, 0x75, 0x74,
-	0x1c, 0x1f, 0xef, 0x7e, 0xdd, 0xdb, 0x1b, 0x76, 0xc0, 0xf8, 0x0c, 0x9a, 0x05, 0x0e, 0xe2, 0x6a,
-	0xb3, 0xf7, 0xb0, 0xb3, 0x82, 0x47, 0x3e, 0xdd, 0x39, 0x3c, 0x45, 0x2f, 0xbb, 0x0a, 0x40, 0xc3,
-	0xd1, 0xe1, 0x4e, 0xff, 0x51, 0x47, 0x35, 0x7e, 0x06, 0xda, 0xa9, 0xeb, 0xec, 0x7a, 0x81, 0x7d,
-	0x81, 0x8a, 0x31, 0xb6, 0x62, 0x2e, 0xb3, 0x71, 0x1a, 0x63, 0x66, 0x43, 0x6a, 0x1f, 0x4b, 0xd9,
-	0x4b, 0x08, 0x79, 0xe5, 0xcf, 0xa6, 0x23, 0xea, 0xb9, 0x57, 0x84, 0xeb, 0xf3, 0x67, 0xd3, 0x53,
-	0xd7, 0x89, 0x8d, 0x3e, 0x34, 0x4e, 0x5d, 0xe7, 0xc4, 0xb2, 0x2f, 0xd0, 0x1d, 0x8d, 0x71, 0xeb,
-	0x51, 0xec, 0x7e, 0xc7, 0xa5, 0x8b, 0xd4, 0x09, 0x33, 0x70, 0xbf, 0xe3, 0xec, 0x03, 0xa8, 0x13,
-	0x90, 0x56, 0x5e, 0x64, 0x48, 0xe9, 0x75, 0x4c, 0x49, 0x33, 0xfe, 0x5c, 0xc9, 0x3e, 0x8b, 0x9a,
-	0xaa, 0xeb, 0x50, 0x0d, 0x2d, 0xfb, 0x42, 0x86, 0x83, 0xa6, 0x5c, 0x83, 0xe7, 0x99, 0x44, 0x60,
-	0x1f, 0x81, 0x26, 0x75, 0x27, 0xdd, 0xb8, 0x59, 0x50, 0x32, 0x33, 0x23, 0x96, 0xa5, 0x5a, 0x29,
-	0x4b, 0x95, 0x32, 0xf3, 0xd0, 0x73, 0xa9, 0x3f, 0x56, 0xc1, 0xb0, 0x21, 0x20, 0xe3, 0x27, 0x00,
-	0x79, 0x1f, 0x7b, 0x49, 0x74, 0xbc, 0x01, 0x35, 0xcb, 0x73, 0xad, 0x34, 0xd3, 0x17, 0x80, 0xd1,
-	0x87, 0x66, 0xa1, 0xfb, 0x8d, 0xec, 0xb3, 0x3c, 0x0f, 0x9d, 0x74, 0x4c, 0x6b, 0x35, 0xb3, 0x61,
-	0x79, 0xde, 0x13, 0x7e, 0x15, 0x63, 0x66, 0x22, 0x1a, 0xe7, 0xea, 0x42, 0xcf, 0x95, 0x96, 0x9a,
-	0x82, 0x68, 0x7c, 0x0a, 0x75, 0xd1, 0x88, 0x2d, 0x68, 0xba, 0xf2, 0xd2, 0xdc, 0xec, 0x4b, 0x79,
-	0x67, 0x6a, 0xdb, 0xb2, 0xbb, 0xb2, 0x41, 0x1f, 0x8b, 0xe7, 0x00, 0x25, 0xaf, 0x15, 0xc5, 0x24,
-	0xd9, 0x9b, 0xa7, 0xc9, 0xc6, 0x3e, 0x68, 0xaf, 0x7c, 0xf2, 0x90, 0x0c, 0x50, 0x73, 0x06, 0x2c,
-	0x79, 0x04, 0x31, 0x7e, 0x01, 0x90, 0x37, 0xf2, 0xa5, 0xe1, 0x89, 0x5d, 0xd0, 0xf0, 0x3e, 0x01,
-	0xcd, 0x3e, 0x77, 0x3d, 0x27, 0xe2, 0x7e, 0xe9, 0xab, 0xf3, 0xd6, 0x7f, 0x46, 0x67, 0x1b, 0x50,
-	0xa5, 0xf7, 0x89, 0x4a, 0xee, 0x7a, 0xb3, 0xc7, 0x09, 0xa2, 0x18, 0x73, 0x68, 0x8b, 0x94, 0xef,
-	0x07, 0x84, 0xe9, 0xdb, 0x22, 0x55, 0xa2, 0x90, 0x90, 0xbe, 0xb4, 0x14, 0x30, 0xa8, 0x04, 0x67,
-	0x2e, 0xf7, 0x9c, 0xf4, 0x6b, 0x24, 0x84, 0x42, 0x16, 0xe9, 0x63, 0x55, 0x34, 0x9e, 0x09, 0x30,
-	0xfe, 0x44, 0x05, 0x10, 0x47, 0xf7, 0x03, 0x87, 0x97, 0x8b, 0x1d, 0x65, 0xb1, 0xd8, 0x61, 0x50,
-	0xcd, 0x9e, 0x9e, 0x74, 0x93, 0xc6, 0x79, 0xc4, 0x90, 0x05, 0x90, 0x88, 0x18, 0xef, 0x80, 0x4e,
-	0xd1, 0xda, 0xfd, 0x8e, 0xfa, 0xa5, 0x78, 0x60, 0x8e, 0x28, 0x3e, 0xc4, 0xd4, 0xca, 0x0f, 0x31,
-	0x59, 0xb7, 0xba, 0x2e, 0x76, 0x13, 0xdd, 0xea, 0x25, 0x8d, 0x77, 0x51, 0x3d, 0xc6, 0x3c, 0x4a,
-	0xd2, 0x62, 0x4a, 0x40, 0x59, 0xc1, 0xa0, 0xcb, 0xb9, 0x58, 0x30, 0xac, 0x43, 0xd3, 0x0f, 0x46,
-	0x76, 0xe0, 0x9f, 0x79, 0xae, 0x9d, 0xc8, 0x87, 0x17, 0xf0, 0x83, 0x3d, 0x89, 0x31, 0xbe, 0x82,
-	0x56, 0xca, 0x7f, 0xea, 0x6f, 0x7f, 0x92, 0x25, 0xe5, 0x4a, 0x2e, 0xdb, 0x9c, 0x4d, 0xbb, 0x6a,
-	0x57, 0x49, 0xd3, 0x72, 0xe3, 0xbf, 0x2b, 0xe9, 0x62, 0xd9, 0xa6, 0x7d, 0x35, 0x0f, 0xcb, 0x55,
-	0x93, 0xfa, 0x83, 0xaa, 0xa6, 0x2f, 0x40, 0x77, 0xa8, 0x74, 0x70, 0x2f, 0xd3, 0xb8, 0xb5, 0xb6,
-	0x58, 0x26, 0xc8, 0xe2, 0xc2, 0xbd, 0xe4, 0x66, 0x3e, 0xf9, 0x35, 0x72, 0xc8, 0xb8, 0x5d, 0x5b,
-	0xc6, 0xed, 0xfa, 0xaf, 0xc9, 0xed, 0xf7, 0xa0, 0xe5, 0x07, 0xfe, 0xc8, 0x9f, 0x79, 0x1e, 0xd6,
-	0xdc, 0x92, 0xdd, 0x4d, 0x3f, 0xf0, 0xfb, 0x12, 0xc5, 0x3e, 0x81, 0xeb, 0xc5, 0x29, 0xc2, 0xa8,
-	0x9b, 0x34, 0xef, 0x5a, 0x61, 0x1e, 0x99, 0xfe, 0x26, 0x74, 0x82, 0xf1, 0x2f, 0xb8, 0x9d, 0x10,
-	0xc7, 0x46, 0x64, 0xcd, 0x2d, 0x91, 0x1f, 0x09, 0x3c, 0xb2, 0xa8, 0x8f, 0x76, 0xbd, 0x20, 0xe6,
-	0xf6, 0x0b, 0x62, 0xfe, 0x12, 0xf4, 0x8c, 0x4b, 0x85, 0x32, 0x45, 0x87, 0xda, 0x41, 0x7f, 0xbf,
-	0xf7, 0x7b, 0x1d, 0x05, 0x63, 0xa1, 0xd9, 0x7b, 0xda, 0x33, 0x07, 0xbd, 0x8e, 0x8a, 0x71, 0x6a,
-	0xbf, 0x77, 0xd8, 0x1b, 0xf6, 0x3a, 0x95, 0xaf, 0xab, 0x5a, 0xa3, 0xa3, 0x51, 0xb3, 0xd5, 0x73,
-	0x6d, 0x37, 0x31, 0x06, 0x00, 0x79, 0xed, 0x85, 0x5e, 0x39, 0xbf, 0x9c, 0xec, 0xa4, 0x24, 0xe9,
-	0xb5, 0x36, 0x33, 0x83, 0x54, 0x5f, 0x56, 0xe1, 0x09, 0xba, 0xb1, 0x0d, 0xfa, 0x91, 0x15, 0x3e,
-	0x16, 0xef, 0x0a, 0x77, 0x60, 0x35, 0xb4, 0xa2, 0xc4, 0x45, 0x37, 0x91, 0x7a, 0xdd, 0xca, 0x66,
-	0xcb, 0x6c, 0x67, 0x58, 0xf4, 0xbd, 0xc6, 0x29, 0x68, 0x47, 0x56, 0xf8, 0x42, 0xdd, 0xd3, 0xca,
-	0xda, 0x99, 0x33, 0xf9, 0xea, 0x21, 0x13, 0xa3, 0x3b, 0xd0, 0x90, 0xc1, 0x44, 0xfa, 0xa3, 0x52,
-	0xa0, 0x49, 0x69, 0xc6, 0xdf, 0x2b, 0x70, 0xe3, 0x28, 0xb8, 0xe4, 0x59, 0xf6, 0x79, 0x62, 0x5d,
-	0x79, 0x81, 0xe5, 0xbc, 0x46, 0xbb, 0x31, 0x99, 0x0f, 0x66, 0xf4, 0xb0, 0x90, 0x3e, 0xb6, 0x98,
-	0xba, 0xc0, 0x3c, 0x92, 0xaf, 0xbd, 0x3c, 0x4e, 0x88, 0x28, 0x43, 0x30, 0xc2, 0x48, 0x7a, 0x13,
-	0xea, 0xc9, 0xdc, 0xcf, 0xdf, 0x76, 0x6a, 0x09, 0xb5, 0x0f, 0x97, 0xa6, 0x9e, 0xb5, 0xe5, 0xa9,
-	0xa7, 0xb1, 0x07, 0xfa, 0x70, 0x4e, 0xad, 0x35, 0x91, 0xf5, 0x67, 0xa9, 0x91, 0xf2, 0x8a, 0xd4,
-	0x48, 0x5d, 0x48, 0x8d, 0xfe, 0x53, 0x81, 0x66, 0x21, 0x87, 0x66, 0xef, 0x41, 0x35, 0x99, 0xfb,
-	0xe5, 0x17, 0xd4, 0xf4, 0x10, 0x93, 0x48, 0xa8, 0xf1, 0x53, 0x6b, 0x3e, 0xb2, 0xe2, 0xd8, 0x9d,
-	0xf8, 0xdc, 0x91, 0x5b, 0x36, 0xa7, 0xd6, 0x7c, 0x47, 0xa2, 0xd8, 0x21, 0x5c, 0x13, 0x0e, 0x3d,
-	0xfd, 0x88, 0xb4, 0x31, 0xf0, 0xfe, 0x42, 0xce, 0x2e, 0xda, 0x8f, 0xe9, 0x27, 0xc9, 0x6a, 0x77,
-	0x75, 0x52, 0x42, 0xae, 0xed, 0xc0, 0x1b, 0x4b, 0xa6, 0xfd, 0xa8, 0x86, 0xf3, 0x3a, 0xb4, 0x87,
-	0x73, 0x7f, 0xe8, 0x4e, 0x79, 0x9c, 0x58, 0xd3, 0x90, 0x52, 0x4b, 0x19, 0x90, 0xab, 0xa6, 0x9a,
-	0xc4, 0xc6, 0x87, 0xd0, 0x3a, 0xe1, 0x3c, 0x32, 0x79, 0x1c, 0x06, 0xbe, 0x48, 0xab, 0x64, 0xdb,
-	0x4f, 0x44, 0x7f, 0x09, 0x19, 0xbf, 0x0f, 0x3a, 0x96, 0xb6, 0xbb, 0x56, 0x62, 0x9f, 0xff, 0x98,
-	0xd2, 0xf7, 0x43, 0x68, 0x84, 0x42, 0xa7, 0x64, 0xad, 0xd5, 0xa2, 0x2c, 0x40, 0xea, 0x99, 0x99,
-	0x12, 0x8d, 0xcf, 0xe0, 0x8d, 0xc1, 0x6c, 0x1c, 0xdb, 0x91, 0x1b, 0x52, 0xc4, 0x94, 0x11, 0x72,
-	0x0d, 0xb4, 0x30, 0xe2, 0x67, 0xee, 0x9c, 0xa7, 0x86, 0x91, 0xc1, 0xc6, 0x4f, 0xe1, 0x46, 0x79,
-	0x89, 0xfc, 0x84, 0xf7, 0xa1, 0x72, 0x71, 0x19, 0xcb, 0x9b, 0x5d, 0x2f, 0x95, 0x19, 0xf4, 0x70,
-	0x89, 0x54, 0xc3, 0x84, 0x4a, 0x7f, 0x36, 0x2d, 0xfe, 0xf9, 0xa2, 0x2a, 0xfe, 0x7c, 0x71, 0xab,
-	0xd8, 0xa6, 0x13, 0x95, 0x48, 0xde, 0x8e, 0x7b, 0x07, 0xf4, 0xb3, 0x20, 0xfa, 0x43, 0x2b, 0x72,
-	0xb8, 0x23, 0x43, 0x61, 0x8e, 0x30, 0xbe, 0x85, 0x66, 0xaa, 0x09, 0x07, 0x0e, 0xbd, 0xd4, 0x90,
-	0x2a, 0x1e, 0x38, 0x25, 0xcd, 0x14, 0x4d, 0x30, 0xee, 0x3b, 0x07, 0xa9, 0x0a, 0x09, 0xa0, 0x7c,
-	0xb2, 0x6c, 0xb0, 0xa7, 0x27, 0x1b, 0x0f, 0xa1, 0x95, 0x16, 0x72, 0x47, 0x3c, 0xb1, 0x48, 0xb9,
-	0x3d, 0x97, 0xfb, 0x05, 0xc5, 0xd7, 0x04, 0x62, 0x58, 0xee, 0x65, 0xa9, 0xa5, 0xbc, 0xc2, 0xd8,
-	0x82, 0xba, 0xb4, 0x1c, 0x06, 0x55, 0x3b, 0x70, 0x84, 0x75, 0xd7, 0x4c, 0x1a, 0x23, 0x3b, 0xa6,
-	0xf1, 0x24, 0xcd, 0x99, 0xa6, 0xf1, 0xc4, 0xf8, 0x47, 0x15, 0xda, 0xbb, 0x54, 0xe3, 0xa7, 0x22,
-	0x29, 0xb4, 0x2d, 0x94, 0x52, 0xdb, 0xa2, 0xd8, 0xa2, 0x50, 0x4b, 0x2d, 0x8a, 0xd2, 0x85, 0x2a,
-	0xe5, 0x44, 0xe7, 0x2d, 0x68, 0xcc, 0x7c, 0x77, 0x9e, 0xba, 0x04, 0xdd, 0xac, 0x23, 0x38, 0x8c,
-	0xd9, 0x06, 0x34, 0xd1, 0x6b, 0xb8, 0xbe, 0x68, 0x46, 0x88, 0x8e, 0x42, 0x11, 0xb5, 0xd0, 0x72,
-	0xa8, 0xbf, 0xba, 0xe5, 0xd0, 0x78, 0x6d, 0xcb, 0x41, 0x7b, 0x5d, 0xcb, 0x41, 0x5f, 0x6c, 0x39,
-	0x94, 0x93, 0x34, 0x58, 0x4c, 0xd2, 0x8c, 0x04, 0xda, 0xbd, 0x79, 0x48, 0x0f, 0xea, 0xaf, 0x4d,
-	0xf8, 0x0a, 0x6c, 0x55, 0x4b, 0x6c, 0x2d, 0x30, 0xa8, 0x22, 0x3b, 0xe8, 0x82, 0x41, 0x98, 0x02,
-	0x06, 0xd1, 0xd4, 0x4a, 0x52, 0xc6, 0x09, 0xc8, 0xf8, 0x0b, 0x15, 0x74, 0x21, 0x32, 0xfc, 0xcc,
-	0x8f, 0x65, 0x36, 0xa7, 0xe4, 0x2d, 0xb1, 0x8c, 0xb8, 0xf5, 0x84, 0x5f, 0x51, 0x16, 0x22, 0x92,
-	0xbc, 0x65, 0x4d, 0x61, 0x19, 0x5a, 0x44, 0x0d, 0x42, 0xa1, 0xe5, 0x16, 0xe8, 0xc2, 0xe3, 0xce,
-	0xdc, 0xf4, 0x95, 0x48, 0xb8, 0xe0, 0x53, 0x97, 0x9e, 0xd4, 0x13, 0x1e, 0x4d, 0xa5, 0xb4, 0x68,
-	0x5c, 0xce, 0xf6, 0xda, 0x32, 0xff, 0x30, 0xce, 0xa1, 0x21, 0x4f, 0xc7, 0x70, 0x7c, 0xda, 0x7f,
-	0xd2, 0x3f, 0xfe, 0xa6, 0xdf, 0x59, 0xc9, 0x9a, 0x88, 0x4a, 0x1e, 0xb0, 0xd5, 0x62, 0xc0, 0xae,
-	0x20, 0x7e, 0xef, 0xf8, 0xb4, 0x3f, 0xec, 0x54, 0x59, 0x1b, 0x74, 0x1a, 0x8e, 0xcc, 0xde, 0xd3,
-	0x4e, 0x8d, 0xca, 0xcf, 0xbd, 0xc7, 0xbd, 0xa3, 0x9d, 0x4e, 0x3d, 0x6b, 0x41, 0x36, 0x8c, 0x3f,
-	0x55, 0xe0, 0xba, 0xf8, 0xe4, 0x62, 0xb1, 0x56, 0xfc, 0x5f, 0x56, 0x55, 0xfc, 0x2f, 0xeb, 0x37,
-	0x5b, 0x9f, 0x6d, 0xff, 0x93, 0x02, 0x55, 0xf4, 0x91, 0xec, 0x1e, 0xe8, 0x8f, 0xb9, 0x15, 0x25,
-	0x63, 0x6e, 0x25, 0xac, 0xe4, 0x0f, 0xd7, 0x28, 0x05, 0xcd, 0xdf, 0x6e, 0x8c, 0x95, 0x07, 0x0a,
-	0xdb, 0x12, 0xff, 0xae, 0x48, 0xff, 0x34, 0xd2, 0x4e, 0x7d, 0x2d, 0xf9, 0xe2, 0xb5, 0xd2, 0x7a,
-	0x63, 0x65, 0x93, 0xe6, 0x7f, 0x1d, 0xb8, 0xfe, 0x9e, 0xf8, 0x33, 0x00, 0x5b, 0xf4, 0xcd, 0x8b,
-	0x2b, 0xd8, 0x3d, 0xa8, 0x1f, 0xc4, 0x18, 0x04, 0x5e, 0x9c, 0x4a, 0x49, 0x4c, 0x31, 0x3e, 0x18,
-	0x2b, 0xdb, 0x7f, 0x57, 0x81, 0xea, 0xb7, 0x3c, 0x0a, 0xd8, 0xa7, 0xd0, 0x90, 0x2f, 0x5d, 0xac,
-	0xf0, 0xa2, 0xb5, 0x46, 0x69, 0xee, 0xc2, 0x13, 0x18, 0x9d, 0xd2, 0x11, 0x79, 0x50, 0xde, 0x1f,
-	0x63, 0xf9, 0x43, 0xdc, 0x0b, 0x97, 0xfa, 0x12, 0x3a, 0x83, 0x24, 0xe2, 0xd6, 0xb4, 0x30, 0xbd,
-	0xcc, 0xaa, 0x65, 0xcd, 0x36, 0xe2, 0xd7, 0x5d, 0xa8, 0x8b, 0x48, 0xbb, 0xb0, 0x60, 0xb1, 0x6f,
-	0x46, 0x93, 0x3f, 0x82, 0xe6, 0xe0, 0x3c, 0x98, 0x79, 0xce, 0x80, 0x47, 0x97, 0x9c, 0x15, 0xde,
-	0xae, 0xd7, 0x0a, 0x63, 0x63, 0x85, 0x6d, 0x02, 0x08, 0xe7, 0x7e, 0x8a, 0x9a, 0xd2, 0x40, 0x5a,
-	0x7f, 0x36, 0x15, 0x9b, 0x16, 0xbc, 0xbe, 0x98, 0x59, 0x08, 0xb8, 0xaf, 0x9a, 0xf9, 0x39, 0xb4,
-	0xf7, 0x48, 0x6b, 0x8e, 0xa3, 0x9d, 0x71, 0x10, 0x25, 0x6c, 0xf1, 0xfd, 0x7a, 0x6d, 0x11, 0x61,
-	0xac, 0xb0, 0x07, 0xa0, 0x0d, 0xa3, 0x2b, 0x31, 0xff, 0xba, 0xcc, 0x53, 0xf2, 0xf3, 0x96, 0x7c,
-	0xe5, 0xf6, 0x5f, 0x56, 0xa1, 0xfe, 0x4d, 0x10, 0x5d, 0xf0, 0x08, 0x4b, 0x1e, 0xea, 0x73, 0x4a,
-	0x35, 0xca, 0x7a, 0x9e, 0xcb, 0x0e, 0xfa, 0x00, 0x74, 0x62, 0xca, 0xd0, 0x8a, 0x2f, 0x84, 0xa8,
-	0xe8, 0x3f, 0x81, 0x82, 0x2f, 0xa2, 0x84, 0x22, 0xb9, 0xae, 0x0a, 0x41, 0x65, 0x8d, 0xf1, 0x52,
-	0xd7, 0x71, 0x8d, 0xbe, 0xff, 0xc9, 0xd3, 0x01, 0xaa, 0xe6, 0x03, 0x05, 0xdd, 0xd1, 0x40, 0x7c,
-	0x29, 0x4e, 0xca, 0xff, 0x0b, 0x25, 0x34, 0x3f, 0xff, 0xf3, 0x91, 0xb1, 0xc2, 0xee, 0x43, 0x5d,
-	0xe4, 0xcf, 0xe2, 0x33, 0x4b, 0xa5, 0xf3, 0x5a, 0xa7, 0x88, 0x92, 0x0b, 0x3e, 0x86, 0xba, 0xb0,
-	0x73, 0xb1, 0xa0, 0x14, 0xb6, 0xc4, 0xad, 0x45, 0xe8, 0x33, 0x56, 0xd8, 0x5d, 0x68, 0xc8, 0x5e,
-	0x25, 0x5b, 0xd2, 0xb8, 0x5c, 0x98, 0xfc, 0x31, 0xd4, 0x85, 0x1b, 0x17, 0xfb, 0x96, 0x5c, 0xfa,
-	0xc2, 0xd4, 0x7b, 0xd0, 0x31, 0xb9, 0xcd, 0xdd, 0x42, 0x4a, 0xcd, 0x52, 0x0e, 0x2c, 0x31, 0xd5,
-	0x2f, 0xa1, 0x5d, 0x4a, 0xbf, 0x59, 0x97, 0xa4, 0xb2, 0x24, 0x23, 0x7f, 0xc1, 0x40, 0x7e, 0x0a,
-	0xba, 0xcc, 0x7e, 0xc6, 0x9c, 0x51, 0xaf, 0x72, 0x49, 0xfe, 0xb4, 0xf6, 0x62, 0xfa, 0x83, 0x5a,
-	0xbf, 0xdb, 0xf9, 0xe7, 0xef, 0x6f, 0x2b, 0xff, 0xfa, 0xfd, 0x6d, 0xe5, 0xdf, 0xbf, 0xbf, 0xad,
-	0xfc, 0xf2, 0x3f, 0x6e, 0xaf, 0x8c, 0xeb, 0xf4, 0xaf, 0xd5, 0xcf, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0xce, 0x1d, 0xe7, 0x2b, 0x2b, 0x00, 0x00,
+func (m *RaftBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftBatch.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RaftClient is the client API for Raft service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RaftClient interface {
-	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
-	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
-	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
-	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
+func (m *RaftBatch) XXX_Size() int {
+	return m.Size()
 }
-
-type raftClient struct {
-	cc *grpc.ClientConn
+func (m *RaftBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftBatch.DiscardUnknown(m)
 }
 
-func NewRaftClient(cc *grpc.ClientConn) RaftClient {
-	return &raftClient{cc}
-}
+var xxx_messageInfo_RaftBatch proto.InternalMessageInfo
 
-func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &raftHeartbeatClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RaftBatch) GetContext() *RaftContext {
+	if m != nil {
+		return m.Context
 	}
-	return x, nil
+	return nil
 }
 
-type Raft_HeartbeatClient interface {
-	Recv() (*HealthInfo, error)
-	grpc.ClientStream
+func (m *RaftBatch) GetPayload() *api.Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-type raftHeartbeatClient struct {
-	grpc.ClientStream
+type SubscriptionRequest struct {
+	Prefixes             [][]byte `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
-	m := new(HealthInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SubscriptionRequest) Reset()         { *m = SubscriptionRequest{} }
+func (m *SubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRequest) ProtoMessage()    {}
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{58}
 }
-
-func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &raftRaftMessageClient{stream}
-	return x, nil
 }
-
-type Raft_RaftMessageClient interface {
-	Send(*RaftBatch) error
-	CloseAndRecv() (*api.Payload, error)
-	grpc.ClientStream
+func (m *SubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRequest.Merge(m, src)
 }
-
-type raftRaftMessageClient struct {
-	grpc.ClientStream
+func (m *SubscriptionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
-	return x.ClientStream.SendMsg(m)
+func (m *SubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRequest.DiscardUnknown(m)
 }
 
-func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(api.Payload)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_SubscriptionRequest proto.InternalMessageInfo
 
-func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscriptionRequest) GetPrefixes() [][]byte {
+	if m != nil {
+		return m.Prefixes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
-	out := new(PeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SubscriptionResponse struct {
+	Kvs                  *pb.KVList `protobuf:"bytes,1,opt,name=kvs,proto3" json:"kvs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-// RaftServer is the server API for Raft service.
-type RaftServer interface {
-	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
-	RaftMessage(Raft_RaftMessageServer) error
-	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
-	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
+func (m *SubscriptionResponse) Reset()         { *m = SubscriptionResponse{} }
+func (m *SubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionResponse) ProtoMessage()    {}
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{59}
 }
-
-// UnimplementedRaftServer can be embedded to have forward compatible implementations.
-type UnimplementedRaftServer struct {
+func (m *SubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
-	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+func (m *SubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
-	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
+func (m *SubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionResponse.Merge(m, src)
 }
-func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+func (m *SubscriptionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
+func (m *SubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionResponse.DiscardUnknown(m)
 }
 
-func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
-	s.RegisterService(&_Raft_serviceDesc, srv)
-}
+var xxx_messageInfo_SubscriptionResponse proto.InternalMessageInfo
 
-func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *SubscriptionResponse) GetKvs() *pb.KVList {
+	if m != nil {
+		return m.Kvs
 	}
-	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
+	return nil
 }
 
-type Raft_HeartbeatServer interface {
-	Send(*HealthInfo) error
-	grpc.ServerStream
+type Num struct {
+	Val                  uint64   `protobuf:"varint,1,opt,name=val,proto3" json:"val,omitempty"`
+	ReadOnly             bool     `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	Forwarded            bool     `protobuf:"varint,3,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type raftHeartbeatServer struct {
-	grpc.ServerStream
+func (m *Num) Reset()         { *m = Num{} }
+func (m *Num) String() string { return proto.CompactTextString(m) }
+func (*Num) ProtoMessage()    {}
+func (*Num) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{60}
 }
-
-func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Num) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
+func (m *Num) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Num.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type Raft_RaftMessageServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*RaftBatch, error)
-	grpc.ServerStream
+func (m *Num) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Num.Merge(m, src)
 }
-
-type raftRaftMessageServer struct {
-	grpc.ServerStream
+func (m *Num) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Num) XXX_DiscardUnknown() {
+	xxx_messageInfo_Num.DiscardUnknown(m)
 }
 
-func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
-	m := new(RaftBatch)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_Num proto.InternalMessageInfo
+
+func (m *Num) GetVal() uint64 {
+	if m != nil {
+		return m.Val
 	}
-	return m, nil
+	return 0
 }
 
-func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RaftServer).JoinCluster(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/JoinCluster",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
+func (m *Num) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RaftServer).IsPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/IsPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
+func (m *Num) GetForwarded() bool {
+	if m != nil {
+		return m.Forwarded
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Raft_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Raft",
-	HandlerType: (*RaftServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "JoinCluster",
-			Handler:    _Raft_JoinCluster_Handler,
-		},
-		{
-			MethodName: "IsPeer",
-			Handler:    _Raft_IsPeer_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Heartbeat",
-			Handler:       _Raft_Heartbeat_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "RaftMessage",
-			Handler:       _Raft_RaftMessage_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
+type AssignedIds struct {
+	StartId uint64 `protobuf:"varint,1,opt,name=startId,proto3" json:"startId,omitempty"`
+	EndId   uint64 `protobuf:"varint,2,opt,name=endId,proto3" json:"endId,omitempty"`
+	// The following is used for read only transactions.
+	ReadOnly             uint64   `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// ZeroClient is the client API for Zero service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ZeroClient interface {
-	// These 3 endpoints are for handling membership.
-	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
-	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
-	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
-	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
-	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
-	AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
-	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
+func (m *AssignedIds) Reset()         { *m = AssignedIds{} }
+func (m *AssignedIds) String() string { return proto.CompactTextString(m) }
+func (*AssignedIds) ProtoMessage()    {}
+func (*AssignedIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{61}
 }
-
-type zeroClient struct {
-	cc *grpc.ClientConn
+func (m *AssignedIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
-	return &zeroClient{cc}
+func (m *AssignedIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignedIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignedIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignedIds.Merge(m, src)
+}
+func (m *AssignedIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignedIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignedIds.DiscardUnknown(m)
 }
 
-func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
-	out := new(ConnectionState)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignedIds proto.InternalMessageInfo
+
+func (m *AssignedIds) GetStartId() uint64 {
+	if m != nil {
+		return m.StartId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignedIds) GetEndId() uint64 {
+	if m != nil {
+		return m.EndId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &zeroStreamMembershipClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *AssignedIds) GetReadOnly() uint64 {
+	if m != nil {
+		return m.ReadOnly
 	}
-	return x, nil
+	return 0
 }
 
-type Zero_StreamMembershipClient interface {
-	Recv() (*MembershipState, error)
-	grpc.ClientStream
+type SnapshotMeta struct {
+	ClientTs             uint64   `protobuf:"varint,1,opt,name=client_ts,json=clientTs,proto3" json:"client_ts,omitempty"`
+	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type zeroStreamMembershipClient struct {
-	grpc.ClientStream
+func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
+func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
+func (*SnapshotMeta) ProtoMessage()    {}
+func (*SnapshotMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{62}
 }
-
-func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
-	m := new(MembershipState)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &zeroOracleClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SnapshotMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type Zero_OracleClient interface {
-	Recv() (*OracleDelta, error)
-	grpc.ClientStream
+func (m *SnapshotMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotMeta.Merge(m, src)
 }
-
-type zeroOracleClient struct {
-	grpc.ClientStream
+func (m *SnapshotMeta) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
-	m := new(OracleDelta)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SnapshotMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotMeta.DiscardUnknown(m)
 }
 
-func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
-	out := new(Tablet)
-	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotMeta proto.InternalMessageInfo
+
+func (m *SnapshotMeta) GetClientTs() uint64 {
+	if m != nil {
+		return m.ClientTs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/AssignUids", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SnapshotMeta) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Status describes a general status response.
+// code: 0 = success, 0 != failure.
+type Status struct {
+	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{63}
+}
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
 }
 
-func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
-	out := new(OracleDelta)
-	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *Status) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return 0
 }
 
-// ZeroServer is the server API for Zero service.
-type ZeroServer interface {
-	// These 3 endpoints are for handling membership.
-	Connect(context.Context, *Member) (*ConnectionState, error)
-	UpdateMembership(context.Context, *Group) (*api.Payload, error)
-	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
-	Oracle(*api.Payload, Zero_OracleServer) error
-	ShouldServe(context.Context, *Tablet) (*Tablet, error)
-	AssignUids(context.Context, *Num) (*AssignedIds, error)
-	Timestamps(context.Context, *Num) (*AssignedIds, error)
-	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
-	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
+func (m *Status) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-// UnimplementedZeroServer can be embedded to have forward compatible implementations.
-type UnimplementedZeroServer struct {
+type BackupRequest struct {
+	ReadTs       uint64   `protobuf:"varint,1,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	SinceTs      uint64   `protobuf:"varint,2,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	GroupId      uint32   `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UnixTs       string   `protobuf:"bytes,4,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
+	Destination  string   `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	AccessKey    string   `protobuf:"bytes,6,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey    string   `protobuf:"bytes,7,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	SessionToken string   `protobuf:"bytes,8,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Anonymous    bool     `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Predicates   []string `protobuf:"bytes,10,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// If set, only the selected predicates and types are backed up.
+	Filter               *PredicateFilter `protobuf:"bytes,11,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
-}
-func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
-}
-func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
-}
-func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
-	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{64}
 }
-func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedZeroServer) AssignUids(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignUids not implemented")
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
 }
-func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
+func (m *BackupRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
 }
 
-func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
-	s.RegisterService(&_Zero_serviceDesc, srv)
-}
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
 
-func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Member)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Connect(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Connect",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Connect(ctx, req.(*Member))
+func (m *BackupRequest) GetReadTs() uint64 {
+	if m != nil {
+		return m.ReadTs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).UpdateMembership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/UpdateMembership",
+func (m *BackupRequest) GetSinceTs() uint64 {
+	if m != nil {
+		return m.SinceTs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
+	return 0
+}
+
+func (m *BackupRequest) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *BackupRequest) GetUnixTs() string {
+	if m != nil {
+		return m.UnixTs
 	}
-	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
+	return ""
 }
 
-type Zero_StreamMembershipServer interface {
-	Send(*MembershipState) error
-	grpc.ServerStream
+func (m *BackupRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
 }
 
-type zeroStreamMembershipServer struct {
-	grpc.ServerStream
+func (m *BackupRequest) GetAccessKey() string {
+	if m != nil {
+		return m.AccessKey
+	}
+	return ""
 }
 
-func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
-	return x.ServerStream.SendMsg(m)
+func (m *BackupRequest) GetSecretKey() string {
+	if m != nil {
+		return m.SecretKey
+	}
+	return ""
 }
 
-func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *BackupRequest) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
 	}
-	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
+	return ""
 }
 
-type Zero_OracleServer interface {
-	Send(*OracleDelta) error
-	grpc.ServerStream
+func (m *BackupRequest) GetAnonymous() bool {
+	if m != nil {
+		return m.Anonymous
+	}
+	return false
 }
 
-type zeroOracleServer struct {
-	grpc.ServerStream
+func (m *BackupRequest) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
 }
 
-func (x *zeroOracleServer) Send(m *OracleDelta) error {
-	return x.ServerStream.SendMsg(m)
+func (m *BackupRequest) GetFilter() *PredicateFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tablet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).ShouldServe(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/ShouldServe",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
-	}
-	return interceptor(ctx, in, info, handler)
+type BackupResponse struct {
+	// Size of the backup file written by the group.
+	FileSize uint64 `protobuf:"varint,1,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// Hex-encoded SHA-256 checksum of the backup file.
+	Checksum             string   `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Zero_AssignUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{65}
+}
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).AssignUids(ctx, in)
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetFileSize() uint64 {
+	if m != nil {
+		return m.FileSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/AssignUids",
+	return 0
+}
+
+func (m *BackupResponse) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).AssignUids(ctx, req.(*Num))
+	return ""
+}
+
+// PredicateFilter selects the predicates and types of a selective backup or restore.
+// Exclusions take precedence over inclusions, and everything which isn't excluded is
+// selected if nothing is included. Including or excluding a type also includes or
+// excludes its fields. Type definitions aren't selected if only predicates are included.
+type PredicateFilter struct {
+	IncludePredicates    []string `protobuf:"bytes,1,rep,name=include_predicates,json=includePredicates,proto3" json:"include_predicates,omitempty"`
+	ExcludePredicates    []string `protobuf:"bytes,2,rep,name=exclude_predicates,json=excludePredicates,proto3" json:"exclude_predicates,omitempty"`
+	IncludeTypes         []string `protobuf:"bytes,3,rep,name=include_types,json=includeTypes,proto3" json:"include_types,omitempty"`
+	ExcludeTypes         []string `protobuf:"bytes,4,rep,name=exclude_types,json=excludeTypes,proto3" json:"exclude_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredicateFilter) Reset()         { *m = PredicateFilter{} }
+func (m *PredicateFilter) String() string { return proto.CompactTextString(m) }
+func (*PredicateFilter) ProtoMessage()    {}
+func (*PredicateFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{66}
+}
+func (m *PredicateFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PredicateFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PredicateFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PredicateFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredicateFilter.Merge(m, src)
+}
+func (m *PredicateFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *PredicateFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredicateFilter.DiscardUnknown(m)
 }
 
-func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_PredicateFilter proto.InternalMessageInfo
+
+func (m *PredicateFilter) GetIncludePredicates() []string {
+	if m != nil {
+		return m.IncludePredicates
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Timestamps(ctx, in)
+	return nil
+}
+
+func (m *PredicateFilter) GetExcludePredicates() []string {
+	if m != nil {
+		return m.ExcludePredicates
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Timestamps",
+	return nil
+}
+
+func (m *PredicateFilter) GetIncludeTypes() []string {
+	if m != nil {
+		return m.IncludeTypes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
+	return nil
+}
+
+func (m *PredicateFilter) GetExcludeTypes() []string {
+	if m != nil {
+		return m.ExcludeTypes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.TxnContext)
-	if err := dec(in); err != nil {
-		return nil, err
+type ExportRequest struct {
+	GroupId uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReadTs  uint64 `protobuf:"varint,2,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	UnixTs  int64  `protobuf:"varint,3,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
+	Format  string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// Predicates, if not empty, are the only predicates to export.
+	Predicates []string `protobuf:"bytes,5,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// Types, if not empty, are the only type definitions to export.
+	Types []string `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	// Uids, if set, are the only nodes whose data is exported.
+	Uids                 *List    `protobuf:"bytes,7,opt,name=uids,proto3" json:"uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{67}
+}
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).CommitOrAbort(ctx, in)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/CommitOrAbort",
+	return 0
+}
+
+func (m *ExportRequest) GetReadTs() uint64 {
+	if m != nil {
+		return m.ReadTs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
+	return 0
+}
+
+func (m *ExportRequest) GetUnixTs() int64 {
+	if m != nil {
+		return m.UnixTs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxnTimestamps)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).TryAbort(ctx, in)
+	return ""
+}
+
+func (m *ExportRequest) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/TryAbort",
+	return nil
+}
+
+func (m *ExportRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
+	return nil
+}
+
+func (m *ExportRequest) GetUids() *List {
+	if m != nil {
+		return m.Uids
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Zero_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Zero",
-	HandlerType: (*ZeroServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Connect",
-			Handler:    _Zero_Connect_Handler,
-		},
-		{
-			MethodName: "UpdateMembership",
-			Handler:    _Zero_UpdateMembership_Handler,
-		},
-		{
-			MethodName: "ShouldServe",
-			Handler:    _Zero_ShouldServe_Handler,
-		},
-		{
-			MethodName: "AssignUids",
-			Handler:    _Zero_AssignUids_Handler,
-		},
-		{
-			MethodName: "Timestamps",
-			Handler:    _Zero_Timestamps_Handler,
-		},
-		{
-			MethodName: "CommitOrAbort",
-			Handler:    _Zero_CommitOrAbort_Handler,
-		},
-		{
-			MethodName: "TryAbort",
-			Handler:    _Zero_TryAbort_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamMembership",
-			Handler:       _Zero_StreamMembership_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Oracle",
-			Handler:       _Zero_Oracle_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
-}
-
-// WorkerClient is the client API for Worker service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerClient interface {
-	// Data serving RPCs.
-	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
-	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
-	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
-	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
-	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
-	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Status, error)
-	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
-	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*Status, error)
-	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
-	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
-	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
+type BackupKey struct {
+	Type                 BackupKey_KeyType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BackupKey_KeyType" json:"type,omitempty"`
+	Attr                 string            `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
+	Uid                  uint64            `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	StartUid             uint64            `protobuf:"varint,4,opt,name=start_uid,json=startUid,proto3" json:"start_uid,omitempty"`
+	Term                 string            `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	Count                uint32            `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-type workerClient struct {
-	cc *grpc.ClientConn
+func (m *BackupKey) Reset()         { *m = BackupKey{} }
+func (m *BackupKey) String() string { return proto.CompactTextString(m) }
+func (*BackupKey) ProtoMessage()    {}
+func (*BackupKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{68}
 }
-
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+func (m *BackupKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BackupKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupKey.Merge(m, src)
 }
-
-func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workerStreamSnapshotClient{stream}
-	return x, nil
+func (m *BackupKey) XXX_Size() int {
+	return m.Size()
 }
-
-type Worker_StreamSnapshotClient interface {
-	Send(*Snapshot) error
-	Recv() (*KVS, error)
-	grpc.ClientStream
+func (m *BackupKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupKey.DiscardUnknown(m)
 }
 
-type workerStreamSnapshotClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_BackupKey proto.InternalMessageInfo
 
-func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
-	return x.ClientStream.SendMsg(m)
+func (m *BackupKey) GetType() BackupKey_KeyType {
+	if m != nil {
+		return m.Type
+	}
+	return BackupKey_UNKNOWN
 }
 
-func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
-	m := new(KVS)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *BackupKey) GetAttr() string {
+	if m != nil {
+		return m.Attr
 	}
-	return m, nil
+	return ""
 }
 
-func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
-	out := new(SortResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupKey) GetUid() uint64 {
+	if m != nil {
+		return m.Uid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
-	out := new(SchemaResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupKey) GetStartUid() uint64 {
+	if m != nil {
+		return m.StartUid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupKey) GetTerm() string {
+	if m != nil {
+		return m.Term
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupKey) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// A posting list stored in the format used for writing backups.
+type BackupPostingList struct {
+	Uids                 []uint64   `protobuf:"varint,1,rep,packed,name=uids,proto3" json:"uids,omitempty"`
+	Postings             []*Posting `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"`
+	CommitTs             uint64     `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Splits               []uint64   `protobuf:"varint,4,rep,packed,name=splits,proto3" json:"splits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupPostingList) Reset()         { *m = BackupPostingList{} }
+func (m *BackupPostingList) String() string { return proto.CompactTextString(m) }
+func (*BackupPostingList) ProtoMessage()    {}
+func (*BackupPostingList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{69}
+}
+func (m *BackupPostingList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupPostingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupPostingList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &workerReceivePredicateClient{stream}
-	return x, nil
 }
-
-type Worker_ReceivePredicateClient interface {
-	Send(*KVS) error
-	CloseAndRecv() (*api.Payload, error)
-	grpc.ClientStream
+func (m *BackupPostingList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupPostingList.Merge(m, src)
 }
-
-type workerReceivePredicateClient struct {
-	grpc.ClientStream
+func (m *BackupPostingList) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *workerReceivePredicateClient) Send(m *KVS) error {
-	return x.ClientStream.SendMsg(m)
+func (m *BackupPostingList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupPostingList.DiscardUnknown(m)
 }
 
-func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(api.Payload)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_BackupPostingList proto.InternalMessageInfo
+
+func (m *BackupPostingList) GetUids() []uint64 {
+	if m != nil {
+		return m.Uids
 	}
-	return m, nil
+	return nil
 }
 
-func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BackupPostingList) GetPostings() []*Posting {
+	if m != nil {
+		return m.Postings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
+func (m *BackupPostingList) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
+func (m *BackupPostingList) GetSplits() []uint64 {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
+	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
+	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
+	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
+	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
+	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
+	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
+	proto.RegisterType((*List)(nil), "pb.List")
+	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
+	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
+	proto.RegisterType((*Query)(nil), "pb.Query")
+	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
+	proto.RegisterType((*LangList)(nil), "pb.LangList")
+	proto.RegisterType((*Result)(nil), "pb.Result")
+	proto.RegisterType((*Order)(nil), "pb.Order")
+	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
+	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
+	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
+	proto.RegisterType((*Member)(nil), "pb.Member")
+	proto.RegisterType((*Group)(nil), "pb.Group")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
+	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
+	proto.RegisterType((*License)(nil), "pb.License")
+	proto.RegisterType((*TimestampCheckpoint)(nil), "pb.TimestampCheckpoint")
+	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
+	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
+	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
+	proto.RegisterMapType((map[string]uint32)(nil), "pb.MembershipState.PinnedEntry")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
+	proto.RegisterType((*PredicateMove)(nil), "pb.PredicateMove")
+	proto.RegisterType((*PinPredicate)(nil), "pb.PinPredicate")
+	proto.RegisterType((*DrainGroup)(nil), "pb.DrainGroup")
+	proto.RegisterType((*ReplicationBatch)(nil), "pb.ReplicationBatch")
+	proto.RegisterType((*ReplicationStatus)(nil), "pb.ReplicationStatus")
+	proto.RegisterType((*ReplicationState)(nil), "pb.ReplicationState")
+	proto.RegisterMapType((map[uint32]*ReplicationStatus)(nil), "pb.ReplicationState.GroupsEntry")
+	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
+	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
+	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
+	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
+	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
+	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
+	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
+	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
+	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
+	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
+	proto.RegisterType((*KVS)(nil), "pb.KVS")
+	proto.RegisterType((*Posting)(nil), "pb.Posting")
+	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
+	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
+	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
+	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
+	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
+	proto.RegisterType((*Facets)(nil), "pb.Facets")
+	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
+	proto.RegisterType((*Function)(nil), "pb.Function")
+	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
+	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
+	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
+	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
+	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
+	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
+	proto.RegisterType((*CompositeIndex)(nil), "pb.CompositeIndex")
+	proto.RegisterType((*FieldConstraint)(nil), "pb.FieldConstraint")
+	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
+	proto.RegisterType((*MapEntry)(nil), "pb.MapEntry")
+	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
+	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
+	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
+	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
+	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
+	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
+	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
+	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
+	proto.RegisterType((*Num)(nil), "pb.Num")
+	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
+	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
+	proto.RegisterType((*Status)(nil), "pb.Status")
+	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "pb.BackupResponse")
+	proto.RegisterType((*PredicateFilter)(nil), "pb.PredicateFilter")
+	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
+	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
+	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
+}
+
+func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
+
+var fileDescriptor_f80abaa17e25ccc8 = []byte{
+	// 5477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0xcd, 0x6f, 0x1c, 0xc9,
+	0x75, 0xb8, 0x7a, 0xbe, 0xfb, 0xcd, 0x0c, 0x35, 0x2a, 0x69, 0x57, 0xb3, 0x94, 0x2d, 0xd2, 0xad,
+	0x5d, 0x2f, 0x77, 0x65, 0x51, 0x5a, 0xae, 0xed, 0x9f, 0x65, 0xfb, 0x07, 0xff, 0xf8, 0x31, 0xd2,
+	0xd2, 0xa2, 0x48, 0xba, 0x38, 0x92, 0x7f, 0xf6, 0x21, 0x93, 0xe6, 0x74, 0x91, 0x6c, 0x73, 0xa6,
+	0xbb, 0xdd, 0xdd, 0x43, 0x0f, 0xf7, 0x16, 0x04, 0x49, 0x7c, 0x48, 0x4e, 0x4e, 0x00, 0x9f, 0x1c,
+	0xe4, 0x94, 0x43, 0x90, 0x3f, 0x20, 0x87, 0x1c, 0x02, 0xe4, 0xe0, 0xe4, 0x14, 0x04, 0xc9, 0x55,
+	0x08, 0x9c, 0x00, 0x01, 0x94, 0x63, 0x82, 0x9c, 0x72, 0x08, 0xde, 0x7b, 0xd5, 0x5f, 0xc3, 0xa1,
+	0xb8, 0x6b, 0xc0, 0xa7, 0xa9, 0xf7, 0x51, 0x55, 0x5d, 0xaf, 0x5e, 0xbd, 0xaf, 0xaa, 0x81, 0x46,
+	0x70, 0xb8, 0x1a, 0x84, 0x7e, 0xec, 0x8b, 0x52, 0x70, 0xb8, 0x68, 0xda, 0x81, 0xcb, 0xe0, 0xe2,
+	0x87, 0xc7, 0x6e, 0x7c, 0x32, 0x39, 0x5c, 0x1d, 0xfa, 0xe3, 0x87, 0xce, 0x71, 0x68, 0x07, 0x27,
+	0x0f, 0x5c, 0xff, 0xe1, 0xa1, 0xed, 0x1c, 0xab, 0xf0, 0xe1, 0xd9, 0xda, 0xc3, 0xe0, 0xf0, 0x61,
+	0xd2, 0x75, 0xf1, 0x41, 0x8e, 0xf7, 0xd8, 0x3f, 0xf6, 0x1f, 0x12, 0xfa, 0x70, 0x72, 0x44, 0x10,
+	0x01, 0xd4, 0x62, 0x76, 0x6b, 0x11, 0x2a, 0x3b, 0x6e, 0x14, 0x0b, 0x01, 0x95, 0x89, 0xeb, 0x44,
+	0x5d, 0x63, 0xb9, 0xbc, 0x52, 0x93, 0xd4, 0xb6, 0x9e, 0x83, 0xd9, 0xb7, 0xa3, 0xd3, 0x97, 0xf6,
+	0x68, 0xa2, 0x44, 0x07, 0xca, 0x67, 0xf6, 0xa8, 0x6b, 0x2c, 0x1b, 0x2b, 0x2d, 0x89, 0x4d, 0xb1,
+	0x0a, 0x8d, 0x33, 0x7b, 0x34, 0x88, 0xcf, 0x03, 0xd5, 0x2d, 0x2d, 0x1b, 0x2b, 0x0b, 0x6b, 0x37,
+	0x57, 0x83, 0xc3, 0xd5, 0x7d, 0x3f, 0x8a, 0x5d, 0xef, 0x78, 0xf5, 0xa5, 0x3d, 0xea, 0x9f, 0x07,
+	0x4a, 0xd6, 0xcf, 0xb8, 0x61, 0xed, 0x41, 0xf3, 0x20, 0x1c, 0x3e, 0x99, 0x78, 0xc3, 0xd8, 0xf5,
+	0x3d, 0x9c, 0xd1, 0xb3, 0xc7, 0x8a, 0x46, 0x34, 0x25, 0xb5, 0x11, 0x67, 0x87, 0xc7, 0x51, 0xb7,
+	0xbc, 0x5c, 0x46, 0x1c, 0xb6, 0x45, 0x17, 0xea, 0x6e, 0xb4, 0xe9, 0x4f, 0xbc, 0xb8, 0x5b, 0x59,
+	0x36, 0x56, 0x1a, 0x32, 0x01, 0xad, 0x3f, 0x2d, 0x43, 0xf5, 0x7b, 0x13, 0x15, 0x9e, 0x53, 0xbf,
+	0x38, 0x0e, 0x93, 0xb1, 0xb0, 0x2d, 0x6e, 0x41, 0x75, 0x64, 0x7b, 0xc7, 0x51, 0xb7, 0x44, 0x83,
+	0x31, 0x20, 0xee, 0x80, 0x69, 0x1f, 0xc5, 0x2a, 0x1c, 0x4c, 0x5c, 0xa7, 0x5b, 0x5e, 0x36, 0x56,
+	0x6a, 0xb2, 0x41, 0x88, 0x17, 0xae, 0x23, 0xde, 0x81, 0x86, 0xe3, 0x0f, 0x86, 0xf9, 0xb9, 0x1c,
+	0x9f, 0xe6, 0x12, 0xf7, 0xa0, 0x31, 0x71, 0x9d, 0xc1, 0xc8, 0x8d, 0xe2, 0x6e, 0x75, 0xd9, 0x58,
+	0x69, 0xae, 0x35, 0x70, 0xb1, 0x28, 0x3b, 0x59, 0x9f, 0xb8, 0x0e, 0x36, 0xc4, 0x87, 0xd0, 0x88,
+	0xc2, 0xe1, 0xe0, 0x68, 0xe2, 0x0d, 0xbb, 0x35, 0x62, 0xba, 0x8e, 0x4c, 0xb9, 0x55, 0xcb, 0x7a,
+	0xc4, 0x00, 0x2e, 0x2b, 0x54, 0x67, 0x2a, 0x8c, 0x54, 0xb7, 0xce, 0x53, 0x69, 0x50, 0x3c, 0x82,
+	0xe6, 0x91, 0x3d, 0x54, 0xf1, 0x20, 0xb0, 0x43, 0x7b, 0xdc, 0x6d, 0x64, 0x03, 0x3d, 0x41, 0xf4,
+	0x3e, 0x62, 0x23, 0x09, 0x47, 0x29, 0x20, 0x3e, 0x86, 0x36, 0x41, 0xd1, 0xe0, 0xc8, 0x1d, 0xc5,
+	0x2a, 0xec, 0x9a, 0xd4, 0x67, 0x81, 0xfa, 0x10, 0xa6, 0x1f, 0x2a, 0x25, 0x5b, 0xcc, 0xc4, 0x18,
+	0xf1, 0x45, 0x00, 0x35, 0x0d, 0x6c, 0xcf, 0x19, 0xd8, 0xa3, 0x51, 0x17, 0xe8, 0x1b, 0x4c, 0xc6,
+	0xac, 0x8f, 0x46, 0xe2, 0x36, 0x7e, 0x9f, 0xed, 0x0c, 0xe2, 0xa8, 0xdb, 0x5e, 0x36, 0x56, 0x2a,
+	0xb2, 0x86, 0x60, 0x3f, 0x42, 0xb9, 0x0e, 0xed, 0xe1, 0x89, 0xea, 0x2e, 0x2c, 0x1b, 0x2b, 0x55,
+	0xc9, 0x00, 0x62, 0x8f, 0xdc, 0x30, 0x8a, 0xbb, 0xd7, 0x19, 0x4b, 0x80, 0xb5, 0x06, 0x26, 0x69,
+	0x0f, 0x49, 0xe7, 0x3d, 0xa8, 0x9d, 0x21, 0xc0, 0x4a, 0xd6, 0x5c, 0x6b, 0xe3, 0xe7, 0xa5, 0x0a,
+	0x26, 0x35, 0xd1, 0xba, 0x0b, 0x8d, 0x1d, 0xdb, 0x3b, 0x4e, 0xb4, 0x12, 0xb7, 0x8d, 0x3a, 0x98,
+	0x92, 0xda, 0xd6, 0xcf, 0x4b, 0x50, 0x93, 0x2a, 0x9a, 0x8c, 0x62, 0xf1, 0x3e, 0x00, 0x6e, 0xca,
+	0xd8, 0x8e, 0x43, 0x77, 0xaa, 0x47, 0xcd, 0xb6, 0xc5, 0x9c, 0xb8, 0xce, 0x73, 0x22, 0x89, 0x47,
+	0xd0, 0xa2, 0xd1, 0x13, 0xd6, 0x52, 0xf6, 0x01, 0xe9, 0xf7, 0xc9, 0x26, 0xb1, 0xe8, 0x1e, 0x6f,
+	0x43, 0x8d, 0xf4, 0x80, 0x75, 0xb1, 0x2d, 0x35, 0x24, 0xde, 0x83, 0x05, 0xd7, 0x8b, 0x71, 0x9f,
+	0x86, 0xf1, 0xc0, 0x51, 0x51, 0xa2, 0x28, 0xed, 0x14, 0xbb, 0xa5, 0xa2, 0x58, 0x7c, 0x04, 0x2c,
+	0xec, 0x64, 0xc2, 0xea, 0x72, 0x39, 0xdd, 0x10, 0xda, 0x04, 0x9e, 0x91, 0x78, 0xf4, 0x8c, 0x0f,
+	0xa0, 0x89, 0xeb, 0x4b, 0x7a, 0xd4, 0xa8, 0x47, 0x8b, 0x56, 0xa3, 0xc5, 0x21, 0x01, 0x19, 0x34,
+	0x3b, 0x8a, 0x06, 0x95, 0x91, 0x95, 0x87, 0xda, 0x56, 0x0f, 0xaa, 0x7b, 0xa1, 0xa3, 0xc2, 0xb9,
+	0xe7, 0x41, 0x40, 0xc5, 0x51, 0xd1, 0x90, 0x8e, 0x6a, 0x43, 0x52, 0x3b, 0x3b, 0x23, 0xe5, 0xdc,
+	0x19, 0xb1, 0x7e, 0x61, 0x40, 0xf3, 0xc0, 0x0f, 0xe3, 0xe7, 0x2a, 0x8a, 0xec, 0x63, 0x25, 0x96,
+	0xa0, 0xea, 0xe3, 0xb0, 0x5a, 0xc2, 0x26, 0x7e, 0x13, 0xcd, 0x23, 0x19, 0x3f, 0xb3, 0x0f, 0xa5,
+	0xcb, 0xf7, 0x01, 0x75, 0x87, 0x4e, 0x57, 0x59, 0xeb, 0x0e, 0x02, 0x28, 0x6b, 0xff, 0xe8, 0x28,
+	0x52, 0x2c, 0xcb, 0xaa, 0xd4, 0xd0, 0xa5, 0x2a, 0x68, 0x7d, 0x0d, 0x00, 0xbf, 0xef, 0x73, 0x6a,
+	0x81, 0xf5, 0x07, 0x06, 0x34, 0xa5, 0x7d, 0x14, 0x6f, 0xfa, 0x5e, 0xac, 0xa6, 0xb1, 0x58, 0x80,
+	0x92, 0xeb, 0x90, 0x8c, 0x6a, 0xb2, 0xe4, 0x3a, 0xf8, 0x75, 0xc7, 0xa1, 0x3f, 0x09, 0x48, 0x44,
+	0x6d, 0xc9, 0x00, 0xc9, 0xd2, 0x71, 0xc2, 0x6e, 0x59, 0xcb, 0xd2, 0x71, 0x42, 0xb1, 0x04, 0xcd,
+	0xc8, 0xb3, 0x83, 0xe8, 0xc4, 0x8f, 0xf1, 0xeb, 0x2a, 0xf4, 0x75, 0x90, 0xa0, 0xfa, 0x11, 0x1e,
+	0x2e, 0x37, 0x1a, 0x8c, 0x94, 0x1d, 0x7a, 0x2a, 0x24, 0x83, 0xd1, 0x90, 0xa6, 0x1b, 0xed, 0x30,
+	0xc2, 0xfa, 0x45, 0x19, 0x6a, 0xcf, 0xd5, 0xf8, 0x50, 0x85, 0x17, 0x3e, 0xe2, 0x11, 0x34, 0x68,
+	0xde, 0x81, 0xeb, 0xf0, 0x77, 0x6c, 0xbc, 0xf5, 0xfa, 0xd5, 0xd2, 0x0d, 0xc2, 0x6d, 0x3b, 0x5f,
+	0xf1, 0xc7, 0x6e, 0xac, 0xc6, 0x41, 0x7c, 0x2e, 0xeb, 0x1a, 0x35, 0xf7, 0x03, 0xdf, 0x86, 0xda,
+	0x48, 0xd9, 0xb8, 0x67, 0xac, 0x9e, 0x1a, 0x12, 0x0f, 0xa0, 0x6e, 0x8f, 0x07, 0x8e, 0xb2, 0x1d,
+	0xfe, 0xa8, 0x8d, 0x5b, 0xaf, 0x5f, 0x2d, 0x75, 0xec, 0xf1, 0x96, 0xb2, 0xf3, 0x63, 0xd7, 0x18,
+	0x23, 0x1e, 0xa3, 0x4e, 0x46, 0xf1, 0x60, 0x12, 0x38, 0x76, 0xac, 0xc8, 0xa6, 0x55, 0x36, 0xba,
+	0xaf, 0x5f, 0x2d, 0xdd, 0x42, 0xf4, 0x0b, 0xc2, 0xe6, 0xba, 0x41, 0x86, 0x15, 0xdb, 0x70, 0x63,
+	0x38, 0x9a, 0x44, 0x68, 0x6a, 0x5d, 0xef, 0xc8, 0x1f, 0xf8, 0xde, 0xe8, 0x9c, 0xb6, 0xb1, 0xb1,
+	0xf1, 0xc5, 0xd7, 0xaf, 0x96, 0xde, 0xd1, 0xc4, 0x6d, 0xef, 0xc8, 0xdf, 0xf3, 0x46, 0xe7, 0xb9,
+	0x51, 0xae, 0xcf, 0x90, 0xc4, 0xff, 0x83, 0x85, 0x23, 0x3f, 0x1c, 0xaa, 0x41, 0x2a, 0x98, 0x05,
+	0x1a, 0x67, 0xf1, 0xf5, 0xab, 0xa5, 0xb7, 0x89, 0xf2, 0xf4, 0x82, 0x74, 0x5a, 0x79, 0xbc, 0x78,
+	0x08, 0xf5, 0x64, 0x2f, 0xe8, 0xbc, 0xb0, 0x4c, 0x35, 0x2a, 0x2f, 0x53, 0x8d, 0xb2, 0xfe, 0xa9,
+	0x04, 0x55, 0xea, 0x2c, 0x1e, 0x41, 0x7d, 0x4c, 0x3b, 0x95, 0x98, 0xad, 0xb7, 0x51, 0xb5, 0x88,
+	0xb6, 0xca, 0x5b, 0x18, 0xf5, 0xbc, 0x38, 0x3c, 0x97, 0x09, 0x1b, 0xf6, 0x88, 0xed, 0xc3, 0x91,
+	0x8a, 0xa3, 0x6e, 0x69, 0xb6, 0x47, 0x9f, 0x09, 0xba, 0x87, 0x66, 0x9b, 0x55, 0xa7, 0xf2, 0x05,
+	0x75, 0x5a, 0x84, 0xc6, 0xf0, 0x44, 0x0d, 0x4f, 0xa3, 0xc9, 0x58, 0x2b, 0x5b, 0x0a, 0x23, 0xcd,
+	0x09, 0x6d, 0xd7, 0x73, 0xbd, 0x63, 0xad, 0x68, 0x29, 0xbc, 0xf8, 0x04, 0x5a, 0xf9, 0x6f, 0x44,
+	0x27, 0x7e, 0xaa, 0xce, 0x49, 0xdb, 0x2a, 0x12, 0x9b, 0x62, 0x19, 0xaa, 0x64, 0xf6, 0x48, 0xd7,
+	0x9a, 0x6b, 0x80, 0x9f, 0xca, 0x5d, 0x24, 0x13, 0xbe, 0x59, 0xfa, 0x86, 0x81, 0xe3, 0xe4, 0xbf,
+	0x3c, 0x3f, 0x8e, 0x79, 0xf9, 0x38, 0xdc, 0x25, 0x37, 0x8e, 0xe5, 0x43, 0x7d, 0xc7, 0x1d, 0x2a,
+	0x2f, 0x22, 0x57, 0x3f, 0x89, 0x54, 0x6a, 0xa2, 0xb0, 0x8d, 0x4b, 0x19, 0xdb, 0xd3, 0x5d, 0xdf,
+	0x51, 0x11, 0x8d, 0x53, 0x91, 0x29, 0x8c, 0x34, 0x35, 0x0d, 0xdc, 0xf0, 0xbc, 0xcf, 0x02, 0x2a,
+	0xcb, 0x14, 0x46, 0x5f, 0xaa, 0x3c, 0x9c, 0xcc, 0x49, 0xdc, 0xb6, 0x06, 0xad, 0x03, 0xb8, 0xd9,
+	0x77, 0xc7, 0x2a, 0x8a, 0xed, 0x71, 0xb0, 0x89, 0x12, 0x0b, 0x7c, 0xd7, 0xa3, 0x93, 0x1f, 0x47,
+	0x5a, 0x0c, 0xa5, 0x38, 0xc2, 0x8f, 0x89, 0xdd, 0x31, 0x7f, 0x7c, 0x59, 0x52, 0x1b, 0x07, 0x3d,
+	0xf1, 0x43, 0xf7, 0x53, 0xdf, 0xa3, 0xf9, 0x1a, 0x32, 0x01, 0xad, 0xbf, 0xae, 0x42, 0xeb, 0x87,
+	0x2a, 0xf4, 0xf7, 0x43, 0x3f, 0xf0, 0x23, 0x7b, 0x24, 0xd6, 0x8b, 0xfb, 0xc7, 0x7a, 0xb2, 0x8c,
+	0x22, 0xc8, 0xb3, 0xad, 0x1e, 0xa4, 0x1b, 0xca, 0xfb, 0x9f, 0xdf, 0x61, 0x0b, 0x6a, 0xac, 0x3f,
+	0x73, 0x36, 0x42, 0x53, 0x90, 0x87, 0x35, 0xa6, 0x5b, 0xce, 0x78, 0xb4, 0x90, 0x35, 0x45, 0xdc,
+	0x05, 0x18, 0xdb, 0xd3, 0x1d, 0x65, 0x47, 0x6a, 0xdb, 0x49, 0x0c, 0x53, 0x86, 0xd1, 0x22, 0xee,
+	0x4f, 0xbd, 0x7e, 0xd4, 0xad, 0xa6, 0x22, 0x26, 0x58, 0x7c, 0x01, 0xcc, 0xb1, 0x3d, 0x45, 0x0b,
+	0xb9, 0xed, 0xf0, 0x59, 0x97, 0x19, 0x42, 0x7c, 0x09, 0xca, 0xf1, 0xd4, 0xeb, 0xd6, 0x75, 0x38,
+	0x82, 0xd1, 0x69, 0x7f, 0xea, 0x69, 0x5b, 0x2a, 0x91, 0x96, 0xa8, 0x45, 0x23, 0x53, 0x8b, 0x0e,
+	0x94, 0x87, 0xae, 0x43, 0xf1, 0x88, 0x29, 0xb1, 0x29, 0xde, 0x83, 0xfa, 0x88, 0x55, 0x80, 0x62,
+	0x8e, 0xe6, 0x5a, 0x93, 0x4d, 0x35, 0xa1, 0x64, 0x42, 0x13, 0xdf, 0x86, 0x76, 0x1c, 0x0d, 0x86,
+	0xe9, 0x96, 0x75, 0x9b, 0xc4, 0x7c, 0x9b, 0x96, 0x7c, 0x71, 0x47, 0x65, 0x2b, 0x8e, 0x32, 0x48,
+	0xbc, 0x9b, 0x1d, 0xc1, 0xd6, 0x72, 0x79, 0x46, 0x54, 0x09, 0x49, 0x58, 0x50, 0x0e, 0x5c, 0x8f,
+	0x8c, 0x52, 0x73, 0xad, 0x43, 0xb1, 0xab, 0xeb, 0xed, 0x87, 0xca, 0x71, 0x87, 0x76, 0xac, 0x24,
+	0x12, 0xc5, 0xbb, 0x50, 0xa5, 0xd3, 0x44, 0x26, 0x47, 0x7b, 0xf0, 0x2d, 0x44, 0xd0, 0x79, 0x96,
+	0x4c, 0x14, 0x5f, 0x03, 0x08, 0x55, 0x30, 0xa2, 0x7e, 0x0e, 0x85, 0x40, 0xcd, 0xb5, 0xb7, 0x90,
+	0x55, 0x6a, 0xac, 0xeb, 0x7b, 0x07, 0xb1, 0x1d, 0x4f, 0x22, 0x99, 0x63, 0x14, 0x5f, 0x87, 0x66,
+	0x98, 0x31, 0x74, 0x3b, 0xd4, 0xef, 0xd6, 0x9c, 0x7e, 0x4a, 0xe6, 0x19, 0x17, 0xff, 0x2f, 0x5c,
+	0x9f, 0xd1, 0xa5, 0xfc, 0x89, 0x6c, 0xb3, 0xe8, 0x6f, 0xe5, 0x4f, 0x64, 0x25, 0x7f, 0x0a, 0xff,
+	0xa6, 0x0a, 0xd7, 0xb5, 0x59, 0x38, 0x71, 0x03, 0x1a, 0x1f, 0xb5, 0x9d, 0x9c, 0xb1, 0x3e, 0x91,
+	0x15, 0x99, 0x80, 0xe2, 0xff, 0x40, 0x8d, 0xec, 0x6e, 0x62, 0xcd, 0x96, 0x32, 0xcd, 0x4c, 0xbb,
+	0xb3, 0x75, 0xd3, 0x6a, 0xad, 0xd9, 0xc5, 0x57, 0xa1, 0xfa, 0xa9, 0x0a, 0x7d, 0x0e, 0x2e, 0x9a,
+	0x6b, 0x77, 0xe7, 0xf5, 0xc3, 0xf3, 0xa1, 0xbb, 0x31, 0xf3, 0x6f, 0x50, 0x81, 0xdf, 0xc5, 0x70,
+	0x62, 0xec, 0x9f, 0x29, 0xa7, 0x5b, 0xcf, 0x94, 0x42, 0x9f, 0xb1, 0x84, 0x94, 0x68, 0x6c, 0x63,
+	0xae, 0xc6, 0x9a, 0x6f, 0xd0, 0xd8, 0xaf, 0x42, 0xcb, 0xf7, 0x8e, 0x7d, 0x17, 0x43, 0x38, 0xff,
+	0x2c, 0xd1, 0xee, 0x1b, 0xa4, 0x56, 0x89, 0x4e, 0x3d, 0xf7, 0xcf, 0x94, 0x6c, 0x6a, 0x36, 0x04,
+	0x50, 0xba, 0x81, 0xeb, 0x79, 0xca, 0xe9, 0x36, 0x2f, 0x97, 0xee, 0x3e, 0x71, 0x68, 0xe9, 0x32,
+	0xfb, 0xac, 0xee, 0xb4, 0x3e, 0xab, 0xee, 0x6c, 0x41, 0x33, 0xb7, 0x59, 0x73, 0xf4, 0x66, 0xa9,
+	0x68, 0xc9, 0xcd, 0xd4, 0x79, 0xe5, 0x1d, 0xc2, 0x16, 0x40, 0xb6, 0x75, 0xbf, 0xb6, 0x5b, 0x79,
+	0x0c, 0xcd, 0xdc, 0xd2, 0xe6, 0x78, 0x95, 0x82, 0x0e, 0xb7, 0xf3, 0x3a, 0xfc, 0xd3, 0x12, 0xb4,
+	0x0b, 0x62, 0xc5, 0xcd, 0x0f, 0x12, 0x84, 0x1e, 0x23, 0x43, 0x60, 0x40, 0x16, 0xf9, 0x13, 0x0a,
+	0x22, 0x92, 0xc0, 0x4a, 0x9a, 0x8c, 0x79, 0xaa, 0x33, 0x3f, 0x15, 0xc5, 0x44, 0x2c, 0x13, 0xb1,
+	0x8e, 0xf0, 0x53, 0x8e, 0x0a, 0x83, 0x13, 0x3b, 0x52, 0xa4, 0x8b, 0xa6, 0x64, 0x00, 0xb1, 0xa1,
+	0x3f, 0xf1, 0x38, 0x8c, 0x6a, 0x4b, 0x06, 0x70, 0x96, 0x53, 0x75, 0x1e, 0x0d, 0x58, 0xcb, 0xb4,
+	0x06, 0x22, 0x06, 0xbf, 0x90, 0xc8, 0x51, 0x6c, 0x87, 0xb1, 0x72, 0x06, 0x36, 0x47, 0xee, 0x65,
+	0x69, 0x6a, 0xcc, 0x7a, 0x8c, 0x61, 0xc0, 0x91, 0xeb, 0xb9, 0xd1, 0x09, 0xd3, 0x1b, 0x44, 0x87,
+	0x04, 0xb5, 0x1e, 0xe3, 0xa4, 0x2a, 0x0c, 0xfd, 0x50, 0xdb, 0x53, 0x06, 0xac, 0xa7, 0xd0, 0xca,
+	0xdb, 0xad, 0x2b, 0x04, 0xf1, 0xce, 0x6c, 0x7c, 0x99, 0x06, 0x92, 0xd6, 0x77, 0x00, 0x32, 0xd3,
+	0x56, 0x60, 0x34, 0x0a, 0x8c, 0x18, 0x5d, 0xb2, 0x83, 0xd5, 0xc9, 0x84, 0x86, 0xac, 0xff, 0x36,
+	0xa0, 0x93, 0xd3, 0xbe, 0x0d, 0x3b, 0x1e, 0x9e, 0xbc, 0x69, 0x9c, 0x77, 0xa0, 0x11, 0xb9, 0xde,
+	0x50, 0x0d, 0xe2, 0xc4, 0xdf, 0xd7, 0x09, 0x26, 0x97, 0xde, 0x98, 0x04, 0x83, 0xd8, 0xcf, 0xe2,
+	0xa1, 0xda, 0x24, 0xe8, 0xfb, 0x14, 0x5a, 0x97, 0x4e, 0xcf, 0xba, 0x15, 0x9d, 0xc1, 0x71, 0x11,
+	0x24, 0x38, 0x5c, 0x5b, 0x7d, 0xf6, 0x52, 0x96, 0x4e, 0xcf, 0x30, 0x69, 0x18, 0xdb, 0x53, 0x4a,
+	0xef, 0xd9, 0x3c, 0xd4, 0xc6, 0xf6, 0x14, 0x93, 0xfb, 0xdb, 0x50, 0x8f, 0x94, 0x17, 0xa3, 0x64,
+	0x6b, 0x24, 0xd9, 0x1a, 0x82, 0xeb, 0x31, 0xca, 0xeb, 0xc8, 0x0f, 0x7f, 0x62, 0x87, 0x0e, 0x59,
+	0x06, 0x0a, 0xd5, 0x53, 0x84, 0xb8, 0x87, 0x0e, 0xc0, 0x0f, 0xa2, 0x6e, 0x23, 0xcb, 0x19, 0x9f,
+	0x4f, 0x62, 0x5a, 0x60, 0x24, 0x99, 0x66, 0xfd, 0xac, 0x04, 0x37, 0x2e, 0x98, 0x7a, 0xf1, 0x68,
+	0x76, 0xe5, 0x57, 0x86, 0xf2, 0x5f, 0x07, 0xb0, 0x83, 0x60, 0xe4, 0x2a, 0x27, 0x15, 0xc9, 0xc6,
+	0xed, 0xd7, 0xaf, 0x96, 0x6e, 0x6a, 0x6c, 0x3f, 0xca, 0xf5, 0x32, 0x53, 0x24, 0x86, 0xf5, 0xc9,
+	0xda, 0x28, 0x36, 0xe2, 0xb0, 0x9e, 0xd7, 0x97, 0x0f, 0xeb, 0xf5, 0x8a, 0x73, 0xd3, 0xd8, 0x9c,
+	0x74, 0x95, 0x0b, 0xd3, 0xac, 0xc7, 0x73, 0xa6, 0x59, 0x8f, 0xc5, 0x83, 0x19, 0xd9, 0xf2, 0x34,
+	0x2c, 0xdf, 0xfc, 0x34, 0x8c, 0xb1, 0xfe, 0xb3, 0xa8, 0x0e, 0xa9, 0xa3, 0x89, 0x62, 0xdb, 0x73,
+	0x0e, 0xf9, 0xa0, 0x37, 0x64, 0x02, 0x8a, 0x6f, 0xcc, 0x38, 0x9a, 0xe5, 0x79, 0xc6, 0x6c, 0xae,
+	0xa7, 0x79, 0x0c, 0xcd, 0x20, 0xf4, 0xc7, 0xbe, 0x3e, 0x58, 0x2c, 0x02, 0x4a, 0x53, 0x12, 0x74,
+	0x61, 0x45, 0x90, 0x61, 0x17, 0xf7, 0xaf, 0x32, 0x87, 0xf7, 0x8b, 0x96, 0xec, 0x12, 0xaf, 0x9e,
+	0xb3, 0x4c, 0xbf, 0x63, 0xc0, 0xf5, 0x4d, 0xdf, 0xf3, 0xd4, 0x30, 0x5b, 0x74, 0x16, 0xdd, 0x19,
+	0x97, 0x46, 0x77, 0x1f, 0x40, 0x35, 0x42, 0x66, 0x3d, 0xd1, 0xcd, 0x39, 0x8e, 0x40, 0x32, 0x07,
+	0x1a, 0x0a, 0xdc, 0x87, 0x40, 0x79, 0x0e, 0x46, 0xfd, 0xe5, 0xd4, 0x49, 0xee, 0x33, 0xc6, 0xfa,
+	0x2f, 0x03, 0xe0, 0x13, 0x65, 0x8f, 0xe2, 0x13, 0x4c, 0xa2, 0xd0, 0x67, 0xba, 0x1e, 0x8a, 0x79,
+	0x98, 0x18, 0x84, 0x14, 0xc6, 0xfd, 0xc0, 0x8c, 0x51, 0x45, 0xac, 0x6f, 0xa6, 0x4c, 0x40, 0x3c,
+	0xe5, 0x11, 0xad, 0x4e, 0x67, 0x96, 0x1a, 0xca, 0xd2, 0x64, 0x6d, 0x10, 0x09, 0xc0, 0x71, 0xb0,
+	0x7c, 0x85, 0xbe, 0xa8, 0xca, 0xe3, 0x68, 0x10, 0xc7, 0x99, 0x04, 0x14, 0x5e, 0xeb, 0x73, 0xc7,
+	0x10, 0x7e, 0x15, 0xe6, 0x8b, 0xbd, 0xe1, 0x89, 0xaf, 0x6d, 0x61, 0x0a, 0xe3, 0x68, 0xda, 0x4b,
+	0xd2, 0xb9, 0x33, 0x65, 0x02, 0xf2, 0x5a, 0x1c, 0x35, 0x45, 0x92, 0x49, 0xa4, 0x14, 0xb6, 0x7e,
+	0x5a, 0x81, 0x1a, 0x07, 0x79, 0xbf, 0xc6, 0xd9, 0x2b, 0x98, 0xcd, 0xd2, 0xac, 0xd9, 0xc4, 0xfa,
+	0x16, 0x66, 0x94, 0x3a, 0x17, 0x60, 0x00, 0xb1, 0x51, 0x60, 0x0f, 0x95, 0xfe, 0x7e, 0x06, 0x70,
+	0xc1, 0x1c, 0x4d, 0x90, 0x09, 0x6f, 0x48, 0x0d, 0x89, 0x8f, 0xc1, 0xa4, 0x7a, 0x06, 0xa5, 0xc2,
+	0x26, 0xe5, 0xa1, 0x6f, 0xbf, 0x7e, 0xb5, 0x24, 0x10, 0x39, 0x93, 0x03, 0x37, 0x12, 0x1c, 0x9d,
+	0x39, 0xff, 0x8c, 0x4c, 0x24, 0xe4, 0xce, 0x9c, 0x7f, 0xa6, 0x0a, 0xc6, 0xa0, 0xc6, 0x18, 0x9c,
+	0x83, 0x1c, 0x0a, 0x1d, 0xd2, 0x26, 0x75, 0xa0, 0x39, 0x08, 0x59, 0x3c, 0xa6, 0x8d, 0x04, 0x87,
+	0x73, 0x28, 0xcf, 0xa1, 0x2e, 0xad, 0x6c, 0x0e, 0xe5, 0x39, 0x33, 0xe7, 0x9a, 0x31, 0xe9, 0x3a,
+	0x42, 0x94, 0x14, 0x46, 0xcf, 0x46, 0xb6, 0x0e, 0x59, 0xac, 0x08, 0x34, 0x12, 0x1c, 0xda, 0x9c,
+	0x9f, 0x84, 0x6e, 0xac, 0xb8, 0xd7, 0x02, 0xf5, 0x22, 0x9b, 0x43, 0xd8, 0x99, 0x6e, 0x66, 0x8a,
+	0x14, 0x5f, 0x03, 0x73, 0xe8, 0x8f, 0x03, 0x3f, 0x72, 0x63, 0x45, 0x91, 0xb5, 0xc9, 0xdd, 0x52,
+	0x64, 0xbe, 0x5b, 0x8a, 0xb4, 0xfe, 0xae, 0x04, 0xad, 0x2d, 0x37, 0x54, 0xc3, 0x58, 0x39, 0x3d,
+	0xe7, 0x58, 0xb1, 0xcf, 0x8a, 0xdd, 0xf8, 0x5c, 0xd7, 0x5a, 0x34, 0x94, 0x96, 0xca, 0x4a, 0xc5,
+	0xd2, 0x31, 0x9f, 0xf9, 0x32, 0x55, 0xbb, 0x19, 0x10, 0x6b, 0x00, 0xd4, 0xe0, 0x8a, 0x77, 0xe5,
+	0xf2, 0x8a, 0xb7, 0x49, 0x6c, 0xd8, 0x44, 0x0f, 0xc7, 0x7d, 0xb4, 0xc9, 0xac, 0x51, 0x39, 0x7c,
+	0x82, 0x81, 0x2c, 0xd5, 0xde, 0x0e, 0xd5, 0x88, 0x4e, 0x05, 0xd5, 0xde, 0x0e, 0xd5, 0x28, 0xad,
+	0x78, 0xd6, 0xf9, 0x73, 0xb0, 0x2d, 0xee, 0x41, 0xc9, 0x0f, 0xba, 0x8d, 0x6c, 0xc2, 0xfc, 0xc2,
+	0x56, 0xf7, 0x02, 0x59, 0xf2, 0x03, 0x34, 0x31, 0x5c, 0xde, 0xa5, 0x53, 0x81, 0x26, 0x06, 0x33,
+	0x34, 0x2a, 0x36, 0x4a, 0x4d, 0xd1, 0x25, 0x5f, 0x37, 0x54, 0x11, 0x9a, 0x49, 0xe0, 0xf0, 0x44,
+	0x63, 0xd6, 0x63, 0xeb, 0x6d, 0x28, 0xed, 0x05, 0xa2, 0x0e, 0xe5, 0x83, 0x5e, 0xbf, 0x73, 0x0d,
+	0x1b, 0x5b, 0xbd, 0x9d, 0x8e, 0x61, 0xfd, 0x4f, 0x09, 0xcc, 0xd4, 0xe5, 0x5d, 0xe5, 0xcf, 0x49,
+	0xf9, 0x72, 0xfe, 0x1c, 0xe1, 0x7e, 0x24, 0xbe, 0x0c, 0x55, 0xe5, 0x1c, 0xab, 0x24, 0x19, 0xe8,
+	0xcc, 0x2e, 0x43, 0x32, 0x59, 0xac, 0x40, 0x2d, 0x1a, 0x9e, 0xa8, 0xb1, 0xdd, 0xad, 0x64, 0x8c,
+	0x07, 0x84, 0xe1, 0xc2, 0x92, 0xd4, 0x74, 0xcc, 0xcc, 0x70, 0x23, 0x22, 0x5d, 0x29, 0xa5, 0xcc,
+	0x0c, 0x65, 0xae, 0xd9, 0x98, 0x88, 0xaa, 0x8d, 0x2e, 0x7a, 0xe0, 0x07, 0x24, 0xd2, 0x05, 0x0e,
+	0x91, 0xd3, 0xd5, 0xac, 0x6e, 0x85, 0x7e, 0xb0, 0x17, 0xc8, 0x9a, 0x43, 0xbf, 0x28, 0x21, 0x62,
+	0xe7, 0xed, 0xe7, 0x24, 0xc0, 0x44, 0x0c, 0x5f, 0x82, 0xac, 0x40, 0x63, 0xac, 0x62, 0xdb, 0xb1,
+	0x63, 0x5b, 0xe7, 0x02, 0x54, 0xa0, 0x7d, 0xae, 0x71, 0x32, 0xa5, 0x72, 0x79, 0x9f, 0x0c, 0x93,
+	0x2e, 0xad, 0x27, 0xa0, 0xf5, 0x10, 0x6a, 0x3c, 0xa9, 0x68, 0x40, 0x65, 0x77, 0x6f, 0xb7, 0xc7,
+	0xa2, 0x5e, 0xdf, 0xd9, 0xe9, 0x18, 0x88, 0xda, 0x5a, 0xef, 0xaf, 0x77, 0x4a, 0xd8, 0xea, 0xff,
+	0x60, 0xbf, 0xd7, 0x29, 0x5b, 0x7f, 0x6f, 0x40, 0x23, 0x99, 0x41, 0x7c, 0x13, 0x00, 0x8d, 0xd2,
+	0xe0, 0xc4, 0xf5, 0xd2, 0x4a, 0xc3, 0x9d, 0xfc, 0x37, 0x50, 0xb2, 0xf1, 0x09, 0x52, 0xd9, 0x47,
+	0x9a, 0x41, 0x02, 0x2f, 0x1e, 0xc0, 0x42, 0x91, 0x38, 0x27, 0xe2, 0x2e, 0xb8, 0xbb, 0x85, 0xb5,
+	0xb7, 0x0a, 0x43, 0x63, 0x4f, 0xd2, 0xf0, 0x9c, 0xbb, 0x7b, 0x00, 0x8d, 0x04, 0x2d, 0x9a, 0x50,
+	0xdf, 0xea, 0x3d, 0x59, 0x7f, 0xb1, 0x83, 0xea, 0x03, 0x50, 0x3b, 0xd8, 0xde, 0x7d, 0xba, 0xd3,
+	0xe3, 0x65, 0xed, 0x6c, 0x1f, 0xf4, 0x3b, 0x25, 0xeb, 0x67, 0x06, 0x34, 0x92, 0xdc, 0x55, 0x7c,
+	0x80, 0x49, 0x27, 0xd5, 0x0f, 0xba, 0x46, 0x76, 0xcb, 0x91, 0x2b, 0xd1, 0xca, 0x84, 0x8e, 0xa7,
+	0x85, 0xa5, 0xa9, 0xb3, 0x59, 0x02, 0xf2, 0x15, 0xe2, 0x72, 0xe1, 0x92, 0x02, 0x8b, 0xdd, 0xbe,
+	0xa7, 0x74, 0x39, 0x88, 0xda, 0x85, 0x68, 0xb3, 0x5a, 0x88, 0x36, 0xad, 0xff, 0x28, 0xc1, 0x82,
+	0x54, 0x51, 0xec, 0x87, 0x4a, 0xaa, 0x1f, 0x4f, 0x54, 0x14, 0xbf, 0x49, 0xcd, 0xbf, 0x88, 0xd9,
+	0x3e, 0x31, 0x67, 0x8a, 0x6e, 0x6a, 0x0c, 0x17, 0xeb, 0x46, 0xbe, 0x4e, 0xcb, 0xd8, 0x73, 0xa6,
+	0x30, 0x5e, 0x3f, 0x1d, 0xda, 0xc3, 0x53, 0x1e, 0x96, 0xfd, 0x67, 0x83, 0x11, 0x3c, 0xae, 0x3d,
+	0x1c, 0xaa, 0x28, 0x1a, 0xe0, 0xa6, 0xb0, 0x17, 0x35, 0x19, 0xf3, 0x4c, 0x9d, 0x23, 0x39, 0x52,
+	0xc3, 0x50, 0xc5, 0x44, 0x66, 0xab, 0x61, 0x32, 0x06, 0xc9, 0xf7, 0xa0, 0x1d, 0xa9, 0x08, 0x3d,
+	0xee, 0x20, 0xf6, 0x4f, 0x95, 0xa7, 0x4d, 0x48, 0x4b, 0x23, 0xfb, 0x88, 0x43, 0x27, 0x67, 0x7b,
+	0xbe, 0x77, 0x3e, 0xf6, 0x27, 0x91, 0xf6, 0x4e, 0x19, 0x02, 0xd7, 0x7c, 0xaa, 0xce, 0xf1, 0x12,
+	0x49, 0xe9, 0x14, 0xa3, 0x7e, 0xaa, 0xce, 0x9f, 0xb8, 0x23, 0xca, 0x9f, 0xf4, 0x87, 0x7b, 0x93,
+	0x71, 0x62, 0x3a, 0x18, 0xb3, 0x3b, 0x19, 0x8b, 0xfb, 0x50, 0xd3, 0x57, 0x4f, 0xcd, 0x2c, 0x7a,
+	0x49, 0x53, 0x12, 0xbe, 0x71, 0x92, 0x9a, 0xc5, 0xfa, 0x8b, 0x32, 0x34, 0xd2, 0xda, 0xd9, 0x7d,
+	0x30, 0xc7, 0xc9, 0x69, 0xd4, 0xa1, 0xcf, 0x4c, 0x8c, 0x9d, 0xd1, 0xaf, 0x8a, 0xfd, 0xd3, 0x10,
+	0xaa, 0x7a, 0x65, 0x08, 0xf5, 0x3e, 0x5c, 0x1f, 0x8e, 0x94, 0xed, 0x0d, 0x32, 0x9f, 0xcf, 0x12,
+	0x5d, 0x20, 0x74, 0x96, 0x4d, 0xe9, 0x23, 0x52, 0xcf, 0x8e, 0xc8, 0x7b, 0x50, 0x75, 0xd4, 0x28,
+	0xb6, 0xf3, 0x37, 0x73, 0x7b, 0xa1, 0x3d, 0x1c, 0xa9, 0x2d, 0x44, 0x4b, 0xa6, 0xa2, 0xad, 0x48,
+	0xea, 0x7b, 0x79, 0x5b, 0x91, 0x28, 0xbf, 0x4c, 0xa9, 0x99, 0x6e, 0x43, 0x5e, 0xb7, 0xef, 0xc3,
+	0x0d, 0x35, 0x0d, 0xc8, 0x40, 0x0e, 0xd2, 0xe2, 0x2f, 0x79, 0x74, 0xd9, 0x49, 0x08, 0x9b, 0x1a,
+	0x2f, 0xbe, 0x02, 0x75, 0xad, 0x80, 0xba, 0x12, 0x20, 0x38, 0x4e, 0xcd, 0xab, 0xb4, 0x4c, 0x58,
+	0xc4, 0x7d, 0x68, 0xf2, 0xe2, 0xa3, 0x13, 0x3b, 0x74, 0xba, 0xed, 0x2c, 0x26, 0xd5, 0x25, 0x32,
+	0x20, 0xf2, 0x01, 0x52, 0x31, 0x9e, 0x2d, 0x3f, 0x7b, 0x79, 0xa0, 0x65, 0x6f, 0x5c, 0x26, 0xfb,
+	0xe4, 0xc4, 0x95, 0x2e, 0x39, 0x71, 0xe5, 0x62, 0x7e, 0x77, 0x0b, 0xaa, 0x63, 0x15, 0x1e, 0x27,
+	0x27, 0x94, 0x01, 0x74, 0xd2, 0xae, 0x77, 0xac, 0xf4, 0x1d, 0x6b, 0x43, 0x6a, 0xc8, 0xfa, 0x93,
+	0x0a, 0xd4, 0xb5, 0x97, 0xc5, 0x0d, 0x99, 0xa4, 0x37, 0x26, 0xd8, 0x2c, 0x56, 0x09, 0x52, 0x77,
+	0x9d, 0xbf, 0x9e, 0x2e, 0x5f, 0x7d, 0x3d, 0x2d, 0xbe, 0x09, 0xad, 0x80, 0x69, 0x79, 0x07, 0x7f,
+	0x3b, 0xdf, 0x47, 0xff, 0x52, 0xbf, 0x66, 0x90, 0x01, 0xb8, 0x50, 0xba, 0xbb, 0x8b, 0x6d, 0xae,
+	0xc1, 0xb7, 0x64, 0x1d, 0xe1, 0xbe, 0x7d, 0x7c, 0x89, 0x9b, 0xff, 0x2c, 0xde, 0x7a, 0x81, 0xdc,
+	0x7e, 0x8b, 0x6c, 0x0f, 0x7a, 0xf8, 0xbc, 0x77, 0x6d, 0x17, 0xbd, 0xeb, 0x1d, 0x0a, 0x92, 0xc6,
+	0x2e, 0xd1, 0x16, 0xf4, 0x05, 0x01, 0x21, 0xfa, 0xb3, 0x5e, 0xff, 0xfa, 0xac, 0xd7, 0xff, 0x7d,
+	0x03, 0xea, 0x5a, 0x18, 0x17, 0x0c, 0xf8, 0xc6, 0xf6, 0xee, 0xba, 0xfc, 0x41, 0xc7, 0x40, 0x07,
+	0xb5, 0xbd, 0xdb, 0xef, 0x94, 0x84, 0x09, 0xd5, 0x27, 0x3b, 0x7b, 0xeb, 0xfd, 0x4e, 0x19, 0x8d,
+	0xfa, 0xc6, 0xde, 0xde, 0x4e, 0xa7, 0x22, 0x5a, 0xd0, 0xd8, 0x5a, 0xef, 0xf7, 0xfa, 0xdb, 0xcf,
+	0x7b, 0x9d, 0x2a, 0xf2, 0x3e, 0xed, 0xed, 0x75, 0x6a, 0xd8, 0x78, 0xb1, 0xbd, 0xd5, 0xa9, 0x23,
+	0x7d, 0x7f, 0xfd, 0xe0, 0xe0, 0xfb, 0x7b, 0x72, 0xab, 0xd3, 0x20, 0xc7, 0xd0, 0x97, 0xdb, 0xbb,
+	0x4f, 0x3b, 0x26, 0xb6, 0xf7, 0x36, 0xbe, 0xdb, 0xdb, 0xec, 0x77, 0xc0, 0xfa, 0x08, 0x9a, 0x39,
+	0x01, 0x63, 0x6f, 0xd9, 0x7b, 0xd2, 0xb9, 0x86, 0x53, 0xbe, 0x5c, 0xdf, 0x79, 0x81, 0x7e, 0x64,
+	0x01, 0x80, 0x9a, 0x83, 0x9d, 0xf5, 0xdd, 0xa7, 0x9d, 0x92, 0xf5, 0x3d, 0x68, 0xbc, 0x70, 0x9d,
+	0x8d, 0x91, 0x3f, 0x3c, 0x45, 0x05, 0x3c, 0xc4, 0x32, 0x0d, 0x57, 0xa1, 0xa8, 0x8d, 0xfa, 0x44,
+	0x87, 0x31, 0xd2, 0xaa, 0xa1, 0x21, 0x14, 0xa5, 0x37, 0x19, 0x0f, 0xe8, 0xc5, 0x83, 0x2e, 0xf7,
+	0x78, 0x93, 0xf1, 0x0b, 0x7c, 0xf4, 0xb0, 0x0b, 0xf5, 0x17, 0xae, 0xb3, 0x6f, 0x0f, 0x4f, 0xc9,
+	0xe6, 0xe1, 0xd0, 0x83, 0xc8, 0xfd, 0x54, 0x69, 0x27, 0x60, 0x12, 0xe6, 0xc0, 0xfd, 0x54, 0x89,
+	0x77, 0xa1, 0x46, 0x40, 0x92, 0xaf, 0xd2, 0xf1, 0x4e, 0x3e, 0x47, 0x6a, 0x9a, 0xf5, 0x87, 0x46,
+	0xba, 0x2c, 0xba, 0xd2, 0x5e, 0x82, 0x4a, 0x60, 0x0f, 0x4f, 0xbb, 0x46, 0x56, 0x4a, 0xd4, 0xf3,
+	0x49, 0x22, 0x88, 0xf7, 0xa1, 0xa1, 0x55, 0x2b, 0x19, 0xb8, 0x99, 0xd3, 0x41, 0x99, 0x12, 0x8b,
+	0x9b, 0x5e, 0x9e, 0xd9, 0x74, 0x4c, 0xde, 0x82, 0x91, 0x4b, 0x97, 0x93, 0x65, 0x74, 0x8c, 0x0c,
+	0x59, 0x5f, 0x05, 0xc8, 0x5e, 0x11, 0xcc, 0xaf, 0xb8, 0xd9, 0x23, 0xd7, 0x4e, 0x92, 0x41, 0x06,
+	0xac, 0x5d, 0x68, 0x66, 0xbd, 0x48, 0x7c, 0xf6, 0x68, 0x84, 0x6e, 0x28, 0x4a, 0x92, 0x78, 0x7b,
+	0x34, 0x7a, 0xa6, 0xce, 0x23, 0x8c, 0xca, 0xf8, 0xd9, 0x42, 0x69, 0xe6, 0xc6, 0x9b, 0xba, 0x4a,
+	0x26, 0x5a, 0x5f, 0x81, 0xda, 0x13, 0x56, 0xf2, 0xec, 0x20, 0x18, 0x97, 0x1d, 0x04, 0xeb, 0x31,
+	0x40, 0x76, 0x69, 0x8e, 0xc6, 0x8b, 0xf1, 0xfc, 0x18, 0xc3, 0xc8, 0x4a, 0xb9, 0xcc, 0xa4, 0x5f,
+	0x46, 0x10, 0xb3, 0xb5, 0x05, 0x8d, 0x37, 0x3e, 0x38, 0xd1, 0x02, 0x28, 0x65, 0x02, 0x98, 0xf3,
+	0x04, 0xc5, 0xfa, 0x11, 0x40, 0xf6, 0x8c, 0x42, 0x9f, 0x4b, 0x1e, 0x05, 0xcf, 0xe5, 0x87, 0x78,
+	0x39, 0xe7, 0x8e, 0x9c, 0x50, 0x79, 0x85, 0x55, 0xa7, 0x3d, 0x64, 0x4a, 0x17, 0xcb, 0x50, 0xa1,
+	0xd7, 0x21, 0xe5, 0xcc, 0x21, 0x24, 0xdf, 0x27, 0x89, 0x62, 0x4d, 0xa1, 0xcd, 0xe1, 0xee, 0x67,
+	0x08, 0x44, 0xee, 0x72, 0x30, 0x48, 0x8e, 0x2a, 0x79, 0xe7, 0x92, 0xc3, 0xa0, 0x12, 0x1c, 0xb9,
+	0x6a, 0xe4, 0x24, 0xab, 0xd1, 0x10, 0x6e, 0x32, 0x87, 0xce, 0x15, 0x42, 0x33, 0x60, 0xfd, 0x65,
+	0x09, 0x80, 0xa7, 0xc6, 0x1b, 0xb7, 0x2b, 0xca, 0x88, 0x78, 0x63, 0x96, 0x3c, 0xfc, 0x31, 0x25,
+	0xb5, 0x33, 0x3f, 0xa6, 0x73, 0x64, 0x02, 0x70, 0x1c, 0x8a, 0x47, 0xdc, 0x4f, 0x55, 0xa8, 0x27,
+	0xcc, 0x10, 0xf9, 0x67, 0x30, 0xd5, 0xe2, 0x33, 0x98, 0xf4, 0xad, 0x40, 0x8d, 0x47, 0x23, 0x60,
+	0xde, 0xb3, 0x07, 0x2e, 0x30, 0x44, 0x2a, 0x8c, 0x93, 0x7c, 0x9b, 0xa1, 0x34, 0x97, 0x32, 0x35,
+	0x2f, 0xe6, 0x52, 0x4b, 0xd0, 0xf4, 0xf0, 0x89, 0x8f, 0x77, 0x34, 0x72, 0x87, 0xb1, 0x8e, 0xcd,
+	0xc1, 0xf3, 0x37, 0x35, 0x86, 0x06, 0xf3, 0xdc, 0x1f, 0x4f, 0x54, 0xb7, 0xa9, 0x07, 0x23, 0x08,
+	0x35, 0x25, 0x8e, 0x47, 0x64, 0x8e, 0x4d, 0x89, 0x4d, 0xeb, 0xb7, 0xa1, 0x95, 0xec, 0x14, 0xbd,
+	0x43, 0xf8, 0x30, 0x4d, 0x5d, 0x8c, 0x4c, 0x0b, 0x32, 0x81, 0x6e, 0x94, 0xba, 0xc6, 0xc5, 0xe4,
+	0xa5, 0xf4, 0x86, 0xe4, 0xc5, 0xfa, 0xc7, 0x4a, 0x32, 0x05, 0xe3, 0xaf, 0xd8, 0x93, 0x62, 0x82,
+	0x5a, 0xfa, 0x4c, 0x09, 0xea, 0x37, 0xc0, 0x74, 0x28, 0x0d, 0x73, 0xcf, 0x12, 0x37, 0xb9, 0x38,
+	0x9b, 0x72, 0xe9, 0x44, 0xcd, 0x3d, 0x53, 0x32, 0x63, 0xbe, 0x62, 0x5f, 0xd3, 0xdd, 0xab, 0xce,
+	0xdb, 0xbd, 0xda, 0xaf, 0xb9, 0x7b, 0x5f, 0x82, 0x96, 0xe7, 0x7b, 0x03, 0x6f, 0x32, 0x1a, 0x51,
+	0xf9, 0x99, 0xb7, 0xaf, 0xe9, 0xf9, 0xde, 0xae, 0x46, 0x89, 0x0f, 0xe1, 0x46, 0x9e, 0x85, 0x8d,
+	0x04, 0x6f, 0xe5, 0xf5, 0x1c, 0x1f, 0x99, 0x92, 0x15, 0xe8, 0xf8, 0x87, 0x3f, 0xc2, 0x97, 0x3c,
+	0x28, 0xb1, 0x01, 0x59, 0x07, 0xde, 0xe0, 0x05, 0xc6, 0xa3, 0x88, 0x76, 0xd1, 0x4e, 0xcc, 0xa8,
+	0x4d, 0xfb, 0x0d, 0x6a, 0xb3, 0x50, 0x50, 0x9b, 0x8f, 0x01, 0x86, 0xbe, 0x17, 0xc5, 0x58, 0x57,
+	0x8f, 0xf5, 0xcd, 0xe0, 0x4d, 0x36, 0x0f, 0x6a, 0xe4, 0x6c, 0xa6, 0x24, 0x99, 0x63, 0x4b, 0x74,
+	0xad, 0xc3, 0xf7, 0x29, 0xa8, 0x6b, 0x8f, 0xc1, 0x4c, 0x37, 0x21, 0x97, 0x37, 0x9a, 0x50, 0xdd,
+	0xde, 0xdd, 0xea, 0xfd, 0xff, 0x8e, 0x81, 0xae, 0x5b, 0xf6, 0x5e, 0xf6, 0xe4, 0x41, 0xaf, 0x53,
+	0x42, 0xb7, 0xba, 0xd5, 0xdb, 0xe9, 0xf5, 0x7b, 0x9d, 0xf2, 0x77, 0x2b, 0x8d, 0x7a, 0xa7, 0x41,
+	0x97, 0xe5, 0x23, 0x77, 0xe8, 0xc6, 0xd6, 0x1f, 0x1b, 0x00, 0x99, 0xaa, 0xa1, 0x17, 0xc9, 0x16,
+	0xaf, 0x8b, 0x83, 0x71, 0xb2, 0xec, 0x95, 0xd4, 0x80, 0x94, 0x2e, 0xcb, 0xc6, 0x99, 0x2e, 0xbe,
+	0x03, 0x37, 0xd2, 0xe2, 0xcb, 0x80, 0x0e, 0x7e, 0x9a, 0xeb, 0x53, 0x28, 0xba, 0x99, 0x10, 0xb7,
+	0x91, 0x26, 0x3b, 0xc3, 0x02, 0xac, 0x22, 0xeb, 0x11, 0x2c, 0x14, 0x79, 0x66, 0xac, 0x9b, 0x31,
+	0x6b, 0xdd, 0xac, 0x3f, 0x33, 0xe0, 0xfa, 0x8c, 0x14, 0x31, 0xf7, 0x0a, 0xd5, 0x8f, 0x27, 0x6e,
+	0xa8, 0x1c, 0xed, 0x99, 0x52, 0x18, 0xa5, 0x3a, 0x76, 0xbd, 0xc4, 0xd6, 0x8f, 0x5d, 0xba, 0xaf,
+	0x1e, 0xdb, 0x53, 0x9d, 0xa4, 0x61, 0x93, 0xae, 0x75, 0xd4, 0xb1, 0x9a, 0x26, 0xb5, 0x4d, 0x02,
+	0x50, 0x46, 0x63, 0xd7, 0x1b, 0x64, 0x0a, 0x8d, 0x97, 0x8e, 0xae, 0xc7, 0x2f, 0x03, 0xef, 0xd0,
+	0xa5, 0xe3, 0x20, 0xb3, 0x55, 0x7c, 0x23, 0x49, 0x44, 0x7c, 0x00, 0xf7, 0xdc, 0x0e, 0x3e, 0xe1,
+	0xc7, 0x37, 0xef, 0xc1, 0x42, 0x60, 0x87, 0xb1, 0x8b, 0xd6, 0x3e, 0x71, 0x9e, 0xe5, 0x95, 0x96,
+	0x6c, 0xa7, 0x58, 0x74, 0xa1, 0xd6, 0x0b, 0x68, 0x3c, 0xb7, 0x83, 0x0b, 0x09, 0x7a, 0x2b, 0xbd,
+	0x51, 0x9f, 0xe8, 0xab, 0x1b, 0x1d, 0xfe, 0xbe, 0x07, 0x75, 0x1d, 0x13, 0x68, 0xb7, 0x52, 0x88,
+	0x17, 0x12, 0x9a, 0xf5, 0xbb, 0x25, 0xb8, 0x85, 0xd7, 0x50, 0x69, 0x6a, 0xb3, 0x6f, 0x9f, 0x8f,
+	0x7c, 0xdb, 0xf9, 0x8d, 0x5d, 0x9c, 0xbd, 0x05, 0xb5, 0x78, 0xea, 0x65, 0xef, 0xa3, 0xaa, 0x31,
+	0x5d, 0xd2, 0xce, 0xcd, 0x6b, 0xaa, 0x97, 0xe4, 0x35, 0xf9, 0x0c, 0xa2, 0x56, 0xcc, 0x20, 0xee,
+	0xe4, 0x2b, 0x9d, 0x75, 0x96, 0x7b, 0x5a, 0xd1, 0xbc, 0x9d, 0x55, 0x34, 0x1b, 0x44, 0xd2, 0xb5,
+	0x4b, 0x6b, 0x13, 0xcc, 0xfe, 0x34, 0xb9, 0xa0, 0xc9, 0x47, 0xd4, 0xc6, 0x1b, 0x22, 0xea, 0x52,
+	0x31, 0xb8, 0xb2, 0xfe, 0xcd, 0x80, 0x66, 0x2e, 0xe3, 0x13, 0x5f, 0x82, 0x4a, 0x3c, 0xf5, 0x8a,
+	0xef, 0x1a, 0x93, 0x49, 0x24, 0x91, 0xd0, 0x72, 0xa1, 0x96, 0xd8, 0x51, 0xe4, 0x1e, 0xe3, 0x6d,
+	0x2f, 0x0f, 0x89, 0x65, 0xfc, 0x75, 0x8d, 0x12, 0x3b, 0x70, 0x9d, 0x1d, 0x7d, 0x22, 0x95, 0xe4,
+	0x00, 0xdd, 0x9b, 0xc9, 0x30, 0xf9, 0x0e, 0x24, 0x91, 0x91, 0xae, 0xf3, 0x2c, 0x1c, 0x17, 0x90,
+	0x8b, 0xeb, 0x70, 0x73, 0x0e, 0xdb, 0xe7, 0x7a, 0x27, 0xb0, 0x04, 0x6d, 0xbc, 0x57, 0x4f, 0x5e,
+	0x5b, 0x44, 0xe9, 0xb3, 0x99, 0x32, 0x3f, 0x9b, 0xb1, 0xbe, 0x0c, 0xad, 0x7d, 0xa5, 0x42, 0xa9,
+	0xa2, 0xc0, 0xf7, 0x38, 0xdc, 0xd6, 0x37, 0x06, 0x7c, 0xf6, 0x34, 0x64, 0xfd, 0x16, 0x98, 0x58,
+	0xd4, 0xe1, 0xfb, 0xc0, 0xcf, 0x51, 0xf4, 0xf9, 0x32, 0xd4, 0x03, 0x56, 0x52, 0x5d, 0x19, 0x68,
+	0x51, 0x74, 0xa8, 0x15, 0x57, 0x26, 0x44, 0xeb, 0x23, 0xb8, 0x79, 0x30, 0x39, 0x8c, 0x86, 0xa1,
+	0x1b, 0x50, 0x24, 0xa5, 0x23, 0xa7, 0x45, 0x68, 0x04, 0xa1, 0x3a, 0x72, 0xa7, 0x2a, 0x39, 0x69,
+	0x29, 0x6c, 0x7d, 0x0b, 0x6e, 0x15, 0xbb, 0xe8, 0x25, 0xdc, 0x83, 0xf2, 0xe9, 0x59, 0xa4, 0xbf,
+	0xec, 0x46, 0x21, 0xcd, 0xa5, 0xe7, 0x84, 0x48, 0xb5, 0x24, 0x94, 0xb1, 0xe8, 0x91, 0x7b, 0x12,
+	0x5d, 0xe1, 0x27, 0xd1, 0x77, 0xf2, 0x15, 0xfe, 0x52, 0x62, 0x7f, 0x74, 0x25, 0xbf, 0x70, 0xcf,
+	0x58, 0x9e, 0xb9, 0x67, 0xb4, 0x7e, 0x08, 0xcd, 0x44, 0x13, 0xb6, 0x9d, 0x48, 0x5f, 0x93, 0x85,
+	0xf8, 0x90, 0x21, 0xaf, 0x99, 0x5c, 0x37, 0x56, 0x9e, 0xb3, 0x9d, 0xa8, 0x10, 0x03, 0xc5, 0x99,
+	0xb5, 0x89, 0x4a, 0x66, 0xb6, 0x9e, 0x40, 0x2b, 0x29, 0x3b, 0x60, 0x2d, 0x8f, 0x94, 0x7b, 0xe4,
+	0xe2, 0x85, 0x61, 0xaa, 0xf8, 0x0d, 0x46, 0xf4, 0xa3, 0x37, 0x5d, 0x10, 0xaf, 0x42, 0x4d, 0x9f,
+	0x1c, 0x01, 0x95, 0xa1, 0xef, 0xb0, 0xb9, 0xa8, 0x4a, 0x6a, 0x93, 0x35, 0x8d, 0x8e, 0x53, 0xfb,
+	0x1a, 0x1d, 0x5b, 0xff, 0x5e, 0x82, 0xf6, 0x06, 0xd5, 0x88, 0x92, 0x2d, 0xc9, 0x15, 0xec, 0x8c,
+	0x42, 0xc1, 0xee, 0x0d, 0x57, 0xc1, 0xf9, 0x0f, 0x2a, 0x17, 0x03, 0xe0, 0xdb, 0x50, 0x9f, 0x78,
+	0xee, 0x34, 0xb1, 0x31, 0x26, 0xb9, 0xdd, 0x69, 0x3f, 0x12, 0xcb, 0xd0, 0x44, 0x33, 0xe4, 0x7a,
+	0x5c, 0x86, 0xe3, 0x5a, 0x5a, 0x1e, 0x35, 0x53, 0x6c, 0xab, 0xbd, 0xb9, 0xd8, 0x56, 0xbf, 0xb2,
+	0xd8, 0xd6, 0xb8, 0xaa, 0xd8, 0x66, 0xce, 0x16, 0xdb, 0x8a, 0xee, 0x0d, 0x2e, 0x04, 0xef, 0x9f,
+	0xab, 0xa4, 0xb6, 0x0d, 0x0b, 0x89, 0xa0, 0xb5, 0x22, 0xdf, 0x01, 0x13, 0xeb, 0x78, 0x59, 0xee,
+	0x5a, 0x91, 0x0d, 0x44, 0x50, 0xea, 0x9a, 0x7f, 0x4f, 0xc8, 0xfb, 0x95, 0xc2, 0xd6, 0x5f, 0x19,
+	0x70, 0x7d, 0x66, 0x1a, 0xf1, 0x00, 0x84, 0xeb, 0x0d, 0x47, 0x13, 0x47, 0x0d, 0x2e, 0xb8, 0xe4,
+	0x1b, 0x9a, 0xb2, 0x9f, 0x7d, 0xfa, 0x03, 0x10, 0x6a, 0x7a, 0x81, 0x9d, 0xf3, 0x93, 0x1b, 0x6a,
+	0x3a, 0xcb, 0x7e, 0x0f, 0xda, 0xc9, 0xe8, 0x1c, 0x14, 0x73, 0xb6, 0xd2, 0xd2, 0x48, 0x0c, 0x56,
+	0x88, 0x49, 0x4d, 0xf3, 0x4c, 0x1c, 0x72, 0xb6, 0xd4, 0x34, 0x63, 0xb2, 0x7e, 0x69, 0x40, 0xbb,
+	0x37, 0x0d, 0xe8, 0x6d, 0xf0, 0x95, 0xd9, 0x53, 0x4e, 0x17, 0x4b, 0x05, 0x5d, 0xcc, 0x69, 0x55,
+	0x59, 0xdf, 0x58, 0xb2, 0x56, 0x61, 0x3e, 0xe5, 0x87, 0x63, 0x7d, 0x67, 0x6e, 0x4a, 0x0d, 0xcd,
+	0x6c, 0x65, 0xf5, 0xc2, 0x56, 0xde, 0xca, 0x5f, 0x55, 0x24, 0xf9, 0x96, 0xf8, 0x82, 0xfe, 0xcb,
+	0x45, 0x7d, 0xe6, 0xef, 0x04, 0x84, 0xb5, 0xfe, 0xa8, 0x04, 0x26, 0x6f, 0x29, 0xea, 0xdb, 0x07,
+	0x3a, 0xdd, 0x32, 0xb2, 0xaa, 0x7c, 0x4a, 0x5c, 0x7d, 0xa6, 0xce, 0x29, 0xac, 0x27, 0x96, 0xb9,
+	0x17, 0x5a, 0x3a, 0x68, 0xe0, 0x22, 0x01, 0x36, 0x8b, 0xde, 0xb3, 0x32, 0xe3, 0x3d, 0x31, 0xb9,
+	0x53, 0xe1, 0x58, 0x1f, 0x1b, 0x6a, 0x17, 0xd3, 0xb1, 0xb6, 0x0e, 0xe8, 0xad, 0x13, 0xa8, 0xeb,
+	0xd9, 0x31, 0x00, 0x7d, 0xb1, 0xfb, 0x6c, 0x77, 0xef, 0xfb, 0xbb, 0x9d, 0x6b, 0xe9, 0x3d, 0x86,
+	0x91, 0x85, 0xa8, 0xa5, 0x7c, 0x88, 0x5a, 0x46, 0xfc, 0xe6, 0xde, 0x8b, 0xdd, 0x7e, 0xa7, 0x22,
+	0xda, 0x60, 0x52, 0x73, 0x20, 0x7b, 0x2f, 0x3b, 0x55, 0xaa, 0x0f, 0x6d, 0x7e, 0xd2, 0x7b, 0xbe,
+	0xde, 0xa9, 0xa5, 0xb7, 0x20, 0x75, 0xeb, 0xf7, 0x0c, 0xb8, 0xc1, 0x4b, 0xce, 0x57, 0x53, 0xf2,
+	0x7f, 0x5b, 0xa9, 0xb0, 0xe4, 0x7e, 0xb3, 0x05, 0x94, 0xb5, 0xbf, 0x35, 0xa0, 0x82, 0xce, 0x4a,
+	0x3c, 0x00, 0xf3, 0x13, 0x65, 0x87, 0xf1, 0xa1, 0xb2, 0x63, 0x51, 0x70, 0x4c, 0x8b, 0x94, 0xce,
+	0x65, 0xf7, 0xef, 0xd6, 0xb5, 0x47, 0x86, 0x58, 0xe5, 0xb7, 0xe7, 0xc9, 0x9b, 0xfa, 0x76, 0xe2,
+	0xf4, 0xc8, 0x29, 0x2e, 0x16, 0xfa, 0x5b, 0xd7, 0x56, 0x88, 0xff, 0xbb, 0xbe, 0xeb, 0x6d, 0xf2,
+	0x5b, 0x68, 0x31, 0xeb, 0x24, 0x67, 0x7b, 0x88, 0x07, 0x50, 0xdb, 0x8e, 0xf6, 0xd5, 0x3c, 0x56,
+	0x7e, 0xda, 0x98, 0x73, 0xd4, 0xd6, 0xb5, 0xb5, 0x3f, 0xaf, 0x40, 0x05, 0x5f, 0x70, 0x61, 0xe5,
+	0x58, 0xbf, 0x56, 0x10, 0xb9, 0x57, 0x09, 0x8b, 0x37, 0x39, 0x68, 0x2f, 0x3c, 0x63, 0xa0, 0x59,
+	0x3a, 0x1c, 0xf8, 0x67, 0x65, 0x75, 0x91, 0xbd, 0x10, 0xbb, 0xf0, 0x51, 0x8f, 0xa1, 0x73, 0x10,
+	0x87, 0xca, 0x1e, 0xe7, 0xd8, 0x8b, 0xa2, 0x9a, 0x57, 0xa3, 0x27, 0x79, 0xdd, 0x87, 0x1a, 0x87,
+	0x3c, 0x33, 0x1d, 0x66, 0xcb, 0xed, 0xc4, 0xfc, 0x3e, 0x34, 0x0f, 0x4e, 0xfc, 0xc9, 0xc8, 0x39,
+	0x50, 0xe1, 0x99, 0x12, 0xb9, 0x52, 0xf6, 0x62, 0xae, 0x6d, 0x5d, 0x13, 0x2b, 0x00, 0xec, 0x65,
+	0xb1, 0xd6, 0x27, 0xea, 0x48, 0xdb, 0x9d, 0x8c, 0x79, 0xd0, 0x9c, 0xfb, 0x65, 0xce, 0x5c, 0xe4,
+	0xf3, 0x26, 0xce, 0x8f, 0xa1, 0xbd, 0x49, 0x5a, 0xb3, 0x17, 0xae, 0x1f, 0xfa, 0x61, 0x2c, 0x66,
+	0x1f, 0xc7, 0x2e, 0xce, 0x22, 0xac, 0x6b, 0xf8, 0x3e, 0xa1, 0x1f, 0x9e, 0x33, 0xff, 0x0d, 0x1d,
+	0x30, 0x66, 0xf3, 0xcd, 0x59, 0x25, 0x3e, 0x32, 0x4e, 0x19, 0xd6, 0x63, 0x71, 0xd9, 0x4b, 0xd8,
+	0xc5, 0xcb, 0x08, 0xf4, 0xa5, 0x20, 0xb3, 0xd7, 0xa7, 0xf3, 0x9f, 0xb2, 0xcc, 0xee, 0xe1, 0xda,
+	0x3f, 0x57, 0xa0, 0xf6, 0x7d, 0x3f, 0x3c, 0x55, 0x21, 0x56, 0x38, 0xe8, 0x5a, 0x46, 0xab, 0x6f,
+	0x7a, 0x45, 0x33, 0x6f, 0x81, 0xef, 0x82, 0x49, 0x9b, 0x81, 0x7f, 0xf0, 0x61, 0x15, 0xa1, 0xbf,
+	0x6a, 0xf1, 0x7e, 0x70, 0xc5, 0x84, 0xf4, 0x69, 0x81, 0x15, 0x24, 0xbd, 0x13, 0x2c, 0x5c, 0x92,
+	0x2c, 0x92, 0xdc, 0x9f, 0xbd, 0x3c, 0xc0, 0x23, 0xf1, 0xc8, 0x40, 0x33, 0x78, 0xc0, 0x12, 0x46,
+	0xa6, 0xec, 0x2f, 0x2a, 0x8b, 0x0b, 0x09, 0x22, 0x1d, 0xf9, 0x21, 0xd4, 0x38, 0x51, 0x65, 0xf1,
+	0x16, 0x6a, 0x6a, 0x8b, 0x9d, 0x3c, 0x4a, 0x77, 0xf8, 0x08, 0x6a, 0x6c, 0x5f, 0xb8, 0x43, 0x21,
+	0x6e, 0x59, 0x14, 0x79, 0x54, 0x72, 0x88, 0xc4, 0x7d, 0xa8, 0xeb, 0x2b, 0x16, 0x31, 0xe7, 0xbe,
+	0x85, 0x97, 0xca, 0x52, 0xb5, 0xae, 0x89, 0x0f, 0xa0, 0xc6, 0xae, 0x89, 0xc7, 0x2f, 0xb8, 0xa9,
+	0x19, 0xd6, 0x07, 0xf8, 0x6e, 0x6a, 0xa8, 0xdc, 0x5c, 0xb2, 0x26, 0x12, 0x49, 0xcc, 0x31, 0x15,
+	0x8f, 0xa1, 0x5d, 0x48, 0xec, 0x44, 0x97, 0x76, 0x67, 0x4e, 0xae, 0x77, 0xe1, 0x80, 0x7e, 0x0b,
+	0x4c, 0x1d, 0x06, 0x1f, 0x2a, 0x56, 0xa9, 0x39, 0x81, 0xf4, 0xe2, 0xc5, 0x38, 0x98, 0x4e, 0xdd,
+	0xb7, 0xc1, 0x4c, 0xd5, 0x49, 0xcc, 0x3e, 0x3d, 0x65, 0xbb, 0x36, 0x5f, 0xc7, 0xf0, 0xab, 0x37,
+	0x3a, 0xbf, 0xfc, 0xd5, 0x5d, 0xe3, 0x1f, 0x7e, 0x75, 0xd7, 0xf8, 0x97, 0x5f, 0xdd, 0x35, 0x7e,
+	0xfe, 0xaf, 0x77, 0xaf, 0x1d, 0xd6, 0xe8, 0x2f, 0x89, 0x1f, 0xff, 0xef, 0x00, 0x7d, 0x8d, 0x8a,
+	0xa1, 0x08, 0x39, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RaftClient is the client API for Raft service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RaftClient interface {
+	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
+	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
+	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
+	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
+}
+
+type raftClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRaftClient(cc *grpc.ClientConn) RaftClient {
+	return &raftClient{cc}
+}
+
+func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerSubscribeClient{stream}
+	x := &raftHeartbeatClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5843,1389 +6295,1303 @@ func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, o
 	return x, nil
 }
 
-type Worker_SubscribeClient interface {
-	Recv() (*pb.KVList, error)
+type Raft_HeartbeatClient interface {
+	Recv() (*HealthInfo, error)
 	grpc.ClientStream
 }
 
-type workerSubscribeClient struct {
+type raftHeartbeatClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
-	m := new(pb.KVList)
+func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
+	m := new(HealthInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-// WorkerServer is the server API for Worker service.
-type WorkerServer interface {
-	// Data serving RPCs.
-	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
-	ServeTask(context.Context, *Query) (*Result, error)
-	StreamSnapshot(Worker_StreamSnapshotServer) error
-	Sort(context.Context, *SortMessage) (*SortResult, error)
-	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
-	Backup(context.Context, *BackupRequest) (*Status, error)
-	Restore(context.Context, *RestoreRequest) (*Status, error)
-	Export(context.Context, *ExportRequest) (*Status, error)
-	ReceivePredicate(Worker_ReceivePredicateServer) error
-	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
-	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
+func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftRaftMessageClient{stream}
+	return x, nil
 }
 
-// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServer struct {
+type Raft_RaftMessageClient interface {
+	Send(*RaftBatch) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
 }
 
-func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
-}
-func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
-}
-func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
-}
-func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
-}
-func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
-}
-func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
-}
-func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
-}
-func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
-}
-func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
-}
-func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
-}
-func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
-	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+type raftRaftMessageClient struct {
+	grpc.ClientStream
 }
 
-func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
-	s.RegisterService(&_Worker_serviceDesc, srv)
+func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Mutations)
-	if err := dec(in); err != nil {
+func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Mutate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Mutate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Query)
-	if err := dec(in); err != nil {
+func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).ServeTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/ServeTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
+func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
+	out := new(PeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type Worker_StreamSnapshotServer interface {
-	Send(*KVS) error
-	Recv() (*Snapshot, error)
+// RaftServer is the server API for Raft service.
+type RaftServer interface {
+	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
+	RaftMessage(Raft_RaftMessageServer) error
+	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
+	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
+}
+
+// UnimplementedRaftServer can be embedded to have forward compatible implementations.
+type UnimplementedRaftServer struct {
+}
+
+func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
+	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
+}
+func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+}
+func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
+}
+
+func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
+	s.RegisterService(&_Raft_serviceDesc, srv)
+}
+
+func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
+}
+
+type Raft_HeartbeatServer interface {
+	Send(*HealthInfo) error
 	grpc.ServerStream
 }
 
-type workerStreamSnapshotServer struct {
+type raftHeartbeatServer struct {
 	grpc.ServerStream
 }
 
-func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
-	m := new(Snapshot)
+func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
+}
+
+type Raft_RaftMessageServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*RaftBatch, error)
+	grpc.ServerStream
+}
+
+type raftRaftMessageServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
+	m := new(RaftBatch)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SortMessage)
+func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Sort(ctx, in)
+		return srv.(RaftServer).JoinCluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Sort",
+		FullMethod: "/pb.Raft/JoinCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
+		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchemaRequest)
+func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Schema(ctx, in)
+		return srv.(RaftServer).IsPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Schema",
+		FullMethod: "/pb.Raft/IsPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
+		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BackupRequest)
-	if err := dec(in); err != nil {
+var _Raft_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Raft",
+	HandlerType: (*RaftServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinCluster",
+			Handler:    _Raft_JoinCluster_Handler,
+		},
+		{
+			MethodName: "IsPeer",
+			Handler:    _Raft_IsPeer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Heartbeat",
+			Handler:       _Raft_Heartbeat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RaftMessage",
+			Handler:       _Raft_RaftMessage_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
+}
+
+// ZeroClient is the client API for Zero service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ZeroClient interface {
+	// These 3 endpoints are for handling membership.
+	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
+	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
+	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
+	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
+	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
+	AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
+	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
+	TimestampAt(ctx context.Context, in *TimestampCheckpoint, opts ...grpc.CallOption) (*TimestampCheckpoint, error)
+	Replicated(ctx context.Context, in *ReplicationStatus, opts ...grpc.CallOption) (*api.Payload, error)
+}
+
+type zeroClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
+	return &zeroClient{cc}
+}
+
+func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
+	out := new(ConnectionState)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Backup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Backup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreRequest)
-	if err := dec(in); err != nil {
+func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Restore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Restore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportRequest)
-	if err := dec(in); err != nil {
+func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Export(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Export",
+	x := &zeroStreamMembershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
-}
-
-type Worker_ReceivePredicateServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*KVS, error)
-	grpc.ServerStream
+	return x, nil
 }
 
-type workerReceivePredicateServer struct {
-	grpc.ServerStream
+type Zero_StreamMembershipClient interface {
+	Recv() (*MembershipState, error)
+	grpc.ClientStream
 }
 
-func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
+type zeroStreamMembershipClient struct {
+	grpc.ClientStream
 }
 
-func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
-	m := new(KVS)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
+	m := new(MembershipState)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovePredicatePayload)
-	if err := dec(in); err != nil {
+func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).MovePredicate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/MovePredicate",
+	x := &zeroOracleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscriptionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
+type Zero_OracleClient interface {
+	Recv() (*OracleDelta, error)
+	grpc.ClientStream
 }
 
-type Worker_SubscribeServer interface {
-	Send(*pb.KVList) error
-	grpc.ServerStream
+type zeroOracleClient struct {
+	grpc.ClientStream
 }
 
-type workerSubscribeServer struct {
-	grpc.ServerStream
+func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
+	m := new(OracleDelta)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *workerSubscribeServer) Send(m *pb.KVList) error {
-	return x.ServerStream.SendMsg(m)
+func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
+	out := new(Tablet)
+	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Mutate",
-			Handler:    _Worker_Mutate_Handler,
-		},
-		{
-			MethodName: "ServeTask",
-			Handler:    _Worker_ServeTask_Handler,
-		},
-		{
-			MethodName: "Sort",
-			Handler:    _Worker_Sort_Handler,
-		},
-		{
-			MethodName: "Schema",
-			Handler:    _Worker_Schema_Handler,
-		},
-		{
-			MethodName: "Backup",
-			Handler:    _Worker_Backup_Handler,
-		},
-		{
-			MethodName: "Restore",
-			Handler:    _Worker_Restore_Handler,
-		},
-		{
-			MethodName: "Export",
-			Handler:    _Worker_Export_Handler,
-		},
-		{
-			MethodName: "MovePredicate",
-			Handler:    _Worker_MovePredicate_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamSnapshot",
-			Handler:       _Worker_StreamSnapshot_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReceivePredicate",
-			Handler:       _Worker_ReceivePredicate_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "Subscribe",
-			Handler:       _Worker_Subscribe_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
+func (c *zeroClient) AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/AssignUids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *List) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *List) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
+	out := new(OracleDelta)
+	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Uids) > 0 {
-		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
-		}
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *zeroClient) TimestampAt(ctx context.Context, in *TimestampCheckpoint, opts ...grpc.CallOption) (*TimestampCheckpoint, error) {
+	out := new(TimestampCheckpoint)
+	err := c.cc.Invoke(ctx, "/pb.Zero/TimestampAt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TaskValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *zeroClient) Replicated(ctx context.Context, in *ReplicationStatus, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Replicated", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ZeroServer is the server API for Zero service.
+type ZeroServer interface {
+	// These 3 endpoints are for handling membership.
+	Connect(context.Context, *Member) (*ConnectionState, error)
+	UpdateMembership(context.Context, *Group) (*api.Payload, error)
+	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
+	Oracle(*api.Payload, Zero_OracleServer) error
+	ShouldServe(context.Context, *Tablet) (*Tablet, error)
+	AssignUids(context.Context, *Num) (*AssignedIds, error)
+	Timestamps(context.Context, *Num) (*AssignedIds, error)
+	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
+	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
+	TimestampAt(context.Context, *TimestampCheckpoint) (*TimestampCheckpoint, error)
+	Replicated(context.Context, *ReplicationStatus) (*api.Payload, error)
 }
 
-func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedZeroServer can be embedded to have forward compatible implementations.
+type UnimplementedZeroServer struct {
+}
+
+func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
+}
+func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
+}
+func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
+	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
+}
+func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
+}
+func (*UnimplementedZeroServer) AssignUids(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignUids not implemented")
+}
+func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
+}
+func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
+}
+func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
+}
+func (*UnimplementedZeroServer) TimestampAt(ctx context.Context, req *TimestampCheckpoint) (*TimestampCheckpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimestampAt not implemented")
+}
+func (*UnimplementedZeroServer) Replicated(ctx context.Context, req *ReplicationStatus) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replicated not implemented")
+}
+
+func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
+	s.RegisterService(&_Zero_serviceDesc, srv)
+}
+
+func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(ZeroServer).Connect(ctx, in)
 	}
-	if len(m.Val) > 0 {
-		i -= len(m.Val)
-		copy(dAtA[i:], m.Val)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/Connect",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).Connect(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ZeroServer).UpdateMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/UpdateMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
 }
 
-func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsCount {
-		i--
-		if m.IsCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type Zero_StreamMembershipServer interface {
+	Send(*MembershipState) error
+	grpc.ServerStream
 }
 
-func (m *Query) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type zeroStreamMembershipServer struct {
+	grpc.ServerStream
+}
+
+func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
 }
 
-func (m *Query) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Zero_OracleServer interface {
+	Send(*OracleDelta) error
+	grpc.ServerStream
 }
 
-func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type zeroOracleServer struct {
+	grpc.ServerStream
+}
+
+func (x *zeroOracleServer) Send(m *OracleDelta) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tablet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.First != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.First))
-		i--
-		dAtA[i] = 0x78
+	if interceptor == nil {
+		return srv.(ZeroServer).ShouldServe(ctx, in)
 	}
-	if m.Cache != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
-		i--
-		dAtA[i] = 0x70
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/ShouldServe",
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
 	}
-	if m.ExpandAll {
-		i--
-		if m.ExpandAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_AssignUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FacetsFilter != nil {
-		{
-			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if interceptor == nil {
+		return srv.(ZeroServer).AssignUids(ctx, in)
 	}
-	if m.FacetParam != nil {
-		{
-			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/AssignUids",
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).AssignUids(ctx, req.(*Num))
 	}
-	if m.SrcFunc != nil {
-		{
-			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UidList != nil {
-		{
-			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(ZeroServer).Timestamps(ctx, in)
 	}
-	if m.DoCount {
-		i--
-		if m.DoCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/Timestamps",
 	}
-	if m.AfterUid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
-		i--
-		dAtA[i] = 0x19
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
 	}
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.TxnContext)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(ZeroServer).CommitOrAbort(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/CommitOrAbort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnTimestamps)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ZeroServer).TryAbort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/TryAbort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Zero_TimestampAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimestampCheckpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).TimestampAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/TimestampAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).TimestampAt(ctx, req.(*TimestampCheckpoint))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Zero_Replicated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(ZeroServer).Replicated(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/Replicated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).Replicated(ctx, req.(*ReplicationStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LangList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _Zero_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Zero",
+	HandlerType: (*ZeroServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Connect",
+			Handler:    _Zero_Connect_Handler,
+		},
+		{
+			MethodName: "UpdateMembership",
+			Handler:    _Zero_UpdateMembership_Handler,
+		},
+		{
+			MethodName: "ShouldServe",
+			Handler:    _Zero_ShouldServe_Handler,
+		},
+		{
+			MethodName: "AssignUids",
+			Handler:    _Zero_AssignUids_Handler,
+		},
+		{
+			MethodName: "Timestamps",
+			Handler:    _Zero_Timestamps_Handler,
+		},
+		{
+			MethodName: "CommitOrAbort",
+			Handler:    _Zero_CommitOrAbort_Handler,
+		},
+		{
+			MethodName: "TryAbort",
+			Handler:    _Zero_TryAbort_Handler,
+		},
+		{
+			MethodName: "TimestampAt",
+			Handler:    _Zero_TimestampAt_Handler,
+		},
+		{
+			MethodName: "Replicated",
+			Handler:    _Zero_Replicated_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMembership",
+			Handler:       _Zero_StreamMembership_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Oracle",
+			Handler:       _Zero_Oracle_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
+}
+
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	// Data serving RPCs.
+	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
+	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
+	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
+	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
+	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*Status, error)
+	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
+	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
+	Replicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReplicateClient, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Lang) > 0 {
-		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Lang[iNdEx])
-			copy(dAtA[i:], m.Lang[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	x := &workerStreamSnapshotClient{stream}
+	return x, nil
+}
+
+type Worker_StreamSnapshotClient interface {
+	Send(*Snapshot) error
+	Recv() (*KVS, error)
+	grpc.ClientStream
+}
+
+type workerStreamSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
+	out := new(SortResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
+	out := new(SchemaResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return out, nil
+}
+
+func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LangMatrix) > 0 {
-		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return out, nil
+}
+
+func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FacetMatrix) > 0 {
-		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.IntersectDest {
-		i--
-		if m.IntersectDest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Counts) > 0 {
-		dAtA6 := make([]byte, len(m.Counts)*10)
-		var j5 int
-		for _, num := range m.Counts {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintPb(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValueMatrix) > 0 {
-		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerReceivePredicateClient{stream}
+	return x, nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_ReceivePredicateClient interface {
+	Send(*KVS) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+type workerReceivePredicateClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerReceivePredicateClient) Send(m *KVS) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *SortMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
+func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	x := &workerSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Order) > 0 {
-		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *SortResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Worker_SubscribeClient interface {
+	Recv() (*pb.KVList, error)
+	grpc.ClientStream
 }
 
-func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type workerSubscribeClient struct {
+	grpc.ClientStream
 }
 
-func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
+	m := new(pb.KVList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *RaftContext) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Replicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReplicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[3], "/pb.Worker/Replicate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerReplicateClient{stream}
+	return x, nil
 }
 
-func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_ReplicateClient interface {
+	Send(*ReplicationBatch) error
+	CloseAndRecv() (*ReplicationStatus, error)
+	grpc.ClientStream
 }
 
-func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Group != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
-	}
-	return len(dAtA) - i, nil
+type workerReplicateClient struct {
+	grpc.ClientStream
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *workerReplicateClient) Send(m *ReplicationBatch) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerReplicateClient) CloseAndRecv() (*ReplicationStatus, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	m := new(ReplicationStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	// Data serving RPCs.
+	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
+	ServeTask(context.Context, *Query) (*Result, error)
+	StreamSnapshot(Worker_StreamSnapshotServer) error
+	Sort(context.Context, *SortMessage) (*SortResult, error)
+	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	Restore(context.Context, *RestoreRequest) (*Status, error)
+	Export(context.Context, *ExportRequest) (*Status, error)
+	ReceivePredicate(Worker_ReceivePredicateServer) error
+	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
+	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
+	Replicate(Worker_ReplicateServer) error
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ForceGroupId {
-		i--
-		if m.ForceGroupId {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
+}
+func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
+}
+func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
+}
+func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
+}
+func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+}
+func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedWorkerServer) Replicate(srv Worker_ReplicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+}
+
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
+}
+
+func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Mutations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ClusterInfoOnly {
-		i--
-		if m.ClusterInfoOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	if interceptor == nil {
+		return srv.(WorkerServer).Mutate(ctx, in)
 	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Mutate",
 	}
-	if m.AmDead {
-		i--
-		if m.AmDead {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
 	}
-	if m.Leader {
-		i--
-		if m.Leader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).ServeTask(ctx, in)
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/ServeTask",
 	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_StreamSnapshotServer interface {
+	Send(*KVS) error
+	Recv() (*Snapshot, error)
+	grpc.ServerStream
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type workerStreamSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
+	m := new(Snapshot)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Checksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
-		i--
-		dAtA[i] = 0x20
+	return m, nil
+}
+
+func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(WorkerServer).Sort(ctx, in)
 	}
-	if len(m.Tablets) > 0 {
-		for k := range m.Tablets {
-			v := m.Tablets[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Sort",
 	}
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *License) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).Schema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Schema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *License) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExpiryTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(WorkerServer).Restore(ctx, in)
 	}
-	if m.MaxNodes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Restore",
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
 }
 
-func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+type Worker_ReceivePredicateServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*KVS, error)
+	grpc.ServerStream
+}
+
+type workerReceivePredicateServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x4a
+	return m, nil
+}
+
+func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x42
+	if interceptor == nil {
+		return srv.(WorkerServer).MovePredicate(ctx, in)
 	}
-	if m.Txn != nil {
-		{
-			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/MovePredicate",
 	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
-		i--
-		dAtA[i] = 0x30
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
 	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.MaxLeaseId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
-		i--
-		dAtA[i] = 0x20
+	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
+}
+
+type Worker_SubscribeServer interface {
+	Send(*pb.KVList) error
+	grpc.ServerStream
+}
+
+type workerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerSubscribeServer) Send(m *pb.KVList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_Replicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).Replicate(&workerReplicateServer{stream})
+}
+
+type Worker_ReplicateServer interface {
+	SendAndClose(*ReplicationStatus) error
+	Recv() (*ReplicationBatch, error)
+	grpc.ServerStream
+}
+
+type workerReplicateServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerReplicateServer) SendAndClose(m *ReplicationStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerReplicateServer) Recv() (*ReplicationBatch, error) {
+	m := new(ReplicationBatch)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Tablet != nil {
+	return m, nil
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Member != nil {
+			MethodName: "Mutate",
+			Handler:    _Worker_Mutate_Handler,
+		},
 		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SnapshotTs) > 0 {
-		for k := range m.SnapshotTs {
-			v := m.SnapshotTs[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+			MethodName: "ServeTask",
+			Handler:    _Worker_ServeTask_Handler,
+		},
+		{
+			MethodName: "Sort",
+			Handler:    _Worker_Sort_Handler,
+		},
+		{
+			MethodName: "Schema",
+			Handler:    _Worker_Schema_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _Worker_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Worker_Restore_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Worker_Export_Handler,
+		},
+		{
+			MethodName: "MovePredicate",
+			Handler:    _Worker_MovePredicate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSnapshot",
+			Handler:       _Worker_StreamSnapshot_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReceivePredicate",
+			Handler:       _Worker_ReceivePredicate_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Worker_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Replicate",
+			Handler:       _Worker_Replicate_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
 }
 
-func (m *MembershipState) Marshal() (dAtA []byte, err error) {
+func (m *List) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7235,12 +7601,12 @@ func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
+func (m *List) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7249,111 +7615,19 @@ func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxLeaseId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Zeros) > 0 {
-		for k := range m.Zeros {
-			v := m.Zeros[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Groups) > 0 {
-		for k := range m.Groups {
-			v := m.Groups[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if len(m.Uids) > 0 {
+		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
 		}
-	}
-	if m.Counter != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
+func (m *TaskValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7363,12 +7637,12 @@ func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7377,39 +7651,22 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxPending != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.Val) > 0 {
+		i -= len(m.Val)
+		copy(dAtA[i:], m.Val)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
+func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7419,12 +7676,12 @@ func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7433,73 +7690,36 @@ func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Indexing) > 0 {
-		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Indexing[iNdEx])
-			copy(dAtA[i:], m.Indexing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+	if m.IsCount {
+		i--
+		if m.IsCount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Ongoing) > 0 {
-		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ongoing[iNdEx])
-			copy(dAtA[i:], m.Ongoing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.LastEcho != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Uptime != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Instance) > 0 {
-		i -= len(m.Instance)
-		copy(dAtA[i:], m.Instance)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Marshal() (dAtA []byte, err error) {
+func (m *Query) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7509,12 +7729,12 @@ func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7523,62 +7743,125 @@ func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MoveTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
+	if m.First != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.First))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x78
 	}
-	if m.ReadOnly {
+	if m.Cache != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
 		i--
-		if m.ReadOnly {
+		dAtA[i] = 0x70
+	}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpandAll {
+		i--
+		if m.ExpandAll {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x50
 	}
-	if m.Remove {
+	if m.FacetsFilter != nil {
+		{
+			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Remove {
+		dAtA[i] = 0x4a
+	}
+	if m.FacetParam != nil {
+		{
+			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x38
 	}
-	if m.Space != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Space))
+	if m.SrcFunc != nil {
+		{
+			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x32
 	}
-	if m.Force {
+	if m.UidList != nil {
+		{
+			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Force {
+		dAtA[i] = 0x2a
+	}
+	if m.DoCount {
+		i--
+		if m.DoCount {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if m.AfterUid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x19
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
+func (m *ValueList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7588,12 +7871,12 @@ func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7602,10 +7885,10 @@ func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7613,63 +7896,49 @@ func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Lang) > 0 {
-		i -= len(m.Lang)
-		copy(dAtA[i:], m.Lang)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+
+func (m *LangList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ValueId != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0x12
+	return dAtA[:n], nil
+}
+
+func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Entity != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
-		i--
-		dAtA[i] = 0x9
+	if len(m.Lang) > 0 {
+		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lang[iNdEx])
+			copy(dAtA[i:], m.Lang[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Mutations) Marshal() (dAtA []byte, err error) {
+func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7679,12 +7948,12 @@ func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
+func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7693,34 +7962,20 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.DropValue) > 0 {
-		i -= len(m.DropValue)
-		copy(dAtA[i:], m.DropValue)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+	if m.List {
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.DropOp != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x38
 	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LangMatrix) > 0 {
+		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7731,10 +7986,10 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FacetMatrix) > 0 {
+		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7742,13 +7997,41 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Edges) > 0 {
-		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+	if m.IntersectDest {
+		i--
+		if m.IntersectDest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Counts) > 0 {
+		dAtA6 := make([]byte, len(m.Counts)*10)
+		var j5 int
+		for _, num := range m.Counts {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPb(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueMatrix) > 0 {
+		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7756,59 +8039,19 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PredHints) > 0 {
-		for k := range m.PredHints {
-			v := m.PredHints[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7816,7 +8059,7 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+func (m *Order) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7826,12 +8069,12 @@ func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7840,47 +8083,36 @@ func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
-		i--
-		dAtA[i] = 0x28
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Done {
+	if m.Desc {
 		i--
-		if m.Done {
+		if m.Desc {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
-		i--
 		dAtA[i] = 0x10
 	}
-	if m.Context != nil {
-		{
-			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+func (m *SortMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7890,12 +8122,12 @@ func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7904,72 +8136,53 @@ func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.KeyFile) > 0 {
-		i -= len(m.KeyFile)
-		copy(dAtA[i:], m.KeyFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.KeyFile)))
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x68
 	}
-	if m.Anonymous {
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
 		i--
-		if m.Anonymous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BackupId) > 0 {
-		i -= len(m.BackupId)
-		copy(dAtA[i:], m.BackupId)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Location) > 0 {
-		i -= len(m.Location)
-		copy(dAtA[i:], m.Location)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.RestoreTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
-		i--
-		dAtA[i] = 0x10
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Order) > 0 {
+		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *SortResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7979,12 +8192,12 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7993,82 +8206,10 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Restore != nil {
-		{
-			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Snapshot != nil {
-		{
-			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Delta != nil {
-		{
-			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.CleanPredicate) > 0 {
-		i -= len(m.CleanPredicate)
-		copy(dAtA[i:], m.CleanPredicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Kv) > 0 {
-		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -8076,25 +8217,13 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Mutations != nil {
-		{
-			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *KVS) Marshal() (dAtA []byte, err error) {
+func (m *RaftContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8104,12 +8233,12 @@ func (m *KVS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8118,34 +8247,43 @@ func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Done {
+	if m.IsLearner {
 		i--
-		if m.Done {
+		if m.IsLearner {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Group != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Group))
+		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Kv) > 0 {
-		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Id != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Posting) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8155,12 +8293,12 @@ func (m *Posting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8169,76 +8307,83 @@ func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if m.ForceGroupId {
+		i--
+		if m.ForceGroupId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x70
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.ClusterInfoOnly {
+		i--
+		if m.ClusterInfoOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x68
 	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
+	if m.Learner {
 		i--
-		dAtA[i] = 0x60
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
+	if m.LastUpdate != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x30
 	}
-	if len(m.LangTag) > 0 {
-		i -= len(m.LangTag)
-		copy(dAtA[i:], m.LangTag)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
+	if m.AmDead {
 		i--
-		dAtA[i] = 0x2a
+		if m.AmDead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PostingType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
+	if m.Leader {
+		i--
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Uid != 0 {
+	if m.Id != 0 {
 		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
 		i--
 		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UidBlock) Marshal() (dAtA []byte, err error) {
+func (m *Group) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8248,12 +8393,12 @@ func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8262,73 +8407,80 @@ func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NumUids != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+	if m.Draining {
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Deltas) > 0 {
-		i -= len(m.Deltas)
-		copy(dAtA[i:], m.Deltas)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if m.Base != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Base))
+	if m.Checksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UidPack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x20
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.Tablets) > 0 {
+		for k := range m.Tablets {
+			v := m.Tablets[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
 				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+				i--
+				dAtA[i] = 0x12
 			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if m.BlockSize != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PostingList) Marshal() (dAtA []byte, err error) {
+func (m *License) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8338,12 +8490,12 @@ func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *License) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8352,59 +8504,37 @@ func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Splits) > 0 {
-		dAtA26 := make([]byte, len(m.Splits)*10)
-		var j25 int
-		for _, num := range m.Splits {
-			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA26[j25] = uint8(num)
-			j25++
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintPb(dAtA, i, uint64(j25))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if m.ExpiryTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.MaxNodes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pack != nil {
-		{
-			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetParam) Marshal() (dAtA []byte, err error) {
+func (m *TimestampCheckpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8414,12 +8544,12 @@ func (m *FacetParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *TimestampCheckpoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TimestampCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8428,24 +8558,30 @@ func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Alias) > 0 {
-		i -= len(m.Alias)
-		copy(dAtA[i:], m.Alias)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
+	if m.Horizon {
 		i--
-		dAtA[i] = 0x12
+		if m.Horizon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+	if m.Time != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Time))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.Ts != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ts))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetParams) Marshal() (dAtA []byte, err error) {
+func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8455,12 +8591,12 @@ func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8469,102 +8605,60 @@ func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Param) > 0 {
-		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Replication != nil {
+		{
+			size, err := m.Replication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
-	}
-	if m.AllKeys {
 		i--
-		if m.AllKeys {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Facets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x82
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Replicated != nil {
+		{
+			size, err := m.Replicated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FacetsList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Drain != nil {
+		{
+			size, err := m.Drain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Pin != nil {
+		{
+			size, err := m.Pin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if len(m.FacetsList) > 0 {
-		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Tablets) > 0 {
+		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -8572,63 +8666,117 @@ func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x62
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Function) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Function) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.TsCheckpoint != nil {
+		{
+			size, err := m.TsCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x4a
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+	if m.Txn != nil {
+		{
+			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
+	}
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxLeaseId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tablet != nil {
+		{
+			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SnapshotTs) > 0 {
+		for k := range m.SnapshotTs {
+			v := m.SnapshotTs[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FilterTree) Marshal() (dAtA []byte, err error) {
+func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8638,12 +8786,12 @@ func (m *FilterTree) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8652,9 +8800,9 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Func != nil {
+	if m.Replication != nil {
 		{
-			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Replication.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8662,92 +8810,142 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x62
 	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	if len(m.Pinned) > 0 {
+		for k := range m.Pinned {
+			v := m.Pinned[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.OngoingMove != nil {
+		{
+			size, err := m.OngoingMove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Op) > 0 {
-		i -= len(m.Op)
-		copy(dAtA[i:], m.Op)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.MaxLeaseId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Types[iNdEx])
-			copy(dAtA[i:], m.Types[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+	if len(m.Zeros) > 0 {
+		for k := range m.Zeros {
+			v := m.Zeros[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fields[iNdEx])
-			copy(dAtA[i:], m.Fields[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Counter != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
+func (m *PredicateMove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8757,12 +8955,12 @@ func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *PredicateMove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PredicateMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8771,91 +8969,49 @@ func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NoConflict {
-		i--
-		if m.NoConflict {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Lang {
-		i--
-		if m.Lang {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x4a
 	}
-	if m.Upsert {
-		i--
-		if m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.FinishedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.FinishedAt))
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.StartedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartedAt))
 		i--
 		dAtA[i] = 0x38
 	}
-	if m.Count {
-		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.KeysMoved != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.KeysMoved))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Round != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Round))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Index {
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Phase)))
 		i--
-		if m.Index {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x22
+	}
+	if m.DestGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
+	if m.SourceGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Predicate) > 0 {
 		i -= len(m.Predicate)
@@ -8867,7 +9023,7 @@ func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
+func (m *PinPredicate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8877,12 +9033,12 @@ func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *PinPredicate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PinPredicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8891,26 +9047,24 @@ func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -8918,12 +9072,12 @@ func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *DrainGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DrainGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8932,113 +9086,115 @@ func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NoConflict {
+	if m.Enable {
 		i--
-		if m.NoConflict {
+		if m.Enable {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x10
 	}
-	if len(m.ObjectTypeName) > 0 {
-		i -= len(m.ObjectTypeName)
-		copy(dAtA[i:], m.ObjectTypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x8
 	}
-	if m.NonNullableList {
-		i--
-		if m.NonNullableList {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NonNullable {
-		i--
-		if m.NonNullable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Lang {
-		i--
-		if m.Lang {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Drops) > 0 {
+		for iNdEx := len(m.Drops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		i--
-		dAtA[i] = 0x48
 	}
-	if m.Upsert {
+	if m.Forwarded {
 		i--
-		if m.Upsert {
+		if m.Forwarded {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x38
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.SentAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SentAt))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Count {
-		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.MaxUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxUid))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+	if len(m.Kv) > 0 {
+		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x22
 		}
 	}
-	if m.Directive != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
+	if m.UpToTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UpToTs))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9048,12 +9204,12 @@ func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9062,31 +9218,35 @@ func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.MaxUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxUid))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.TypeName) > 0 {
-		i -= len(m.TypeName)
-		copy(dAtA[i:], m.TypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+	if m.AppliedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.AppliedAt))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppliedTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.AppliedTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MapHeader) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9096,12 +9256,12 @@ func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9110,19 +9270,49 @@ func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionKeys) > 0 {
-		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PartitionKeys[iNdEx])
-			copy(dAtA[i:], m.PartitionKeys[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
+	if m.PromotedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PromotedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if m.Standby {
+		i--
+		if m.Standby {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MapEntry) Marshal() (dAtA []byte, err error) {
+func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9132,12 +9322,12 @@ func (m *MapEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MapEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9146,9 +9336,14 @@ func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Posting != nil {
+	if m.MaxPending != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != nil {
 		{
-			size, err := m.Posting.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9156,25 +9351,24 @@ func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Uid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
-		i--
-		dAtA[i] = 0x11
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
+func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9184,12 +9378,12 @@ func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9198,37 +9392,73 @@ func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
+	if len(m.Indexing) > 0 {
+		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexing[iNdEx])
+			copy(dAtA[i:], m.Indexing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Ongoing) > 0 {
+		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ongoing[iNdEx])
+			copy(dAtA[i:], m.Ongoing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastEcho != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
 	}
-	if m.DestGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
+	if m.Uptime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x30
 	}
-	if m.SourceGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x2a
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
+func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9238,12 +9468,12 @@ func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9252,20 +9482,91 @@ func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if len(m.Composite) > 0 {
+		i -= len(m.Composite)
+		copy(dAtA[i:], m.Composite)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Composite)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x7a
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.WriteRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WriteRate))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if m.ReadRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReadRate))))
+		i--
+		dAtA[i] = 0x69
+	}
+	if m.EndUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EndUid))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StartUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MoveTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Space != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Space))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
+func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9275,12 +9576,12 @@ func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9289,30 +9590,15 @@ func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupChecksums) > 0 {
-		for k := range m.GroupChecksums {
-			v := m.GroupChecksums[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MaxAssigned != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpiresAt))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x50
 	}
-	if len(m.Txns) > 0 {
-		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9320,13 +9606,63 @@ func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x4a
 		}
 	}
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ValueId != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Entity != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
+		i--
+		dAtA[i] = 0x9
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9336,12 +9672,12 @@ func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9350,30 +9686,98 @@ func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Ts) > 0 {
-		dAtA31 := make([]byte, len(m.Ts)*10)
-		var j30 int
-		for _, num := range m.Ts {
-			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j30++
+	if m.Reindex {
+		i--
+		if m.Reindex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPb(dAtA, i, uint64(j30))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if len(m.DropValue) > 0 {
+		i -= len(m.DropValue)
+		copy(dAtA[i:], m.DropValue)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DropOp != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -9381,12 +9785,12 @@ func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9395,20 +9799,27 @@ func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.PredHints) > 0 {
+		for k := range m.PredHints {
+			v := m.PredHints[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9418,12 +9829,12 @@ func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9432,17 +9843,30 @@ func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Payload != nil {
-		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
+	}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Context != nil {
 		{
@@ -9459,7 +9883,7 @@ func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9469,12 +9893,12 @@ func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9483,19 +9907,89 @@ func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Prefixes) > 0 {
-		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prefixes[iNdEx])
-			copy(dAtA[i:], m.Prefixes[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.BackupNum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BackupNum))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.KeyFile) > 0 {
+		i -= len(m.KeyFile)
+		copy(dAtA[i:], m.KeyFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.KeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BackupId) > 0 {
+		i -= len(m.BackupId)
+		copy(dAtA[i:], m.BackupId)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestoreTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9505,12 +9999,12 @@ func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9519,9 +10013,9 @@ func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Kvs != nil {
+	if m.CleanShard != nil {
 		{
-			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CleanShard.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9529,64 +10023,110 @@ func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Num) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Restore != nil {
+		{
+			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Num) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.Forwarded {
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
 		i--
-		if m.Forwarded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x50
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x4a
 	}
-	if m.ReadOnly {
+	if m.Delta != nil {
+		{
+			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		if m.ReadOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x42
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CleanPredicate) > 0 {
+		i -= len(m.CleanPredicate)
+		copy(dAtA[i:], m.CleanPredicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x2a
 	}
-	if m.Val != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Val))
+	if len(m.Kv) > 0 {
+		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mutations != nil {
+		{
+			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
+func (m *KVS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9596,12 +10136,12 @@ func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9610,25 +10150,59 @@ func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ReadOnly != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
+	if m.Ingest {
+		i--
+		if m.Ingest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.EndId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
+	if m.Merge {
 		i--
-		dAtA[i] = 0x10
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.StartId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Kv) > 0 {
+		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
+func (m *Posting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9638,12 +10212,12 @@ func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9652,20 +10226,81 @@ func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpiresAt))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x78
 	}
-	if m.ClientTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x70
+	}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LangTag) > 0 {
+		i -= len(m.LangTag)
+		copy(dAtA[i:], m.LangTag)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PostingType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Uid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
+func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9675,12 +10310,12 @@ func (m *Status) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9689,22 +10324,27 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+	if m.NumUids != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Deltas) > 0 {
+		i -= len(m.Deltas)
+		copy(dAtA[i:], m.Deltas)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+	if m.Base != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Base))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+func (m *UidPack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9714,12 +10354,12 @@ func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9728,79 +10368,29 @@ func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.Anonymous {
-		i--
-		if m.Anonymous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UnixTs) > 0 {
-		i -= len(m.UnixTs)
-		copy(dAtA[i:], m.UnixTs)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
-		i--
-		dAtA[i] = 0x10
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if m.BlockSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9810,12 +10400,12 @@ func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9824,32 +10414,59 @@ func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+	if len(m.Splits) > 0 {
+		dAtA36 := make([]byte, len(m.Splits)*10)
+		var j35 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintPb(dAtA, i, uint64(j35))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.UnixTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Pack != nil {
+		{
+			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+func (m *FacetParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9859,12 +10476,12 @@ func (m *BackupKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9873,44 +10490,24 @@ func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.StartUid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Uid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9920,12 +10517,12 @@ func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9934,33 +10531,10 @@ func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Splits) > 0 {
-		dAtA36 := make([]byte, len(m.Splits)*10)
-		var j35 int
-		for _, num := range m.Splits {
-			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA36[j35] = uint8(num)
-			j35++
-		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintPb(dAtA, i, uint64(j35))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Param) > 0 {
+		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9971,1209 +10545,1871 @@ func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Uids) > 0 {
-		dAtA38 := make([]byte, len(m.Uids)*10)
-		var j37 int
-		for _, num := range m.Uids {
-			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j37++
-			}
-			dAtA38[j37] = uint8(num)
-			j37++
+	if m.AllKeys {
+		i--
+		if m.AllKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPb(dAtA, i, uint64(j37))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Facets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *List) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TaskValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FacetsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.FacetsList) > 0 {
+		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SrcFunction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Function) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.IsCount {
-		n += 2
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Query) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FilterTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if m.Func != nil {
+		{
+			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AfterUid != 0 {
-		n += 9
-	}
-	if m.DoCount {
-		n += 2
-	}
-	if m.UidList != nil {
-		l = m.UidList.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SrcFunc != nil {
-		l = m.SrcFunc.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.FacetParam != nil {
-		l = m.FacetParam.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.FacetsFilter != nil {
-		l = m.FacetsFilter.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ExpandAll {
-		n += 2
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.Cache != 0 {
-		n += 1 + sovPb(uint64(m.Cache))
-	}
-	if m.First != 0 {
-		n += 1 + sovPb(uint64(m.First))
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValueList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LangList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Lang) > 0 {
-		for _, s := range m.Lang {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Result) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.ValueMatrix) > 0 {
-		for _, e := range m.ValueMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Counts) > 0 {
-		l = 0
-		for _, e := range m.Counts {
-			l += sovPb(uint64(e))
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 1 + sovPb(uint64(l)) + l
 	}
-	if m.IntersectDest {
-		n += 2
-	}
-	if len(m.FacetMatrix) > 0 {
-		for _, e := range m.FacetMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.LangMatrix) > 0 {
-		for _, e := range m.LangMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.List {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Desc {
-		n += 2
+	if len(m.Ttl) > 0 {
+		i -= len(m.Ttl)
+		copy(dAtA[i:], m.Ttl)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Ttl)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NoConflict {
+		i--
+		if m.NoConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *SortMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Lang {
+		i--
+		if m.Lang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	if len(m.Order) > 0 {
-		for _, e := range m.Order {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.List {
+		i--
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
+	if m.Count {
+		i--
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Offset != 0 {
-		n += 1 + sovPb(uint64(m.Offset))
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Index {
+		i--
+		if m.Index {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SortResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RaftContext) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Group != 0 {
-		n += 1 + sovPb(uint64(m.Group))
+	if m.Ttl != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Constraint != nil {
+		{
+			size, err := m.Constraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NoConflict {
+		i--
+		if m.NoConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	return n
-}
-
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ObjectTypeName) > 0 {
+		i -= len(m.ObjectTypeName)
+		copy(dAtA[i:], m.ObjectTypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 9
+	if m.NonNullableList {
+		i--
+		if m.NonNullableList {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.NonNullable {
+		i--
+		if m.NonNullable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Lang {
+		i--
+		if m.Lang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Leader {
-		n += 2
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.AmDead {
-		n += 2
+	if m.List {
+		i--
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.LastUpdate != 0 {
-		n += 1 + sovPb(uint64(m.LastUpdate))
+	if m.Count {
+		i--
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ClusterInfoOnly {
-		n += 2
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.ForceGroupId {
-		n += 2
+	if m.Directive != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Group) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tablets) > 0 {
-		for k, v := range m.Tablets {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if len(m.CompositeIndexes) > 0 {
+		for iNdEx := len(m.CompositeIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositeIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
-	}
-	if m.Checksum != 0 {
-		n += 1 + sovPb(uint64(m.Checksum))
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *License) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CompositeIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.MaxNodes != 0 {
-		n += 1 + sovPb(uint64(m.MaxNodes))
-	}
-	if m.ExpiryTs != 0 {
-		n += 1 + sovPb(uint64(m.ExpiryTs))
-	}
-	if m.Enabled {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ZeroProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SnapshotTs) > 0 {
-		for k, v := range m.SnapshotTs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Tablet != nil {
-		l = m.Tablet.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovPb(uint64(m.MaxLeaseId))
+	if m.MaxCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	if m.MinCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Regex)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Txn != nil {
-		l = m.Txn.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MapHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PartitionKeys) > 0 {
+		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionKeys[iNdEx])
+			copy(dAtA[i:], m.PartitionKeys[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MembershipState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MapEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Counter != 0 {
-		n += 1 + sovPb(uint64(m.Counter))
-	}
-	if len(m.Groups) > 0 {
-		for k, v := range m.Groups {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Zeros) > 0 {
-		for k, v := range m.Zeros {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if m.Posting != nil {
+		{
+			size, err := m.Posting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovPb(uint64(m.MaxLeaseId))
-	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	if m.Uid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+		i--
+		dAtA[i] = 0x11
 	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.MaxPending != 0 {
-		n += 1 + sovPb(uint64(m.MaxPending))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HealthInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Instance)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.EndUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EndUid))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.StartUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Uptime != 0 {
-		n += 1 + sovPb(uint64(m.Uptime))
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LastEcho != 0 {
-		n += 1 + sovPb(uint64(m.LastEcho))
+	if m.TxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Ongoing) > 0 {
-		for _, s := range m.Ongoing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.DestGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Indexing) > 0 {
-		for _, s := range m.Indexing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.SourceGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Force {
-		n += 2
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Space != 0 {
-		n += 1 + sovPb(uint64(m.Space))
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Remove {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ReadOnly {
-		n += 2
+	if len(m.GroupChecksums) > 0 {
+		for k := range m.GroupChecksums {
+			v := m.GroupChecksums[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.MoveTs != 0 {
-		n += 1 + sovPb(uint64(m.MoveTs))
+	if m.MaxAssigned != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Txns) > 0 {
+		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DirectedEdge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Entity != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
-	}
-	if m.ValueId != 0 {
-		n += 9
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Lang)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
-	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Ts) > 0 {
+		dAtA42 := make([]byte, len(m.Ts)*10)
+		var j41 int
+		for _, num := range m.Ts {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
 		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPb(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Mutations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
-	}
-	if len(m.Edges) > 0 {
-		for _, e := range m.Edges {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Types) > 0 {
-		for _, e := range m.Types {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.DropOp != 0 {
-		n += 1 + sovPb(uint64(m.DropOp))
-	}
-	l = len(m.DropValue)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PredHints) > 0 {
-		for k, v := range m.PredHints {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.Done {
-		n += 2
-	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RestoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.RestoreTs != 0 {
-		n += 1 + sovPb(uint64(m.RestoreTs))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Location)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Kvs != nil {
+		{
+			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BackupId)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SessionToken)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Anonymous {
-		n += 2
-	}
-	l = len(m.KeyFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Num) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Num) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Mutations != nil {
-		l = m.Mutations.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Forwarded {
+		i--
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.CleanPredicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Delta != nil {
-		l = m.Delta.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Val != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Val))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	return dAtA[:n], nil
+}
+
+func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	if m.ReadOnly != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Restore != nil {
-		l = m.Restore.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.EndId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *KVS) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Done {
-		n += 2
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ClientTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Posting) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 9
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
-	}
-	if m.PostingType != 0 {
-		n += 1 + sovPb(uint64(m.PostingType))
-	}
-	l = len(m.LangTag)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UidBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Base != 0 {
-		n += 1 + sovPb(uint64(m.Base))
-	}
-	l = len(m.Deltas)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.NumUids != 0 {
-		n += 1 + sovPb(uint64(m.NumUids))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *UidPack) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if m.BlockSize != 0 {
-		n += 1 + sovPb(uint64(m.BlockSize))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *PostingList) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.Pack != nil {
-		l = m.Pack.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if len(m.UnixTs) > 0 {
+		i -= len(m.UnixTs)
+		copy(dAtA[i:], m.UnixTs)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Splits) > 0 {
-		l = 0
-		for _, e := range m.Splits {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FacetParam) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Alias)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.FileSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FacetParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PredicateFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PredicateFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PredicateFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AllKeys {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Param) > 0 {
-		for _, e := range m.Param {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.ExcludeTypes) > 0 {
+		for iNdEx := len(m.ExcludeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeTypes[iNdEx])
+			copy(dAtA[i:], m.ExcludeTypes[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.ExcludeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Facets) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.IncludeTypes) > 0 {
+		for iNdEx := len(m.IncludeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncludeTypes[iNdEx])
+			copy(dAtA[i:], m.IncludeTypes[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.IncludeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.ExcludePredicates) > 0 {
+		for iNdEx := len(m.ExcludePredicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludePredicates[iNdEx])
+			copy(dAtA[i:], m.ExcludePredicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.ExcludePredicates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.IncludePredicates) > 0 {
+		for iNdEx := len(m.IncludePredicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncludePredicates[iNdEx])
+			copy(dAtA[i:], m.IncludePredicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.IncludePredicates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FacetsList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FacetsList) > 0 {
-		for _, e := range m.FacetsList {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Function) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Uids != nil {
+		{
+			size, err := m.Uids.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UnixTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FilterTree) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Op)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Children) > 0 {
-		for _, e := range m.Children {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Func != nil {
-		l = m.Func.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Splits) > 0 {
+		dAtA49 := make([]byte, len(m.Splits)*10)
+		var j48 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j48++
+			}
+			dAtA49[j48] = uint8(num)
+			j48++
 		}
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintPb(dAtA, i, uint64(j48))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Types) > 0 {
-		for _, s := range m.Types {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Uids) > 0 {
+		dAtA51 := make([]byte, len(m.Uids)*10)
+		var j50 int
+		for _, num := range m.Uids {
+			for num >= 1<<7 {
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j50++
+			}
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPb(dAtA, i, uint64(j50))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *List) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11181,45 +12417,42 @@ func (m *SchemaRequest) Size() (n int) {
 	return n
 }
 
-func (m *SchemaNode) Size() (n int) {
+func (m *TaskValue) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	l = len(m.Val)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Type)
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SrcFunction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Index {
-		n += 2
-	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.Count {
-		n += 2
-	}
-	if m.List {
-		n += 2
-	}
-	if m.Upsert {
-		n += 2
-	}
-	if m.Lang {
-		n += 2
-	}
-	if m.NoConflict {
+	if m.IsCount {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -11228,89 +12461,73 @@ func (m *SchemaNode) Size() (n int) {
 	return n
 }
 
-func (m *SchemaResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SchemaUpdate) Size() (n int) {
+func (m *Query) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
-	}
-	if m.Directive != 0 {
-		n += 1 + sovPb(uint64(m.Directive))
-	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Count {
-		n += 2
+	if m.AfterUid != 0 {
+		n += 9
 	}
-	if m.List {
+	if m.DoCount {
 		n += 2
 	}
-	if m.Upsert {
-		n += 2
+	if m.UidList != nil {
+		l = m.UidList.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Lang {
-		n += 2
+	if m.SrcFunc != nil {
+		l = m.SrcFunc.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.NonNullable {
+	if m.Reverse {
 		n += 2
 	}
-	if m.NonNullableList {
-		n += 2
+	if m.FacetParam != nil {
+		l = m.FacetParam.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.ObjectTypeName)
-	if l > 0 {
+	if m.FacetsFilter != nil {
+		l = m.FacetsFilter.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.NoConflict {
+	if m.ExpandAll {
 		n += 2
 	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.Cache != 0 {
+		n += 1 + sovPb(uint64(m.Cache))
+	}
+	if m.First != 0 {
+		n += 1 + sovPb(uint64(m.First))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TypeUpdate) Size() (n int) {
+func (m *ValueList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TypeName)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -11321,15 +12538,15 @@ func (m *TypeUpdate) Size() (n int) {
 	return n
 }
 
-func (m *MapHeader) Size() (n int) {
+func (m *LangList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PartitionKeys) > 0 {
-		for _, b := range m.PartitionKeys {
-			l = len(b)
+	if len(m.Lang) > 0 {
+		for _, s := range m.Lang {
+			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
@@ -11339,50 +12556,48 @@ func (m *MapHeader) Size() (n int) {
 	return n
 }
 
-func (m *MapEntry) Size() (n int) {
+func (m *Result) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Uid != 0 {
-		n += 9
-	}
-	if m.Posting != nil {
-		l = m.Posting.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *MovePredicatePayload) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ValueMatrix) > 0 {
+		for _, e := range m.ValueMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
 	}
-	if m.SourceGid != 0 {
-		n += 1 + sovPb(uint64(m.SourceGid))
+	if m.IntersectDest {
+		n += 2
 	}
-	if m.DestGid != 0 {
-		n += 1 + sovPb(uint64(m.DestGid))
+	if len(m.FacetMatrix) > 0 {
+		for _, e := range m.FacetMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.TxnTs != 0 {
-		n += 1 + sovPb(uint64(m.TxnTs))
+	if len(m.LangMatrix) > 0 {
+		for _, e := range m.LangMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	if m.List {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11390,17 +12605,24 @@ func (m *MovePredicatePayload) Size() (n int) {
 	return n
 }
 
-func (m *TxnStatus) Size() (n int) {
+func (m *Order) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if m.Desc {
+		n += 2
+	}
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11408,47 +12630,50 @@ func (m *TxnStatus) Size() (n int) {
 	return n
 }
 
-func (m *OracleDelta) Size() (n int) {
+func (m *SortMessage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txns) > 0 {
-		for _, e := range m.Txns {
+	if len(m.Order) > 0 {
+		for _, e := range m.Order {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.MaxAssigned != 0 {
-		n += 1 + sovPb(uint64(m.MaxAssigned))
-	}
-	if len(m.GroupChecksums) > 0 {
-		for k, v := range m.GroupChecksums {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPb(uint64(m.Offset))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TxnTimestamps) Size() (n int) {
+func (m *SortResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Ts) > 0 {
-		l = 0
-		for _, e := range m.Ts {
-			l += sovPb(uint64(e))
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
-		n += 1 + sovPb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11456,13 +12681,26 @@ func (m *TxnTimestamps) Size() (n int) {
 	return n
 }
 
-func (m *PeerResponse) Size() (n int) {
+func (m *RaftContext) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status {
+	if m.Id != 0 {
+		n += 9
+	}
+	if m.Group != 0 {
+		n += 1 + sovPb(uint64(m.Group))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
+	}
+	if m.IsLearner {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -11471,53 +12709,39 @@ func (m *PeerResponse) Size() (n int) {
 	return n
 }
 
-func (m *RaftBatch) Size() (n int) {
+func (m *Member) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Id != 0 {
+		n += 9
 	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *SubscriptionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Leader {
+		n += 2
 	}
-	var l int
-	_ = l
-	if len(m.Prefixes) > 0 {
-		for _, b := range m.Prefixes {
-			l = len(b)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.AmDead {
+		n += 2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.LastUpdate != 0 {
+		n += 1 + sovPb(uint64(m.LastUpdate))
 	}
-	return n
-}
-
-func (m *SubscriptionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Learner {
+		n += 2
 	}
-	var l int
-	_ = l
-	if m.Kvs != nil {
-		l = m.Kvs.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.ClusterInfoOnly {
+		n += 2
+	}
+	if m.ForceGroupId {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11525,19 +12749,45 @@ func (m *SubscriptionResponse) Size() (n int) {
 	return n
 }
 
-func (m *Num) Size() (n int) {
+func (m *Group) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Val != 0 {
-		n += 1 + sovPb(uint64(m.Val))
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	if m.ReadOnly {
-		n += 2
+	if len(m.Tablets) > 0 {
+		for k, v := range m.Tablets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	if m.Forwarded {
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovPb(uint64(m.Checksum))
+	}
+	if m.Draining {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -11546,20 +12796,24 @@ func (m *Num) Size() (n int) {
 	return n
 }
 
-func (m *AssignedIds) Size() (n int) {
+func (m *License) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartId != 0 {
-		n += 1 + sovPb(uint64(m.StartId))
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.EndId != 0 {
-		n += 1 + sovPb(uint64(m.EndId))
+	if m.MaxNodes != 0 {
+		n += 1 + sovPb(uint64(m.MaxNodes))
 	}
-	if m.ReadOnly != 0 {
-		n += 1 + sovPb(uint64(m.ReadOnly))
+	if m.ExpiryTs != 0 {
+		n += 1 + sovPb(uint64(m.ExpiryTs))
+	}
+	if m.Enabled {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11567,17 +12821,20 @@ func (m *AssignedIds) Size() (n int) {
 	return n
 }
 
-func (m *SnapshotMeta) Size() (n int) {
+func (m *TimestampCheckpoint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ClientTs != 0 {
-		n += 1 + sovPb(uint64(m.ClientTs))
+	if m.Ts != 0 {
+		n += 1 + sovPb(uint64(m.Ts))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Time != 0 {
+		n += 1 + sovPb(uint64(m.Time))
+	}
+	if m.Horizon {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11585,125 +12842,200 @@ func (m *SnapshotMeta) Size() (n int) {
 	return n
 }
 
-func (m *Status) Size() (n int) {
+func (m *ZeroProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if len(m.SnapshotTs) > 0 {
+		for k, v := range m.SnapshotTs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
+	if m.Member != nil {
+		l = m.Member.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *BackupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Tablet != nil {
+		l = m.Tablet.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovPb(uint64(m.MaxLeaseId))
 	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
 	}
-	l = len(m.UnixTs)
-	if l > 0 {
+	if m.Txn != nil {
+		l = m.Txn.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Destination)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.AccessKey)
+	l = len(m.Cid)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.SecretKey)
-	if l > 0 {
+	if m.License != nil {
+		l = m.License.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.SessionToken)
-	if l > 0 {
+	if m.TsCheckpoint != nil {
+		l = m.TsCheckpoint.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Anonymous {
-		n += 2
-	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
+	if len(m.Tablets) > 0 {
+		for _, e := range m.Tablets {
+			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if m.Pin != nil {
+		l = m.Pin.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Drain != nil {
+		l = m.Drain.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Replicated != nil {
+		l = m.Replicated.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 2 + l + sovPb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ExportRequest) Size() (n int) {
+func (m *MembershipState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Counter != 0 {
+		n += 1 + sovPb(uint64(m.Counter))
 	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	if m.UnixTs != 0 {
-		n += 1 + sovPb(uint64(m.UnixTs))
+	if len(m.Zeros) > 0 {
+		for k, v := range m.Zeros {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.Format)
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovPb(uint64(m.MaxLeaseId))
+	}
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	}
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	l = len(m.Cid)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.License != nil {
+		l = m.License.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.OngoingMove != nil {
+		l = m.OngoingMove.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Pinned) > 0 {
+		for k, v := range m.Pinned {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *BackupKey) Size() (n int) {
+func (m *PredicateMove) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPb(uint64(m.Type))
-	}
-	l = len(m.Attr)
+	l = len(m.Predicate)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Uid != 0 {
-		n += 1 + sovPb(uint64(m.Uid))
+	if m.SourceGid != 0 {
+		n += 1 + sovPb(uint64(m.SourceGid))
 	}
-	if m.StartUid != 0 {
-		n += 1 + sovPb(uint64(m.StartUid))
+	if m.DestGid != 0 {
+		n += 1 + sovPb(uint64(m.DestGid))
 	}
-	l = len(m.Term)
+	l = len(m.Phase)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
+	if m.Round != 0 {
+		n += 1 + sovPb(uint64(m.Round))
+	}
+	if m.KeysMoved != 0 {
+		n += 1 + sovPb(uint64(m.KeysMoved))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovPb(uint64(m.StartedAt))
+	}
+	if m.FinishedAt != 0 {
+		n += 1 + sovPb(uint64(m.FinishedAt))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11711,34 +13043,78 @@ func (m *BackupKey) Size() (n int) {
 	return n
 }
 
-func (m *BackupPostingList) Size() (n int) {
+func (m *PinPredicate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		l = 0
-		for _, e := range m.Uids {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrainGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.Enable {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.UpToTs != 0 {
+		n += 1 + sovPb(uint64(m.UpToTs))
+	}
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if m.MaxUid != 0 {
+		n += 1 + sovPb(uint64(m.MaxUid))
 	}
-	if len(m.Splits) > 0 {
-		l = 0
-		for _, e := range m.Splits {
-			l += sovPb(uint64(e))
+	if m.SentAt != 0 {
+		n += 1 + sovPb(uint64(m.SentAt))
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if len(m.Drops) > 0 {
+		for _, e := range m.Drops {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
-		n += 1 + sovPb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11746,152 +13122,4554 @@ func (m *BackupPostingList) Size() (n int) {
 	return n
 }
 
-func sovPb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPb(x uint64) (n int) {
-	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.AppliedTs != 0 {
+		n += 1 + sovPb(uint64(m.AppliedTs))
+	}
+	if m.SentAt != 0 {
+		n += 1 + sovPb(uint64(m.SentAt))
+	}
+	if m.AppliedAt != 0 {
+		n += 1 + sovPb(uint64(m.AppliedAt))
+	}
+	if m.MaxUid != 0 {
+		n += 1 + sovPb(uint64(m.MaxUid))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *List) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: List: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Uids) == 0 {
-					m.Uids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					m.Uids = append(m.Uids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+
+func (m *ReplicationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Standby {
+		n += 2
+	}
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
+	if m.PromotedAt != 0 {
+		n += 1 + sovPb(uint64(m.PromotedAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (m *ConnectionState) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return nil
+	var l int
+	_ = l
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.MaxPending != 0 {
+		n += 1 + sovPb(uint64(m.MaxPending))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *HealthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uptime != 0 {
+		n += 1 + sovPb(uint64(m.Uptime))
+	}
+	if m.LastEcho != 0 {
+		n += 1 + sovPb(uint64(m.LastEcho))
+	}
+	if len(m.Ongoing) > 0 {
+		for _, s := range m.Ongoing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+	}
+	if len(m.Indexing) > 0 {
+		for _, s := range m.Indexing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tablet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.Space != 0 {
+		n += 1 + sovPb(uint64(m.Space))
+	}
+	if m.Remove {
+		n += 2
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.MoveTs != 0 {
+		n += 1 + sovPb(uint64(m.MoveTs))
+	}
+	if m.StartUid != 0 {
+		n += 1 + sovPb(uint64(m.StartUid))
+	}
+	if m.EndUid != 0 {
+		n += 1 + sovPb(uint64(m.EndUid))
+	}
+	if m.ReadRate != 0 {
+		n += 9
+	}
+	if m.WriteRate != 0 {
+		n += 9
+	}
+	l = len(m.Composite)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DirectedEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entity != 0 {
+		n += 9
+	}
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
+	}
+	if m.ValueId != 0 {
+		n += 9
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPb(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Mutations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.DropOp != 0 {
+		n += 1 + sovPb(uint64(m.DropOp))
+	}
+	l = len(m.DropValue)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Reindex {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PredHints) > 0 {
+		for k, v := range m.PredHints {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.RestoreTs != 0 {
+		n += 1 + sovPb(uint64(m.RestoreTs))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.BackupId)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	l = len(m.KeyFile)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.BackupNum != 0 {
+		n += 1 + sovPb(uint64(m.BackupNum))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mutations != nil {
+		l = m.Mutations.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.CleanPredicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Delta != nil {
+		l = m.Delta.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	if m.Restore != nil {
+		l = m.Restore.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.CleanShard != nil {
+		l = m.CleanShard.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KVS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.Merge {
+		n += 2
+	}
+	if m.Ingest {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Posting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uid != 0 {
+		n += 9
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
+	}
+	if m.PostingType != 0 {
+		n += 1 + sovPb(uint64(m.PostingType))
+	}
+	l = len(m.LangTag)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPb(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UidBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != 0 {
+		n += 1 + sovPb(uint64(m.Base))
+	}
+	l = len(m.Deltas)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NumUids != 0 {
+		n += 1 + sovPb(uint64(m.NumUids))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UidPack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSize != 0 {
+		n += 1 + sovPb(uint64(m.BlockSize))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pack != nil {
+		l = m.Pack.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Splits) > 0 {
+		l = 0
+		for _, e := range m.Splits {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FacetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FacetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllKeys {
+		n += 2
+	}
+	if len(m.Param) > 0 {
+		for _, e := range m.Param {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Facets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FacetsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FacetsList) > 0 {
+		for _, e := range m.FacetsList {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Function) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FilterTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Func != nil {
+		l = m.Func.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index {
+		n += 2
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	if m.Unique {
+		n += 2
+	}
+	l = len(m.Ttl)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
+	}
+	if m.Directive != 0 {
+		n += 1 + sovPb(uint64(m.Directive))
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NonNullable {
+		n += 2
+	}
+	if m.NonNullableList {
+		n += 2
+	}
+	l = len(m.ObjectTypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	if m.Unique {
+		n += 2
+	}
+	if m.Constraint != nil {
+		l = m.Constraint.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 2 + sovPb(uint64(m.Ttl))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TypeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.CompositeIndexes) > 0 {
+		for _, e := range m.CompositeIndexes {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompositeIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Required {
+		n += 2
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Regex)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovPb(uint64(m.MinCount))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovPb(uint64(m.MaxCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MapHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionKeys) > 0 {
+		for _, b := range m.PartitionKeys {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MapEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uid != 0 {
+		n += 9
+	}
+	if m.Posting != nil {
+		l = m.Posting.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MovePredicatePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.SourceGid != 0 {
+		n += 1 + sovPb(uint64(m.SourceGid))
+	}
+	if m.DestGid != 0 {
+		n += 1 + sovPb(uint64(m.DestGid))
+	}
+	if m.TxnTs != 0 {
+		n += 1 + sovPb(uint64(m.TxnTs))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.StartUid != 0 {
+		n += 1 + sovPb(uint64(m.StartUid))
+	}
+	if m.EndUid != 0 {
+		n += 1 + sovPb(uint64(m.EndUid))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OracleDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txns) > 0 {
+		for _, e := range m.Txns {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.MaxAssigned != 0 {
+		n += 1 + sovPb(uint64(m.MaxAssigned))
+	}
+	if len(m.GroupChecksums) > 0 {
+		for k, v := range m.GroupChecksums {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ts) > 0 {
+		l = 0
+		for _, e := range m.Ts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, b := range m.Prefixes {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kvs != nil {
+		l = m.Kvs.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Num) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Val != 0 {
+		n += 1 + sovPb(uint64(m.Val))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignedIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartId != 0 {
+		n += 1 + sovPb(uint64(m.StartId))
+	}
+	if m.EndId != 0 {
+		n += 1 + sovPb(uint64(m.EndId))
+	}
+	if m.ReadOnly != 0 {
+		n += 1 + sovPb(uint64(m.ReadOnly))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientTs != 0 {
+		n += 1 + sovPb(uint64(m.ClientTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.UnixTs)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FileSize != 0 {
+		n += 1 + sovPb(uint64(m.FileSize))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PredicateFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncludePredicates) > 0 {
+		for _, s := range m.IncludePredicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.ExcludePredicates) > 0 {
+		for _, s := range m.ExcludePredicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.IncludeTypes) > 0 {
+		for _, s := range m.IncludeTypes {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.ExcludeTypes) > 0 {
+		for _, s := range m.ExcludeTypes {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.UnixTs != 0 {
+		n += 1 + sovPb(uint64(m.UnixTs))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Uids != nil {
+		l = m.Uids.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPb(uint64(m.Type))
+	}
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	if m.StartUid != 0 {
+		n += 1 + sovPb(uint64(m.StartUid))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupPostingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		l = 0
+		for _, e := range m.Uids {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Splits) > 0 {
+		l = 0
+		for _, e := range m.Splits {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPb(x uint64) (n int) {
+	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Uids) == 0 {
+					m.Uids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= Posting_ValType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &FacetParams{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpandAll = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LangList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangMatrix = append(m.LangMatrix, &LangList{})
+			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			m.SnapshotTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AmDead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Learner = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterInfoOnly = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceGroupId = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
+			}
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Members[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
+			}
+			var mapkey string
+			var mapvalue *Tablet
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Tablet{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tablets[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			m.SnapshotTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *License) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: License: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
+			}
+			m.MaxNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
+			}
+			m.ExpiryTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimestampCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			m.Ts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Horizon = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotTs == nil {
+				m.SnapshotTs = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SnapshotTs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			}
+			m.MaxLeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			}
+			m.MaxTxnTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			}
+			m.MaxRaftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRaftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txn == nil {
+				m.Txn = &api.TxnContext{}
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TsCheckpoint == nil {
+				m.TsCheckpoint = &TimestampCheckpoint{}
+			}
+			if err := m.TsCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11901,31 +17679,31 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
+			m.Tablets = append(m.Tablets, &Tablet{})
+			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pin", wireType)
 			}
-			m.ValType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11935,70 +17713,33 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pin == nil {
+				m.Pin = &PinPredicate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12008,29 +17749,33 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Drain == nil {
+				m.Drain = &DrainGroup{}
+			}
+			if err := m.Drain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12040,29 +17785,33 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.Replicated == nil {
+				m.Replicated = &ReplicationStatus{}
+			}
+			if err := m.Replicated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12072,12 +17821,28 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCount = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replication == nil {
+				m.Replication = &ReplicationState{}
+			}
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -12103,7 +17868,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12126,17 +17891,36 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12146,29 +17930,112 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
+			}
+			var mapkey uint32
+			var mapvalue *Group
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Group{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12178,39 +18045,112 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
 			}
-			m.AfterUid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
+			m.Zeros[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			var v int
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12220,17 +18160,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxLeaseId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoCount = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var msglen int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12240,69 +18179,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UidList == nil {
-				m.UidList = &List{}
-			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
-			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			var v int
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12312,15 +18198,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxRaftId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12347,18 +18232,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetParam == nil {
-				m.FacetParam = &FacetParams{}
-			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12368,53 +18251,29 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
-			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExpandAll = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
-			m.ReadTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12424,16 +18283,33 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Cache = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OngoingMove", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12443,16 +18319,33 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cache |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.First = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OngoingMove == nil {
+				m.OngoingMove = &PredicateMove{}
+			}
+			if err := m.OngoingMove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12462,68 +18355,108 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.First |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValueList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pinned == nil {
+				m.Pinned = make(map[string]uint32)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Pinned[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12550,8 +18483,10 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Replication == nil {
+				m.Replication = &ReplicationState{}
+			}
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12580,7 +18515,7 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LangList) Unmarshal(dAtA []byte) error {
+func (m *PredicateMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12603,15 +18538,15 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PredicateMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PredicateMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12632,74 +18567,20 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Result) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			var msglen int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
+			}
+			m.SourceGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12709,31 +18590,35 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SourceGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DestGid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestGid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12743,107 +18628,86 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysMoved", wireType)
+			}
+			m.KeysMoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysMoved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Counts) == 0 {
-					m.Counts = make([]uint32, 0, elementCount)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var v int
+			m.FinishedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12853,17 +18717,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FinishedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12873,31 +18736,83 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinPredicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinPredicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinPredicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12907,31 +18822,29 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangMatrix = append(m.LangMatrix, &LangList{})
-			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12941,12 +18854,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -12972,7 +18884,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *DrainGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12995,17 +18907,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var stringLen uint64
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13015,27 +18927,14 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13052,39 +18951,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Enable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13110,7 +18977,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
+func (m *ReplicationBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13133,17 +19000,17 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13153,29 +19020,52 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.SinceTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpToTs", wireType)
 			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UpToTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpToTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13202,16 +19092,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kv = append(m.Kv, &pb.KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUid", wireType)
 			}
-			m.Count = 0
+			m.MaxUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13221,16 +19111,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				m.MaxUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
 			}
-			m.Offset = 0
+			m.SentAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13240,16 +19130,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				m.SentAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
 			}
-			m.ReadTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13259,11 +19149,46 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Drops = append(m.Drops, &Mutations{})
+			if err := m.Drops[len(m.Drops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13289,7 +19214,7 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SortResult) Unmarshal(dAtA []byte) error {
+func (m *ReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13312,17 +19237,17 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13332,26 +19257,87 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedTs", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.AppliedTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedAt", wireType)
+			}
+			m.AppliedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUid", wireType)
+			}
+			m.MaxUid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13377,7 +19363,7 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
+func (m *ReplicationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13400,27 +19386,17 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
 			}
-			m.Group = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13430,16 +19406,17 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Standby = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13449,29 +19426,112 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*ReplicationStatus)
+			}
+			var mapkey uint32
+			var mapvalue *ReplicationStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ReplicationStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotedAt", wireType)
 			}
-			m.SnapshotTs = 0
+			m.PromotedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13481,7 +19541,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				m.PromotedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13511,7 +19571,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13534,46 +19594,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13583,49 +19614,33 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if m.Member == nil {
+				m.Member = &Member{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Leader = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13635,56 +19650,33 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AmDead = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.State == nil {
+				m.State = &MembershipState{}
 			}
-			m.ClusterInfoOnly = bool(v != 0)
-		case 14:
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			var v int
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13694,12 +19686,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxPending |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceGroupId = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13725,7 +19716,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13748,17 +19739,17 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13768,112 +19759,61 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Members[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13883,126 +19823,93 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var mapkey string
-			var mapvalue *Tablet
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Tablet{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Tablets[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			m.SnapshotTs = 0
+			m.Uptime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14012,16 +19919,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				m.Uptime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
 			}
-			m.Checksum = 0
+			m.LastEcho = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14031,11 +19938,75 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= uint64(b&0x7F) << shift
+				m.LastEcho |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14061,7 +20032,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *License) Unmarshal(dAtA []byte) error {
+func (m *Tablet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14084,17 +20055,147 @@ func (m *License) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: License: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			m.Space = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Space |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.ReadOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
+			}
+			m.MoveTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14104,29 +20205,16 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MoveTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.User = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-			m.MaxNodes = 0
+			m.StartUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14136,16 +20224,16 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNodes |= uint64(b&0x7F) << shift
+				m.StartUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndUid", wireType)
 			}
-			m.ExpiryTs = 0
+			m.EndUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14155,16 +20243,38 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryTs |= int64(b&0x7F) << shift
+				m.EndUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
 			}
-			var v int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReadRate = float64(math.Float64frombits(v))
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WriteRate = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Composite", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14174,12 +20284,24 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Composite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14205,7 +20327,7 @@ func (m *License) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14228,188 +20350,27 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
 			}
-			if postIndex > l {
+			m.Entity = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SnapshotTs == nil {
-				m.SnapshotTs = make(map[uint32]uint64)
-			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SnapshotTs[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			m.MaxLeaseId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14419,16 +20380,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.MaxTxnTs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14438,16 +20412,31 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			m.MaxRaftId = 0
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14457,16 +20446,26 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			}
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14476,31 +20475,27 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &api.TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14528,13 +20523,13 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Lang = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var stringLen uint64
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14544,27 +20539,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= DirectedEdge_Op(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14591,13 +20573,30 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.License == nil {
-				m.License = &License{}
-			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14623,7 +20622,7 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
+func (m *Mutations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14646,17 +20645,17 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Counter = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14666,129 +20665,33 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var msglen int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
-			}
-			var mapkey uint32
-			var mapvalue *Group
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Group{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Groups[mapkey] = mapvalue
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14815,97 +20718,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
-			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Zeros[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.MaxLeaseId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14915,52 +20737,29 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.MaxTxnTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxRaftId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14987,14 +20786,33 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+			}
+			m.DropOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15022,11 +20840,11 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.DropValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15053,13 +20871,33 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.License == nil {
-				m.License = &License{}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
 			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reindex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reindex = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15085,7 +20923,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15108,51 +20946,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15179,32 +20981,90 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+			if m.PredHints == nil {
+				m.PredHints = make(map[string]Metadata_HintType)
 			}
-			m.MaxPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue Metadata_HintType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxPending |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Metadata_HintType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.PredHints[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15230,7 +21090,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthInfo) Unmarshal(dAtA []byte) error {
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15253,17 +21113,17 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15273,29 +21133,33 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instance = string(dAtA[iNdEx:postIndex])
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15305,29 +21169,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			var stringLen uint64
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15337,29 +21188,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15369,29 +21207,17 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Done = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			var stringLen uint64
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15401,29 +21227,70 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Uptime = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15433,16 +21300,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uptime |= int64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
 			}
-			m.LastEcho = 0
+			m.RestoreTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15452,14 +21319,14 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEcho |= int64(b&0x7F) << shift
+				m.RestoreTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15487,11 +21354,11 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
+			m.Location = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15519,67 +21386,13 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
+			m.BackupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Tablet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15589,14 +21402,27 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15624,13 +21450,13 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15640,17 +21466,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			m.Space = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15660,16 +21498,17 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Space |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			m.Anonymous = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFile", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15679,17 +21518,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupNum", wireType)
 			}
-			var v int
+			m.BackupNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15699,17 +21550,16 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BackupNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			m.MoveTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15719,11 +21569,28 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MoveTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &PredicateFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15749,7 +21616,7 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15772,27 +21639,17 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
-			}
-			m.Entity = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15802,29 +21659,33 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
+			}
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15834,31 +21695,31 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Kv = append(m.Kv, &pb.KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.ValueType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15868,24 +21729,31 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15913,11 +21781,11 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15945,13 +21813,13 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
-			m.Op = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15961,14 +21829,31 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= DirectedEdge_Op(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delta == nil {
+				m.Delta = &OracleDelta{}
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15995,70 +21880,18 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.GroupId = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16068,16 +21901,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
 			}
-			m.StartTs = 0
+			m.ExpectedChecksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16087,14 +21920,14 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16121,14 +21954,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Restore == nil {
+				m.Restore = &RestoreRequest{}
+			}
+			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanShard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16155,14 +21990,70 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CleanShard == nil {
+				m.CleanShard = &Tablet{}
+			}
+			if err := m.CleanShard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16189,16 +22080,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, &TypeUpdate{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kv = append(m.Kv, &pb.KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			m.DropOp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16208,16 +22099,17 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+			m.Done = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			var stringLen uint64
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16227,29 +22119,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DropValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16259,28 +22138,32 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			m.Merge = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingest", wireType)
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Ingest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16306,7 +22189,7 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16329,17 +22212,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16349,162 +22242,133 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PredHints == nil {
-				m.PredHints = make(map[string]Metadata_HintType)
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			var mapkey string
-			var mapvalue Metadata_HintType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= Metadata_HintType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= Posting_ValType(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PredHints[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			if skippy < 0 {
+			m.PostingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostingType |= Posting_PostingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Snapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.LangTag == nil {
+				m.LangTag = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16531,18 +22395,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.Index = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16552,16 +22414,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Op |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.ReadTs = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16571,16 +22433,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var v int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16590,17 +22452,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Done = bool(v != 0)
-		case 5:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.SinceTs = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16610,7 +22471,7 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16640,7 +22501,7 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+func (m *UidBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16663,17 +22524,17 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			m.GroupId = 0
+			m.Base = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16683,35 +22544,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Base |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
-			}
-			m.RestoreTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RestoreTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16721,61 +22563,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Location = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
+			if m.Deltas == nil {
+				m.Deltas = []byte{}
 			}
-			m.BackupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
 			}
-			var stringLen uint64
+			m.NumUids = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16785,93 +22597,70 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumUids |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UidPack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
 			}
-			var v int
+			m.BlockSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16881,17 +22670,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Anonymous = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16901,23 +22689,25 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFile = string(dAtA[iNdEx:postIndex])
+			m.Blocks = append(m.Blocks, &UidBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16944,7 +22734,7 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *PostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16967,15 +22757,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17002,16 +22792,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
+			if m.Pack == nil {
+				m.Pack = &UidPack{}
 			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17038,16 +22828,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &pb.KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var msglen int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17057,97 +22847,146 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Splits = append(m.Splits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Splits) == 0 {
+					m.Splits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Splits = append(m.Splits, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17157,33 +22996,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delta == nil {
-				m.Delta = &OracleDelta{}
-			}
-			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17193,52 +23028,83 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snapshot == nil {
-				m.Snapshot = &Snapshot{}
-			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 11:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
 			}
-			m.ExpectedChecksum = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17248,14 +23114,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			m.AllKeys = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17282,10 +23149,8 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restore == nil {
-				m.Restore = &RestoreRequest{}
-			}
-			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Param = append(m.Param, &FacetParam{})
+			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17314,7 +23179,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVS) Unmarshal(dAtA []byte) error {
+func (m *Facets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17337,15 +23202,15 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17372,31 +23237,11 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &pb.KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17422,7 +23267,7 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17445,27 +23290,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17475,69 +23310,85 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.PostingType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PostingType |= Posting_PostingType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17547,29 +23398,27 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
-			if m.LangTag == nil {
-				m.LangTag = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17597,13 +23446,13 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17613,83 +23462,24 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
-			}
-			m.CommitTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17715,7 +23505,7 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UidBlock) Unmarshal(dAtA []byte) error {
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17738,17 +23528,17 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.Base = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17758,16 +23548,29 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Base |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17777,31 +23580,31 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
-			if m.Deltas == nil {
-				m.Deltas = []byte{}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
 			}
-			m.NumUids = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17811,11 +23614,28 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumUids |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Func == nil {
+				m.Func = &Function{}
+			}
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17841,7 +23661,7 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UidPack) Unmarshal(dAtA []byte) error {
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17864,17 +23684,17 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.BlockSize = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17884,16 +23704,80 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockSize |= uint32(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17903,25 +23787,23 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &UidBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17948,7 +23830,7 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17971,17 +23853,17 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17991,33 +23873,29 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pack == nil {
-				m.Pack = &UidPack{}
-			}
-			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18027,31 +23905,29 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.CommitTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18061,87 +23937,216 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Index = bool(v != 0)
 		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Splits = append(m.Splits, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lang = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoConflict = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Splits) == 0 {
-					m.Splits = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Splits = append(m.Splits, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ttl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18167,7 +24172,7 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetParam) Unmarshal(dAtA []byte) error {
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18190,17 +24195,17 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18210,29 +24215,31 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Schema = append(m.Schema, &SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18242,23 +24249,25 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18285,7 +24294,7 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetParams) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18308,17 +24317,17 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18328,17 +24337,29 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllKeys = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			var msglen int
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18348,85 +24369,87 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Directive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Param = append(m.Param, &FacetParam{})
-			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Facets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			m.Count = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18436,85 +24459,17 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18524,83 +24479,75 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
+			m.Upsert = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Lang = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Function) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.NonNullable = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NonNullableList = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18628,13 +24575,53 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoConflict = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18644,29 +24631,33 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Constraint == nil {
+				m.Constraint = &FieldConstraint{}
+			}
+			if err := m.Constraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			var stringLen uint64
+			m.Ttl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18676,24 +24667,11 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Ttl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18719,7 +24697,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18742,15 +24720,15 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18778,11 +24756,11 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Op = string(dAtA[iNdEx:postIndex])
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18809,14 +24787,14 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, &SchemaUpdate{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeIndexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18843,10 +24821,8 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Func == nil {
-				m.Func = &Function{}
-			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CompositeIndexes = append(m.CompositeIndexes, &CompositeIndex{})
+			if err := m.CompositeIndexes[len(m.CompositeIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18875,7 +24851,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *CompositeIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18898,32 +24874,13 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
@@ -18955,70 +24912,6 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19044,7 +24937,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaNode) Unmarshal(dAtA []byte) error {
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19067,79 +24960,15 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19156,64 +24985,12 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Index = bool(v != 0)
-		case 4:
+			m.Required = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19223,17 +25000,29 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Count = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19243,17 +25032,29 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19263,17 +25064,29 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Upsert = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			var v int
+			m.MinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19283,17 +25096,16 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lang = bool(v != 0)
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
 			}
-			var v int
+			m.MaxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19303,12 +25115,11 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoConflict = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19334,7 +25145,7 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+func (m *MapHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19357,17 +25168,17 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19377,25 +25188,23 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19422,7 +25231,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19445,17 +25254,17 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19465,48 +25274,41 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			m.ValueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
 			}
-			m.Directive = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19516,14 +25318,85 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Posting == nil {
+				m.Posting = &Posting{}
+			}
+			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19551,33 +25424,13 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
 			}
-			var v int
+			m.SourceGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19587,17 +25440,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SourceGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
 			}
-			var v int
+			m.DestGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19607,17 +25459,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DestGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Upsert = bool(v != 0)
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
 			}
-			var v int
+			m.TxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19627,17 +25478,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lang = bool(v != 0)
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
 			}
-			var v int
+			m.ExpectedChecksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19647,17 +25497,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonNullable = bool(v != 0)
-		case 11:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			var v int
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19667,17 +25516,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonNullableList = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-			var stringLen uint64
+			m.StartUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19687,29 +25535,16 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndUid", wireType)
 			}
-			var v int
+			m.EndUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19719,12 +25554,11 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EndUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoConflict = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19750,7 +25584,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
+func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19773,17 +25607,17 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19793,29 +25627,16 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var msglen int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19825,26 +25646,11 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, &SchemaUpdate{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19870,7 +25676,7 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapHeader) Unmarshal(dAtA []byte) error {
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19893,17 +25699,17 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19913,83 +25719,31 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
+			m.Txns = append(m.Txns, &TxnStatus{})
+			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MapEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
 			}
-			var byteLen int
+			m.MaxAssigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19999,39 +25753,14 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxAssigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20048,22 +25777,85 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Posting == nil {
-				m.Posting = &Posting{}
-			}
-			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupChecksums == nil {
+				m.GroupChecksums = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GroupChecksums[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20090,7 +25882,7 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20113,119 +25905,87 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
-			}
-			m.SourceGid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceGid |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
-			}
-			m.DestGid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Ts = append(m.Ts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestGid |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
 				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
-			}
-			m.TxnTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxnTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
-			}
-			m.ExpectedChecksum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Ts) == 0 {
+					m.Ts = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -20252,7 +26012,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnStatus) Unmarshal(dAtA []byte) error {
+func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20275,36 +26035,17 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.CommitTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20314,11 +26055,12 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20344,7 +26086,7 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20367,15 +26109,15 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20402,33 +26144,16 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txns = append(m.Txns, &TxnStatus{})
-			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
-			}
-			m.MaxAssigned = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxAssigned |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20455,75 +26180,12 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupChecksums == nil {
-				m.GroupChecksums = make(map[uint32]uint64)
+			if m.Payload == nil {
+				m.Payload = &api.Payload{}
 			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.GroupChecksums[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20550,7 +26212,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20573,88 +26235,44 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ts = append(m.Ts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ts) == 0 {
-					m.Ts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
+			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20680,7 +26298,7 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20703,17 +26321,17 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20723,12 +26341,28 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kvs == nil {
+				m.Kvs = &pb.KVList{}
+			}
+			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20754,7 +26388,7 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftBatch) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20777,17 +26411,17 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var msglen int
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20797,33 +26431,16 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Val |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20833,28 +26450,32 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Payload == nil {
-				m.Payload = &api.Payload{}
+			m.ReadOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
 			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Forwarded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20880,7 +26501,7 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20903,17 +26524,17 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
 			}
-			var byteLen int
+			m.StartId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20923,24 +26544,49 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.EndId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			m.ReadOnly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadOnly |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
-			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20966,7 +26612,7 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20989,17 +26635,17 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
 			}
-			var msglen int
+			m.ClientTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21009,28 +26655,30 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClientTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kvs == nil {
-				m.Kvs = &pb.KVList{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21056,7 +26704,7 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Num) Unmarshal(dAtA []byte) error {
+func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21079,17 +26727,17 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Val = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21099,16 +26747,16 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= uint64(b&0x7F) << shift
+				m.Code |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21118,32 +26766,24 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Forwarded = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21169,7 +26809,7 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignedIds) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21192,17 +26832,55 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			}
+			m.SinceTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.StartId = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21212,16 +26890,16 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartId |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
 			}
-			m.EndId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21231,16 +26909,29 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnixTs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			m.ReadOnly = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21250,70 +26941,29 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadOnly |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			m.ClientTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21323,16 +26973,29 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.GroupId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21342,70 +27005,61 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Status) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			m.Code = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21415,14 +27069,15 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Anonymous = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21443,14 +27098,50 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &PredicateFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21477,7 +27168,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21500,17 +27191,17 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			m.ReadTs = 0
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21520,52 +27211,14 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.FileSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
-			}
-			m.SinceTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21593,43 +27246,65 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnixTs = string(dAtA[iNdEx:postIndex])
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PredicateFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PredicateFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PredicateFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePredicates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21657,11 +27332,11 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			m.IncludePredicates = append(m.IncludePredicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePredicates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21689,11 +27364,11 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			m.ExcludePredicates = append(m.ExcludePredicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21721,31 +27396,11 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			m.IncludeTypes = append(m.IncludeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Anonymous = bool(v != 0)
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21773,7 +27428,7 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			m.ExcludeTypes = append(m.ExcludeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21918,6 +27573,106 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uids == nil {
+				m.Uids = &List{}
+			}
+			if err := m.Uids.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// eqArg returns the attribute and value of sg if it is an equality check with a single value
+// that can be answered by a composite index.
+func eqArg(sg *SubGraph) (string, string, bool) {
+	fn := sg.SrcFunc
+	if fn == nil || fn.Name != "eq" || fn.IsCount || fn.IsValueVar || fn.IsLenVar ||
+		len(fn.Args) != 1 || fn.Args[0].IsValueVar || len(sg.Params.Langs) > 0 ||
+		len(sg.Params.NeedsVar) > 0 || strings.HasPrefix(sg.Attr, "~") {
+		return "", "", false
+	}
+	return sg.Attr, fn.Args[0].Value, true
+}
+
+// eqIndexed returns true if eq() can be evaluated over the predicate by its own index, so that
+// replacing it by a composite index lookup doesn't change the result of the query. Strings need
+// an exact or hash index, which compare whole values like the composite index does.
+func eqIndexed(pred string) bool {
+	ctx := context.Background()
+	if !schema.State().IsIndexed(ctx, pred) {
+		return false
+	}
+	typ, err := schema.State().TypeOf(pred)
+	if err != nil {
+		return false
+	}
+	if typ != types.StringID && typ != types.DefaultID {
+		return true
+	}
+	return schema.State().HasTokenizer(ctx, tok.IdentExact, pred) ||
+		schema.State().HasTokenizer(ctx, tok.IdentHash, pred)
+}
+
+// bestCompositeIndex returns the composite index with the longest prefix of predicates
+// that have a value in eqs, along with the length of that prefix. Prefixes shorter than two
+// predicates are ignored because the index of a single predicate serves them equally well.
+// If must is not empty, the prefix has to include that predicate. Indexes whose prefix has a
+// predicate that eq() can't use are skipped, so that the query fails like it would without them.
+func bestCompositeIndex(eqs map[string]string, must string) (*pb.CompositeIndex, int) {
+	var best *pb.CompositeIndex
+	var bestLen int
+	for _, ci := range schema.State().CompositeIndexes("") {
+		n := 0
+		for n < len(ci.Predicates) {
+			if _, ok := eqs[ci.Predicates[n]]; !ok {
+				break
+			}
+			n++
+		}
+		if n < 2 || n < bestLen {
+			continue
+		}
+		indexed := true
+		for _, pred := range ci.Predicates[:n] {
+			indexed = indexed && eqIndexed(pred)
+		}
+		if !indexed {
+			continue
+		}
+		if must != "" {
+			found := false
+			for _, pred := range ci.Predicates[:n] {
+				found = found || pred == must
+			}
+			if !found {
+				continue
+			}
+		}
+		if n > bestLen || schema.CompositeIndexName(ci) < schema.CompositeIndexName(best) {
+			best, bestLen = ci, n
+		}
+	}
+	return best, bestLen
+}
+
+func compositeFunction(ci *pb.CompositeIndex, n int, eqs map[string]string) *Function {
+	fn := &Function{Name: "composite"}
+	fn.Args = append(fn.Args, gql.Arg{Value: schema.CompositeIndexName(ci)})
+	for _, pred := range ci.Predicates[:n] {
+		fn.Args = append(fn.Args, gql.Arg{Value: eqs[pred]})
+	}
+	return fn
+}
+
+// removeEqFilters removes the equality filters of sg over the given predicates.
+func (sg *SubGraph) removeEqFilters(preds []string) {
+	filters := sg.Filters[:0]
+	for _, filter := range sg.Filters {
+		attr, _, ok := eqArg(filter)
+		if ok && x.HasString(preds, attr) {
+			// Only remove one filter per predicate in case it was repeated.
+			preds = removeMember(attr, preds)
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	sg.Filters = filters
+}
+
+// useCompositeIndexInFilter replaces the equality checks of an "and" filter that match the
+// prefix of a composite index by a single lookup in that index.
+func (sg *SubGraph) useCompositeIndexInFilter() {
+	if sg.FilterOp != "and" {
+		return
+	}
+	eqs := make(map[string]string)
+	for _, filter := range sg.Filters {
+		if attr, val, ok := eqArg(filter); ok {
+			if _, seen := eqs[attr]; !seen {
+				eqs[attr] = val
+			}
+		}
+	}
+	ci, n := bestCompositeIndex(eqs, "")
+	if ci == nil {
+		return
+	}
+
+	sg.removeEqFilters(ci.Predicates[:n])
+	sg.Filters = append(sg.Filters, &SubGraph{
+		Attr:    ci.Predicates[0],
+		SrcFunc: compositeFunction(ci, n, eqs),
+	})
+}
+
+// useCompositeIndexAtRoot handles queries like
+// q(func: eq(city, "X")) @filter(eq(status, "Y")) by looking up the root uids directly
+// in a composite index over city and status.
+func (sg *SubGraph) useCompositeIndexAtRoot() {
+	rootAttr, rootVal, ok := eqArg(sg)
+	if !ok || len(sg.Filters) != 1 {
+		return
+	}
+
+	filter := sg.Filters[0]
+	eqs := map[string]string{rootAttr: rootVal}
+	if attr, val, ok := eqArg(filter); ok && attr != rootAttr && len(filter.Filters) == 0 {
+		eqs[attr] = val
+	} else if filter.FilterOp == "and" {
+		for _, child := range filter.Filters {
+			if attr, val, ok := eqArg(child); ok {
+				if _, seen := eqs[attr]; !seen {
+					eqs[attr] = val
+				}
+			}
+		}
+	}
+	ci, n := bestCompositeIndex(eqs, rootAttr)
+	if ci == nil {
+		return
+	}
+
+	var consumed []string
+	for _, pred := range ci.Predicates[:n] {
+		if pred != rootAttr {
+			consumed = append(consumed, pred)
+		}
+	}
+	sg.Attr = ci.Predicates[0]
+	sg.SrcFunc = compositeFunction(ci, n, eqs)
+	if filter.FilterOp == "and" {
+		filter.removeEqFilters(consumed)
+		if len(filter.Filters) > 0 {
+			return
+		}
+	}
+	sg.Filters = nil
+}
+
+func removeMember(s string, list []string) []string {
+	out := make([]string, 0, len(list))
+	for _, item := range list {
+		if item != s {
+			out = append(out, item)
+		}
+	}
+	return out
+}
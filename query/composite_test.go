@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+// setCompositeSchema sets the local schema, types included, to the given one.
+func setCompositeSchema(t *testing.T, s string) {
+	require.NoError(t, schema.ParseBytes([]byte(""), 1))
+	result, err := schema.Parse(s)
+	require.NoError(t, err)
+	for _, update := range result.Preds {
+		schema.State().Set(update.Predicate, update)
+	}
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, *typ)
+	}
+}
+
+func compositeSubGraph(t *testing.T, q string) *SubGraph {
+	res, err := gql.Parse(gql.Request{Str: q})
+	require.NoError(t, err)
+	sg, err := ToSubGraph(context.Background(), res.Query[0])
+	require.NoError(t, err)
+	return sg
+}
+
+func TestCompositeRewrite(t *testing.T) {
+	defer func() { require.NoError(t, schema.ParseBytes([]byte(""), 1)) }()
+	setCompositeSchema(t, `
+		city: string @index(exact) .
+		status: string @index(hash) .
+		score: int @index(int) .
+		type Place {
+			city
+			status
+			score
+		} @index(composite: [city, status, score])
+	`)
+
+	// The root function and its filter are replaced by a lookup in the composite index.
+	sg := compositeSubGraph(t, `{
+		q(func: eq(city, "Paris")) @filter(eq(status, "open") AND eq(score, 3)) { uid }
+	}`)
+	require.Equal(t, "composite", sg.SrcFunc.Name)
+	require.Equal(t, "city", sg.Attr)
+	require.Len(t, sg.SrcFunc.Args, 4)
+	require.Equal(t, "Paris", sg.SrcFunc.Args[1].Value)
+	require.Equal(t, "open", sg.SrcFunc.Args[2].Value)
+	require.Equal(t, "3", sg.SrcFunc.Args[3].Value)
+	require.Empty(t, sg.Filters)
+
+	// So are the equality checks of an "and" filter.
+	sg = compositeSubGraph(t, `{
+		q(func: has(name)) @filter(eq(city, "Paris") AND eq(status, "open")) { uid }
+	}`)
+	require.Equal(t, "has", sg.SrcFunc.Name)
+	require.Len(t, sg.Filters, 1)
+	require.Len(t, sg.Filters[0].Filters, 1)
+	require.Equal(t, "composite", sg.Filters[0].Filters[0].SrcFunc.Name)
+}
+
+func TestCompositeRewriteNeedsEqIndexes(t *testing.T) {
+	defer func() { require.NoError(t, schema.ParseBytes([]byte(""), 1)) }()
+	for _, s := range []string{
+		// status isn't indexed, so eq(status, ...) must fail like it does without the index.
+		`city: string @index(exact) .
+		status: string .`,
+		// A term index doesn't compare whole values.
+		`city: string @index(exact) .
+		status: string @index(term) .`,
+	} {
+		setCompositeSchema(t, s+`
+			type Place {
+				city
+				status
+			} @index(composite: [city, status])
+		`)
+		sg := compositeSubGraph(t, `{
+			q(func: eq(city, "Paris")) @filter(eq(status, "open")) { uid }
+		}`)
+		require.Equal(t, "eq", sg.SrcFunc.Name)
+		require.Len(t, sg.Filters, 1)
+		require.Equal(t, "eq", sg.Filters[0].SrcFunc.Name)
+	}
+}
@@ -465,6 +465,7 @@ func filterCopy(sg *SubGraph, ft *gql.FilterTree) error {
 		}
 		sg.Filters = append(sg.Filters, child)
 	}
+	sg.useCompositeIndexInFilter()
 	return nil
 }
 
@@ -814,6 +815,7 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 			return nil, errors.Wrapf(err, "while copying filter")
 		}
 		sg.Filters = append(sg.Filters, sgf)
+		sg.useCompositeIndexAtRoot()
 	}
 	if gq.FacetsFilter != nil {
 		facetsFilter, err := toFacetsFilter(gq.FacetsFilter)
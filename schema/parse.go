@@ -17,7 +17,9 @@
 package schema
 
 import (
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -73,6 +75,17 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Upsert = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "ttl":
+		ttl, err := parseTTLDirective(it)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
+	case "unique":
+		if t == types.UidID || t == types.PasswordID {
+			return next.Errorf("@unique directive is not supported for type [%v]", t.Name())
+		}
+		schema.Unique = true
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -87,6 +100,29 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	return nil
 }
 
+// parseTTLDirective parses the duration in "@ttl(24h)" and returns it in seconds.
+func parseTTLDirective(it *lex.ItemIterator) (uint64, error) {
+	var dur string
+	expected := []lex.ItemType{itemLeftRound, itemNumber, itemRightRound}
+	for _, typ := range expected {
+		it.Next()
+		if it.Item().Typ != typ {
+			return 0, it.Item().Errorf("Invalid @ttl directive. Got %v", it.Item().Val)
+		}
+		if typ == itemNumber {
+			dur = it.Item().Val
+		}
+	}
+	ttl, err := time.ParseDuration(dur)
+	if err != nil {
+		return 0, it.Item().Errorf("Invalid duration for @ttl directive: %v", err)
+	}
+	if ttl < time.Second {
+		return 0, it.Item().Errorf("Duration for @ttl directive must be at least 1s. Got %v", ttl)
+	}
+	return uint64(ttl / time.Second), nil
+}
+
 func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate, error) {
 	it.Next()
 	next := it.Item()
@@ -320,6 +356,14 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 		switch item.Typ {
 		case itemRightCurl:
 			it.Next()
+			for it.Item().Typ == itemAt {
+				ci, err := parseCompositeIndex(it)
+				if err != nil {
+					return nil, err
+				}
+				typeUpdate.CompositeIndexes = append(typeUpdate.CompositeIndexes, ci)
+				it.Next()
+			}
 			if it.Item().Typ != itemNewLine && it.Item().Typ != lex.ItemEOF {
 				return nil, it.Item().Errorf(
 					"Expected new line or EOF after type declaration. Got %v", it.Item())
@@ -336,6 +380,15 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 				fieldSet[field.GetPredicate()] = struct{}{}
 			}
 
+			for _, ci := range typeUpdate.CompositeIndexes {
+				for _, pred := range ci.Predicates {
+					if _, ok := fieldSet[pred]; !ok {
+						return nil, it.Item().Errorf("Composite index predicate %s is not a "+
+							"field of type %s", pred, typeUpdate.TypeName)
+					}
+				}
+			}
+
 			typeUpdate.Fields = fields
 			return typeUpdate, nil
 		case itemText:
@@ -353,11 +406,77 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 	return nil, errors.Errorf("Shouldn't reach here.")
 }
 
+// parseCompositeIndex works on the "@index(composite: [pred1, pred2])" directive that can
+// follow a type declaration.
+func parseCompositeIndex(it *lex.ItemIterator) (*pb.CompositeIndex, error) {
+	// Iterator is currently on the @ token.
+	it.Next()
+	if it.Item().Typ != itemText || it.Item().Val != "index" {
+		return nil, it.Item().Errorf("Invalid directive for type declaration: %v", it.Item().Val)
+	}
+	expected := []lex.ItemType{itemLeftRound, itemText, itemColon, itemLeftSquare}
+	for _, typ := range expected {
+		it.Next()
+		if it.Item().Typ != typ {
+			return nil, it.Item().Errorf("Invalid composite index. Got %v", it.Item().Val)
+		}
+		if typ == itemText && it.Item().Val != "composite" {
+			return nil, it.Item().Errorf("Expected composite. Got %v", it.Item().Val)
+		}
+	}
+
+	ci := &pb.CompositeIndex{}
+	seen := make(map[string]struct{})
+	expectArg := true
+	for {
+		it.Next()
+		next := it.Item()
+		if next.Typ == itemRightSquare {
+			break
+		}
+		if next.Typ == itemComma {
+			if expectArg {
+				return nil, next.Errorf("Expected a predicate but got comma")
+			}
+			expectArg = true
+			continue
+		}
+		if next.Typ != itemText || !expectArg {
+			return nil, next.Errorf("Invalid composite index. Got %v", next.Val)
+		}
+		if strings.HasPrefix(next.Val, "~") {
+			return nil, next.Errorf("Reverse predicate %s cannot be part of a composite index",
+				next.Val)
+		}
+		if _, ok := seen[next.Val]; ok {
+			return nil, next.Errorf("Duplicate predicate %s in composite index", next.Val)
+		}
+		seen[next.Val] = struct{}{}
+		ci.Predicates = append(ci.Predicates, next.Val)
+		expectArg = false
+	}
+	if len(ci.Predicates) < 2 {
+		return nil, it.Item().Errorf("Composite index requires at least two predicates")
+	}
+
+	it.Next()
+	if it.Item().Typ != itemRightRound {
+		return nil, it.Item().Errorf("Expected ). Got %v", it.Item().Val)
+	}
+	return ci, nil
+}
+
 func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, error) {
 	field := &pb.SchemaUpdate{Predicate: it.Item().Val}
 	var list bool
 	it.Next()
 
+	if it.Item().Typ == itemAt {
+		if err := parseFieldConstraints(it, field); err != nil {
+			return nil, err
+		}
+	}
+
 	// Simplified type definitions only require the field name. If a new line is found,
 	// proceed to the next field in the type.
 	if it.Item().Typ == itemNewLine {
@@ -407,6 +526,132 @@ func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, er
 	return field, nil
 }
 
+// parseFieldConstraints parses the constraints that can follow a field in a type declaration,
+// e.g. "age @required @range(min: 0, max: 150)". The iterator is left on the first token after
+// the constraints.
+func parseFieldConstraints(it *lex.ItemIterator, field *pb.SchemaUpdate) error {
+	if strings.HasPrefix(field.Predicate, "~") {
+		return it.Item().Errorf("Constraints are not supported for reverse field %s",
+			field.Predicate)
+	}
+
+	c := &pb.FieldConstraint{}
+	seen := make(map[string]bool)
+	for it.Item().Typ == itemAt {
+		it.Next()
+		next := it.Item()
+		if next.Typ != itemText {
+			return next.Errorf("Missing constraint name")
+		}
+		if seen[next.Val] {
+			return next.Errorf("Duplicate constraint %s for field %s", next.Val, field.Predicate)
+		}
+		seen[next.Val] = true
+
+		switch next.Val {
+		case "required":
+			c.Required = true
+		case "range":
+			args, err := parseConstraintArgs(it, "min", "max")
+			if err != nil {
+				return err
+			}
+			c.Min, c.Max = args["min"], args["max"]
+			if c.Min == "" && c.Max == "" {
+				return next.Errorf("@range constraint requires min or max")
+			}
+		case "regex":
+			args, err := parseConstraintArgs(it, "pattern")
+			if err != nil {
+				return err
+			}
+			if _, err := ConstraintRegex(args["pattern"]); err != nil {
+				return next.Errorf("Invalid regex for field %s: %v", field.Predicate, err)
+			}
+			c.Regex = args["pattern"]
+		case "count":
+			args, err := parseConstraintArgs(it, "min", "max")
+			if err != nil {
+				return err
+			}
+			for name, val := range args {
+				n, err := strconv.ParseUint(val, 10, 64)
+				if err != nil || n == 0 {
+					return next.Errorf("Invalid %s for @count constraint: %s", name, val)
+				}
+				if name == "min" {
+					c.MinCount = n
+				} else {
+					c.MaxCount = n
+				}
+			}
+			if c.MaxCount > 0 && c.MinCount > c.MaxCount {
+				return next.Errorf("Min is greater than max in @count constraint")
+			}
+		default:
+			return next.Errorf("Invalid constraint %s for field %s", next.Val, field.Predicate)
+		}
+		it.Next()
+	}
+	field.Constraint = c
+	return nil
+}
+
+// parseConstraintArgs parses the arguments of a constraint, like "(min: 1, max: 10)". A single
+// unnamed argument, like "("^a+$")", is returned under the first allowed name.
+func parseConstraintArgs(it *lex.ItemIterator, allowed ...string) (map[string]string, error) {
+	it.Next()
+	if it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Expected ( after constraint. Got %v", it.Item().Val)
+	}
+
+	args := make(map[string]string)
+	for it.Next() {
+		item := it.Item()
+		name := allowed[0]
+		if item.Typ == itemText {
+			name = item.Val
+			if !x.HasString(allowed, name) {
+				return nil, item.Errorf("Invalid constraint argument %s", name)
+			}
+			if _, ok := args[name]; ok {
+				return nil, item.Errorf("Duplicate constraint argument %s", name)
+			}
+			it.Next()
+			if it.Item().Typ != itemColon {
+				return nil, it.Item().Errorf("Expected : after %s. Got %v", name, it.Item().Val)
+			}
+			it.Next()
+			item = it.Item()
+		} else if len(args) > 0 {
+			return nil, item.Errorf("Missing name of constraint argument")
+		}
+
+		switch item.Typ {
+		case itemNumber:
+			args[name] = item.Val
+		case itemQuotedText:
+			val, err := strconv.Unquote(item.Val)
+			if err != nil {
+				return nil, item.Errorf("Invalid string %s: %v", item.Val, err)
+			}
+			args[name] = val
+		default:
+			return nil, item.Errorf("Invalid value for constraint argument %s: %v", name, item.Val)
+		}
+
+		it.Next()
+		switch it.Item().Typ {
+		case itemComma:
+		case itemRightRound:
+			return args, nil
+		default:
+			return nil, it.Item().Errorf("Expected , or ). Got %v", it.Item().Val)
+		}
+	}
+	return nil, errors.Errorf("Invalid ending while parsing constraint arguments")
+}
+
 // ParsedSchema represents the parsed schema and type updates.
 type ParsedSchema struct {
 	Preds []*pb.SchemaUpdate
@@ -267,6 +267,26 @@ func TestParse9_Error(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseUnique(t *testing.T) {
+	reset()
+	result, err := Parse("email: string @index(exact) @unique .")
+	require.NoError(t, err)
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: "email",
+		ValueType: 9,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		Unique:    true,
+	}, result.Preds[0])
+}
+
+func TestParseUnique_Error(t *testing.T) {
+	reset()
+	_, err := Parse("friend: uid @unique .")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@unique directive is not supported for type [uid]")
+}
+
 func TestParseScalarList(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -601,6 +621,98 @@ func TestParseTypeComments(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseTypeCompositeIndex(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Place {
+			city
+			status
+			zip
+		} @index(composite: [city, status]) @index(composite: [zip, city])
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, []*pb.CompositeIndex{
+		{Predicates: []string{"city", "status"}},
+		{Predicates: []string{"zip", "city"}},
+	}, result.Types[0].CompositeIndexes)
+}
+
+func TestParseTypeCompositeIndexErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{`type Place {
+			city
+		} @index(composite: [city])`, "at least two predicates"},
+		{`type Place {
+			city
+		} @index(composite: [city, status])`, "status is not a field of type Place"},
+		{`type Place {
+			city
+		} @index(composite: [city, city])`, "Duplicate predicate city"},
+		{`type Place {
+			city
+			status
+		} @index(exact)`, "Expected composite. Got exact"},
+		{`type Place {
+			city
+			status
+		} @reverse`, "Invalid directive for type declaration"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), tc.err)
+	}
+}
+
+func TestParseTypeConstraints(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person {
+			name @required @regex("^[A-Z]")
+			age @range(min: 0, max: 150)
+			born @range(min: "1900-01-01")
+			friend @count(max: 10)
+			email
+		}
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Types, 1)
+	fields := result.Types[0].Fields
+	require.Equal(t, &pb.FieldConstraint{Required: true, Regex: "^[A-Z]"}, fields[0].Constraint)
+	require.Equal(t, &pb.FieldConstraint{Min: "0", Max: "150"}, fields[1].Constraint)
+	require.Equal(t, &pb.FieldConstraint{Min: "1900-01-01"}, fields[2].Constraint)
+	require.Equal(t, &pb.FieldConstraint{MaxCount: 10}, fields[3].Constraint)
+	require.Nil(t, fields[4].Constraint)
+}
+
+func TestParseTypeConstraintErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{"type Person {\n name @unknown\n}", "Invalid constraint unknown"},
+		{"type Person {\n name @required @required\n}", "Duplicate constraint required"},
+		{"type Person {\n age @range(step: 1)\n}", "Invalid constraint argument step"},
+		{"type Person {\n age @range(min: 1, min: 2)\n}", "Duplicate constraint argument min"},
+		{"type Person {\n age @range(min 1)\n}", "Expected : after min"},
+		{"type Person {\n name @regex(\"[a-\")\n}", "Invalid regex"},
+		{"type Person {\n friend @count(min: 5, max: 2)\n}", "Min is greater than max"},
+		{"type Person {\n friend @count(max: -1)\n}", "Invalid max for @count"},
+		{"type Person {\n <~friend> @required\n}", "not supported for reverse field"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.Error(t, err, tc.schema)
+		require.Contains(t, err.Error(), tc.err)
+	}
+}
+
 var ps *badger.DB
 
 func TestMain(m *testing.M) {
@@ -619,3 +731,43 @@ func TestMain(m *testing.M) {
 	os.RemoveAll(dir)
 	os.Exit(r)
 }
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse("session: string @ttl(1h30m) .")
+	require.NoError(t, err)
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: "session",
+		ValueType: 9,
+		Ttl:       5400,
+	}, result.Preds[0])
+}
+
+func TestParseTTL_Error(t *testing.T) {
+	reset()
+	_, err := Parse("session: string @ttl(1x) .")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid duration")
+
+	_, err = Parse("session: string @ttl(10ms) .")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be at least 1s")
+}
+
+func TestConstraintRegexCompiledOnce(t *testing.T) {
+	_, err := Parse(`
+		name: string .
+		type Person {
+			name @regex("^[a-z]+$")
+		}
+	`)
+	require.NoError(t, err)
+	re, ok := constraintRegexes.Load("^[a-z]+$")
+	require.True(t, ok)
+	cached, err := ConstraintRegex("^[a-z]+$")
+	require.NoError(t, err)
+	require.True(t, re == cached)
+
+	_, err = ConstraintRegex("[a-")
+	require.Error(t, err)
+}
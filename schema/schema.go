@@ -21,6 +21,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/golang/glog"
@@ -38,8 +40,24 @@ import (
 var (
 	pstate *state
 	pstore *badger.DB
+	// constraintRegexes holds the compiled patterns of the @regex constraints, keyed by pattern.
+	constraintRegexes sync.Map
 )
 
+// ConstraintRegex returns the compiled pattern of a @regex constraint. Patterns are compiled once,
+// when the schema is parsed or the first time a type loaded from disk uses them.
+func ConstraintRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := constraintRegexes.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	constraintRegexes.Store(pattern, re)
+	return re, nil
+}
+
 // We maintain two schemas for a predicate if a background task is building indexes
 // for that predicate. Now, we need to use the new schema for mutations whereas
 // a query schema for queries. While calling functions in this package, we need
@@ -234,6 +252,35 @@ func (s *state) GetType(typeName string) (pb.TypeUpdate, bool) {
 	return *typ, true
 }
 
+// CompositeIndexes returns the composite indexes defined across all types that include
+// the given predicate. Passing an empty predicate returns all composite indexes.
+func (s *state) CompositeIndexes(pred string) []*pb.CompositeIndex {
+	s.RLock()
+	defer s.RUnlock()
+	var out []*pb.CompositeIndex
+	seen := make(map[string]struct{})
+	for _, typ := range s.types {
+		for _, ci := range typ.CompositeIndexes {
+			name := CompositeIndexName(ci)
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			if pred != "" && !x.HasString(ci.Predicates, pred) {
+				continue
+			}
+			seen[name] = struct{}{}
+			out = append(out, ci)
+		}
+	}
+	return out
+}
+
+// CompositeIndexName returns the name that identifies a composite index within the
+// index keys of its first predicate.
+func CompositeIndexName(ci *pb.CompositeIndex) string {
+	return strings.Join(ci.Predicates, ",")
+}
+
 // TypeOf returns the schema type of predicate
 func (s *state) TypeOf(pred string) (types.TypeID, error) {
 	s.RLock()
@@ -398,6 +445,21 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// IsUnique returns whether the predicate has the @unique directive.
+func (s *state) IsUnique(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetUnique()
+}
+
+// TTL returns the time to live in seconds of the values of the given predicate. Zero means
+// that the values never expire.
+func (s *state) TTL(pred string) uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetTtl()
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
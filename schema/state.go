@@ -35,6 +35,8 @@ const (
 	itemLeftSquare
 	itemRightSquare
 	itemExclamationMark
+	itemQuotedText
+	itemNumber
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -81,6 +83,14 @@ Loop:
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
+		case r == '"':
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf("Invalid schema: %v", err)
+			}
+			l.Emit(itemQuotedText)
+		case isDigit(r) || r == '-':
+			// Numbers are only used as arguments of directives and type constraints.
+			return lexNumber
 		default:
 			return l.Errorf("Invalid schema. Unexpected %s", l.Input[l.Start:l.Pos])
 		}
@@ -106,6 +116,15 @@ func lexWord(l *lex.Lexer) lex.StateFn {
 	return lexText
 }
 
+// lexNumber lexes a number, like the bounds of a range, or a duration like 24h30m.
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	l.AcceptRun(func(r rune) bool {
+		return isDigit(r) || isNameBegin(r) || r == '.' || r == '+' || r == '-'
+	})
+	l.Emit(itemNumber)
+	return lexText
+}
+
 // lexTextComment lexes a comment text inside a schema.
 func lexTextComment(l *lex.Lexer) lex.StateFn {
 	for {
@@ -137,6 +156,10 @@ func isNameBegin(r rune) bool {
 	}
 }
 
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
 func isNameSuffix(r rune) bool {
 	if isNameBegin(r) {
 		return true
@@ -310,7 +310,8 @@ func runFailingRestore(t *testing.T, backupLocation, lastDir string, commitTs ui
 	// calling restore.
 	require.NoError(t, os.RemoveAll(restoreDir))
 
-	result := worker.RunRestore("./data/restore", backupLocation, lastDir, "../../../ee/enc/enc-key")
+	result := worker.RunRestore("./data/restore", backupLocation, lastDir, 0,
+		"../../../ee/enc/enc-key")
 	require.Error(t, result.Err)
 	require.Contains(t, result.Err.Error(), "expected a BackupNum value of 1")
 }
@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/testutil"
+)
+
+func moveTablet(t *testing.T, pred string, group int) {
+	moveURL := fmt.Sprintf("http://%s/moveTablet?tablet=%s&group=%d",
+		testutil.SockAddrZeroHttp, pred, group)
+	resp, err := http.Get(moveURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+}
+
+func servingGroup(t *testing.T, pred string) int {
+	state, err := testutil.GetState()
+	require.NoError(t, err)
+	for _, group := range state.Groups {
+		if tab, ok := group.Tablets[pred]; ok {
+			return tab.GroupID
+		}
+	}
+	return 0
+}
+
+// write adds nodes with a value of the predicate until done is closed, and returns the number of
+// them which were committed. Aborted transactions are retried.
+func write(t *testing.T, dg *dgo.Dgraph, worker int, done <-chan struct{}) int64 {
+	var committed int64
+	for i := 0; ; i++ {
+		select {
+		case <-done:
+			return committed
+		default:
+		}
+		nquad := fmt.Sprintf(`_:n <moved> "%d-%d" .`, worker, i)
+		for {
+			_, err := dg.NewTxn().Mutate(context.Background(), &api.Mutation{
+				SetNquads: []byte(nquad),
+				CommitNow: true,
+			})
+			if err == nil {
+				committed++
+				break
+			}
+			// Commits are aborted while the predicate is being moved.
+			require.True(t, strings.Contains(err.Error(), "aborted") ||
+				strings.Contains(err.Error(), "Please retry") ||
+				strings.Contains(err.Error(), "predicate move") ||
+				strings.Contains(err.Error(), "MoveTs") ||
+				strings.Contains(err.Error(), "Tablet isn't being served"), err.Error())
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestMoveUnderConcurrentWrites(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, dg.Alter(ctx, &api.Operation{DropAll: true}))
+	require.NoError(t, dg.Alter(ctx, &api.Operation{Schema: `moved: string @index(exact) .`}))
+	require.NoError(t, testutil.RetryMutation(dg, &api.Mutation{
+		SetNquads: []byte(`_:n <moved> "first" .`),
+		CommitNow: true,
+	}))
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	var committed int64
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			atomic.AddInt64(&committed, write(t, dg, worker, done))
+		}(i)
+	}
+
+	// Let the writers commit some nodes before each move, so that the catch-up rounds have
+	// keys to send.
+	src := servingGroup(t, "moved")
+	require.NotZero(t, src)
+	for _, dst := range []int{src%3 + 1, (src+1)%3 + 1, src} {
+		time.Sleep(2 * time.Second)
+		moveTablet(t, "moved", dst)
+		require.Equal(t, dst, servingGroup(t, "moved"))
+	}
+	time.Sleep(time.Second)
+	close(done)
+	wg.Wait()
+	require.NotZero(t, committed)
+
+	resp, err := dg.NewReadOnlyTxn().Query(ctx, `{ q(func: has(moved)) { count(uid) } }`)
+	require.NoError(t, err)
+	var result struct {
+		Q []struct {
+			Count int64 `json:"count"`
+		} `json:"q"`
+	}
+	require.NoError(t, json.Unmarshal(resp.GetJson(), &result))
+	require.Len(t, result.Q, 1)
+	// The first node was added before the writers started.
+	require.Equal(t, committed+1, result.Q[0].Count)
+
+	// The index was moved along with the data.
+	resp, err = dg.NewReadOnlyTxn().Query(ctx, `{ q(func: eq(moved, "0-0")) { count(uid) } }`)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(resp.GetJson(), &result))
+	require.Equal(t, int64(1), result.Q[0].Count)
+}
@@ -51,6 +51,7 @@ const (
 	IdentBool      = 0x9
 	IdentTrigram   = 0xA
 	IdentHash      = 0xB
+	IdentComposite = 0xC
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit seperator
 )
@@ -480,3 +481,24 @@ func EncodeRegexTokens(tokens []string) {
 		tokens[i] = encodeToken(tokens[i], TrigramTokenizer{}.Identifier())
 	}
 }
+
+// EncodeCompositeToken encodes the values of a composite index as a single token. name
+// identifies the composite index so that several of them can share the same predicate.
+// Passing fewer values than the index has predicates returns a prefix of all the tokens
+// starting with those values.
+func EncodeCompositeToken(name string, vals []string) string {
+	var buf []byte
+	buf = append(buf, IdentComposite)
+	buf = appendLenPrefixed(buf, name)
+	for _, val := range vals {
+		buf = appendLenPrefixed(buf, val)
+	}
+	return string(buf)
+}
+
+func appendLenPrefixed(buf []byte, val string) []byte {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(val)))
+	buf = append(buf, lenBuf[:n]...)
+	return append(buf, val...)
+}
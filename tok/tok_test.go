@@ -19,6 +19,7 @@ package tok
 import (
 	"math"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -299,3 +300,15 @@ func checkSortedAndUnique(t *testing.T, tokens []string) {
 		set[tok] = struct{}{}
 	}
 }
+
+func TestCompositeToken(t *testing.T) {
+	full := EncodeCompositeToken("city,status", []string{"sf", "open"})
+	prefix := EncodeCompositeToken("city,status", []string{"sf"})
+	require.True(t, strings.HasPrefix(full, prefix))
+	require.Equal(t, byte(IdentComposite), full[0])
+
+	// Length prefixes keep values that concatenate to the same string apart.
+	other := EncodeCompositeToken("city,status", []string{"sfo", "pen"})
+	require.NotEqual(t, full, other)
+	require.False(t, strings.HasPrefix(other, prefix))
+}
@@ -0,0 +1,317 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// azureBlobSuffix is appended to the account name when no host is provided.
+	azureBlobSuffix = ".blob.core.windows.net"
+
+	// azureVersion is the version of the Blob service REST API used.
+	azureVersion = "2019-02-02"
+
+	// azureBlockSize is the size of the blocks the backup objects are uploaded in. A blob can
+	// have up to 50,000 blocks.
+	azureBlockSize = 16 << 20
+)
+
+// azureStore stores the backup objects in an Azure Blob Storage container, via its REST API.
+type azureStore struct {
+	client    *http.Client
+	endpoint  string
+	container string
+	account   string
+	key       []byte
+	sas       url.Values
+}
+
+// newAzureStore returns the store of the container of the URI. The account name is, in this
+// order, the access key of the request, AZURE_STORAGE_ACCOUNT, or the first label of the host.
+// Requests are signed with the account key given as the secret key of the request, or in
+// AZURE_STORAGE_KEY. Otherwise, a SAS token given as the session token of the request, or in
+// AZURE_STORAGE_SAS_TOKEN, is used.
+func newAzureStore(uri *url.URL, creds *Credentials) (objectStore, string, error) {
+	container, prefix, err := splitBucketPath(uri)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s := &azureStore{
+		client:    &http.Client{},
+		container: container,
+		account:   os.Getenv("AZURE_STORAGE_ACCOUNT"),
+	}
+	key := os.Getenv("AZURE_STORAGE_KEY")
+	sas := os.Getenv("AZURE_STORAGE_SAS_TOKEN")
+	if creds.hasCredentials() {
+		s.account, key, sas = creds.AccessKey, creds.SecretKey, creds.SessionToken
+	}
+	host := uri.Host
+	switch {
+	case len(host) == 0 && len(s.account) == 0:
+		return nil, "", errors.Errorf("Azure handler requires a host or an account name")
+	case len(host) == 0:
+		host = s.account + azureBlobSuffix
+	case len(s.account) == 0:
+		s.account = strings.Split(host, ".")[0]
+	}
+	scheme := "https"
+	if !isSecure(uri) {
+		scheme = "http"
+	}
+	s.endpoint = scheme + "://" + host
+
+	switch {
+	case creds.isAnonymous():
+	case len(key) > 0:
+		if s.key, err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, "", errors.Wrapf(err, "while decoding the Azure account key")
+		}
+	case len(sas) > 0:
+		if s.sas, err = url.ParseQuery(strings.TrimPrefix(sas, "?")); err != nil {
+			return nil, "", errors.Wrapf(err, "while parsing the Azure SAS token")
+		}
+	}
+	return s, prefix, nil
+}
+
+// newRequest returns a request for the blob, or the container if name is empty.
+func (s *azureStore) newRequest(method, name string, q url.Values, body []byte) (
+	*http.Request, error) {
+	p := "/" + s.container
+	if len(name) > 0 {
+		p += "/" + name
+	}
+	u, err := url.Parse(s.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = p
+	if q == nil {
+		q = url.Values{}
+	}
+	for k, v := range s.sas {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureVersion)
+	return req, nil
+}
+
+func (s *azureStore) do(req *http.Request, op string) (*http.Response, error) {
+	if len(s.key) > 0 {
+		req.Header.Set("Authorization", "SharedKey "+s.account+":"+s.sign(req))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, checkResponse(resp, op)
+}
+
+// sign returns the Shared Key signature of the request.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key.
+func (s *azureStore) sign(req *http.Request) string {
+	var length string
+	if req.ContentLength > 0 {
+		length = strconv.FormatInt(req.ContentLength, 10)
+	}
+	h := req.Header
+	var buf strings.Builder
+	for _, v := range []string{req.Method, h.Get("Content-Encoding"), h.Get("Content-Language"),
+		length, h.Get("Content-MD5"), h.Get("Content-Type"), "", h.Get("If-Modified-Since"),
+		h.Get("If-Match"), h.Get("If-None-Match"), h.Get("If-Unmodified-Since"),
+		h.Get("Range")} {
+		buf.WriteString(v)
+		buf.WriteByte('\n')
+	}
+
+	var names []string
+	for name := range h {
+		if name = strings.ToLower(name); strings.HasPrefix(name, "x-ms-") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s:%s\n", name, strings.TrimSpace(h.Get(name)))
+	}
+
+	fmt.Fprintf(&buf, "/%s%s", s.account, req.URL.EscapedPath())
+	q := req.URL.Query()
+	var params []string
+	for name := range q {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		vals := append([]string{}, q[name]...)
+		sort.Strings(vals)
+		fmt.Fprintf(&buf, "\n%s:%s", strings.ToLower(name), strings.Join(vals, ","))
+	}
+
+	return hmacSHA256(s.key, buf.String())
+}
+
+func hmacSHA256(key []byte, s string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(s))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *azureStore) list(prefix string) ([]string, error) {
+	var names []string
+	var marker string
+	for {
+		q := url.Values{}
+		q.Set("restype", "container")
+		q.Set("comp", "list")
+		q.Set("prefix", prefix)
+		if len(marker) > 0 {
+			q.Set("marker", marker)
+		}
+		req, err := s.newRequest(http.MethodGet, "", q, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req, "Listing Azure container "+s.container)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Blobs []struct {
+				Name string `xml:"Name"`
+			} `xml:"Blobs>Blob"`
+			NextMarker string `xml:"NextMarker"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range page.Blobs {
+			names = append(names, blob.Name)
+		}
+		if len(page.NextMarker) == 0 {
+			return names, nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+func (s *azureStore) get(name string) (io.ReadCloser, error) {
+	req, err := s.newRequest(http.MethodGet, name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, "Getting Azure blob "+name)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// put uploads the blob in blocks, and then commits the list of blocks, so the size of the blob
+// doesn't need to be known upfront.
+func (s *azureStore) put(name string, r io.Reader) error {
+	var ids []string
+	buf := make([]byte, azureBlockSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if n == 0 && len(ids) > 0 {
+			break
+		}
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%08d", len(ids))))
+		q := url.Values{}
+		q.Set("comp", "block")
+		q.Set("blockid", id)
+		req, rerr := s.newRequest(http.MethodPut, name, q, buf[:n])
+		if rerr != nil {
+			return rerr
+		}
+		resp, rerr := s.do(req, "Uploading block of Azure blob "+name)
+		if rerr != nil {
+			return rerr
+		}
+		resp.Body.Close()
+		ids = append(ids, id)
+		if err != nil {
+			// The last block was read.
+			break
+		}
+	}
+
+	var list bytes.Buffer
+	list.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, id := range ids {
+		fmt.Fprintf(&list, "<Latest>%s</Latest>", id)
+	}
+	list.WriteString("</BlockList>")
+	q := url.Values{}
+	q.Set("comp", "blocklist")
+	req, err := s.newRequest(http.MethodPut, name, q, list.Bytes())
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, "Committing Azure blob "+name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *azureStore) delete(name string) error {
+	req, err := s.newRequest(http.MethodDelete, name, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, "Deleting Azure blob "+name)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
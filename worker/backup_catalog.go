@@ -0,0 +1,213 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// BackupSeries is a full backup and the incremental backups taken on top of it.
+type BackupSeries struct {
+	BackupId string
+	// Manifests are the manifests of the backups of the series, sorted by backup number.
+	Manifests []*Manifest
+}
+
+// Last returns the manifest of the last backup of the series.
+func (s *BackupSeries) Last() *Manifest {
+	return s.Manifests[len(s.Manifests)-1]
+}
+
+// Size returns the total size of the backup files of the series. Only the files whose size
+// was recorded in the manifests are counted.
+func (s *BackupSeries) Size() uint64 {
+	var size uint64
+	for _, m := range s.Manifests {
+		size += m.Size()
+	}
+	return size
+}
+
+// Size returns the total size of the backup files of the manifest, if they were recorded.
+func (m *Manifest) Size() uint64 {
+	var size uint64
+	for _, file := range m.Files {
+		size += file.Size
+	}
+	return size
+}
+
+// Time returns the time at which the backup was taken, from the name of its directory.
+func (m *Manifest) Time() (time.Time, error) {
+	dir := path.Base(path.Dir(filepath.ToSlash(m.Path)))
+	ts := strings.TrimPrefix(dir, strings.TrimSuffix(backupPathFmt, "%s"))
+	t, err := time.Parse(backupTimeFmt, ts)
+	return t, errors.Wrapf(err, "while getting the time of the backup in %q", dir)
+}
+
+// ListBackupSeries returns the backup series at the location, sorted by the time of their
+// full backup.
+func ListBackupSeries(location string) ([]*BackupSeries, error) {
+	manifests, err := ListBackupManifests(location)
+	if err != nil {
+		return nil, err
+	}
+	return groupSeries(manifests), nil
+}
+
+// groupSeries groups the manifests by backup series. The paths of the manifests sort in the
+// order the backups were taken.
+func groupSeries(manifests map[string]*Manifest) []*BackupSeries {
+	var paths []string
+	for p := range manifests {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var series []*BackupSeries
+	byId := make(map[string]*BackupSeries)
+	for _, p := range paths {
+		m := manifests[p]
+		s, ok := byId[m.BackupId]
+		if !ok {
+			s = &BackupSeries{BackupId: m.BackupId}
+			byId[m.BackupId] = s
+			series = append(series, s)
+		}
+		s.Manifests = append(s.Manifests, m)
+	}
+	for _, s := range series {
+		sort.SliceStable(s.Manifests, func(i, j int) bool {
+			return s.Manifests[i].BackupNum < s.Manifests[j].BackupNum
+		})
+	}
+	return series
+}
+
+// VerifyBackupSeries checks that the given backup series, or the latest one if backupId is
+// empty, can be restored. The series must start with a full backup and have no missing
+// backups, and all the backup files are read to check their sizes and checksums against the
+// manifests.
+func VerifyBackupSeries(location, backupId string) error {
+	res := LoadBackup(location, backupId, 0,
+		func(r io.Reader, groupId int, preds predicateSet) (uint64, error) {
+			_, err := io.Copy(ioutil.Discard, r)
+			return 0, err
+		})
+	return res.Err
+}
+
+// BackupAt returns the backup series ID and the number of the last backup taken at or before
+// the timestamp ts, in the given series if backupId is not empty. Restoring the series up to
+// that backup restores the data committed at or before ts, up to the last backup before it.
+func BackupAt(location, backupId string, ts uint64) (string, uint64, error) {
+	series, err := ListBackupSeries(location)
+	if err != nil {
+		return "", 0, err
+	}
+	m := backupAt(series, backupId, ts)
+	if m == nil {
+		return "", 0, errors.Errorf("No backup taken at or before timestamp %d", ts)
+	}
+	return m.BackupId, m.BackupNum, nil
+}
+
+func backupAt(series []*BackupSeries, backupId string, ts uint64) *Manifest {
+	var last *Manifest
+	for _, s := range series {
+		if len(backupId) > 0 && s.BackupId != backupId {
+			continue
+		}
+		for _, m := range s.Manifests {
+			if m.Since <= ts && (last == nil || m.Since > last.Since) {
+				last = m
+			}
+		}
+	}
+	return last
+}
+
+// seriesToPrune returns the series which are not retained: the ones older than the latest
+// keep series whose last backup was taken before the given time, or before any time if it's
+// zero. The latest series is always retained.
+func seriesToPrune(series []*BackupSeries, keep int, before time.Time) ([]*BackupSeries, error) {
+	if keep < 1 {
+		keep = 1
+	}
+	if len(series) <= keep {
+		return nil, nil
+	}
+
+	var prune []*BackupSeries
+	for _, s := range series[:len(series)-keep] {
+		if !before.IsZero() {
+			t, err := s.Last().Time()
+			if err != nil {
+				return nil, err
+			}
+			if !t.Before(before) {
+				continue
+			}
+		}
+		prune = append(prune, s)
+	}
+	return prune, nil
+}
+
+// PruneBackups deletes the backup series at the location which are not retained, as decided by
+// seriesToPrune, and returns them. Nothing is deleted if dryRun is true. The backups of a series
+// are deleted from the last one to the first one, so the series can still be restored up to the
+// backups which are left if the pruning fails midway.
+func PruneBackups(location string, keep int, before time.Time, dryRun bool) (
+	[]*BackupSeries, error) {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	// TODO(martinmr): allow overriding credentials while pruning backups.
+	h := getHandler(uri.Scheme, nil)
+	if h == nil {
+		return nil, errors.Errorf("Unsupported URI: %v", uri)
+	}
+
+	manifests, err := readManifests(h, uri)
+	if err != nil {
+		return nil, err
+	}
+	prune, err := seriesToPrune(groupSeries(manifests), keep, before)
+	if err != nil || dryRun {
+		return prune, err
+	}
+
+	for _, s := range prune {
+		for i := len(s.Manifests) - 1; i >= 0; i-- {
+			m := s.Manifests[i]
+			glog.Infof("Removing backup %d of series %s at %s", m.BackupNum, s.BackupId, m.Path)
+			if err := h.RemoveBackup(m.Path); err != nil {
+				return nil, errors.Wrapf(err, "while removing backup %d of series %s",
+					m.BackupNum, s.BackupId)
+			}
+		}
+	}
+	return prune, nil
+}
@@ -0,0 +1,126 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testSeries returns two series of two backups each, taken a day apart, and a third series
+// with a single backup.
+func testSeries() map[string]*Manifest {
+	manifests := make(map[string]*Manifest)
+	add := func(day int, id string, num, since uint64) {
+		path := fmt.Sprintf("/backups/dgraph.202001%02d.000000.000/manifest.json", day)
+		typ := "incremental"
+		if num == 1 {
+			typ = "full"
+		}
+		manifests[path] = &Manifest{Type: typ, BackupId: id, BackupNum: num, Since: since,
+			Path: path, Files: map[uint32]*BackupFile{1: {Size: since}}}
+	}
+	add(1, "aa", 1, 10)
+	add(2, "aa", 2, 20)
+	add(3, "bb", 1, 30)
+	add(4, "bb", 2, 40)
+	add(5, "cc", 1, 50)
+	return manifests
+}
+
+func TestGroupSeries(t *testing.T) {
+	series := groupSeries(testSeries())
+	require.Len(t, series, 3)
+	require.Equal(t, "aa", series[0].BackupId)
+	require.Equal(t, uint64(2), series[0].Last().BackupNum)
+	require.Equal(t, uint64(30), series[0].Size())
+	require.Equal(t, "cc", series[2].BackupId)
+
+	ts, err := series[1].Last().Time()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC), ts)
+}
+
+func TestBackupAt(t *testing.T) {
+	series := groupSeries(testSeries())
+	require.Nil(t, backupAt(series, "", 5))
+	m := backupAt(series, "", 35)
+	require.Equal(t, "bb", m.BackupId)
+	require.Equal(t, uint64(1), m.BackupNum)
+	m = backupAt(series, "aa", 35)
+	require.Equal(t, "aa", m.BackupId)
+	require.Equal(t, uint64(2), m.BackupNum)
+	require.Equal(t, uint64(50), backupAt(series, "", 100).Since)
+}
+
+func TestSeriesToPrune(t *testing.T) {
+	series := groupSeries(testSeries())
+
+	prune, err := seriesToPrune(series, 0, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, series[:2], prune)
+
+	prune, err = seriesToPrune(series, 2, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, series[:1], prune)
+
+	prune, err = seriesToPrune(series, 5, time.Time{})
+	require.NoError(t, err)
+	require.Empty(t, prune)
+
+	// Only the series whose last backup is older than the 3rd of January are pruned.
+	prune, err = seriesToPrune(series, 1, time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, series[:1], prune)
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backups")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, m := range testSeries() {
+		path := filepath.Join(dir, filepath.Base(filepath.Dir(m.Path)), backupManifest)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		b, err := json.Marshal(m)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(path, b, 0600))
+		require.NoError(t, ioutil.WriteFile(
+			filepath.Join(filepath.Dir(path), backupName(m.Since, 1)), nil, 0600))
+	}
+
+	prune, err := PruneBackups(dir, 2, time.Time{}, true)
+	require.NoError(t, err)
+	require.Len(t, prune, 1)
+	series, err := ListBackupSeries(dir)
+	require.NoError(t, err)
+	require.Len(t, series, 3)
+
+	prune, err = PruneBackups(dir, 2, time.Time{}, false)
+	require.NoError(t, err)
+	require.Len(t, prune, 1)
+	require.Equal(t, "aa", prune[0].BackupId)
+	series, err = ListBackupSeries(dir)
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	require.Equal(t, "bb", series[0].BackupId)
+	_, err = os.Stat(filepath.Join(dir, "dgraph.20200101.000000.000"))
+	require.True(t, os.IsNotExist(err))
+}
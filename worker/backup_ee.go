@@ -27,12 +27,13 @@ import (
 )
 
 // Backup handles a request coming from another node.
-func (w *grpcWorker) Backup(ctx context.Context, req *pb.BackupRequest) (*pb.Status, error) {
+func (w *grpcWorker) Backup(ctx context.Context, req *pb.BackupRequest) (
+	*pb.BackupResponse, error) {
 	glog.V(2).Infof("Received backup request via Grpc: %+v", req)
 	return backupCurrentGroup(ctx, req)
 }
 
-func backupCurrentGroup(ctx context.Context, req *pb.BackupRequest) (*pb.Status, error) {
+func backupCurrentGroup(ctx context.Context, req *pb.BackupRequest) (*pb.BackupResponse, error) {
 	glog.Infof("Backup request: group %d at %d", req.GroupId, req.ReadTs)
 	if err := ctx.Err(); err != nil {
 		glog.Errorf("Context error during backup: %v\n", err)
@@ -54,7 +55,7 @@ func backupCurrentGroup(ctx context.Context, req *pb.BackupRequest) (*pb.Status,
 }
 
 // BackupGroup backs up the group specified in the backup request.
-func BackupGroup(ctx context.Context, in *pb.BackupRequest) (*pb.Status, error) {
+func BackupGroup(ctx context.Context, in *pb.BackupRequest) (*pb.BackupResponse, error) {
 	glog.V(2).Infof("Sending backup request: %+v\n", in)
 	if groups().groupId() == in.GroupId {
 		return backupCurrentGroup(ctx, in)
@@ -96,7 +97,7 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 	}
 
 	req.ReadTs = ts.ReadOnly
-	req.UnixTs = time.Now().UTC().Format("20060102.150405.000")
+	req.UnixTs = time.Now().UTC().Format(backupTimeFmt)
 
 	// Read the manifests to get the right timestamp from which to start the backup.
 	uri, err := url.Parse(req.Destination)
@@ -112,6 +113,11 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 		return err
 	}
 
+	sel, err := newSelection(req.Filter, schemaTypeFields)
+	if err != nil {
+		return err
+	}
+
 	req.SinceTs = latestManifest.Since
 	if forceFull {
 		req.SinceTs = 0
@@ -133,6 +139,14 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 		}
 	}
 
+	// The backups of a series must all select the same data, since an incremental backup
+	// only has the changes to the data of the previous backups.
+	if req.SinceTs > 0 && !sameFilter(latestManifest.Filter, req.Filter) {
+		glog.Infof("Taking a full backup, since the predicates and types selected by the " +
+			"latest backup are different.")
+		req.SinceTs = 0
+	}
+
 	// Update the membership state to get the latest mapping of groups to predicates.
 	if err := UpdateMembershipState(ctx); err != nil {
 		return err
@@ -145,8 +159,10 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 	for gid, group := range state.Groups {
 		groups = append(groups, gid)
 		predMap[gid] = make([]string, 0)
-		for pred := range group.Tablets {
-			predMap[gid] = append(predMap[gid], pred)
+		for pred, tablet := range group.Tablets {
+			if sel.keepPredicate(tablet.GetPredicate()) {
+				predMap[gid] = append(predMap[gid], pred)
+			}
 		}
 	}
 
@@ -154,25 +170,39 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	errCh := make(chan error, len(state.Groups))
+	type groupResult struct {
+		gid uint32
+		res *pb.BackupResponse
+		err error
+	}
+	resCh := make(chan groupResult, len(state.Groups))
 	for _, gid := range groups {
 		br := proto.Clone(req).(*pb.BackupRequest)
 		br.GroupId = gid
 		br.Predicates = predMap[gid]
 		go func(req *pb.BackupRequest) {
-			_, err := BackupGroup(ctx, req)
-			errCh <- err
+			res, err := BackupGroup(ctx, req)
+			resCh <- groupResult{gid: req.GroupId, res: res, err: err}
 		}(br)
 	}
 
+	files := make(map[uint32]*BackupFile)
 	for range groups {
-		if err := <-errCh; err != nil {
-			glog.Errorf("Error received during backup: %v", err)
-			return err
+		r := <-resCh
+		if r.err != nil {
+			glog.Errorf("Error received during backup: %v", r.err)
+			return r.err
+		}
+		// Alphas running an older version don't return the checksum of the file.
+		if r.res.GetChecksum() != "" {
+			files[r.gid] = &BackupFile{Size: r.res.GetFileSize(), Checksum: r.res.GetChecksum()}
 		}
 	}
 
-	m := Manifest{Since: req.ReadTs, Groups: predMap}
+	m := Manifest{Since: req.ReadTs, Groups: predMap, Files: files, Types: schemaTypes()}
+	if isSelective(req.Filter) {
+		m.Filter = req.Filter
+	}
 	if req.SinceTs == 0 {
 		m.Type = "full"
 		m.BackupId = x.GetRandomName(1)
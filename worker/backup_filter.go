@@ -0,0 +1,131 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// selection is a predicate filter, with the types it refers to resolved to their fields.
+type selection struct {
+	// include is true if the filter includes predicates or types. Only those are selected then.
+	include       bool
+	preds         map[string]struct{}
+	types         map[string]struct{}
+	excludedPreds map[string]struct{}
+	excludedTypes map[string]struct{}
+}
+
+// isSelective returns whether the filter selects less than all the predicates and types.
+func isSelective(f *pb.PredicateFilter) bool {
+	return len(f.GetIncludePredicates()) > 0 || len(f.GetExcludePredicates()) > 0 ||
+		len(f.GetIncludeTypes()) > 0 || len(f.GetExcludeTypes()) > 0
+}
+
+// sameFilter returns whether both filters select the same predicates and types.
+func sameFilter(a, b *pb.PredicateFilter) bool {
+	if !isSelective(a) || !isSelective(b) {
+		return isSelective(a) == isSelective(b)
+	}
+	return proto.Equal(a, b)
+}
+
+// newSelection resolves the filter. typeFields returns the fields of a type, and false if the
+// type doesn't exist.
+func newSelection(f *pb.PredicateFilter, typeFields func(string) ([]string, bool)) (
+	*selection, error) {
+	s := &selection{
+		include:       len(f.GetIncludePredicates()) > 0 || len(f.GetIncludeTypes()) > 0,
+		preds:         make(map[string]struct{}),
+		types:         make(map[string]struct{}),
+		excludedPreds: make(map[string]struct{}),
+		excludedTypes: make(map[string]struct{}),
+	}
+	for _, pred := range f.GetIncludePredicates() {
+		s.preds[pred] = struct{}{}
+	}
+	for _, pred := range f.GetExcludePredicates() {
+		s.excludedPreds[pred] = struct{}{}
+	}
+
+	addType := func(name string, types, preds map[string]struct{}) error {
+		fields, ok := typeFields(name)
+		if !ok {
+			return errors.Errorf("type %s not found", name)
+		}
+		types[name] = struct{}{}
+		for _, field := range fields {
+			// The edges of a reverse field are stored with the forward predicate.
+			preds[strings.TrimPrefix(field, "~")] = struct{}{}
+		}
+		return nil
+	}
+	for _, name := range f.GetIncludeTypes() {
+		if err := addType(name, s.types, s.preds); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range f.GetExcludeTypes() {
+		if err := addType(name, s.excludedTypes, s.excludedPreds); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *selection) keepPredicate(pred string) bool {
+	if _, ok := s.excludedPreds[pred]; ok {
+		return false
+	}
+	if !s.include {
+		return true
+	}
+	_, ok := s.preds[pred]
+	return ok
+}
+
+func (s *selection) keepType(name string) bool {
+	if _, ok := s.excludedTypes[name]; ok {
+		return false
+	}
+	if !s.include {
+		return true
+	}
+	_, ok := s.types[name]
+	return ok
+}
+
+// schemaTypeFields returns the fields of the type in the schema of the cluster.
+func schemaTypeFields(name string) ([]string, bool) {
+	typ, ok := schema.State().GetType(name)
+	var fields []string
+	for _, field := range typ.Fields {
+		fields = append(fields, field.Predicate)
+	}
+	return fields, ok
+}
+
+// schemaTypes returns the fields of all the types in the schema of the cluster.
+func schemaTypes() map[string][]string {
+	types := make(map[string][]string)
+	for _, name := range schema.State().Types() {
+		types[name], _ = schemaTypeFields(name)
+	}
+	return types
+}
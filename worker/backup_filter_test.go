@@ -0,0 +1,89 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelection(t *testing.T) {
+	m := &Manifest{Types: map[string][]string{
+		"Person": {"name", "age", "~friend"},
+		"Film":   {"name", "director"},
+	}}
+
+	tests := []struct {
+		filter    *pb.PredicateFilter
+		preds     map[string]bool
+		types     map[string]bool
+		selective bool
+	}{
+		{
+			filter: nil,
+			preds:  map[string]bool{"name": true, "friend": true, "color": true},
+			types:  map[string]bool{"Person": true, "Film": true},
+		},
+		{
+			filter:    &pb.PredicateFilter{IncludePredicates: []string{"color"}},
+			preds:     map[string]bool{"name": false, "color": true},
+			types:     map[string]bool{"Person": false, "Film": false},
+			selective: true,
+		},
+		{
+			filter:    &pb.PredicateFilter{IncludeTypes: []string{"Person"}},
+			preds:     map[string]bool{"name": true, "friend": true, "director": false},
+			types:     map[string]bool{"Person": true, "Film": false},
+			selective: true,
+		},
+		{
+			filter: &pb.PredicateFilter{
+				IncludeTypes:      []string{"Person"},
+				ExcludePredicates: []string{"age"},
+			},
+			preds:     map[string]bool{"name": true, "age": false},
+			types:     map[string]bool{"Person": true},
+			selective: true,
+		},
+		{
+			filter:    &pb.PredicateFilter{ExcludeTypes: []string{"Film"}},
+			preds:     map[string]bool{"name": false, "director": false, "age": true},
+			types:     map[string]bool{"Person": true, "Film": false},
+			selective: true,
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.selective, isSelective(tc.filter), "filter %v", tc.filter)
+		sel, err := newSelection(tc.filter, m.typeFields)
+		require.NoError(t, err)
+		for pred, keep := range tc.preds {
+			require.Equal(t, keep, sel.keepPredicate(pred), "filter %v, pred %s", tc.filter, pred)
+		}
+		for name, keep := range tc.types {
+			require.Equal(t, keep, sel.keepType(name), "filter %v, type %s", tc.filter, name)
+		}
+	}
+
+	_, err := newSelection(&pb.PredicateFilter{IncludeTypes: []string{"Album"}}, m.typeFields)
+	require.Error(t, err)
+}
+
+func TestSameFilter(t *testing.T) {
+	f := &pb.PredicateFilter{IncludePredicates: []string{"name"}}
+	require.True(t, sameFilter(nil, &pb.PredicateFilter{}))
+	require.True(t, sameFilter(f, &pb.PredicateFilter{IncludePredicates: []string{"name"}}))
+	require.False(t, sameFilter(f, nil))
+	require.False(t, sameFilter(f, &pb.PredicateFilter{ExcludePredicates: []string{"name"}}))
+}
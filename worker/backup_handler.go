@@ -13,9 +13,14 @@
 package worker
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
+	"io/ioutil"
 	"net/url"
+	"os"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 
@@ -27,6 +32,9 @@ const (
 	// The expected parameter is a date in string format.
 	backupPathFmt = `dgraph.%s`
 
+	// backupTimeFmt is the format of the date in the path of backup objects, in UTC.
+	backupTimeFmt = "20060102.150405.000"
+
 	// backupNameFmt defines the name of backups files or objects (remote).
 	// The first parameter is the read timestamp at the time of backup. This is used for
 	// incremental backups and partial restore.
@@ -60,8 +68,9 @@ type UriHandler interface {
 	io.WriteCloser
 
 	// GetManfiest returns the list of manfiests for the given backup series ID
-	// at the specified location.
-	GetManifests(*url.URL, string) ([]*Manifest, error)
+	// at the specified location. If the backup number is not zero, the series is cut
+	// after the backup with that number.
+	GetManifests(*url.URL, string, uint64) ([]*Manifest, error)
 
 	// GetLatestManifest reads the manifests at the given URL and returns the
 	// latest manifest.
@@ -74,16 +83,16 @@ type UriHandler interface {
 	CreateManifest(*url.URL, *pb.BackupRequest) error
 
 	// Load will scan location URI for backup files, then load them via loadFn.
-	// It optionally takes the name of the last directory to consider. Any backup directories
-	// created after will be ignored.
+	// It optionally takes the backup series ID and the number of the last backup of the
+	// series to consider. Any backups taken after will be ignored.
 	// Objects implementing this function will be used for retrieving (dowload) backup files
 	// and loading the data into a DB. The restore CLI command uses this call.
-	Load(*url.URL, string, loadFn) LoadResult
+	Load(*url.URL, string, uint64, loadFn) LoadResult
 
 	// Verify checks that the specified backup can be restored to a cluster with the
 	// given groups. The last manifest of that backup should have the same number of
 	// groups as given list of groups.
-	Verify(*url.URL, string, []uint32) error
+	Verify(*url.URL, string, uint64, []uint32) error
 
 	// ListManifests will scan the provided URI and return the paths to the manifests stored
 	// in that location.
@@ -92,6 +101,11 @@ type UriHandler interface {
 	// ReadManifest will read the manifest at the given location and load it into the given
 	// Manifest object.
 	ReadManifest(string, *Manifest) error
+
+	// RemoveBackup deletes the backup of the manifest at the given location, including the
+	// manifest. The manifest is deleted first, so that a backup which is only partially
+	// removed is ignored.
+	RemoveBackup(string) error
 }
 
 // Credentials holds the credentials needed to perform a backup operation.
@@ -136,20 +150,24 @@ func getHandler(scheme string, creds *Credentials) UriHandler {
 		return &s3Handler{
 			creds: creds,
 		}
+	case "gs", "azure", "http", "https":
+		return &objectHandler{
+			creds: creds,
+		}
 	}
 	return nil
 }
 
 // NewUriHandler parses the requested URI and finds the corresponding UriHandler.
 // If the passed credentials are not nil, they will be used to override the
-// default credentials (only for backups to minio or S3).
+// default credentials (only for backups to minio, S3, GCS, Azure or HTTP).
 // Target URI formats:
 //   [scheme]://[host]/[path]?[args]
 //   [scheme]:///[path]?[args]
 //   /[path]?[args] (only for local or NFS)
 //
 // Target URI parts:
-//   scheme - service handler, one of: "file", "s3", "minio"
+//   scheme - service handler, one of: "file", "s3", "minio", "gs", "azure", "http", "https"
 //     host - remote address. ex: "dgraph.s3.amazonaws.com"
 //     path - directory, bucket or container at target. ex: "/dgraph/backups/"
 //     args - specific arguments that are ok to appear in logs.
@@ -163,6 +181,9 @@ func getHandler(scheme string, creds *Credentials) UriHandler {
 // Examples:
 //   s3://dgraph.s3.amazonaws.com/dgraph/backups?secure=true
 //   minio://localhost:9000/dgraph?secure=true
+//   gs:///dgraph-bucket/backups
+//   azure://myaccount.blob.core.windows.net/dgraph/backups
+//   https://backups.example.com/dgraph
 //   file:///tmp/dgraph/backups
 //   /tmp/dgraph/backups?compress=gzip
 func NewUriHandler(uri *url.URL, creds *Credentials) (UriHandler, error) {
@@ -182,9 +203,72 @@ type predicateSet map[string]struct{}
 // are passed as arguments.
 type loadFn func(reader io.Reader, groupId int, preds predicateSet) (uint64, error)
 
+// loadFile loads the backup file of a group, read from r, via fn. If the manifest has the size
+// and checksum of the file, they are checked before fn gets any data, so a corrupt file fails
+// the restore before anything is written. The file is read twice if r can seek, and staged in
+// a temporary file otherwise.
+func loadFile(m *Manifest, gid uint32, r io.Reader, preds predicateSet, fn loadFn) (
+	uint64, error) {
+	file, ok := m.Files[gid]
+	if !ok {
+		return fn(r, int(gid), preds)
+	}
+
+	rs, ok := r.(io.ReadSeeker)
+	if !ok {
+		tmp, err := ioutil.TempFile("", "dgraph-restore-")
+		if err != nil {
+			return 0, errors.Wrapf(err, "while staging the backup file of group %d", gid)
+		}
+		defer func() {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}()
+		r, rs = io.TeeReader(r, tmp), tmp
+	}
+
+	cw := newChecksumWriter(ioutil.Discard)
+	if _, err := io.Copy(cw, r); err != nil {
+		return 0, errors.Wrapf(err, "while reading the backup file of group %d", gid)
+	}
+	if cw.size != file.Size || cw.checksum() != file.Checksum {
+		return 0, errors.Errorf("Backup file of group %d taken at %d is corrupted. "+
+			"Expected %d bytes with checksum %s, got %d bytes with checksum %s",
+			gid, m.Since, file.Size, file.Checksum, cw.size, cw.checksum())
+	}
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return fn(rs, int(gid), preds)
+}
+
+// checksumWriter computes the size and the SHA-256 checksum of the data written to w.
+type checksumWriter struct {
+	w    io.Writer
+	h    hash.Hash
+	size uint64
+}
+
+func newChecksumWriter(w io.Writer) *checksumWriter {
+	return &checksumWriter{w: w, h: sha256.New()}
+}
+
+func (cw *checksumWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.h.Write(b[:n])
+	cw.size += uint64(n)
+	return n, err
+}
+
+// checksum returns the hex-encoded checksum of the data written so far.
+func (cw *checksumWriter) checksum() string {
+	return hex.EncodeToString(cw.h.Sum(nil))
+}
+
 // LoadBackup will scan location l for backup files in the given backup series and load them
-// sequentially. Returns the maximum Since value on success, otherwise an error.
-func LoadBackup(location, backupId string, fn loadFn) LoadResult {
+// sequentially, up to the backup with the given number if it's not zero. Returns the maximum
+// Since value on success, otherwise an error.
+func LoadBackup(location, backupId string, backupNum uint64, fn loadFn) LoadResult {
 	uri, err := url.Parse(location)
 	if err != nil {
 		return LoadResult{0, 0, err}
@@ -196,12 +280,13 @@ func LoadBackup(location, backupId string, fn loadFn) LoadResult {
 		return LoadResult{0, 0, errors.Errorf("Unsupported URI: %v", uri)}
 	}
 
-	return h.Load(uri, backupId, fn)
+	return h.Load(uri, backupId, backupNum, fn)
 }
 
 // VerifyBackup will access the backup location and verify that the specified backup can
 // be restored to the cluster.
-func VerifyBackup(location, backupId string, creds *Credentials, currentGroups []uint32) error {
+func VerifyBackup(location, backupId string, backupNum uint64, creds *Credentials,
+	currentGroups []uint32) error {
 	uri, err := url.Parse(location)
 	if err != nil {
 		return err
@@ -212,7 +297,7 @@ func VerifyBackup(location, backupId string, creds *Credentials, currentGroups [
 		return errors.Errorf("Unsupported URI: %v", uri)
 	}
 
-	return h.Verify(uri, backupId, currentGroups)
+	return h.Verify(uri, backupId, backupNum, currentGroups)
 }
 
 // ListBackupManifests scans location l for backup files and returns the list of manifests.
@@ -227,7 +312,11 @@ func ListBackupManifests(l string) (map[string]*Manifest, error) {
 	if h == nil {
 		return nil, errors.Errorf("Unsupported URI: %v", uri)
 	}
+	return readManifests(h, uri)
+}
 
+// readManifests reads all the manifests at the URI using the handler.
+func readManifests(h UriHandler, uri *url.URL) (map[string]*Manifest, error) {
 	paths, err := h.ListManifests(uri)
 	if err != nil {
 		return nil, err
@@ -239,6 +328,7 @@ func ListBackupManifests(l string) (map[string]*Manifest, error) {
 		if err := h.ReadManifest(path, &m); err != nil {
 			return nil, errors.Wrapf(err, "While reading %q", path)
 		}
+		m.Path = path
 		listedManifests[path] = &m
 	}
 
@@ -246,8 +336,10 @@ func ListBackupManifests(l string) (map[string]*Manifest, error) {
 }
 
 // filterManifests takes a list of manifests and returns the list of manifests
-// that should be considered during a restore.
-func filterManifests(manifests []*Manifest, backupId string) ([]*Manifest, error) {
+// that should be considered during a restore. If backupNum is not zero, the
+// manifests of the backups taken after the one with that number are left out.
+func filterManifests(manifests []*Manifest, backupId string, backupNum uint64) (
+	[]*Manifest, error) {
 	// Go through the files in reverse order and stop when the latest full backup is found.
 	var filteredManifests []*Manifest
 	for i := len(manifests) - 1; i >= 0; i-- {
@@ -274,6 +366,14 @@ func filterManifests(manifests []*Manifest, backupId string) ([]*Manifest, error
 		return nil, err
 	}
 
+	if backupNum > 0 {
+		// verifyManifests checked that the backups are numbered from one onwards.
+		if backupNum > uint64(len(filteredManifests)) {
+			return nil, errors.Errorf("backup number %d not found. The series has %d backups",
+				backupNum, len(filteredManifests))
+		}
+		filteredManifests = filteredManifests[:backupNum]
+	}
 	return filteredManifests, nil
 }
 
@@ -13,6 +13,10 @@
 package worker
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,6 +30,9 @@ func TestGetHandler(t *testing.T) {
 		{in: "file", out: &fileHandler{}},
 		{in: "minio", out: &s3Handler{}},
 		{in: "s3", out: &s3Handler{}},
+		{in: "gs", out: &objectHandler{}},
+		{in: "azure", out: &objectHandler{}},
+		{in: "https", out: &objectHandler{}},
 		{in: "", out: &fileHandler{}},
 		{in: "something", out: nil},
 	}
@@ -55,7 +62,7 @@ func TestFilterManifestDefault(t *testing.T) {
 			BackupNum: 1,
 		},
 	}
-	manifests, err := filterManifests(manifests, "")
+	manifests, err := filterManifests(manifests, "", 0)
 	require.NoError(t, err)
 	require.Equal(t, manifests, expected)
 }
@@ -80,7 +87,7 @@ func TestFilterManifestSelectSeries(t *testing.T) {
 			BackupNum: 1,
 		},
 	}
-	manifests, err := filterManifests(manifests, "aa")
+	manifests, err := filterManifests(manifests, "aa", 0)
 	require.NoError(t, err)
 	require.Equal(t, manifests, expected)
 }
@@ -98,7 +105,7 @@ func TestFilterManifestMissingBackup(t *testing.T) {
 			BackupNum: 3,
 		},
 	}
-	_, err := filterManifests(manifests, "aa")
+	_, err := filterManifests(manifests, "aa", 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "found a manifest with backup number")
 }
@@ -116,7 +123,7 @@ func TestFilterManifestMissingFirstBackup(t *testing.T) {
 			BackupNum: 3,
 		},
 	}
-	_, err := filterManifests(manifests, "aa")
+	_, err := filterManifests(manifests, "aa", 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "expected a BackupNum value of 1 for first manifest")
 }
@@ -134,7 +141,65 @@ func TestFilterManifestDifferentSeries(t *testing.T) {
 			BackupNum: 2,
 		},
 	}
-	_, err := filterManifests(manifests, "")
+	_, err := filterManifests(manifests, "", 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "found a manifest with backup ID")
 }
+
+func TestFilterManifestBackupNum(t *testing.T) {
+	manifests := []*Manifest{
+		{Type: "full", BackupId: "aa", BackupNum: 1},
+		{Type: "incremental", BackupId: "aa", BackupNum: 2},
+		{Type: "incremental", BackupId: "aa", BackupNum: 3},
+		{Type: "full", BackupId: "ab", BackupNum: 1},
+	}
+	filtered, err := filterManifests(manifests, "aa", 2)
+	require.NoError(t, err)
+	require.Equal(t, manifests[:2], filtered)
+
+	filtered, err = filterManifests(manifests, "", 1)
+	require.NoError(t, err)
+	require.Equal(t, manifests[3:], filtered)
+
+	_, err = filterManifests(manifests, "ab", 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "backup number 2 not found")
+}
+
+func TestLoadFileChecksum(t *testing.T) {
+	data := []byte("backup data")
+	cw := newChecksumWriter(ioutil.Discard)
+	_, err := cw.Write(data)
+	require.NoError(t, err)
+	m := &Manifest{Since: 10, Files: map[uint32]*BackupFile{
+		1: {Size: cw.size, Checksum: cw.checksum()},
+	}}
+
+	// The load function reads the whole file, whether it can seek or is staged.
+	var loaded [][]byte
+	readAll := func(r io.Reader, groupId int, preds predicateSet) (uint64, error) {
+		b, err := ioutil.ReadAll(r)
+		loaded = append(loaded, b)
+		return 5, err
+	}
+	for _, r := range []io.Reader{bytes.NewReader(data), ioutil.NopCloser(bytes.NewReader(data))} {
+		maxUid, err := loadFile(m, 1, r, nil, readAll)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), maxUid)
+	}
+	require.Equal(t, [][]byte{data, data}, loaded)
+
+	// A corrupted file is never passed to the load function.
+	loaded = nil
+	for _, r := range []io.Reader{strings.NewReader("backup dat4"),
+		ioutil.NopCloser(strings.NewReader("backup data, and more"))} {
+		_, err = loadFile(m, 1, r, nil, readAll)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "corrupted")
+	}
+	require.Empty(t, loaded)
+
+	// Files without a checksum in the manifest are loaded as they are.
+	_, err = loadFile(m, 2, strings.NewReader("anything"), nil, readAll)
+	require.NoError(t, err)
+}
@@ -80,6 +80,31 @@ type Manifest struct {
 	Path string `json:"-"`
 	// Encrypted indicates whether this backup was encrypted or not.
 	Encrypted bool `json:"encrypted"`
+	// Files is the map of groups to the size and checksum of their backup files. It's not set
+	// in the manifests of older backups, whose files are restored without being verified.
+	Files map[uint32]*BackupFile `json:"files,omitempty"`
+	// Filter is set if only the predicates and types it selects were backed up. The groups
+	// then only list the selected predicates.
+	Filter *pb.PredicateFilter `json:"filter,omitempty"`
+	// Types is the map of the types in the schema at the time of the backup to their fields.
+	// It's used to select the predicates of types during a selective restore.
+	Types map[string][]string `json:"types,omitempty"`
+}
+
+// BackupFile describes the backup file written by a group.
+type BackupFile struct {
+	// Size is the size of the file in bytes, as stored at the backup location.
+	Size uint64 `json:"size"`
+	// Checksum is the hex-encoded SHA-256 checksum of the file, as stored at the backup
+	// location. It can be verified without the encryption key of the backup.
+	Checksum string `json:"checksum"`
+}
+
+// typeFields returns the fields of the type at the time of the backup, and false if the type
+// didn't exist or the backup was taken before types were recorded.
+func (m *Manifest) typeFields(name string) ([]string, bool) {
+	fields, ok := m.Types[name]
+	return fields, ok
 }
 
 func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
@@ -99,8 +124,8 @@ func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
 // retrieval to stream.Orchestrate. The writer will create all the fd's needed to
 // collect the data and later move to the target.
 // Returns errors on failure, nil on success.
-func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.Status, error) {
-	var emptyRes pb.Status
+func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse, error) {
+	var emptyRes pb.BackupResponse
 
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -126,10 +151,19 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.Status, error)
 	for _, pred := range pr.Request.Predicates {
 		predMap[pred] = struct{}{}
 	}
+	// The predicates were already filtered by the Alpha which got the backup request, but the
+	// type definitions are found in every group.
+	sel, err := newSelection(pr.Request.Filter, schemaTypeFields)
+	if err != nil {
+		return &emptyRes, err
+	}
 
 	var maxVersion uint64
 
-	newhandler, err := enc.GetWriter(Config.BadgerKeyFile, handler)
+	// The checksum is computed over the bytes written to the handler, after the compression
+	// and the encryption.
+	cw := newChecksumWriter(handler)
+	newhandler, err := enc.GetWriter(Config.BadgerKeyFile, cw)
 	if err != nil {
 		return &emptyRes, err
 	}
@@ -153,7 +187,7 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.Status, error)
 
 		// Backup type keys in every group.
 		if parsedKey.IsType() {
-			return true
+			return sel.keepType(parsedKey.Attr)
 		}
 
 		// Only backup schema and data keys for the requested predicates.
@@ -190,7 +224,7 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.Status, error)
 		return &emptyRes, err
 	}
 	glog.Infof("Backup complete: group %d at %d", pr.Request.GroupId, pr.Request.ReadTs)
-	return &emptyRes, nil
+	return &pb.BackupResponse{FileSize: cw.size, Checksum: cw.checksum()}, nil
 }
 
 // CompleteBackup will finalize a backup by writing the manifest at the backup destination.
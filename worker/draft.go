@@ -213,9 +213,10 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 	glog.Infof("Node ID: %#x with GroupID: %d\n", id, gid)
 
 	rc := &pb.RaftContext{
-		Addr:  myAddr,
-		Group: gid,
-		Id:    id,
+		Addr:      myAddr,
+		Group:     gid,
+		Id:        id,
+		IsLearner: x.WorkerConfig.Learner,
 	}
 	m := conn.NewNode(rc, store)
 
@@ -228,7 +229,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// to maintain quorum health.
 		applyCh: make(chan []*pb.Proposal, 1000),
 		elog:    trace.NewEventLog("Dgraph", "ApplyCh"),
-		closer:  y.NewCloser(4), // Matches CLOSER:1
+		closer:  y.NewCloser(5), // Matches CLOSER:1
 		ops:     make(map[op]*y.Closer),
 	}
 	if x.WorkerConfig.LudicrousMode {
@@ -293,10 +294,26 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	if proposal.Mutations.DropOp == pb.Mutations_DATA {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
-		return posting.DeleteData()
+		if err := posting.DeleteData(); err != nil {
+			return err
+		}
+		return logDrop(proposal.Mutations)
 	}
 
 	if proposal.Mutations.DropOp == pb.Mutations_ALL {
+		// The standby drops the schema of the predicates we served along with all the data.
+		drop := &pb.Mutations{StartTs: proposal.Mutations.StartTs, DropOp: pb.Mutations_ALL}
+		for _, pred := range schema.State().Predicates() {
+			if x.IsReservedPredicate(pred) {
+				continue
+			}
+			drop.Edges = append(drop.Edges, &pb.DirectedEdge{
+				Attr:  pred,
+				Value: []byte(x.Star),
+				Op:    pb.DirectedEdge_DEL,
+			})
+		}
+
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
 		schema.State().DeleteAll()
@@ -330,11 +347,14 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 			}
 		}
 
-		return nil
+		return logDrop(drop)
 	}
 
 	if proposal.Mutations.DropOp == pb.Mutations_TYPE {
-		return schema.State().DeleteType(proposal.Mutations.DropValue)
+		if err := schema.State().DeleteType(proposal.Mutations.DropValue); err != nil {
+			return err
+		}
+		return logDrop(proposal.Mutations)
 	}
 
 	if proposal.Mutations.StartTs == 0 {
@@ -354,12 +374,13 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 		}
-		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs); err != nil {
+		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs,
+			proposal.Mutations.Reindex); err != nil {
 			return err
 		}
 
 		for _, tupdate := range proposal.Mutations.Types {
-			if err := runTypeMutation(ctx, tupdate); err != nil {
+			if err := runTypeMutation(ctx, tupdate, startTs); err != nil {
 				return err
 			}
 		}
@@ -376,6 +397,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	// Stores a map of predicate and type of first mutation for each predicate.
 	schemaMap := make(map[string]types.TypeID)
 	for _, edge := range proposal.Mutations.Edges {
+		tabletLoads.addWrites(edge.Attr, 1)
 		if edge.Entity == 0 && bytes.Equal(edge.Value, []byte(x.Star)) {
 			// We should only drop the predicate if there is no pending
 			// transaction.
@@ -384,7 +406,11 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 			span.Annotatef(nil, "Deleting predicate: %s", edge.Attr)
-			return posting.DeletePredicate(ctx, edge.Attr)
+			if err := posting.DeletePredicate(ctx, edge.Attr); err != nil {
+				return err
+			}
+			return logDrop(&pb.Mutations{StartTs: proposal.Mutations.StartTs,
+				Edges: []*pb.DirectedEdge{edge}})
 		}
 		// Don't derive schema when doing deletion.
 		if edge.Op == pb.DirectedEdge_DEL {
@@ -549,6 +575,26 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 		}
 		return posting.DeletePredicate(ctx, proposal.CleanPredicate)
 
+	case proposal.CleanShard != nil:
+		n.elog.Printf("Cleaning shard: %+v", proposal.CleanShard)
+		end := time.Now().Add(10 * time.Second)
+		for proposal.ExpectedChecksum > 0 && time.Now().Before(end) {
+			cur := atomic.LoadUint64(&groups().membershipChecksum)
+			if proposal.ExpectedChecksum == cur {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+			glog.Infof("Waiting for checksums to match. Expected: %d. Current: %d\n",
+				proposal.ExpectedChecksum, cur)
+		}
+		if time.Now().After(end) {
+			glog.Warningf(
+				"Giving up on shard deletion: %+v due to timeout. Wanted checksum: %d.",
+				proposal.CleanShard, proposal.ExpectedChecksum)
+			return nil
+		}
+		return deleteUidRange(ctx, proposal.CleanShard)
+
 	case proposal.Delta != nil:
 		n.elog.Printf("Applying Oracle Delta for key: %s", proposal.Key)
 		return n.commitOrAbort(proposal.Key, proposal.Delta)
@@ -580,7 +626,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
 		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		setDiscardTs(snap.ReadTs)
 		return nil
 
 	case proposal.Restore != nil:
@@ -1234,7 +1280,9 @@ func listWrap(kv *bpb.KV) *bpb.KVList {
 // calculateTabletSizes updates the tablet sizes for the keys.
 func (n *node) calculateTabletSizes() {
 	if !n.AmLeader() {
-		// Only leader sends the tablet size updates to Zero. No one else does.
+		// Only leader sends the tablet size updates to Zero. The followers serve queries too, so
+		// they report the read rates of their tablets, which Zero adds to the leader's.
+		n.sendFollowerReads()
 		return
 	}
 	var total int64
@@ -1271,10 +1319,13 @@ func (n *node) calculateTabletSizes() {
 		}
 		total += int64(tinfo.EstimatedSz)
 	}
+	tabletLoads.fillRates(tablets, n.gid)
+	fillComposites(tablets, n.gid)
 	if len(tablets) == 0 {
 		glog.V(2).Infof("No tablets found.")
 		return
 	}
+	splitShardSizes(tablets, n.gid)
 	// Update Zero with the tablet sizes. If Zero sees a tablet which does not belong to
 	// this group, it would send instruction to delete that tablet. There's an edge case
 	// here if the followers are still running Rollup, and happen to read a key before and
@@ -1291,6 +1342,56 @@ func (n *node) calculateTabletSizes() {
 	}
 }
 
+// sendFollowerReads reports the read rates of the tablets counted by this follower since the last
+// call to Zero, and resets the counts. The writes are counted by the leader, which applies them
+// too.
+func (n *node) sendFollowerReads() {
+	tablets := make(map[string]*pb.Tablet)
+	tabletLoads.fillRates(tablets, n.gid)
+	for pred, tab := range tablets {
+		if tab.ReadRate == 0 {
+			delete(tablets, pred)
+			continue
+		}
+		tab.Space, tab.WriteRate = 0, 0
+	}
+	if len(tablets) == 0 {
+		return
+	}
+	if err := groups().doSendMembership(tablets); err != nil {
+		glog.Warningf("While sending read rates to Zero. Error: %v", err)
+	}
+}
+
+// splitShardSizes replaces the tablets of sharded predicates with the shards served by the group.
+// The size and load of a predicate can't be attributed to its uid ranges, so they're split evenly
+// among them.
+func splitShardSizes(tablets map[string]*pb.Tablet, gid uint32) {
+	for pred, tablet := range tablets {
+		var mine []*pb.Tablet
+		for _, shard := range groups().Shards(pred) {
+			if shard.GroupId == gid {
+				mine = append(mine, shard)
+			}
+		}
+		if len(mine) == 0 {
+			continue
+		}
+		delete(tablets, pred)
+		for _, shard := range mine {
+			tablets[x.ShardTabletKey(pred, shard.StartUid)] = &pb.Tablet{
+				GroupId:   gid,
+				Predicate: pred,
+				Space:     tablet.Space / int64(len(mine)),
+				ReadRate:  tablet.ReadRate / float64(len(mine)),
+				WriteRate: tablet.WriteRate / float64(len(mine)),
+				StartUid:  shard.StartUid,
+				EndUid:    shard.EndUid,
+			}
+		}
+	}
+}
+
 var errNoConnection = errors.New("No connection exists")
 
 func (n *node) blockingAbort(req *pb.TxnTimestamps) error {
@@ -1591,7 +1692,8 @@ func (n *node) InitAndStartNode() {
 			n.SetConfState(&sp.Metadata.ConfState)
 
 			members := groups().members(n.gid)
-			for _, id := range sp.Metadata.ConfState.Nodes {
+			cs := sp.Metadata.ConfState
+			for _, id := range append(cs.Nodes, cs.Learners...) {
 				m, ok := members[id]
 				if ok {
 					n.Connect(id, m.Addr)
@@ -1613,6 +1715,9 @@ func (n *node) InitAndStartNode() {
 			n.retryUntilSuccess(n.joinPeers, time.Second)
 			n.SetRaft(raft.StartNode(n.Cfg, nil))
 		} else {
+			// Zero only assigns learners to groups with other members, as a learner can't be
+			// elected the leader of a group of its own.
+			x.AssertTruef(!x.WorkerConfig.Learner, "A learner can't start group %d", n.gid)
 			peers := []raft.Peer{{ID: n.Id}}
 			n.SetRaft(raft.StartNode(n.Cfg, peers))
 			// Trigger election, so this node can become the leader of this single-node cluster.
@@ -1620,6 +1725,7 @@ func (n *node) InitAndStartNode() {
 		}
 	}
 	go n.processTabletSizes()
+	go n.processReplication()
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	// Ignoring the error since InitAndStartNode does not return an error and using x.Check would
@@ -27,10 +27,12 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
 	"github.com/dgraph-io/badger/v2"
@@ -38,9 +40,11 @@ import (
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
 
+	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -53,6 +57,9 @@ type exportFormat struct {
 	ext  string // file extension
 	pre  string // string to write before exported records
 	post string // string to write after exported records
+	// byNode is true if the format needs all the data of a node together. The data is then
+	// collated by node before being written, see nodeCollator.
+	byNode bool
 }
 
 var exportFormats = map[string]exportFormat{
@@ -66,8 +73,26 @@ var exportFormats = map[string]exportFormat{
 		pre:  "",
 		post: "",
 	},
+	"jsonld": {
+		ext:  ".jsonld",
+		pre:  jsonldContext + ",\"@graph\":[\n",
+		post: "\n]}\n",
+	},
+	"graphml": {
+		ext:    ".graphml",
+		byNode: true,
+	},
+	"csv": {
+		ext:    ".csv",
+		byNode: true,
+	},
 }
 
+// jsonldContext starts JSON-LD exports. Predicates are terms of the Dgraph vocabulary, and the
+// data types are the ones used in RDF exports.
+const jsonldContext = `{"@context":{"@vocab":"https://dgraph.io/ns#",` +
+	`"xs":"http://www.w3.org/2001/XMLSchema#","geo":"http://www.opengis.net/ont/geosparql#"}`
+
 type exporter struct {
 	pl     *posting.List
 	uid    uint64
@@ -209,6 +234,56 @@ func (e *exporter) toJSON() (*bpb.KVList, error) {
 	return listWrap(kv), err
 }
 
+// toJSONLD exports each posting as a node object, which JSON-LD processors merge by their @id.
+// JSON-LD has no way to attach properties to edges, so facets are not exported.
+func (e *exporter) toJSONLD() (*bpb.KVList, error) {
+	bp := new(bytes.Buffer)
+
+	key := escapedString(e.attr)
+	if e.attr == "dgraph.type" {
+		key = `"@type"`
+	}
+	continuing := false
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		var obj string
+		if p.PostingType == pb.Posting_REF {
+			obj = fmt.Sprintf(`{"@id":"_:0x%x"}`, p.Uid)
+		} else {
+			val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+			str, err := valToStr(val)
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				return nil
+			}
+
+			rdfType, ok := rdfTypeMap[val.Tid]
+			switch {
+			case p.PostingType == pb.Posting_VALUE_LANG:
+				obj = fmt.Sprintf(`{"@value":%s,"@language":%s}`, escapedString(str),
+					escapedString(string(p.LangTag)))
+			case e.attr == "dgraph.type" || val.Tid == types.StringID || !ok:
+				obj = escapedString(str)
+			default:
+				obj = fmt.Sprintf(`{"@value":%s,"@type":"%s"}`, escapedString(str), rdfType)
+			}
+		}
+
+		if continuing {
+			fmt.Fprint(bp, ",\n")
+		} else {
+			continuing = true
+		}
+		fmt.Fprintf(bp, `  {"@id":"_:0x%x",%s:%s}`, e.uid, key, obj)
+		return nil
+	})
+
+	kv := &bpb.KV{
+		Value:   bp.Bytes(),
+		Version: 1,
+	}
+	return listWrap(kv), err
+}
+
 func (e *exporter) toRDF() (*bpb.KVList, error) {
 	bp := new(bytes.Buffer)
 
@@ -308,6 +383,12 @@ func toSchema(attr string, update *pb.SchemaUpdate) (*bpb.KVList, error) {
 	if update.GetUpsert() {
 		x.Check2(buf.WriteString(" @upsert"))
 	}
+	if update.GetUnique() {
+		x.Check2(buf.WriteString(" @unique"))
+	}
+	if ttl := update.GetTtl(); ttl > 0 {
+		x.Check2(buf.WriteString(fmt.Sprintf(" @ttl(%s)", time.Duration(ttl)*time.Second)))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -323,7 +404,12 @@ func toType(attr string, update pb.TypeUpdate) (*bpb.KVList, error) {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
 
-	x.Check2(buf.WriteString("}\n"))
+	x.Check2(buf.WriteString("}"))
+	for _, ci := range update.CompositeIndexes {
+		x.Check2(buf.WriteString(fmt.Sprintf(" @index(composite: [%s])",
+			strings.Join(ci.Predicates, ", "))))
+	}
+	x.Check2(buf.WriteString("\n"))
 
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -344,10 +430,45 @@ func fieldToString(update *pb.SchemaUpdate) string {
 	} else {
 		x.Check2(builder.WriteString(update.Predicate))
 	}
+	x.Check2(builder.WriteString(constraintToString(update.Constraint)))
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
 }
 
+func constraintToString(c *pb.FieldConstraint) string {
+	if c == nil {
+		return ""
+	}
+	var builder strings.Builder
+	if c.Required {
+		x.Check2(builder.WriteString(" @required"))
+	}
+	var bounds []string
+	if c.Min != "" {
+		bounds = append(bounds, "min: "+strconv.Quote(c.Min))
+	}
+	if c.Max != "" {
+		bounds = append(bounds, "max: "+strconv.Quote(c.Max))
+	}
+	if len(bounds) > 0 {
+		x.Check2(builder.WriteString(fmt.Sprintf(" @range(%s)", strings.Join(bounds, ", "))))
+	}
+	if c.Regex != "" {
+		x.Check2(builder.WriteString(fmt.Sprintf(" @regex(%s)", strconv.Quote(c.Regex))))
+	}
+	var counts []string
+	if c.MinCount > 0 {
+		counts = append(counts, fmt.Sprintf("min: %d", c.MinCount))
+	}
+	if c.MaxCount > 0 {
+		counts = append(counts, fmt.Sprintf("max: %d", c.MaxCount))
+	}
+	if len(counts) > 0 {
+		x.Check2(builder.WriteString(fmt.Sprintf(" @count(%s)", strings.Join(counts, ", "))))
+	}
+	return builder.String()
+}
+
 type fileWriter struct {
 	fd *os.File
 	bw *bufio.Writer
@@ -385,6 +506,47 @@ func (writer *fileWriter) Close() error {
 	return writer.fd.Close()
 }
 
+// exportFilter selects the data to export, as set by the filters of the export request.
+type exportFilter struct {
+	// preds are the predicates to export, or nil to export all of them.
+	preds map[string]struct{}
+	// types are the type definitions to export, or nil to export all of them.
+	types map[string]struct{}
+	// uids are the nodes whose data is exported, or nil to export all of them.
+	uids *pb.List
+}
+
+func newExportFilter(in *pb.ExportRequest) *exportFilter {
+	f := &exportFilter{uids: in.Uids}
+	if len(in.Predicates) > 0 {
+		f.preds = make(map[string]struct{})
+		for _, pred := range in.Predicates {
+			f.preds[pred] = struct{}{}
+		}
+	}
+	if len(in.Types) > 0 {
+		f.types = make(map[string]struct{})
+		for _, name := range in.Types {
+			f.types[name] = struct{}{}
+		}
+	}
+	return f
+}
+
+func (f *exportFilter) keep(pk x.ParsedKey) bool {
+	if pk.IsType() {
+		_, ok := f.types[pk.Attr]
+		return f.types == nil || ok
+	}
+	if _, ok := f.preds[pk.Attr]; f.preds != nil && !ok {
+		return false
+	}
+	if pk.IsSchema() || f.uids == nil {
+		return true
+	}
+	return algo.IndexOf(f.uids, pk.Uid) >= 0
+}
+
 // export creates a export of data by exporting it as an RDF gzip.
 func export(ctx context.Context, in *pb.ExportRequest) error {
 	if in.GroupId != groups().groupId() {
@@ -412,16 +574,26 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 		return filepath.Abs(path.Join(bdir, fmt.Sprintf("g%02d%s", in.GroupId, suffix)))
 	}
 
-	// Open data file now.
-	dataPath, err := fpath(xfmt.ext + ".gz")
-	if err != nil {
-		return err
-	}
-
-	glog.Infof("Exporting data for group: %d at %s\n", in.GroupId, dataPath)
+	// Open data file now. The data of the formats written by node is collated first, and
+	// written to their files once the stream is done.
 	dataWriter := &fileWriter{}
-	if err := dataWriter.open(dataPath); err != nil {
-		return err
+	var collator *nodeCollator
+	if xfmt.byNode {
+		var err error
+		if collator, err = newNodeCollator(); err != nil {
+			return err
+		}
+		defer collator.close()
+	} else {
+		dataPath, err := fpath(xfmt.ext + ".gz")
+		if err != nil {
+			return err
+		}
+
+		glog.Infof("Exporting data for group: %d at %s\n", in.GroupId, dataPath)
+		if err := dataWriter.open(dataPath); err != nil {
+			return err
+		}
 	}
 
 	// Open schema file now.
@@ -435,6 +607,7 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 		return err
 	}
 
+	filter := newExportFilter(in)
 	stream := pstore.NewStreamAt(in.ReadTs)
 	stream.LogPrefix = "Export"
 	stream.ChooseKey = func(item *badger.Item) bool {
@@ -462,6 +635,15 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 				return false
 			}
 		}
+		// The schema of a sharded predicate is only exported by the group serving its first shard.
+		if pk.IsSchema() && len(groups().Shards(pk.Attr)) > 0 {
+			if gid, err := groups().BelongsTo(pk.Attr); err != nil || gid != groups().groupId() {
+				return false
+			}
+		}
+		if !filter.keep(pk) {
+			return false
+		}
 
 		// We need to ensure that schema keys are separately identifiable, so they can be
 		// written to a different file.
@@ -515,8 +697,12 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 			switch in.Format {
 			case "json":
 				return e.toJSON()
+			case "jsonld":
+				return e.toJSONLD()
 			case "rdf":
 				return e.toRDF()
+			case "graphml", "csv":
+				return e.toNodeRecords()
 			default:
 				glog.Fatalf("Invalid export format found: %s", in.Format)
 			}
@@ -530,17 +716,26 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	hasDataBefore := false
 	var separator []byte
 	switch in.Format {
-	case "json":
+	case "json", "jsonld":
 		separator = []byte(",\n")
 	case "rdf":
 		// The separator for RDF should be empty since the toRDF function already
 		// adds newline to each RDF entry.
+	case "graphml", "csv":
+		// The data is written by the collator.
 	default:
 		glog.Fatalf("Invalid export format found: %s", in.Format)
 	}
 
 	stream.Send = func(list *bpb.KVList) error {
 		for _, kv := range list.Kv {
+			if kv.Version == nodeRecordVersion {
+				if err := collator.add(kv); err != nil {
+					return err
+				}
+				continue
+			}
+
 			// Skip nodes that have no data. Otherwise, the exported data could have
 			// formatting and/or syntax errors.
 			if len(kv.Value) == 0 {
@@ -576,17 +771,35 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	}
 
 	// All prepwork done. Time to roll.
-	if _, err = dataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
-		return err
-	}
-	if err := stream.Orchestrate(ctx); err != nil {
-		return err
-	}
-	if _, err = dataWriter.gw.Write([]byte(xfmt.post)); err != nil {
-		return err
-	}
-	if err := dataWriter.Close(); err != nil {
-		return err
+	if xfmt.byNode {
+		if err := stream.Orchestrate(ctx); err != nil {
+			return err
+		}
+		if err := collator.flush(); err != nil {
+			return err
+		}
+		switch in.Format {
+		case "graphml":
+			err = writeGraphML(collator, fpath)
+		case "csv":
+			err = writeCSV(ctx, collator, in, fpath)
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		if _, err = dataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
+			return err
+		}
+		if err := stream.Orchestrate(ctx); err != nil {
+			return err
+		}
+		if _, err = dataWriter.gw.Write([]byte(xfmt.post)); err != nil {
+			return err
+		}
+		if err := dataWriter.Close(); err != nil {
+			return err
+		}
 	}
 	if err := schemaWriter.Close(); err != nil {
 		return err
@@ -633,21 +846,34 @@ func handleExportOverNetwork(ctx context.Context, in *pb.ExportRequest) error {
 	return err
 }
 
-// ExportOverNetwork sends export requests to all the known groups.
-func ExportOverNetwork(ctx context.Context, format string) error {
+// ExportOverNetwork sends export requests to all the known groups. The format and the filters of
+// the request are sent to all of them. If the request has no read timestamp, a new read-only
+// timestamp is used. If the request has types, only the nodes of those types are exported,
+// along with their fields and the predicates of the request.
+func ExportOverNetwork(ctx context.Context, in *pb.ExportRequest) error {
 	// If we haven't even had a single membership update, don't run export.
 	if err := x.HealthCheck(); err != nil {
 		glog.Errorf("Rejecting export request due to health check error: %v\n", err)
 		return err
 	}
-	// Get ReadTs from zero and wait for stream to catch up.
-	ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
-	if err != nil {
-		glog.Errorf("Unable to retrieve readonly ts for export: %v\n", err)
-		return err
+	readTs := in.ReadTs
+	if readTs == 0 {
+		// Get ReadTs from zero and wait for stream to catch up.
+		ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
+		if err != nil {
+			glog.Errorf("Unable to retrieve readonly ts for export: %v\n", err)
+			return err
+		}
+		readTs = ts.ReadOnly
+		glog.Infof("Got readonly ts from Zero: %d\n", readTs)
+	}
+
+	in = proto.Clone(in).(*pb.ExportRequest)
+	if len(in.Types) > 0 {
+		if err := selectExportTypes(ctx, in, readTs); err != nil {
+			return err
+		}
 	}
-	readTs := ts.ReadOnly
-	glog.Infof("Got readonly ts from Zero: %d\n", readTs)
 
 	// Let's first collect all groups.
 	gids := groups().KnownGroups()
@@ -656,12 +882,10 @@ func ExportOverNetwork(ctx context.Context, format string) error {
 	ch := make(chan error, len(gids))
 	for _, gid := range gids {
 		go func(group uint32) {
-			req := &pb.ExportRequest{
-				GroupId: group,
-				ReadTs:  readTs,
-				UnixTs:  time.Now().Unix(),
-				Format:  format,
-			}
+			req := proto.Clone(in).(*pb.ExportRequest)
+			req.GroupId = group
+			req.ReadTs = readTs
+			req.UnixTs = time.Now().Unix()
 			ch <- handleExportOverNetwork(ctx, req)
 		}(gid)
 	}
@@ -678,6 +902,43 @@ func ExportOverNetwork(ctx context.Context, format string) error {
 	return nil
 }
 
+// selectExportTypes restricts the export to the nodes of the types of the request, and adds the
+// fields of the types to its predicates. The edges of reverse fields are stored with other
+// nodes, so they are not selected.
+func selectExportTypes(ctx context.Context, in *pb.ExportRequest, readTs uint64) error {
+	preds := append(in.Predicates, "dgraph.type")
+	var lists []*pb.List
+	for _, name := range in.Types {
+		typ, ok := schema.State().GetType(name)
+		if !ok {
+			return errors.Errorf("Type %s does not exist", name)
+		}
+		for _, field := range typ.Fields {
+			if !strings.HasPrefix(field.Predicate, "~") {
+				preds = append(preds, field.Predicate)
+			}
+		}
+
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    "dgraph.type",
+			SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{name}},
+			ReadTs:  readTs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "while getting the nodes of type %s", name)
+		}
+		lists = append(lists, res.UidMatrix...)
+	}
+
+	in.Predicates = preds
+	uids := algo.MergeSorted(lists)
+	if in.Uids != nil {
+		uids = algo.IntersectSorted([]*pb.List{in.Uids, uids})
+	}
+	in.Uids = uids
+	return nil
+}
+
 // NormalizeExportFormat returns the normalized string for the export format if it is valid, an
 // empty string otherwise.
 func NormalizeExportFormat(format string) string {
@@ -0,0 +1,704 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/options"
+	bpb "github.com/dgraph-io/badger/v2/pb"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// nodeRecordVersion marks the records sent to the collator by the export stream. Data and schema
+// records use the versions 1 and 2.
+const nodeRecordVersion = 3
+
+// Number of nodes whose types are read at once in CSV exports.
+const csvBatchSize = 1000
+
+// nodeCollator sorts the posting lists read by the export stream by node, in a temporary Badger
+// DB, for the formats which need all the data of a node together.
+type nodeCollator struct {
+	dir string
+	db  *badger.DB
+	wb  *badger.WriteBatch
+}
+
+// exportNode holds the posting lists of a node read from the collator, sorted by predicate.
+type exportNode struct {
+	uid   uint64
+	preds []nodePred
+}
+
+type nodePred struct {
+	attr     string
+	postings []*pb.Posting
+}
+
+func newNodeCollator() (*nodeCollator, error) {
+	dir, err := ioutil.TempDir("", "dgraph_export_")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp dir for export")
+	}
+	glog.V(1).Infof("Collating export data by node using the temp folder %s\n", dir)
+
+	db, err := badger.Open(badger.DefaultOptions(dir).
+		WithSyncWrites(false).
+		WithLogger(&x.ToGlog{}).
+		WithCompression(options.None).
+		WithEncryptionKey(enc.ReadEncryptionKeyFile(x.WorkerConfig.BadgerKeyFile)))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap(err, "error opening temp badger for export")
+	}
+	return &nodeCollator{dir: dir, db: db, wb: db.NewWriteBatch()}, nil
+}
+
+func nodeRecordKey(uid uint64, attr string) []byte {
+	key := make([]byte, 8+len(attr))
+	binary.BigEndian.PutUint64(key, uid)
+	copy(key[8:], attr)
+	return key
+}
+
+// toNodeRecords returns the postings of the list as a record for the collator, and an empty
+// record for every node the list points to, so that all the nodes it refers to are written.
+func (e *exporter) toNodeRecords() (*bpb.KVList, error) {
+	list := &pb.PostingList{}
+	kvs := &bpb.KVList{}
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		list.Postings = append(list.Postings, proto.Clone(p).(*pb.Posting))
+		if p.PostingType == pb.Posting_REF {
+			kvs.Kv = append(kvs.Kv, &bpb.KV{
+				Key:     nodeRecordKey(p.Uid, ""),
+				Value:   []byte{},
+				Version: nodeRecordVersion,
+			})
+		}
+		return nil
+	})
+	if err != nil || len(list.Postings) == 0 {
+		return nil, err
+	}
+
+	val, err := list.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	kvs.Kv = append(kvs.Kv, &bpb.KV{
+		Key:     nodeRecordKey(e.uid, e.attr),
+		Value:   val,
+		Version: nodeRecordVersion,
+	})
+	return kvs, nil
+}
+
+func (c *nodeCollator) add(kv *bpb.KV) error {
+	return c.wb.Set(kv.Key, kv.Value)
+}
+
+// flush must be called once all the records have been added.
+func (c *nodeCollator) flush() error {
+	return c.wb.Flush()
+}
+
+// scan calls fn with the data of each node, in uid order.
+func (c *nodeCollator) scan(fn func(*exportNode) error) error {
+	return c.db.View(func(txn *badger.Txn) error {
+		itr := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer itr.Close()
+
+		var node *exportNode
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			item := itr.Item()
+			key := item.Key()
+			uid := binary.BigEndian.Uint64(key)
+			if node != nil && node.uid != uid {
+				if err := fn(node); err != nil {
+					return err
+				}
+				node = nil
+			}
+			if node == nil {
+				node = &exportNode{uid: uid}
+			}
+			if len(key) == 8 {
+				// The node is only referred to by an edge.
+				continue
+			}
+
+			list := &pb.PostingList{}
+			if err := item.Value(list.Unmarshal); err != nil {
+				return err
+			}
+			node.preds = append(node.preds, nodePred{attr: string(key[8:]), postings: list.Postings})
+		}
+		if node != nil {
+			return fn(node)
+		}
+		return nil
+	})
+}
+
+func (c *nodeCollator) close() {
+	if err := c.db.Close(); err != nil {
+		glog.Warningf("Error while closing temp badger for export: %v", err)
+	}
+	if err := os.RemoveAll(c.dir); err != nil {
+		glog.Warningf("Error while removing temp dir for export: %v", err)
+	}
+}
+
+// graphmlKey is an attribute of the nodes or edges of a GraphML export. The attributes must be
+// declared before the graph.
+type graphmlKey struct {
+	id     string
+	domain string
+	name   string
+	typ    string
+}
+
+// graphmlType returns the GraphML type of the attributes holding values of the given type.
+func graphmlType(tid types.TypeID) string {
+	switch tid {
+	case types.IntID:
+		return "long"
+	case types.FloatID:
+		return "double"
+	case types.BoolID:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// graphmlWriter writes the nodes of the collator as a GraphML document.
+type graphmlWriter struct {
+	keys    map[string]*graphmlKey
+	keyList []*graphmlKey
+	buf     bytes.Buffer
+}
+
+func (w *graphmlWriter) key(domain, name string, tid types.TypeID) *graphmlKey {
+	if k, ok := w.keys[domain+"|"+name]; ok {
+		return k
+	}
+	k := &graphmlKey{
+		id:     fmt.Sprintf("%c%d", domain[0], len(w.keyList)),
+		domain: domain,
+		name:   name,
+		typ:    graphmlType(tid),
+	}
+	w.keys[domain+"|"+name] = k
+	w.keyList = append(w.keyList, k)
+	return k
+}
+
+// nodeValues returns the values of the node by attribute, and declares the attributes. The
+// values of list predicates are written as JSON arrays, and the values in each language are
+// written as a separate attribute.
+func (w *graphmlWriter) nodeValues(node *exportNode) ([]*graphmlKey, map[*graphmlKey]string) {
+	var keys []*graphmlKey
+	vals := make(map[*graphmlKey]string)
+	for _, pred := range node.preds {
+		list := schema.State().IsList(pred.attr)
+		tid, err := schema.State().TypeOf(pred.attr)
+		if err != nil || list {
+			tid = types.StringID
+		}
+
+		var items []string
+		for _, p := range pred.postings {
+			if p.PostingType == pb.Posting_REF {
+				continue
+			}
+			str, err := valToStr(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value})
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				continue
+			}
+			if p.PostingType == pb.Posting_VALUE_LANG {
+				k := w.key("node", pred.attr+"@"+string(p.LangTag), types.StringID)
+				keys = append(keys, k)
+				vals[k] = str
+				continue
+			}
+			items = append(items, str)
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		k := w.key("node", pred.attr, tid)
+		keys = append(keys, k)
+		if list {
+			b, err := json.Marshal(items)
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				continue
+			}
+			vals[k] = string(b)
+		} else {
+			vals[k] = items[0]
+		}
+	}
+	return keys, vals
+}
+
+func (w *graphmlWriter) escape(s string) string {
+	w.buf.Reset()
+	// EscapeText only fails if the writer does.
+	x.Check(xml.EscapeText(&w.buf, []byte(s)))
+	return w.buf.String()
+}
+
+func (w *graphmlWriter) writeNode(out *fileWriter, node *exportNode) error {
+	var b strings.Builder
+	keys, vals := w.nodeValues(node)
+	if len(keys) == 0 {
+		fmt.Fprintf(&b, "    <node id=\"0x%x\"/>\n", node.uid)
+	} else {
+		fmt.Fprintf(&b, "    <node id=\"0x%x\">\n", node.uid)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "      <data key=\"%s\">%s</data>\n", k.id, w.escape(vals[k]))
+		}
+		b.WriteString("    </node>\n")
+	}
+
+	label := w.key("edge", "label", types.StringID)
+	for _, pred := range node.preds {
+		for _, p := range pred.postings {
+			if p.PostingType != pb.Posting_REF {
+				continue
+			}
+			fmt.Fprintf(&b, "    <edge source=\"0x%x\" target=\"0x%x\">\n", node.uid, p.Uid)
+			fmt.Fprintf(&b, "      <data key=\"%s\">%s</data>\n", label.id, w.escape(pred.attr))
+			for _, fct := range p.Facets {
+				str, err := facetToString(fct)
+				if err != nil {
+					glog.Errorf("Ignoring error: %+v", err)
+					continue
+				}
+				tid, err := facets.TypeIDFor(fct)
+				if err != nil {
+					glog.Errorf("Error getting type id from facet %#v: %v", fct, err)
+					continue
+				}
+				k := w.key("edge", fct.Key, tid)
+				fmt.Fprintf(&b, "      <data key=\"%s\">%s</data>\n", k.id, w.escape(str))
+			}
+			b.WriteString("    </edge>\n")
+		}
+	}
+
+	if out == nil {
+		// Only declaring the attributes.
+		return nil
+	}
+	_, err := out.gw.Write([]byte(b.String()))
+	return err
+}
+
+// writeGraphML writes the nodes of the collator as a GraphML document, for graph visualization
+// tools such as Gephi or yEd. Nodes are identified by their uids and edges are labelled with
+// their predicates. The attributes must be declared before the graph, so the nodes are read
+// twice.
+func writeGraphML(c *nodeCollator, fpath func(string) (string, error)) error {
+	w := &graphmlWriter{keys: make(map[string]*graphmlKey)}
+	if err := c.scan(func(node *exportNode) error {
+		return w.writeNode(nil, node)
+	}); err != nil {
+		return err
+	}
+
+	dataPath, err := fpath(".graphml.gz")
+	if err != nil {
+		return err
+	}
+	glog.Infof("Exporting data at %s\n", dataPath)
+	out := &fileWriter{}
+	if err := out.open(dataPath); err != nil {
+		return err
+	}
+
+	var header strings.Builder
+	header.WriteString(xml.Header)
+	header.WriteString("<graphml xmlns=\"http://graphml.graphdrawing.org/xmlns\">\n")
+	for _, k := range w.keyList {
+		fmt.Fprintf(&header, "  <key id=\"%s\" for=\"%s\" attr.name=\"%s\" attr.type=\"%s\"/>\n",
+			k.id, k.domain, w.escape(k.name), k.typ)
+	}
+	header.WriteString("  <graph edgedefault=\"directed\">\n")
+	if _, err := out.gw.Write([]byte(header.String())); err != nil {
+		return err
+	}
+	if err := c.scan(func(node *exportNode) error {
+		return w.writeNode(out, node)
+	}); err != nil {
+		return err
+	}
+	if _, err := out.gw.Write([]byte("  </graph>\n</graphml>\n")); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// csvFile is one of the CSV files of an export.
+type csvFile struct {
+	fw *fileWriter
+	w  *csv.Writer
+}
+
+func openCSV(path string, header []string) (*csvFile, error) {
+	glog.Infof("Exporting data at %s\n", path)
+	fw := &fileWriter{}
+	if err := fw.open(path); err != nil {
+		return nil, err
+	}
+	f := &csvFile{fw: fw, w: csv.NewWriter(fw.gw)}
+	return f, f.w.Write(header)
+}
+
+func (f *csvFile) close() error {
+	f.w.Flush()
+	if err := f.w.Error(); err != nil {
+		return err
+	}
+	return f.fw.Close()
+}
+
+// csvExport writes the nodes of the collator as CSV files: a file for the nodes of each type,
+// with a column for each scalar field of the type exported by this group, a file for the edges
+// and a file for the values which aren't in the files of the types of their nodes.
+type csvExport struct {
+	ctx    context.Context
+	readTs uint64
+	fpath  func(string) (string, error)
+	filter *exportFilter
+
+	// localTypes is true if the types of the nodes are in their data, because this group
+	// exports dgraph.type.
+	localTypes bool
+	columns    map[string][]string
+	nodes      map[string]*csvFile
+	edges      *csvFile
+	values     *csvFile
+	batch      []*exportNode
+}
+
+func writeCSV(ctx context.Context, c *nodeCollator, in *pb.ExportRequest,
+	fpath func(string) (string, error)) error {
+	e := &csvExport{
+		ctx:     ctx,
+		readTs:  in.ReadTs,
+		fpath:   fpath,
+		filter:  newExportFilter(in),
+		columns: make(map[string][]string),
+		nodes:   make(map[string]*csvFile),
+	}
+	if gid, err := groups().BelongsToReadOnly("dgraph.type", 0); err == nil &&
+		gid == groups().groupId() && len(groups().Shards("dgraph.type")) == 0 {
+		_, ok := e.filter.preds["dgraph.type"]
+		e.localTypes = e.filter.preds == nil || ok
+	}
+
+	path, err := fpath(".edges.csv.gz")
+	if err != nil {
+		return err
+	}
+	if e.edges, err = openCSV(path, []string{"source", "predicate", "target", "facets"}); err != nil {
+		return err
+	}
+	if path, err = fpath(".values.csv.gz"); err != nil {
+		return err
+	}
+	if e.values, err = openCSV(path, []string{"uid", "predicate", "lang", "value"}); err != nil {
+		return err
+	}
+
+	if err := c.scan(func(node *exportNode) error {
+		e.batch = append(e.batch, node)
+		if len(e.batch) < csvBatchSize {
+			return nil
+		}
+		return e.writeBatch()
+	}); err != nil {
+		return err
+	}
+	if err := e.writeBatch(); err != nil {
+		return err
+	}
+
+	files := []*csvFile{e.edges, e.values}
+	for _, f := range e.nodes {
+		files = append(files, f)
+	}
+	for _, f := range files {
+		if err := f.close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// typeColumns returns the columns of the file of the nodes of the type.
+func (e *csvExport) typeColumns(name string) []string {
+	if cols, ok := e.columns[name]; ok {
+		return cols
+	}
+	var cols []string
+	typ, _ := schema.State().GetType(name)
+	for _, field := range typ.Fields {
+		pred := field.Predicate
+		if strings.HasPrefix(pred, "~") {
+			continue
+		}
+		if _, ok := e.filter.preds[pred]; e.filter.preds != nil && !ok {
+			continue
+		}
+		if gid, err := groups().BelongsToReadOnly(pred, 0); err != nil ||
+			gid != groups().groupId() {
+			continue
+		}
+		if tid, err := schema.State().TypeOf(pred); err != nil || tid == types.UidID {
+			continue
+		}
+		cols = append(cols, pred)
+	}
+	e.columns[name] = cols
+	return cols
+}
+
+func (e *csvExport) typeFile(name string) (*csvFile, error) {
+	if f, ok := e.nodes[name]; ok {
+		return f, nil
+	}
+	path, err := e.fpath(".nodes." + name + ".csv.gz")
+	if err != nil {
+		return nil, err
+	}
+	f, err := openCSV(path, append([]string{"uid"}, e.typeColumns(name)...))
+	if err != nil {
+		return nil, err
+	}
+	e.nodes[name] = f
+	return f, nil
+}
+
+func (e *csvExport) keepType(name string) bool {
+	_, ok := e.filter.types[name]
+	return e.filter.types == nil || ok
+}
+
+// nodeTypes returns the types of each node of the batch.
+func (e *csvExport) nodeTypes() ([][]string, error) {
+	out := make([][]string, len(e.batch))
+	if e.localTypes {
+		for i, node := range e.batch {
+			for _, pred := range node.preds {
+				if pred.attr != "dgraph.type" {
+					continue
+				}
+				for _, p := range pred.postings {
+					if name := string(p.Value); e.keepType(name) {
+						out[i] = append(out[i], name)
+					}
+				}
+			}
+		}
+		return out, nil
+	}
+
+	uids := make([]uint64, 0, len(e.batch))
+	for _, node := range e.batch {
+		uids = append(uids, node.uid)
+	}
+	res, err := ProcessTaskOverNetwork(e.ctx, &pb.Query{
+		Attr:    "dgraph.type",
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  e.readTs,
+	})
+	switch {
+	case err == errNonExistentTablet:
+		// No node has a type.
+		return out, nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "while getting the types of the exported nodes")
+	}
+
+	for i := range uids {
+		if i >= len(res.ValueMatrix) {
+			break
+		}
+		for _, tv := range res.ValueMatrix[i].Values {
+			if name := string(tv.Val); e.keepType(name) {
+				out[i] = append(out[i], name)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (e *csvExport) writeBatch() error {
+	if len(e.batch) == 0 {
+		return nil
+	}
+	nodeTypes, err := e.nodeTypes()
+	if err != nil {
+		return err
+	}
+	for i, node := range e.batch {
+		if err := e.writeNode(node, nodeTypes[i]); err != nil {
+			return err
+		}
+	}
+	e.batch = e.batch[:0]
+	return nil
+}
+
+// csvCell returns the content of the cell holding the values. Several values are written as a
+// JSON array.
+func csvCell(vals []string) string {
+	switch len(vals) {
+	case 0:
+		return ""
+	case 1:
+		return vals[0]
+	}
+	b, err := json.Marshal(vals)
+	x.Check(err)
+	return string(b)
+}
+
+func facetsToJSON(fcts []*api.Facet) string {
+	if len(fcts) == 0 {
+		return ""
+	}
+	m := make(map[string]interface{})
+	for _, fct := range fcts {
+		str, err := facetToString(fct)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v", err)
+			continue
+		}
+		tid, err := facets.TypeIDFor(fct)
+		if err != nil {
+			glog.Errorf("Error getting type id from facet %#v: %v", fct, err)
+			continue
+		}
+		if tid.IsNumber() {
+			m[fct.Key] = json.Number(str)
+		} else {
+			m[fct.Key] = str
+		}
+	}
+	b, err := json.Marshal(m)
+	x.Check(err)
+	return string(b)
+}
+
+func (e *csvExport) writeNode(node *exportNode, typeNames []string) error {
+	uid := fmt.Sprintf("0x%x", node.uid)
+	values := make(map[string][]string)
+	for _, pred := range node.preds {
+		// The types of the nodes are given by the files they are in.
+		if pred.attr == "dgraph.type" {
+			continue
+		}
+		for _, p := range pred.postings {
+			if p.PostingType == pb.Posting_REF {
+				row := []string{uid, pred.attr, fmt.Sprintf("0x%x", p.Uid), facetsToJSON(p.Facets)}
+				if err := e.edges.w.Write(row); err != nil {
+					return err
+				}
+				continue
+			}
+
+			str, err := valToStr(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value})
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				continue
+			}
+			if p.PostingType == pb.Posting_VALUE_LANG {
+				row := []string{uid, pred.attr, string(p.LangTag), str}
+				if err := e.values.w.Write(row); err != nil {
+					return err
+				}
+				continue
+			}
+			values[pred.attr] = append(values[pred.attr], str)
+		}
+	}
+
+	written := make(map[string]bool)
+	for _, name := range typeNames {
+		cols := e.typeColumns(name)
+		row := []string{uid}
+		hasValues := false
+		for _, col := range cols {
+			row = append(row, csvCell(values[col]))
+			hasValues = hasValues || len(values[col]) > 0
+		}
+		if !hasValues {
+			continue
+		}
+		f, err := e.typeFile(name)
+		if err != nil {
+			return err
+		}
+		if err := f.w.Write(row); err != nil {
+			return err
+		}
+		for _, col := range cols {
+			written[col] = true
+		}
+	}
+
+	for _, pred := range node.preds {
+		if written[pred.attr] {
+			continue
+		}
+		for _, val := range values[pred.attr] {
+			if err := e.values.w.Write([]string{uid, pred.attr, "", val}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
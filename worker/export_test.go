@@ -22,6 +22,7 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"io/ioutil"
 	"math"
 	"net/http"
@@ -509,3 +510,169 @@ func TestToSchema(t *testing.T) {
 		require.Equal(t, testCase.expected, string(list.Kv[0].Value))
 	}
 }
+
+// runTestExport runs the export and returns the directory of the export, and the names of the
+// data files in it. The caller must remove the export path.
+func runTestExport(t *testing.T, req *pb.ExportRequest) (string, []string) {
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+
+	x.WorkerConfig.ExportPath = bdir
+	req.ReadTs = timestamp()
+	req.GroupId = 1
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: req.ReadTs})
+	require.NoError(t, export(context.Background(), req))
+
+	dirs, err := ioutil.ReadDir(bdir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dirs))
+	dir := filepath.Join(bdir, dirs[0].Name())
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, f := range files {
+		if !strings.Contains(f.Name(), "schema") {
+			names = append(names, f.Name())
+		}
+	}
+	return dir, names
+}
+
+func readExportFile(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestExportJsonLD(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+	dir, files := runTestExport(t, &pb.ExportRequest{Format: "jsonld"})
+	defer os.RemoveAll(filepath.Dir(dir))
+	require.Equal(t, []string{"g01.jsonld.gz"}, files)
+
+	var doc struct {
+		Context map[string]string        `json:"@context"`
+		Graph   []map[string]interface{} `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(readExportFile(t, filepath.Join(dir, files[0]))), &doc))
+	require.Equal(t, "https://dgraph.io/ns#", doc.Context["@vocab"])
+	require.Equal(t, 9, len(doc.Graph))
+	require.Contains(t, doc.Graph, map[string]interface{}{"@id": "_:0x1", "name": "pho\ton"})
+	require.Contains(t, doc.Graph, map[string]interface{}{"@id": "_:0x2",
+		"name": map[string]interface{}{"@value": "pho\ton", "@language": "en"}})
+	require.Contains(t, doc.Graph, map[string]interface{}{"@id": "_:0x4",
+		"friend": map[string]interface{}{"@id": "_:0x5"}})
+}
+
+func TestExportGraphML(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+	dir, files := runTestExport(t, &pb.ExportRequest{Format: "graphml"})
+	defer os.RemoveAll(filepath.Dir(dir))
+	require.Equal(t, []string{"g01.graphml.gz"}, files)
+
+	type data struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:",chardata"`
+	}
+	var doc struct {
+		Keys []struct {
+			Id   string `xml:"id,attr"`
+			For  string `xml:"for,attr"`
+			Name string `xml:"attr.name,attr"`
+		} `xml:"key"`
+		Nodes []struct {
+			Id   string `xml:"id,attr"`
+			Data []data `xml:"data"`
+		} `xml:"graph>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+			Data   []data `xml:"data"`
+		} `xml:"graph>edge"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(readExportFile(t, filepath.Join(dir, files[0]))), &doc))
+
+	keys := make(map[string]string)
+	for _, k := range doc.Keys {
+		keys[k.Id] = k.For + ":" + k.Name
+	}
+	var nodes []string
+	for _, n := range doc.Nodes {
+		nodes = append(nodes, n.Id)
+		if n.Id == "0x2" {
+			require.Equal(t, []data{{Key: n.Data[0].Key, Value: "pho\ton"}}, n.Data)
+			require.Equal(t, "node:name@en", keys[n.Data[0].Key])
+		}
+	}
+	require.Equal(t, []string{"0x1", "0x2", "0x3", "0x4", "0x5", "0x6"}, nodes)
+
+	require.Equal(t, 4, len(doc.Edges))
+	edge := doc.Edges[3]
+	require.Equal(t, "0x4", edge.Source)
+	require.Equal(t, "0x5", edge.Target)
+	values := make(map[string]string)
+	for _, d := range edge.Data {
+		values[keys[d.Key]] = d.Value
+	}
+	require.Equal(t, "friend", values["edge:label"])
+	require.Equal(t, "33", values["edge:age"])
+	require.Equal(t, "football", values["edge:game"])
+}
+
+func TestExportCSV(t *testing.T) {
+	initTestExport(t, "name:string .")
+	schema.State().SetType("Person", *personType)
+	gr.Lock()
+	gr.tablets["dgraph.type"] = &pb.Tablet{GroupId: 1}
+	gr.Unlock()
+	typeEdge := &pb.DirectedEdge{Entity: 1, Attr: "dgraph.type", Value: []byte("Person"),
+		ValueType: pb.Posting_STRING}
+	addEdge(t, typeEdge, getOrCreate(x.DataKey("dgraph.type", 1)))
+	// The tablet is kept, as other exports read the key of the deleted edge.
+	defer delEdge(t, typeEdge, getOrCreate(x.DataKey("dgraph.type", 1)))
+
+	dir, files := runTestExport(t, &pb.ExportRequest{Format: "csv"})
+	defer os.RemoveAll(filepath.Dir(dir))
+	require.Equal(t, []string{"g01.edges.csv.gz", "g01.nodes.Person.csv.gz",
+		"g01.values.csv.gz"}, files)
+
+	require.Equal(t, "uid,name\n0x1,pho\ton\n",
+		readExportFile(t, filepath.Join(dir, "g01.nodes.Person.csv.gz")))
+	require.Equal(t, "uid,predicate,lang,value\n"+
+		"0x2,name,en,pho\ton\n"+
+		"0x3,name,,\"First Line\nSecondLine\"\n"+
+		"0x5,name,,\n"+
+		"0x6,name,,Ding!\aDing!\aDing!\a\n",
+		readExportFile(t, filepath.Join(dir, "g01.values.csv.gz")))
+	edges := readExportFile(t, filepath.Join(dir, "g01.edges.csv.gz"))
+	require.True(t, strings.HasPrefix(edges, "source,predicate,target,facets\n"+
+		"0x1,friend,0x5,\n0x2,friend,0x5,\n0x3,friend,0x5,\n0x4,friend,0x5,"), edges)
+	require.Contains(t, edges, `""age"":33`)
+}
+
+func TestExportFilter(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+	dir, files := runTestExport(t, &pb.ExportRequest{
+		Format:     "rdf",
+		Predicates: []string{"friend"},
+		Types:      []string{"Animal"},
+		Uids:       &pb.List{Uids: []uint64{2, 3}},
+	})
+	defer os.RemoveAll(filepath.Dir(dir))
+	require.Equal(t, "<0x2> <friend> <0x5> .\n<0x3> <friend> <0x5> .\n",
+		readExportFile(t, filepath.Join(dir, files[0])))
+
+	schemaFile := filepath.Join(dir, "g01.schema.gz")
+	require.Equal(t, "<friend>:uid . \n", readExportFile(t, schemaFile))
+
+	// An empty set of nodes exports no data.
+	dir, files = runTestExport(t, &pb.ExportRequest{Format: "rdf", Uids: &pb.List{}})
+	defer os.RemoveAll(filepath.Dir(dir))
+	require.Equal(t, "", readExportFile(t, filepath.Join(dir, files[0])))
+}
@@ -109,7 +109,8 @@ func (h *fileHandler) CreateManifest(uri *url.URL, req *pb.BackupRequest) error
 	return h.createFiles(uri, req, backupManifest)
 }
 
-func (h *fileHandler) GetManifests(uri *url.URL, backupId string) ([]*Manifest, error) {
+func (h *fileHandler) GetManifests(uri *url.URL, backupId string,
+	backupNum uint64) ([]*Manifest, error) {
 	if !pathExist(uri.Path) {
 		return nil, errors.Errorf("The path %q does not exist or it is inaccessible.", uri.Path)
 	}
@@ -134,7 +135,7 @@ func (h *fileHandler) GetManifests(uri *url.URL, backupId string) ([]*Manifest,
 		m.Path = path
 		manifests = append(manifests, &m)
 	}
-	manifests, err := filterManifests(manifests, backupId)
+	manifests, err := filterManifests(manifests, backupId, backupNum)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +145,9 @@ func (h *fileHandler) GetManifests(uri *url.URL, backupId string) ([]*Manifest,
 
 // Load uses tries to load any backup files found.
 // Returns the maximum value of Since on success, error otherwise.
-func (h *fileHandler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult {
-	manifests, err := h.GetManifests(uri, backupId)
+func (h *fileHandler) Load(uri *url.URL, backupId string, backupNum uint64,
+	fn loadFn) LoadResult {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
 	if err != nil {
 		return LoadResult{0, 0, errors.Wrapf(err, "cannot retrieve manifests")}
 	}
@@ -173,7 +175,7 @@ func (h *fileHandler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult
 			// of the last backup.
 			predSet := manifests[len(manifests)-1].getPredsInGroup(gid)
 
-			groupMaxUid, err := fn(fp, int(gid), predSet)
+			groupMaxUid, err := loadFile(manifest, gid, fp, predSet, fn)
 			if err != nil {
 				return LoadResult{0, 0, err}
 			}
@@ -189,8 +191,9 @@ func (h *fileHandler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult
 
 // Verify performs basic checks to decide whether the specified backup can be restored
 // to a live cluster.
-func (h *fileHandler) Verify(uri *url.URL, backupId string, currentGroups []uint32) error {
-	manifests, err := h.GetManifests(uri, backupId)
+func (h *fileHandler) Verify(uri *url.URL, backupId string, backupNum uint64,
+	currentGroups []uint32) error {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
 	if err != nil {
 		return errors.Wrapf(err, "while retrieving manifests")
 	}
@@ -223,6 +226,14 @@ func (h *fileHandler) ReadManifest(path string, m *Manifest) error {
 	return h.readManifest(path, m)
 }
 
+// RemoveBackup deletes the backup directory of the manifest at the given path.
+func (h *fileHandler) RemoveBackup(path string) error {
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Dir(path))
+}
+
 func (h *fileHandler) Close() error {
 	if h.fp == nil {
 		return nil
@@ -0,0 +1,263 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultEndpointGCS is used with gs scheme when no host is provided.
+	defaultEndpointGCS = "storage.googleapis.com"
+
+	// gcsScope is the OAuth2 scope needed to read and write the backup objects.
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// gcsStore stores the backup objects in a Google Cloud Storage bucket, via its JSON API.
+type gcsStore struct {
+	client   *http.Client
+	endpoint string
+	bucket   string
+	token    func() (string, error)
+}
+
+// newGCSStore returns the store of the bucket of the URI. The credentials are, in this order:
+//   an OAuth2 access token given as the session token of the request,
+//   the service account key file set in GOOGLE_APPLICATION_CREDENTIALS,
+//   none, for public buckets or when the request is anonymous.
+func newGCSStore(uri *url.URL, creds *Credentials) (objectStore, string, error) {
+	bucket, prefix, err := splitBucketPath(uri)
+	if err != nil {
+		return nil, "", err
+	}
+	host := uri.Host
+	if len(host) == 0 {
+		host = defaultEndpointGCS
+	}
+	scheme := "https"
+	if !isSecure(uri) {
+		scheme = "http"
+	}
+
+	s := &gcsStore{
+		client:   &http.Client{},
+		endpoint: scheme + "://" + host,
+		bucket:   bucket,
+	}
+	switch {
+	case creds.isAnonymous():
+	case creds != nil && len(creds.SessionToken) > 0:
+		token := creds.SessionToken
+		s.token = func() (string, error) { return token, nil }
+	case len(os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")) > 0:
+		sa, err := readServiceAccount(os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"))
+		if err != nil {
+			return nil, "", err
+		}
+		s.token = sa.accessToken
+	}
+	return s, prefix, nil
+}
+
+func (s *gcsStore) do(req *http.Request, op string) (*http.Response, error) {
+	if s.token != nil {
+		token, err := s.token()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting an access token for GCS")
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, checkResponse(resp, op)
+}
+
+func (s *gcsStore) objectsURL() string {
+	return s.endpoint + "/storage/v1/b/" + url.PathEscape(s.bucket) + "/o"
+}
+
+func (s *gcsStore) list(prefix string) ([]string, error) {
+	var names []string
+	var pageToken string
+	for {
+		q := url.Values{}
+		q.Set("prefix", prefix)
+		q.Set("fields", "items(name),nextPageToken")
+		if len(pageToken) > 0 {
+			q.Set("pageToken", pageToken)
+		}
+		req, err := http.NewRequest(http.MethodGet, s.objectsURL()+"?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req, "Listing GCS bucket "+s.bucket)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			names = append(names, item.Name)
+		}
+		if len(page.NextPageToken) == 0 {
+			return names, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (s *gcsStore) get(name string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet,
+		s.objectsURL()+"/"+url.PathEscape(name)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, "Getting GCS object "+name)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *gcsStore) put(name string, r io.Reader) error {
+	q := url.Values{}
+	q.Set("uploadType", "media")
+	q.Set("name", name)
+	req, err := http.NewRequest(http.MethodPost, s.endpoint+"/upload/storage/v1/b/"+
+		url.PathEscape(s.bucket)+"/o?"+q.Encode(), ioutil.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.do(req, "Uploading GCS object "+name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *gcsStore) delete(name string) error {
+	req, err := http.NewRequest(http.MethodDelete, s.objectsURL()+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, "Deleting GCS object "+name)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// serviceAccount gets OAuth2 access tokens for a Google service account, by signing a JWT with
+// its private key.
+type serviceAccount struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyId string `json:"private_key_id"`
+	TokenUri     string `json:"token_uri"`
+
+	sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func readServiceAccount(file string) (*serviceAccount, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the service account key file")
+	}
+	var sa serviceAccount
+	if err := json.Unmarshal(b, &sa); err != nil {
+		return nil, errors.Wrapf(err, "while parsing the service account key file")
+	}
+	if len(sa.TokenUri) == 0 {
+		sa.TokenUri = "https://oauth2.googleapis.com/token"
+	}
+	return &sa, nil
+}
+
+func (sa *serviceAccount) accessToken() (string, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	if len(sa.token) > 0 && time.Now().Add(time.Minute).Before(sa.expiry) {
+		return sa.token, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(sa.PrivateKey))
+	if err != nil {
+		return "", errors.Wrapf(err, "while parsing the private key of the service account")
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   sa.ClientEmail,
+		"scope": gcsScope,
+		"aud":   sa.TokenUri,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = sa.PrivateKeyId
+	assertion, err := t.SignedString(key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	resp, err := http.Post(sa.TokenUri, "application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	if err := checkResponse(resp, "Getting an access token"); err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	sa.token = out.AccessToken
+	sa.expiry = now.Add(time.Duration(out.ExpiresIn) * time.Second)
+	return sa.token, nil
+}
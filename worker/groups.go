@@ -49,6 +49,7 @@ type groupi struct {
 	Node         *node
 	gid          uint32
 	tablets      map[string]*pb.Tablet
+	shards       map[string][]*pb.Tablet
 	triggerCh    chan struct{} // Used to trigger membership sync
 	blockDeletes *sync.Mutex   // Ensure that deletion won't happen when move is going on.
 	closer       *y.Closer
@@ -62,6 +63,7 @@ type groupi struct {
 var gr = &groupi{
 	blockDeletes: new(sync.Mutex),
 	tablets:      make(map[string]*pb.Tablet),
+	shards:       make(map[string][]*pb.Tablet),
 }
 
 func groups() *groupi {
@@ -110,7 +112,7 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 
 	// Connect with Zero leader and figure out what group we should belong to.
 	m := &pb.Member{Id: x.WorkerConfig.RaftId, GroupId: x.WorkerConfig.ProposedGroupId,
-		Addr: x.WorkerConfig.MyAddr}
+		Addr: x.WorkerConfig.MyAddr, Learner: x.WorkerConfig.Learner}
 	if m.GroupId > 0 {
 		m.ForceGroupId = true
 	}
@@ -154,10 +156,11 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 	x.UpdateHealthStatus(true)
 	glog.Infof("Server is ready")
 
-	gr.closer = y.NewCloser(3) // Match CLOSER:1 in this file.
+	gr.closer = y.NewCloser(4) // Match CLOSER:1 in this file.
 	go gr.sendMembershipUpdates()
 	go gr.receiveMembershipUpdates()
 	go gr.processOracleDeltaStream()
+	go gr.updateHistoryHorizon()
 
 	gr.informZeroAboutTablets()
 	gr.proposeInitialSchema()
@@ -313,6 +316,7 @@ func (g *groupi) applyState(state *pb.MembershipState) {
 	// Sometimes this can cause us to lose latest tablet info, but that shouldn't cause any issues.
 	var foundSelf bool
 	g.tablets = make(map[string]*pb.Tablet)
+	g.shards = make(map[string][]*pb.Tablet)
 	for gid, group := range g.state.Groups {
 		for _, member := range group.Members {
 			if x.WorkerConfig.RaftId == member.Id {
@@ -324,6 +328,13 @@ func (g *groupi) applyState(state *pb.MembershipState) {
 			}
 		}
 		for _, tablet := range group.Tablets {
+			if tablet.StartUid > 0 {
+				g.shards[tablet.Predicate] = append(g.shards[tablet.Predicate], tablet)
+				if tablet.StartUid > 1 {
+					// Only the first shard stands for the predicate.
+					continue
+				}
+			}
 			g.tablets[tablet.Predicate] = tablet
 		}
 		if gid == g.groupId() {
@@ -331,6 +342,11 @@ func (g *groupi) applyState(state *pb.MembershipState) {
 			atomic.StoreUint64(&g.membershipChecksum, group.Checksum)
 		}
 	}
+	for _, shards := range g.shards {
+		sort.Slice(shards, func(i, j int) bool {
+			return shards[i].StartUid < shards[j].StartUid
+		})
+	}
 	for _, member := range g.state.Zeros {
 		if x.WorkerConfig.MyAddr != member.Addr {
 			conn.GetPools().Connect(member.Addr)
@@ -450,15 +466,58 @@ func (g *groupi) BelongsToReadOnly(key string, ts uint64) (uint32, error) {
 	return out.GetGroupId(), nil
 }
 
+// BelongsToUid acts like BelongsTo, except that for a predicate sharded by uid ranges it returns
+// the group serving the shard of the given uid.
+func (g *groupi) BelongsToUid(key string, uid uint64) (uint32, error) {
+	if tablet := x.ShardFor(g.Shards(key), uid); tablet != nil {
+		return tablet.GroupId, nil
+	}
+	return g.BelongsTo(key)
+}
+
+// Shards returns the tablets of the shards of the predicate sorted by their start uid, or nil if
+// the predicate isn't sharded. Do not modify the returned tablets.
+func (g *groupi) Shards(key string) []*pb.Tablet {
+	g.RLock()
+	defer g.RUnlock()
+	return g.shards[key]
+}
+
+// ServesTablet returns true if this group serves the tablet, or any shard of it.
 func (g *groupi) ServesTablet(key string) (bool, error) {
 	if tablet, err := g.Tablet(key); err != nil {
 		return false, err
 	} else if tablet != nil && tablet.GroupId == groups().groupId() {
 		return true, nil
 	}
+	for _, shard := range g.Shards(key) {
+		if shard.GroupId == groups().groupId() {
+			return true, nil
+		}
+	}
 	return false, nil
 }
 
+// groupForRead acts like BelongsToReadOnly, except that for a sharded predicate it returns this
+// group if it serves any of the shards, since it's then asked to read the uids of those shards.
+func (g *groupi) groupForRead(key string, ts uint64) (uint32, error) {
+	gid, err := g.BelongsToReadOnly(key, ts)
+	if err != nil || gid == g.groupId() {
+		return gid, err
+	}
+	for _, shard := range g.Shards(key) {
+		if shard.GroupId != g.groupId() {
+			continue
+		}
+		if ts > 0 && ts < shard.MoveTs {
+			return 0, errors.Errorf("StartTs: %d is from before MoveTs: %d for pred: %q",
+				ts, shard.MoveTs, key)
+		}
+		return shard.GroupId, nil
+	}
+	return gid, nil
+}
+
 // Do not modify the returned Tablet
 func (g *groupi) Tablet(key string) (*pb.Tablet, error) {
 	emptyTablet := pb.Tablet{}
@@ -535,6 +594,27 @@ func (g *groupi) AnyTwoServers(gid uint32) []string {
 	return res
 }
 
+// AnyTwoReadServers returns the addresses of up to two members of the group to send reads to,
+// with the learners first if preferLearners is set, for reads which can be served by any replica.
+func (g *groupi) AnyTwoReadServers(gid uint32, preferLearners bool) []string {
+	if !preferLearners {
+		return g.AnyTwoServers(gid)
+	}
+	var learners, voters []string
+	for _, m := range g.members(gid) {
+		if m.Learner {
+			learners = append(learners, m.Addr)
+		} else {
+			voters = append(voters, m.Addr)
+		}
+	}
+	res := append(learners, voters...)
+	if len(res) > 2 {
+		res = res[:2]
+	}
+	return res
+}
+
 func (g *groupi) members(gid uint32) map[uint64]*pb.Member {
 	g.RLock()
 	defer g.RUnlock()
@@ -563,10 +643,12 @@ func (g *groupi) AnyServer(gid uint32) *conn.Pool {
 	return nil
 }
 
+// MyPeer returns another voting member of the group of this node. Learners are skipped, as they
+// can't become the leader.
 func (g *groupi) MyPeer() (uint64, bool) {
 	members := g.members(g.groupId())
 	for _, m := range members {
-		if m.Id != g.Node.Id {
+		if m.Id != g.Node.Id && !m.Learner {
 			return m.Id, true
 		}
 	}
@@ -685,14 +767,15 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Addr:       x.WorkerConfig.MyAddr,
 		Leader:     leader,
 		LastUpdate: uint64(time.Now().Unix()),
+		Learner:    x.WorkerConfig.Learner,
 	}
 	group := &pb.Group{
 		Members: make(map[uint64]*pb.Member),
 	}
 	group.Members[member.Id] = member
+	// The tablets sent by a follower only carry its read rates.
+	group.Tablets = tablets
 	if leader {
-		// Do not send tablet information, if I'm not the leader.
-		group.Tablets = tablets
 		if snap, err := g.Node.Snapshot(); err == nil {
 			group.SnapshotTs = snap.ReadTs
 		}
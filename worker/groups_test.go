@@ -0,0 +1,42 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestAnyTwoReadServers(t *testing.T) {
+	g := &groupi{state: &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {Members: map[uint64]*pb.Member{
+			1: {Id: 1, Addr: "voter1"},
+			2: {Id: 2, Addr: "voter2"},
+			3: {Id: 3, Addr: "learner", Learner: true},
+		}},
+	}}}
+	addrs := g.AnyTwoReadServers(1, true)
+	require.Len(t, addrs, 2)
+	require.Equal(t, "learner", addrs[0])
+	require.Contains(t, []string{"voter1", "voter2"}, addrs[1])
+
+	require.Len(t, g.AnyTwoReadServers(1, false), 2)
+	require.Empty(t, g.AnyTwoReadServers(2, true))
+}
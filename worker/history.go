@@ -0,0 +1,127 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var (
+	// historyHorizonTs is the timestamp assigned by Zero at the start of the history retention
+	// window. Versions newer than it are kept when discarding old versions.
+	historyHorizonTs uint64
+	// discardedTs is the timestamp below which old versions might have been discarded.
+	discardedTs uint64
+)
+
+// TimestampAt returns the checkpoint recorded by Zero at or before the given time. Reading at its
+// timestamp shows the data as it was at that time.
+func TimestampAt(ctx context.Context, t time.Time) (*pb.TimestampCheckpoint, error) {
+	return timestampAt(ctx, &pb.TimestampCheckpoint{Time: t.Unix()})
+}
+
+func timestampAt(ctx context.Context, req *pb.TimestampCheckpoint) (*pb.TimestampCheckpoint,
+	error) {
+	pl := groups().connToZeroLeader()
+	if pl == nil {
+		return nil, conn.ErrNoConnection
+	}
+	c := pb.NewZeroClient(pl.Get())
+	return c.TimestampAt(ctx, req)
+}
+
+// ValidateReadTs checks that a read-only query can be run at the given timestamp, i.e. that it
+// has already been assigned and that the versions needed to read at it haven't been discarded.
+func ValidateReadTs(ts uint64) error {
+	if maxTs := posting.Oracle().MaxAssigned(); ts > maxTs {
+		return errors.Errorf("Read timestamp %d is ahead of the max assigned timestamp %d",
+			ts, maxTs)
+	}
+	return ValidateRetainedTs(ts)
+}
+
+// ValidateRetainedTs checks that the versions needed to read at the given timestamp haven't been
+// discarded. Unlike ValidateReadTs, it accepts timestamps assigned by Zero which this Alpha
+// hasn't caught up with yet, like the start timestamps of the transactions of the clients.
+func ValidateRetainedTs(ts uint64) error {
+	if discarded := atomic.LoadUint64(&discardedTs); ts < discarded {
+		return errors.Errorf("Read timestamp %d is too old. Versions older than %d have been"+
+			" discarded. Use --history_retention to keep them for longer.", ts, discarded)
+	}
+	return nil
+}
+
+// setDiscardTs discards the versions older than the given timestamp, keeping those needed for
+// reads within the history retention window.
+func setDiscardTs(readTs uint64) {
+	ts := readTs
+	if x.WorkerConfig.HistoryRetention > 0 {
+		ts = x.Min(ts, atomic.LoadUint64(&historyHorizonTs))
+	}
+	pstore.SetDiscardTs(ts)
+	atomic.StoreUint64(&discardedTs, ts)
+}
+
+// updateHistoryHorizon periodically asks Zero for the timestamp at the start of the history
+// retention window. Until Zero knows it, because the cluster is younger than the window, the
+// horizon stays at zero and no versions are discarded.
+func (g *groupi) updateHistoryHorizon() {
+	defer g.closer.Done() // CLOSER:1
+
+	retention := x.WorkerConfig.HistoryRetention
+	if retention == 0 {
+		return
+	}
+
+	update := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		// Zero keeps the checkpoints needed to map the horizons asked by the Alphas.
+		cp, err := timestampAt(ctx, &pb.TimestampCheckpoint{
+			Time:    time.Now().Add(-retention).Unix(),
+			Horizon: true,
+		})
+		if err != nil {
+			glog.V(2).Infof("While getting history horizon: %v", err)
+			return
+		}
+		atomic.StoreUint64(&historyHorizonTs, cp.Ts)
+	}
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	update()
+	for {
+		select {
+		case <-g.closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+			update()
+		}
+	}
+}
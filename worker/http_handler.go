@@ -0,0 +1,166 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// backupIndex is the name of the object listing the manifests stored at an HTTP location, since
+// HTTP has no standard way to list objects. It's a JSON array of the names of the manifests.
+const backupIndex = `index.json`
+
+// httpStore stores the backup objects at an HTTP location, which must support PUT and GET
+// requests to the objects under it. The access key and secret key of the request are sent as
+// basic auth, or the session token as a bearer token.
+type httpStore struct {
+	client *http.Client
+	base   string
+	creds  *Credentials
+}
+
+func newHTTPStore(uri *url.URL, creds *Credentials) (objectStore, error) {
+	base := *uri
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	base.RawQuery = ""
+	return &httpStore{client: &http.Client{}, base: base.String(), creds: creds}, nil
+}
+
+func (s *httpStore) do(method, name string, body io.Reader, op string) (*http.Response, error) {
+	u := s.base + "/" + (&url.URL{Path: name}).EscapedPath()
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case s.creds.isAnonymous():
+	case s.creds == nil:
+	case len(s.creds.SessionToken) > 0:
+		req.Header.Set("Authorization", "Bearer "+s.creds.SessionToken)
+	case len(s.creds.AccessKey) > 0:
+		req.SetBasicAuth(s.creds.AccessKey, s.creds.SecretKey)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, checkResponse(resp, op)
+}
+
+// index returns the names of the manifests listed in the index.
+func (s *httpStore) index() ([]string, error) {
+	resp, err := s.do(http.MethodGet, backupIndex, nil, "Getting "+backupIndex)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		// Nothing was backed up here yet.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var names []string
+	err = json.NewDecoder(resp.Body).Decode(&names)
+	return names, err
+}
+
+func (s *httpStore) list(prefix string) ([]string, error) {
+	names, err := s.index()
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			out = append(out, name)
+		}
+	}
+	return out, nil
+}
+
+func (s *httpStore) get(name string) (io.ReadCloser, error) {
+	resp, err := s.do(http.MethodGet, name, nil, "Getting "+name)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// put uploads the object, and adds it to the index if it's a manifest. The manifest is written
+// once all the groups are backed up, by the Alpha which got the backup request, so the index
+// isn't updated concurrently as long as backups to the location aren't taken concurrently.
+func (s *httpStore) put(name string, r io.Reader) error {
+	resp, err := s.do(http.MethodPut, name, ioutil.NopCloser(r), "Uploading "+name)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if path.Base(name) != backupManifest {
+		return nil
+	}
+
+	names, err := s.index()
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(append(names, name))
+	if err != nil {
+		return err
+	}
+	resp, err = s.do(http.MethodPut, backupIndex, bytes.NewReader(b), "Uploading "+backupIndex)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// delete deletes the object, and removes it from the index if it's a manifest.
+func (s *httpStore) delete(name string) error {
+	resp, err := s.do(http.MethodDelete, name, nil, "Deleting "+name)
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+	case err != nil:
+		return err
+	default:
+		resp.Body.Close()
+	}
+	if path.Base(name) != backupManifest {
+		return nil
+	}
+
+	names, err := s.index()
+	if err != nil {
+		return err
+	}
+	var rest []string
+	for _, n := range names {
+		if n != name {
+			rest = append(rest, n)
+		}
+	}
+	b, err := json.Marshal(rest)
+	if err != nil {
+		return err
+	}
+	resp, err = s.do(http.MethodPut, backupIndex, bytes.NewReader(b), "Uploading "+backupIndex)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// tabletLoad counts the queries and the mutated edges of each predicate served by this Alpha.
+// The group leader reports their rates to Zero along with the tablet sizes, for Zero to balance
+// the load of the groups.
+type tabletLoad struct {
+	sync.Mutex
+	reads  map[string]uint64
+	writes map[string]uint64
+	since  time.Time
+}
+
+var tabletLoads = newTabletLoad()
+
+func newTabletLoad() *tabletLoad {
+	return &tabletLoad{
+		reads:  make(map[string]uint64),
+		writes: make(map[string]uint64),
+		since:  time.Now(),
+	}
+}
+
+func (t *tabletLoad) addRead(attr string) {
+	t.Lock()
+	defer t.Unlock()
+	t.reads[attr]++
+}
+
+func (t *tabletLoad) addWrites(attr string, n uint64) {
+	t.Lock()
+	defer t.Unlock()
+	t.writes[attr] += n
+}
+
+// fillRates sets the read and write rates of the tablets since the last call, and resets the
+// counts. The predicates with some load which aren't in tablets get added to it, with the size
+// Zero last knew of, if this group serves them.
+func (t *tabletLoad) fillRates(tablets map[string]*pb.Tablet, gid uint32) {
+	t.Lock()
+	reads, writes, since := t.reads, t.writes, t.since
+	t.reads = make(map[string]uint64)
+	t.writes = make(map[string]uint64)
+	t.since = time.Now()
+	t.Unlock()
+
+	secs := time.Since(since).Seconds()
+	if secs <= 0 {
+		return
+	}
+	tablet := func(pred string) *pb.Tablet {
+		if tab, ok := tablets[pred]; ok {
+			return tab
+		}
+		// Don't use groups().Tablet, which would ask Zero to serve unknown predicates.
+		g := groups()
+		g.RLock()
+		known, ok := g.tablets[pred]
+		g.RUnlock()
+		if !ok || known.GroupId != gid || known.StartUid > 0 {
+			return nil
+		}
+		tab := &pb.Tablet{GroupId: gid, Predicate: pred, Space: known.Space}
+		tablets[pred] = tab
+		return tab
+	}
+	for pred, n := range reads {
+		if tab := tablet(pred); tab != nil {
+			tab.ReadRate = float64(n) / secs
+		}
+	}
+	for pred, n := range writes {
+		if tab := tablet(pred); tab != nil {
+			tab.WriteRate = float64(n) / secs
+		}
+	}
+}
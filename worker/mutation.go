@@ -32,11 +32,14 @@ import (
 	"github.com/dgraph-io/badger/v2/y"
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -77,6 +80,11 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	if err := ValidateAndConvert(edge, &su); err != nil {
 		return err
 	}
+	if su.GetUnique() && edge.Op == pb.DirectedEdge_SET {
+		if err := checkUnique(ctx, edge, txn); err != nil {
+			return err
+		}
+	}
 
 	key := x.DataKey(edge.Attr, edge.Entity)
 	// The following is a performance optimization which allows us to not read a posting list from
@@ -118,6 +126,74 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	return plist.AddMutationWithIndex(ctx, edge, txn)
 }
 
+// checkUnique returns an error if a node other than edge.Entity already has the value being set
+// for a predicate with the @unique directive. The candidates are looked up in the index. If the
+// index is lossy, their values are read to rule out tokens shared by different values.
+// Concurrent transactions setting the same value are aborted by the conflict keys of the index.
+func checkUnique(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) error {
+	val := types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value}
+	schemaType, err := schema.State().TypeOf(edge.Attr)
+	if err != nil {
+		return err
+	}
+	sv, err := types.Convert(val, schemaType)
+	if err != nil {
+		return err
+	}
+
+	var lossy bool
+	var candidates *pb.List
+	for _, tokenizer := range schema.State().Tokenizer(ctx, edge.Attr) {
+		lossy = lossy || tokenizer.IsLossy()
+		tokens, err := tok.BuildTokens(sv.Value, tokenizer)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			pl, err := txn.Get(x.IndexKey(edge.Attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(posting.ListOptions{ReadTs: txn.StartTs})
+			if err != nil {
+				return err
+			}
+			if candidates == nil {
+				candidates = uids
+			} else {
+				algo.IntersectWith(candidates, uids, candidates)
+			}
+		}
+	}
+
+	for _, uid := range candidates.GetUids() {
+		if uid == edge.Entity {
+			continue
+		}
+		if lossy {
+			pl, err := txn.Get(x.DataKey(edge.Attr, uid))
+			if err != nil {
+				return err
+			}
+			vals, err := pl.AllValues(txn.StartTs)
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, v := range vals {
+				b, ok := v.Value.([]byte)
+				found = found || (ok && v.Tid == val.Tid && bytes.Equal(b, edge.Value))
+			}
+			if !found {
+				continue
+			}
+		}
+		return errors.Errorf("Value for unique predicate %s of node %#x is already used by node %#x",
+			edge.Attr, edge.Entity, uid)
+	}
+	return nil
+}
+
 func undoSchemaUpdate(predicate string) {
 	maxRetries := 10
 	loadErr := x.RetryUntilSuccess(maxRetries, 10*time.Millisecond, func() error {
@@ -129,7 +205,8 @@ func undoSchemaUpdate(predicate string) {
 	}
 }
 
-func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64) error {
+func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64,
+	reindex bool) error {
 	if len(updates) == 0 {
 		return nil
 	}
@@ -210,9 +287,11 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	}
 
 	for _, su := range updates {
-		if tablet, err := groups().Tablet(su.Predicate); err != nil {
+		// Each group serving a shard of a sharded predicate keeps its schema and indexes.
+		if serves, err := groups().ServesTablet(su.Predicate); err != nil {
 			return err
-		} else if tablet.GetGroupId() != groups().groupId() {
+		} else if !serves {
+			tablet, _ := groups().Tablet(su.Predicate)
 			return errors.Errorf("Tablet isn't being served by this group. Tablet: %+v", tablet)
 		}
 
@@ -227,6 +306,10 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 			OldSchema:     &old,
 			CurrentSchema: su,
 		}
+		if reindex {
+			// All the indexes of the schema are built again, over the current ones.
+			rebuild.OldSchema, rebuild.InPlace = nil, true
+		}
 		querySchema := rebuild.GetQuerySchema()
 		// Sets the schema only in memory. The schema is written to
 		// disk only after schema mutations are successful.
@@ -306,10 +389,172 @@ func createSchema(attr string, typ types.TypeID, hint pb.Metadata_HintType) erro
 	return updateSchema(&s)
 }
 
-func runTypeMutation(ctx context.Context, update *pb.TypeUpdate) error {
+func runTypeMutation(ctx context.Context, update *pb.TypeUpdate, startTs uint64) error {
+	before := compositeIndexSet()
 	current := *update
 	schema.State().SetType(update.TypeName, current)
-	return updateType(update.TypeName, *update)
+	if err := updateType(update.TypeName, *update); err != nil {
+		return err
+	}
+	if err := rebuildCompositeIndexes(ctx, before, startTs); err != nil {
+		return err
+	}
+	if len(before) > 0 || len(compositeIndexSet()) > 0 {
+		// Let Zero know right away which predicates must stay in the same group.
+		go reportCompositeTablets()
+	}
+	return nil
+}
+
+func compositeIndexSet() map[string]*pb.CompositeIndex {
+	set := make(map[string]*pb.CompositeIndex)
+	for _, ci := range schema.State().CompositeIndexes("") {
+		set[schema.CompositeIndexName(ci)] = ci
+	}
+	return set
+}
+
+// rebuildCompositeIndexes drops the composite indexes that are no longer defined by any type
+// and builds the ones that have been added since before was computed. Only the group serving
+// the first predicate of a composite index stores it.
+func rebuildCompositeIndexes(ctx context.Context, before map[string]*pb.CompositeIndex,
+	startTs uint64) error {
+	after := compositeIndexSet()
+	servesIndex := func(ci *pb.CompositeIndex) (bool, error) {
+		gid, err := groups().BelongsToReadOnly(ci.Predicates[0], 0)
+		return gid == groups().groupId(), err
+	}
+
+	for name, ci := range before {
+		if _, ok := after[name]; ok {
+			continue
+		}
+		if serves, err := servesIndex(ci); err != nil || !serves {
+			continue
+		}
+		if err := posting.DropCompositeIndex(ci); err != nil {
+			return err
+		}
+	}
+	for name, ci := range after {
+		if _, ok := before[name]; ok {
+			continue
+		}
+		serves, err := servesIndex(ci)
+		if err != nil {
+			return err
+		}
+		if !serves {
+			continue
+		}
+		if err := posting.RebuildCompositeIndex(ctx, ci, startTs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compositeKeys returns the predicates of the composite indexes, keyed by the smallest predicate
+// sharing a composite index with them, directly or through other indexes.
+func compositeKeys() map[string]string {
+	parent := make(map[string]string)
+	var root func(pred string) string
+	root = func(pred string) string {
+		p, ok := parent[pred]
+		if !ok || p == pred {
+			parent[pred] = pred
+			return pred
+		}
+		r := root(p)
+		parent[pred] = r
+		return r
+	}
+	for _, ci := range schema.State().CompositeIndexes("") {
+		for _, pred := range ci.Predicates[1:] {
+			a, b := root(ci.Predicates[0]), root(pred)
+			if b < a {
+				a, b = b, a
+			}
+			parent[b] = a
+		}
+	}
+	keys := make(map[string]string, len(parent))
+	for pred := range parent {
+		keys[pred] = root(pred)
+	}
+	return keys
+}
+
+// fillComposites sets the composite key of the tablets served by the group, so Zero keeps the
+// predicates of a composite index in the same group. Tablets whose composite key changed are
+// added to the map.
+func fillComposites(tablets map[string]*pb.Tablet, gid uint32) {
+	keys := compositeKeys()
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	for pred, known := range g.tablets {
+		key := keys[pred]
+		if known.GroupId != gid || known.StartUid > 0 || (key == "" && known.Composite == "") {
+			continue
+		}
+		tab, ok := tablets[pred]
+		if !ok {
+			tc := *known
+			tab = &tc
+			tablets[pred] = tab
+		}
+		tab.Composite = key
+	}
+}
+
+// reportCompositeTablets sends the composite keys of the tablets served by the group to Zero, if
+// this Alpha is the leader of its group.
+func reportCompositeTablets() {
+	g := groups()
+	if !g.Node.AmLeader() {
+		return
+	}
+	tablets := make(map[string]*pb.Tablet)
+	fillComposites(tablets, g.groupId())
+	if len(tablets) == 0 {
+		return
+	}
+	if err := g.doSendMembership(tablets); err != nil {
+		glog.Warningf("While sending composite tablets to Zero. Error: %v", err)
+	}
+}
+
+// checkCompositeGroups returns an error if the mutation updates a composite index whose
+// predicates are served by different groups, which happens until Zero moves them together.
+func checkCompositeGroups(m *pb.Mutations) error {
+	checked := make(map[string]bool)
+	for _, edge := range m.Edges {
+		for _, ci := range schema.State().CompositeIndexes(edge.Attr) {
+			name := schema.CompositeIndexName(ci)
+			if checked[name] {
+				continue
+			}
+			checked[name] = true
+			var first uint32
+			for _, pred := range ci.Predicates {
+				gid, err := groups().BelongsToReadOnly(pred, m.StartTs)
+				if err != nil {
+					return err
+				}
+				if gid == 0 {
+					continue
+				}
+				if first == 0 {
+					first = gid
+				} else if gid != first {
+					return errors.Errorf("Composite index %s can't be updated while its "+
+						"predicates are served by different groups", name)
+				}
+			}
+		}
+	}
+	return nil
 }
 
 // We commit schema to disk in blocking way, should be ok because this happens
@@ -355,6 +600,59 @@ func hasEdges(attr string, startTs uint64) bool {
 	}
 	return false
 }
+
+// findDuplicate returns two nodes which have the same value for the predicate at the given
+// timestamp, or zeros if all the values are unique.
+func findDuplicate(attr string, readTs uint64) (uint64, uint64, error) {
+	pk := x.ParsedKey{Attr: attr}
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.Prefix = pk.DataPrefix()
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	it := txn.NewIterator(iterOpt)
+	defer it.Close()
+
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+
+	owners := make(map[string]uint64)
+	for _, key := range keys {
+		pk, err := x.Parse(key)
+		if err != nil {
+			return 0, 0, err
+		}
+		if pk.HasStartUid {
+			// The parts of a split list are read through its main key.
+			continue
+		}
+		pl, err := posting.GetNoStore(key, readTs)
+		if err != nil {
+			return 0, 0, err
+		}
+		vals, err := pl.AllValues(readTs)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, v := range vals {
+			b, ok := v.Value.([]byte)
+			if !ok {
+				continue
+			}
+			// The values are compared as they are stored, like checkUnique does.
+			val := string(append([]byte{byte(v.Tid)}, b...))
+			if uid, ok := owners[val]; ok && uid != pk.Uid {
+				return uid, pk.Uid, nil
+			}
+			owners[val] = pk.Uid
+		}
+	}
+	return 0, 0, nil
+}
+
 func checkSchema(s *pb.SchemaUpdate) error {
 	if s == nil {
 		return errors.Errorf("Nil schema")
@@ -393,12 +691,41 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			s.Predicate)
 	}
 
+	// The index is used to look for existing values of unique predicates.
+	if s.Unique && len(s.Tokenizer) == 0 {
+		return errors.Errorf("Index tokenizer is mandatory for: [%s] when specifying @unique directive",
+			s.Predicate)
+	}
+	if s.Unique && s.Lang {
+		return errors.Errorf("@unique directive cannot be used with @lang on predicate %s",
+			s.Predicate)
+	}
+
+	// Expired postings are skipped during reads but the count index is only updated by
+	// mutations, so it would keep counting them.
+	if s.Ttl > 0 && s.Count {
+		return errors.Errorf("@ttl directive cannot be used with @count on predicate %s",
+			s.Predicate)
+	}
+
 	t, err := schema.State().TypeOf(s.Predicate)
 	if err != nil {
 		// No schema previously defined, so no need to do checks about schema conversions.
 		return nil
 	}
 
+	// The values set before the @unique directive was added could already be shared by nodes.
+	if s.Unique && !schema.State().IsUnique(s.Predicate) {
+		uid, other, err := findDuplicate(s.Predicate, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+		if uid != 0 {
+			return errors.Errorf("Nodes %#x and %#x have the same value for predicate %s, "+
+				"which can't have the @unique directive", uid, other, s.Predicate)
+		}
+	}
+
 	// schema was defined already
 	switch {
 	case t.IsScalar() && (t.Enum() == pb.Posting_PASSWORD || s.ValueType == pb.Posting_PASSWORD):
@@ -572,7 +899,7 @@ func proposeOrSend(ctx context.Context, gid uint32, m *pb.Mutations, chr chan re
 func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 	mm := make(map[uint32]*pb.Mutations)
 	for _, edge := range src.Edges {
-		gid, err := groups().BelongsTo(edge.Attr)
+		gid, err := groups().BelongsToUid(edge.Attr, edge.Entity)
 		if err != nil {
 			return nil, err
 		}
@@ -592,12 +919,21 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 			return nil, err
 		}
 
-		mu := mm[gid]
-		if mu == nil {
-			mu = &pb.Mutations{GroupId: gid}
-			mm[gid] = mu
+		// All the groups serving a shard of the predicate need its schema.
+		gids := []uint32{gid}
+		for _, sgid := range shardGroups(schema.Predicate) {
+			if sgid != gid {
+				gids = append(gids, sgid)
+			}
+		}
+		for _, gid := range gids {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Schema = append(mu.Schema, schema)
 		}
-		mu.Schema = append(mu.Schema, schema)
 	}
 
 	if src.DropOp > 0 {
@@ -642,6 +978,14 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	constraintKeys, err := checkTypeConstraints(ctx, m)
+	if err != nil {
+		return tctx, err
+	}
+	tctx.Keys = append(tctx.Keys, constraintKeys...)
+	if err := checkCompositeGroups(m); err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
@@ -706,7 +1050,8 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 	}
 
 	// Retrieve the schema for those predicates.
-	schemas, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: fields})
+	schemas, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: fields,
+		Fields: []string{"type", "list"}})
 	if err != nil {
 		return errors.Wrapf(err, "cannot retrieve predicate information")
 	}
@@ -732,11 +1077,72 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 					field.Predicate, t.TypeName)
 			}
 		}
+
+		if err := verifyCompositeIndexes(t, m.Schema, schemas); err != nil {
+			return err
+		}
+		if err := verifyFieldConstraints(t, m.Schema, schemas); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// verifyCompositeIndexes checks that the predicates of every composite index in the type are
+// scalar, non-list predicates served by the same group. The index is maintained using the
+// values stored locally, so it can't span predicates from different groups.
+func verifyCompositeIndexes(t *pb.TypeUpdate, updates []*pb.SchemaUpdate,
+	schemas []*pb.SchemaNode) error {
+	for _, ci := range t.CompositeIndexes {
+		var gid uint32
+		for _, pred := range ci.Predicates {
+			typ, list, found := fieldSchema(pred, updates, schemas)
+			if !found {
+				return errors.Errorf("Schema does not contain predicate %s used in composite "+
+					"index of type %s", pred, t.TypeName)
+			}
+			if !typ.IsScalar() || list {
+				return errors.Errorf("Composite index of type %s can only include scalar, "+
+					"non-list predicates. Got: %s", t.TypeName, pred)
+			}
+
+			if len(groups().Shards(pred)) > 0 {
+				return errors.Errorf("Composite index of type %s can't include predicate %s, "+
+					"which is sharded", t.TypeName, pred)
+			}
+			predGid, err := groups().BelongsTo(pred)
+			if err != nil {
+				return err
+			}
+			if gid != 0 && predGid != gid {
+				return errors.Errorf("Predicates in composite index of type %s must be served "+
+					"by the same group", t.TypeName)
+			}
+			gid = predGid
+		}
+	}
+	return nil
+}
+
+// fieldSchema returns the type of the predicate and whether it's a list, looking first at the
+// schema updates of the request and then at the existing schema.
+func fieldSchema(pred string, updates []*pb.SchemaUpdate,
+	schemas []*pb.SchemaNode) (types.TypeID, bool, bool) {
+	for _, su := range updates {
+		if su.Predicate == pred {
+			return types.TypeID(su.ValueType), su.List, true
+		}
+	}
+	for _, sn := range schemas {
+		if sn.Predicate == pred {
+			typ, _ := types.TypeForName(sn.Type)
+			return typ, sn.List, true
+		}
+	}
+	return types.DefaultID, false, false
+}
+
 // typeSanityCheck performs basic sanity checks on the given type update.
 func typeSanityCheck(t *pb.TypeUpdate) error {
 	for _, field := range t.Fields {
@@ -807,12 +1213,80 @@ func (w *grpcWorker) proposeAndWait(ctx context.Context, txnCtx *api.TxnContext,
 		return err
 	}
 
+	// The expiry times are set before proposing so that all the replicas store the same values.
+	if err := setExpiry(m.Edges, time.Now()); err != nil {
+		return err
+	}
+
 	node := groups().Node
 	err := node.proposeAndWait(ctx, &pb.Proposal{Mutations: m})
 	fillTxnContext(txnCtx, m.StartTs)
 	return err
 }
 
+// ttlFacet is the facet which sets the time to live of a single edge. Its value is either a
+// duration string like "1h30m", the number of seconds as an int or the expiry time as a datetime.
+const ttlFacet = "dgraph.ttl"
+
+// setExpiry sets the expiry time of the edges which are being set and either have the ttlFacet
+// or belong to a predicate with the @ttl directive. The facet is removed from the edge.
+func setExpiry(edges []*pb.DirectedEdge, now time.Time) error {
+	for _, edge := range edges {
+		if edge.Op != pb.DirectedEdge_SET || edge.ExpiresAt > 0 {
+			continue
+		}
+
+		var expiresAt time.Time
+		if ttl := schema.State().TTL(edge.Attr); ttl > 0 {
+			expiresAt = now.Add(time.Duration(ttl) * time.Second)
+		}
+		for i, f := range edge.Facets {
+			if f.Key != ttlFacet {
+				continue
+			}
+			t, err := facetExpiry(f, now)
+			if err != nil {
+				return errors.Wrapf(err, "while setting expiry of predicate %s", edge.Attr)
+			}
+			expiresAt = t
+			edge.Facets = append(edge.Facets[:i], edge.Facets[i+1:]...)
+			break
+		}
+		if !expiresAt.IsZero() {
+			edge.ExpiresAt = uint64(expiresAt.Unix())
+		}
+	}
+	return nil
+}
+
+func facetExpiry(f *api.Facet, now time.Time) (time.Time, error) {
+	val, err := facets.ValFor(f)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var expiresAt time.Time
+	switch v := val.Value.(type) {
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "invalid value %q for facet %s", v, ttlFacet)
+		}
+		expiresAt = now.Add(d)
+	case int64:
+		expiresAt = now.Add(time.Duration(v) * time.Second)
+	case time.Time:
+		expiresAt = v
+	default:
+		return time.Time{}, errors.Errorf("facet %s must be a duration, an int or a datetime",
+			ttlFacet)
+	}
+	if !expiresAt.After(now) {
+		return time.Time{}, errors.Errorf("facet %s must be in the future", ttlFacet)
+	}
+	return expiresAt, nil
+}
+
 // Mutate is used to apply mutations over the network on other instances.
 func (w *grpcWorker) Mutate(ctx context.Context, m *pb.Mutations) (*api.TxnContext, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.Mutate")
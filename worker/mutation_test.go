@@ -17,15 +17,18 @@
 package worker
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 )
 
 func TestConvertEdgeType(t *testing.T) {
@@ -215,6 +218,103 @@ func TestCheckSchema(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func setName(t *testing.T, uid uint64, name string) {
+	addEdge(t, &pb.DirectedEdge{Entity: uid, Attr: "name", Value: []byte(name),
+		ValueType: pb.Posting_STRING}, getOrCreate(x.DataKey("name", uid)))
+}
+
+func uniqueEdge(uid uint64, name string) *pb.DirectedEdge {
+	return &pb.DirectedEdge{Entity: uid, Attr: "name", Value: []byte(name),
+		ValueType: pb.Posting_STRING, Op: pb.DirectedEdge_SET}
+}
+
+func TestCheckUnique(t *testing.T) {
+	tests := []struct {
+		index string
+		name  string
+		uid   uint64
+		ok    bool
+	}{
+		{"exact", "Alice Smith", 2, false},
+		{"exact", "Alice Smith", 1, true},
+		{"exact", "Bob", 2, true},
+		// The candidates found through a lossy index are compared by value.
+		{"hash", "Alice Smith", 2, false},
+		{"hash", "Bob", 2, true},
+		{"term", "Alice Smith", 2, false},
+		{"term", "Smith Alice", 2, true},
+		{"term", "alice smith", 2, true},
+		{"term", "Alice", 2, true},
+	}
+	for _, tc := range tests {
+		require.NoError(t, posting.DeleteAll())
+		require.NoError(t, schema.ParseBytes(
+			[]byte("name: string @index("+tc.index+") @unique ."), 1))
+		setName(t, 1, "Alice Smith")
+
+		txn := posting.Oracle().RegisterStartTs(timestamp())
+		err := checkUnique(context.Background(), uniqueEdge(tc.uid, tc.name), txn)
+		if tc.ok {
+			require.NoError(t, err, "%s %q", tc.index, tc.name)
+			continue
+		}
+		require.Error(t, err, "%s %q", tc.index, tc.name)
+		require.Contains(t, err.Error(), "is already used by node 0x1")
+	}
+}
+
+func TestUniqueConflictKeys(t *testing.T) {
+	require.NoError(t, posting.DeleteAll())
+	require.NoError(t, schema.ParseBytes([]byte("name: string @index(term) @unique ."), 1))
+
+	// Neither of two concurrent transactions sees the value set by the other one, so they share
+	// a conflict key for Zero to abort one of them.
+	var keys []map[string]struct{}
+	for _, uid := range []uint64{1, 2} {
+		txn := posting.Oracle().RegisterStartTs(timestamp())
+		edge := uniqueEdge(uid, "Alice Smith")
+		require.NoError(t, checkUnique(context.Background(), edge, txn))
+		l, err := txn.Get(x.DataKey("name", uid))
+		require.NoError(t, err)
+		require.NoError(t, l.AddMutationWithIndex(context.Background(), edge, txn))
+
+		tctx := &api.TxnContext{}
+		txn.FillContext(tctx, 1)
+		set := make(map[string]struct{})
+		for _, key := range tctx.Keys {
+			set[key] = struct{}{}
+		}
+		keys = append(keys, set)
+	}
+	var shared int
+	for key := range keys[0] {
+		if _, ok := keys[1][key]; ok {
+			shared++
+		}
+	}
+	require.NotZero(t, shared)
+}
+
+func TestCheckSchemaUnique(t *testing.T) {
+	require.NoError(t, posting.DeleteAll())
+	require.NoError(t, schema.ParseBytes([]byte("name: string @index(exact) ."), 1))
+	setName(t, 1, "Alice")
+	setName(t, 2, "Bob")
+	unique := &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}, Unique: true}
+	require.NoError(t, checkSchema(unique))
+
+	// Adding the directive to a predicate which already has duplicate values is rejected.
+	setName(t, 3, "Alice")
+	err := checkSchema(unique)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Nodes 0x1 and 0x3 have the same value for predicate name")
+
+	delEdge(t, &pb.DirectedEdge{Entity: 3, Attr: "name", Value: []byte("Alice"),
+		ValueType: pb.Posting_STRING}, getOrCreate(x.DataKey("name", 3)))
+	require.NoError(t, checkSchema(unique))
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
@@ -0,0 +1,383 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// objectStore is a bucket, container or HTTP location holding backup objects, addressed by
+// their names.
+type objectStore interface {
+	// list returns the names of the objects whose names start with prefix.
+	list(prefix string) ([]string, error)
+	// get returns a reader of the object, which the caller must close.
+	get(name string) (io.ReadCloser, error)
+	// put stores the object with the data read from r, until EOF.
+	put(name string, r io.Reader) error
+	// delete deletes the object. It's not an error if the object doesn't exist.
+	delete(name string) error
+}
+
+// objectHandler is used for the 'gs:', 'azure:', 'http:' and 'https:' URI schemes. It stores
+// the backups in the object store of the scheme, just like s3Handler does in S3.
+type objectHandler struct {
+	store   objectStore
+	prefix  string
+	pwriter *io.PipeWriter
+	preader *io.PipeReader
+	cerr    chan error
+	creds   *Credentials
+	uri     *url.URL
+}
+
+// setup creates the object store for the URI, and the prefix of the backup objects in it.
+func (h *objectHandler) setup(uri *url.URL) error {
+	glog.V(2).Infof("Backup using scheme: %s, host: %s, path: %s", uri.Scheme, uri.Host, uri.Path)
+
+	var err error
+	switch uri.Scheme {
+	case "gs":
+		h.store, h.prefix, err = newGCSStore(uri, h.creds)
+	case "azure":
+		h.store, h.prefix, err = newAzureStore(uri, h.creds)
+	case "http", "https":
+		h.store, err = newHTTPStore(uri, h.creds)
+	default:
+		err = errors.Errorf("Unsupported URI scheme: %s", uri.Scheme)
+	}
+	h.uri = uri
+	return err
+}
+
+func (h *objectHandler) createObject(req *pb.BackupRequest, objectName string) {
+	// The backup object is: folder1...folderN/dgraph.20181106.0113/r110001-g1.backup
+	object := path.Join(h.prefix, fmt.Sprintf(backupPathFmt, req.UnixTs), objectName)
+	glog.V(2).Infof("Sending data to %s object %q ...", h.uri.Scheme, object)
+
+	h.cerr = make(chan error, 1)
+	h.preader, h.pwriter = io.Pipe()
+	go func() {
+		h.cerr <- h.upload(object)
+	}()
+}
+
+// listManifests returns the names of the manifests in the store, sorted.
+func (h *objectHandler) listManifests() ([]string, error) {
+	names, err := h.store.list(h.prefix)
+	if err != nil {
+		return nil, err
+	}
+	var manifests []string
+	suffix := "/" + backupManifest
+	for _, name := range names {
+		if strings.HasSuffix(name, suffix) {
+			manifests = append(manifests, name)
+		}
+	}
+	sort.Strings(manifests)
+	return manifests, nil
+}
+
+// GetLatestManifest reads the manifests at the given URL and returns the
+// latest manifest.
+func (h *objectHandler) GetLatestManifest(uri *url.URL) (*Manifest, error) {
+	if err := h.setup(uri); err != nil {
+		return nil, err
+	}
+	manifests, err := h.listManifests()
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if len(manifests) == 0 {
+		return &m, nil
+	}
+	if err := h.readManifest(manifests[len(manifests)-1], &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// CreateBackupFile prepares the data stream for the backup.
+// URI formats:
+//   gs://<host>/bucket/folder1.../folderN?secure=true|false
+//   gs:///bucket/folder1.../folderN (use default GCS endpoint)
+//   azure://<account>.blob.core.windows.net/container/folder1.../folderN
+//   azure:///container/folder1.../folderN (account from the credentials)
+//   http[s]://<host>/folder1.../folderN
+func (h *objectHandler) CreateBackupFile(uri *url.URL, req *pb.BackupRequest) error {
+	if err := h.setup(uri); err != nil {
+		return err
+	}
+	h.createObject(req, backupName(req.ReadTs, req.GroupId))
+	return nil
+}
+
+// CreateManifest finishes a backup by creating an object to store the manifest.
+func (h *objectHandler) CreateManifest(uri *url.URL, req *pb.BackupRequest) error {
+	if err := h.setup(uri); err != nil {
+		return err
+	}
+	h.createObject(req, backupManifest)
+	return nil
+}
+
+// readManifest reads a manifest object using the handler.
+// Returns nil on success, otherwise an error.
+func (h *objectHandler) readManifest(object string, m *Manifest) error {
+	reader, err := h.store.get(object)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return json.NewDecoder(reader).Decode(m)
+}
+
+func (h *objectHandler) GetManifests(uri *url.URL, backupId string,
+	backupNum uint64) ([]*Manifest, error) {
+	if err := h.setup(uri); err != nil {
+		return nil, err
+	}
+	paths, err := h.listManifests()
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.Errorf("No manifests found at: %s", uri.String())
+	}
+
+	// Read and filter the manifests to get the list of manifests to consider
+	// for this restore operation.
+	var manifests []*Manifest
+	for _, path := range paths {
+		var m Manifest
+		if err := h.readManifest(path, &m); err != nil {
+			return nil, errors.Wrapf(err, "while reading %q", path)
+		}
+		m.Path = path
+		manifests = append(manifests, &m)
+	}
+	manifests, err = filterManifests(manifests, backupId, backupNum)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort manifests in the ascending order of their BackupNum so that the first
+	// manifest corresponds to the first full backup and so on.
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].BackupNum < manifests[j].BackupNum
+	})
+	return manifests, nil
+}
+
+// Load scans for backup objects in the store, then tries to load any backup objects found.
+// Returns nil and the maximum Since value on success, error otherwise.
+func (h *objectHandler) Load(uri *url.URL, backupId string, backupNum uint64,
+	fn loadFn) LoadResult {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
+	if err != nil {
+		return LoadResult{0, 0, errors.Wrapf(err, "while retrieving manifests")}
+	}
+
+	// since is returned with the max manifest Since value found.
+	var since uint64
+
+	// Process each manifest, first check that they are valid and then confirm the
+	// backup objects for each group exist. Each group in manifest must have a backup object,
+	// otherwise this is a failure and the user must remedy.
+	var maxUid uint64
+	for i, manifest := range manifests {
+		if manifest.Since == 0 || len(manifest.Groups) == 0 {
+			continue
+		}
+
+		dir := path.Dir(manifests[i].Path)
+		for gid := range manifest.Groups {
+			object := path.Join(dir, backupName(manifest.Since, gid))
+			reader, err := h.store.get(object)
+			if err != nil {
+				return LoadResult{0, 0, errors.Wrapf(err, "Failed to get %q", object)}
+			}
+			defer reader.Close()
+
+			// Only restore the predicates that were assigned to this group at the time
+			// of the last backup.
+			predSet := manifests[len(manifests)-1].getPredsInGroup(gid)
+
+			groupMaxUid, err := loadFile(manifest, gid, reader, predSet, fn)
+			if err != nil {
+				return LoadResult{0, 0, err}
+			}
+			if groupMaxUid > maxUid {
+				maxUid = groupMaxUid
+			}
+		}
+		since = manifest.Since
+	}
+
+	return LoadResult{since, maxUid, nil}
+}
+
+// Verify performs basic checks to decide whether the specified backup can be restored
+// to a live cluster.
+func (h *objectHandler) Verify(uri *url.URL, backupId string, backupNum uint64,
+	currentGroups []uint32) error {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
+	if err != nil {
+		return errors.Wrapf(err, "while retrieving manifests")
+	}
+
+	if len(manifests) == 0 {
+		return errors.Errorf("No backups with the specified backup ID %s", backupId)
+	}
+
+	return verifyGroupsInBackup(manifests, currentGroups)
+}
+
+// ListManifests loads the manifests in the locations and returns them.
+func (h *objectHandler) ListManifests(uri *url.URL) ([]string, error) {
+	if err := h.setup(uri); err != nil {
+		return nil, err
+	}
+	manifests, err := h.listManifests()
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, errors.Errorf("No manifests found at: %s", uri.String())
+	}
+	return manifests, nil
+}
+
+func (h *objectHandler) ReadManifest(path string, m *Manifest) error {
+	if err := h.setup(h.uri); err != nil {
+		return err
+	}
+	return h.readManifest(path, m)
+}
+
+// RemoveBackup deletes the objects of the backup of the manifest with the given name.
+func (h *objectHandler) RemoveBackup(manifest string) error {
+	if err := h.setup(h.uri); err != nil {
+		return err
+	}
+	var m Manifest
+	if err := h.readManifest(manifest, &m); err != nil {
+		return err
+	}
+	if err := h.store.delete(manifest); err != nil {
+		return err
+	}
+
+	// The store might hold other objects in the directory of the backup, such as the ones
+	// left by a backup which failed.
+	dir := path.Dir(manifest)
+	names, err := h.store.list(dir + "/")
+	if err != nil {
+		return err
+	}
+	for gid := range m.Groups {
+		names = append(names, path.Join(dir, backupName(m.Since, gid)))
+	}
+	deleted := make(map[string]bool)
+	for _, name := range names {
+		if deleted[name] {
+			continue
+		}
+		if err := h.store.delete(name); err != nil {
+			return err
+		}
+		deleted[name] = true
+	}
+	return nil
+}
+
+// upload will block until it's done or an error occurs.
+func (h *objectHandler) upload(object string) error {
+	start := time.Now()
+	err := h.store.put(object, h.preader)
+	glog.V(2).Infof("Backup sent %q. Time elapsed: %s",
+		object, time.Since(start).Round(time.Second))
+
+	if err != nil {
+		// This should cause Write to fail as well.
+		glog.Errorf("Backup: Closing RW pipe due to error: %v", err)
+		if err := h.pwriter.Close(); err != nil {
+			return err
+		}
+		if err := h.preader.CloseWithError(err); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (h *objectHandler) Close() error {
+	// Done buffering, send EOF.
+	if err := h.pwriter.CloseWithError(nil); err != nil && err != io.EOF {
+		glog.Errorf("Unexpected error when closing pipe: %v", err)
+	}
+	glog.V(2).Infof("Backup waiting for upload to complete.")
+	return <-h.cerr
+}
+
+func (h *objectHandler) Write(b []byte) (int, error) {
+	return h.pwriter.Write(b)
+}
+
+// isSecure returns whether the object store at the URI should be accessed over TLS. It's secure
+// by default.
+func isSecure(uri *url.URL) bool {
+	return uri.Query().Get("secure") != "false"
+}
+
+// splitBucketPath splits the path of the URI into the name of the bucket or container, and the
+// prefix of the backup objects in it.
+func splitBucketPath(uri *url.URL) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	if len(parts[0]) == 0 {
+		return "", "", errors.Errorf("Invalid bucket: %q", uri.Path)
+	}
+	if len(parts) == 1 {
+		return parts[0], "", nil
+	}
+	return parts[0], strings.Trim(parts[1], "/"), nil
+}
+
+// checkResponse returns an error with the body of the response if its status code isn't 2xx,
+// and closes it then.
+func checkResponse(resp *http.Response, op string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+	return errors.Errorf("%s failed with status %q: %s", op, resp.Status,
+		strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,337 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// fakeObjects holds the objects of a fake object store server.
+type fakeObjects struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeObjects) set(name string, data []byte) {
+	f.Lock()
+	defer f.Unlock()
+	f.objects[name] = data
+}
+
+func (f *fakeObjects) delete(name string) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, name)
+}
+
+func (f *fakeObjects) get(w http.ResponseWriter, name string) {
+	f.Lock()
+	defer f.Unlock()
+	data, ok := f.objects[name]
+	if !ok {
+		http.NotFound(w, nil)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+func (f *fakeObjects) list(prefix string) []string {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for name := range f.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newFakeObjects() *fakeObjects {
+	return &fakeObjects{objects: make(map[string][]byte)}
+}
+
+// fakeGCS serves the subset of the GCS JSON API used by gcsStore, for the bucket "bucket". It
+// returns one object per page when listing, to exercise paging.
+func fakeGCS(t *testing.T, objects *fakeObjects, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/bucket/o":
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			objects.set(r.URL.Query().Get("name"), data)
+		case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/bucket/o":
+			names := objects.list(r.URL.Query().Get("prefix"))
+			var page struct {
+				Items         []map[string]string `json:"items"`
+				NextPageToken string              `json:"nextPageToken,omitempty"`
+			}
+			for i, name := range names {
+				if name > r.URL.Query().Get("pageToken") {
+					page.Items = append(page.Items, map[string]string{"name": name})
+					if i < len(names)-1 {
+						page.NextPageToken = name
+					}
+					break
+				}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(page))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/storage/v1/b/bucket/o/"):
+			require.Equal(t, "media", r.URL.Query().Get("alt"))
+			objects.get(w, strings.TrimPrefix(r.URL.Path, "/storage/v1/b/bucket/o/"))
+		case r.Method == http.MethodDelete:
+			objects.delete(strings.TrimPrefix(r.URL.Path, "/storage/v1/b/bucket/o/"))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+// fakeAzure serves the subset of the Blob service REST API used by azureStore, for the container
+// "container" of the account "account".
+func fakeAzure(t *testing.T, objects *fakeObjects) *httptest.Server {
+	blocks := make(map[string][]byte)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey account:") ||
+			len(r.Header.Get("x-ms-date")) == 0 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		q := r.URL.Query()
+		name := strings.TrimPrefix(r.URL.Path, "/container/")
+		switch {
+		case r.Method == http.MethodPut && q.Get("comp") == "block":
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			blocks[name+"/"+q.Get("blockid")] = data
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut && q.Get("comp") == "blocklist":
+			var list struct {
+				Latest []string `xml:"Latest"`
+			}
+			require.NoError(t, xml.NewDecoder(r.Body).Decode(&list))
+			var data []byte
+			for _, id := range list.Latest {
+				data = append(data, blocks[name+"/"+id]...)
+			}
+			objects.set(name, data)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/container":
+			require.Equal(t, "list", q.Get("comp"))
+			fmt.Fprint(w, "<EnumerationResults><Blobs>")
+			for _, name := range objects.list(q.Get("prefix")) {
+				fmt.Fprintf(w, "<Blob><Name>%s</Name></Blob>", name)
+			}
+			fmt.Fprint(w, "</Blobs><NextMarker/></EnumerationResults>")
+		case r.Method == http.MethodGet:
+			objects.get(w, name)
+		case r.Method == http.MethodDelete:
+			objects.delete(name)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+// fakeHTTP serves the objects under /backups via PUT and GET requests.
+func fakeHTTP(t *testing.T, objects *fakeObjects) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, "/backups/")
+		switch r.Method {
+		case http.MethodPut:
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			objects.set(name, data)
+		case http.MethodGet:
+			objects.get(w, name)
+		case http.MethodDelete:
+			objects.delete(name)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func writeObject(t *testing.T, h UriHandler, data []byte) {
+	_, err := h.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, h.Close())
+}
+
+// testObjectHandler takes a full and an incremental backup of group 1 at the location, and then
+// checks that they are listed, verified, loaded and removed.
+func testObjectHandler(t *testing.T, location string, creds *Credentials) {
+	uri, err := url.Parse(location)
+	require.NoError(t, err)
+
+	for i, m := range []*Manifest{
+		{Type: "full", Since: 10, BackupId: "aa", BackupNum: 1},
+		{Type: "incremental", Since: 20, BackupId: "aa", BackupNum: 2},
+	} {
+		m.Groups = map[uint32][]string{1: {"name"}}
+		req := &pb.BackupRequest{ReadTs: m.Since, GroupId: 1,
+			UnixTs: fmt.Sprintf("2020010%d.0000", i+1)}
+
+		h, err := NewUriHandler(uri, creds)
+		require.NoError(t, err)
+		require.NoError(t, h.CreateBackupFile(uri, req))
+		cw := newChecksumWriter(h)
+		_, err = fmt.Fprintf(cw, "backup at %d", m.Since)
+		require.NoError(t, err)
+		require.NoError(t, h.Close())
+		m.Files = map[uint32]*BackupFile{1: {Size: cw.size, Checksum: cw.checksum()}}
+
+		h, err = NewUriHandler(uri, creds)
+		require.NoError(t, err)
+		require.NoError(t, h.CreateManifest(uri, req))
+		b, err := json.Marshal(m)
+		require.NoError(t, err)
+		writeObject(t, h, b)
+	}
+
+	h, err := NewUriHandler(uri, creds)
+	require.NoError(t, err)
+	paths, err := h.ListManifests(uri)
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	require.True(t, strings.HasSuffix(paths[0], "dgraph.20200101.0000/manifest.json"), paths[0])
+	var m Manifest
+	require.NoError(t, h.ReadManifest(paths[1], &m))
+	require.Equal(t, uint64(20), m.Since)
+
+	latest, err := h.GetLatestManifest(uri)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest.BackupNum)
+
+	require.NoError(t, h.Verify(uri, "aa", 0, []uint32{1}))
+	require.Error(t, h.Verify(uri, "aa", 0, []uint32{1, 2}))
+
+	var loaded []string
+	res := h.Load(uri, "", 0, func(r io.Reader, gid int, preds predicateSet) (uint64, error) {
+		require.Equal(t, 1, gid)
+		require.Contains(t, preds, "name")
+		b, err := ioutil.ReadAll(r)
+		loaded = append(loaded, string(b))
+		return 100, err
+	})
+	require.NoError(t, res.Err)
+	require.Equal(t, uint64(20), res.Version)
+	require.Equal(t, uint64(100), res.MaxLeaseUid)
+	require.Equal(t, []string{"backup at 10", "backup at 20"}, loaded)
+
+	// Only the full backup is loaded when restoring up to it.
+	loaded = nil
+	res = h.Load(uri, "aa", 1, func(r io.Reader, gid int, preds predicateSet) (uint64, error) {
+		b, err := ioutil.ReadAll(r)
+		loaded = append(loaded, string(b))
+		return 0, err
+	})
+	require.NoError(t, res.Err)
+	require.Equal(t, uint64(10), res.Version)
+	require.Equal(t, []string{"backup at 10"}, loaded)
+
+	// Removing the incremental backup leaves the full backup.
+	_, err = h.ListManifests(uri)
+	require.NoError(t, err)
+	require.NoError(t, h.RemoveBackup(paths[1]))
+	paths, err = h.ListManifests(uri)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	latest, err = h.GetLatestManifest(uri)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), latest.BackupNum)
+}
+
+func TestGCSHandler(t *testing.T) {
+	objects := newFakeObjects()
+	srv := fakeGCS(t, objects, "token")
+	defer srv.Close()
+
+	location := "gs://" + strings.TrimPrefix(srv.URL, "http://") + "/bucket/dgraph?secure=false"
+	testObjectHandler(t, location, &Credentials{SessionToken: "token"})
+	require.Contains(t, objects.objects, "dgraph/dgraph.20200101.0000/r10-g1.backup")
+	require.NotContains(t, objects.objects, "dgraph/dgraph.20200102.0000/r20-g1.backup")
+
+	// Requests without the token are rejected.
+	uri, err := url.Parse(location)
+	require.NoError(t, err)
+	_, err = (&objectHandler{}).ListManifests(uri)
+	require.Error(t, err)
+}
+
+func TestAzureHandler(t *testing.T) {
+	objects := newFakeObjects()
+	srv := fakeAzure(t, objects)
+	defer srv.Close()
+
+	location := "azure://" + strings.TrimPrefix(srv.URL, "http://") + "/container?secure=false"
+	key := base64.StdEncoding.EncodeToString([]byte("key"))
+	testObjectHandler(t, location, &Credentials{AccessKey: "account", SecretKey: key})
+	require.Contains(t, objects.objects, "dgraph.20200101.0000/r10-g1.backup")
+}
+
+func TestAzureSign(t *testing.T) {
+	s := &azureStore{
+		endpoint:  "https://account.blob.core.windows.net",
+		container: "container",
+		account:   "account",
+		key:       []byte("key"),
+	}
+	req, err := s.newRequest(http.MethodGet, "", url.Values{"comp": {"list"},
+		"restype": {"container"}}, nil)
+	require.NoError(t, err)
+	req.Header.Set("x-ms-date", "Mon, 01 Jun 2020 00:00:00 GMT")
+
+	toSign := "GET\n\n\n\n\n\n\n\n\n\n\n\n" +
+		"x-ms-date:Mon, 01 Jun 2020 00:00:00 GMT\nx-ms-version:2019-02-02\n" +
+		"/account/container\ncomp:list\nrestype:container"
+	require.Equal(t, hmacSHA256([]byte("key"), toSign), s.sign(req))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	objects := newFakeObjects()
+	srv := fakeHTTP(t, objects)
+	defer srv.Close()
+
+	testObjectHandler(t, srv.URL+"/backups", &Credentials{AccessKey: "user", SecretKey: "pass"})
+	var index []string
+	require.NoError(t, json.Unmarshal(objects.objects[backupIndex], &index))
+	require.Equal(t, []string{"dgraph.20200101.0000/manifest.json"}, index)
+	require.NotContains(t, objects.objects, "dgraph.20200102.0000/r20-g1.backup")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 
+	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 )
@@ -44,13 +45,15 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
 		currentGroups = append(currentGroups, gid)
 	}
 
-	creds := Credentials{
-		AccessKey:    req.AccessKey,
-		SecretKey:    req.SecretKey,
-		SessionToken: req.SessionToken,
-		Anonymous:    req.Anonymous,
-	}
-	if err := VerifyBackup(req.Location, req.BackupId, &creds, currentGroups); err != nil {
+	creds := restoreCredentials(req)
+	if isSelective(req.Filter) {
+		// The predicates are restored into the groups serving them now, so the groups in the
+		// backup don't need to match the ones in the cluster.
+		if err := verifySelectiveRestore(req, creds); err != nil {
+			return errors.Wrapf(err, "failed to verify backup")
+		}
+	} else if err := VerifyBackup(req.Location, req.BackupId, req.BackupNum, creds,
+		currentGroups); err != nil {
 		return errors.Wrapf(err, "failed to verify backup")
 	}
 
@@ -76,6 +79,38 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest) error {
 	return nil
 }
 
+// verifySelectiveRestore checks that the backup to restore exists, and that the types selected
+// by the filter of the request are in it.
+func verifySelectiveRestore(req *pb.RestoreRequest, creds *Credentials) error {
+	manifests, err := getRestoreManifests(req, creds)
+	if err != nil {
+		return err
+	}
+	_, err = newSelection(req.Filter, manifests[len(manifests)-1].typeFields)
+	return err
+}
+
+// getRestoreManifests returns the manifests of the backups to restore, in order.
+func getRestoreManifests(req *pb.RestoreRequest, creds *Credentials) ([]*Manifest, error) {
+	uri, err := url.Parse(req.Location)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot parse backup location")
+	}
+	handler, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot create backup handler")
+	}
+
+	manifests, err := handler.GetManifests(uri, req.BackupId, req.BackupNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get backup manifests")
+	}
+	if len(manifests) == 0 {
+		return nil, errors.Errorf("no backup manifests found at location %s", req.Location)
+	}
+	return manifests, nil
+}
+
 func proposeRestoreOrSend(ctx context.Context, req *pb.RestoreRequest) error {
 	if groups().ServesGroup(req.GetGroupId()) {
 		_, err := (&grpcWorker{}).Restore(ctx, req)
@@ -121,6 +156,9 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	if req == nil {
 		return errors.Errorf("nil restore request")
 	}
+	if isSelective(req.Filter) {
+		return handleSelectiveRestore(ctx, req)
+	}
 
 	// Drop all the current data. This also cancels all existing transactions.
 	dropProposal := pb.Proposal{
@@ -138,27 +176,9 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	// backup could be in a different group. The tablets need to be moved.
 
 	// Reset tablets and set correct tablets to match the restored backup.
-	creds := &Credentials{
-		AccessKey:    req.AccessKey,
-		SecretKey:    req.SecretKey,
-		SessionToken: req.SessionToken,
-		Anonymous:    req.Anonymous,
-	}
-	uri, err := url.Parse(req.Location)
-	if err != nil {
-		return errors.Wrapf(err, "cannot parse backup location")
-	}
-	handler, err := NewUriHandler(uri, creds)
+	manifests, err := getRestoreManifests(req, restoreCredentials(req))
 	if err != nil {
-		return errors.Wrapf(err, "cannot create backup handler")
-	}
-
-	manifests, err := handler.GetManifests(uri, req.BackupId)
-	if err != nil {
-		return errors.Wrapf(err, "cannot get backup manifests")
-	}
-	if len(manifests) == 0 {
-		return errors.Errorf("no backup manifests found at location %s", req.Location)
+		return err
 	}
 	lastManifest := manifests[len(manifests)-1]
 	preds, ok := lastManifest.Groups[req.GroupId]
@@ -175,10 +195,82 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	}
 
 	// Write restored values to disk and update the UID lease.
-	if err := writeBackup(ctx, req); err != nil {
+	if err := writeBackup(ctx, req, nil, nil); err != nil {
 		return errors.Wrapf(err, "cannot write backup")
 	}
+	return finishRestore(ctx)
+}
+
+// handleSelectiveRestore restores the predicates selected by the filter of the request which are
+// served by this group, and the selected types, replacing their current data. The rest of the
+// data is left untouched. The predicates are read from the backup files of the groups they were
+// in at the time of the backup, which might not be this group.
+func handleSelectiveRestore(ctx context.Context, req *pb.RestoreRequest) error {
+	manifests, err := getRestoreManifests(req, restoreCredentials(req))
+	if err != nil {
+		return err
+	}
+	lastManifest := manifests[len(manifests)-1]
+	sel, err := newSelection(req.Filter, lastManifest.typeFields)
+	if err != nil {
+		return err
+	}
 
+	preds := make(predicateSet)
+	for _, groupPreds := range lastManifest.Groups {
+		for _, pred := range groupPreds {
+			if !sel.keepPredicate(pred) {
+				continue
+			}
+			tablet, err := groups().Tablet(pred)
+			if err != nil {
+				return errors.Wrapf(err, "cannot create tablet for restored predicate %s", pred)
+			}
+			if tablet.GetGroupId() == req.GroupId {
+				preds[pred] = struct{}{}
+			}
+		}
+	}
+	types := make(predicateSet)
+	for name := range lastManifest.Types {
+		if sel.keepType(name) {
+			types[name] = struct{}{}
+		}
+	}
+
+	// Drop the current data of the restored predicates and types.
+	for pred := range preds {
+		if err := detectPendingTxns(pred); err != nil {
+			return err
+		}
+		if err := posting.DeletePredicate(ctx, pred); err != nil {
+			return errors.Wrapf(err, "cannot drop predicate %s before restoring it", pred)
+		}
+	}
+	for name := range types {
+		if err := schema.State().DeleteType(name); err != nil {
+			return errors.Wrapf(err, "cannot drop type %s before restoring it", name)
+		}
+	}
+
+	glog.Infof("Restoring predicates %v and types %v of group %d", preds, types, req.GroupId)
+	if err := writeBackup(ctx, req, preds, types); err != nil {
+		return errors.Wrapf(err, "cannot write backup")
+	}
+	return finishRestore(ctx)
+}
+
+func restoreCredentials(req *pb.RestoreRequest) *Credentials {
+	return &Credentials{
+		AccessKey:    req.AccessKey,
+		SecretKey:    req.SecretKey,
+		SessionToken: req.SessionToken,
+		Anonymous:    req.Anonymous,
+	}
+}
+
+// finishRestore loads the restored schema, and takes a snapshot so the restore isn't replayed.
+func finishRestore(ctx context.Context) error {
 	// Load schema back.
 	if err := schema.LoadFromDb(); err != nil {
 		return errors.Wrapf(err, "cannot load schema after restore")
@@ -197,9 +289,24 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	return nil
 }
 
-func writeBackup(ctx context.Context, req *pb.RestoreRequest) error {
-	res := LoadBackup(req.Location, req.BackupId,
+// writeBackup loads the backup into the DB and updates the UID lease. If keep is not nil, only
+// the predicates in it are loaded, and only the types in types, see loadFromBackup.
+func writeBackup(ctx context.Context, req *pb.RestoreRequest, keep, types predicateSet) error {
+	res := LoadBackup(req.Location, req.BackupId, req.BackupNum,
 		func(r io.Reader, groupId int, preds predicateSet) (uint64, error) {
+			if keep != nil {
+				selected := make(predicateSet)
+				for pred := range preds {
+					if _, ok := keep[pred]; ok {
+						selected[pred] = struct{}{}
+					}
+				}
+				if len(selected) == 0 && len(types) == 0 {
+					return 0, nil
+				}
+				preds = selected
+			}
+
 			r, err := enc.GetReader(req.GetKeyFile(), r)
 			if err != nil {
 				return 0, errors.Wrapf(err, "cannot get encrypted reader")
@@ -209,7 +316,7 @@ func writeBackup(ctx context.Context, req *pb.RestoreRequest) error {
 				return 0, errors.Wrapf(err, "cannot create gzip reader")
 			}
 
-			maxUid, err := loadFromBackup(pstore, gzReader, req.RestoreTs, preds)
+			maxUid, err := loadFromBackup(pstore, gzReader, req.RestoreTs, preds, types)
 			if err != nil {
 				return 0, errors.Wrapf(err, "cannot write backup")
 			}
@@ -54,11 +54,44 @@ func populateKeyValues(ctx context.Context, kvs []*bpb.KV) error {
 	if err := writer.Flush(); err != nil {
 		return err
 	}
-	pk, err := x.Parse(kvs[0].Key)
-	if err != nil {
+	// The keys replicated from a primary cluster can carry the schema of several predicates, and
+	// the types.
+	for i, kv := range kvs {
+		pk, err := x.Parse(kv.Key)
+		if err != nil {
+			return err
+		}
+		switch {
+		case i == 0 && !pk.IsType(), pk.IsSchema():
+			if err := schema.Load(pk.Attr); err != nil {
+				return err
+			}
+		case pk.IsType():
+			var t pb.TypeUpdate
+			if err := t.Unmarshal(kv.Value); err != nil {
+				return err
+			}
+			schema.State().SetType(pk.Attr, t)
+		}
+	}
+	return nil
+}
+
+// cleanReceivedPredicate deletes the predicate of the first key received, which must be the
+// schema key, on all the nodes of the group.
+func cleanReceivedPredicate(ctx context.Context, pk x.ParsedKey) error {
+	if !pk.IsSchema() {
+		return errors.Errorf("Expecting first key to be schema key: %+v", pk)
+	}
+
+	// Delete on all nodes.
+	p := &pb.Proposal{CleanPredicate: pk.Attr}
+	glog.Infof("Predicate being received: %v", pk.Attr)
+	if err := groups().Node.proposeAndWait(ctx, p); err != nil {
+		glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
 		return err
 	}
-	return schema.Load(pk.Attr)
+	return nil
 }
 
 func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
@@ -68,8 +101,12 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 	size := 0
 	var pk x.ParsedKey
 
+	var ingestTs uint64
 	for kvBatch := range kvs {
 		for _, kv := range kvBatch.Kv {
+			if kvBatch.Ingest && kv.Version > ingestTs {
+				ingestTs = kv.Version
+			}
 			if len(pk.Attr) == 0 {
 				// This only happens once.
 				var err error
@@ -78,16 +115,20 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 					return err
 				}
 
-				if !pk.IsSchema() {
-					return errors.Errorf("Expecting first key to be schema key: %+v", kv)
-				}
-
-				// Delete on all nodes.
-				p := &pb.Proposal{CleanPredicate: pk.Attr}
-				glog.Infof("Predicate being received: %v", pk.Attr)
-				if err := n.proposeAndWait(ctx, p); err != nil {
-					glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
-					return err
+				// The keys catching up with an earlier copy of the predicate are written on
+				// top of it, and the keys of a uid range are merged with the data held for the
+				// other ranges, so the predicate must not be cleaned.
+				switch {
+				case kvBatch.SinceTs > 0:
+					glog.Infof("Predicate being caught up since ts %d: %v", kvBatch.SinceTs, pk.Attr)
+				case kvBatch.Merge:
+					glog.Infof("Uid range of predicate being received: %v", pk.Attr)
+				case kvBatch.Ingest:
+					glog.Infof("Keys of predicate being ingested: %v", pk.Attr)
+				default:
+					if err := cleanReceivedPredicate(ctx, pk); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -108,6 +149,38 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 			return err
 		}
 	}
+	if ingestTs > 0 && !pk.IsType() {
+		return rebuildIngestedIndexes(ctx, pk.Attr, ingestTs)
+	}
+	return nil
+}
+
+// rebuildIngestedIndexes rebuilds the indexes of a predicate once the keys ingested at ts are
+// visible, so that they cover both the data held before and the ingested data. The indexes are
+// built over the current ones, which keep serving the queries and checking the @upsert and
+// @unique directives until the build is done.
+func rebuildIngestedIndexes(ctx context.Context, attr string, ts uint64) error {
+	if err := posting.Oracle().WaitForTs(ctx, ts); err != nil {
+		return errors.Wrapf(err, "while waiting for ingested keys of %s", attr)
+	}
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok || (su.Directive == pb.SchemaUpdate_NONE && !su.Count) {
+		return nil
+	}
+	su.Predicate = attr
+
+	glog.Infof("Rebuilding indexes of ingested predicate: %v", attr)
+	// The indexes are built in the background, and only one predicate is indexed at a time.
+	groups().Node.waitForTask(opIndexing)
+	m := &pb.Mutations{
+		GroupId: groups().groupId(),
+		StartTs: State.GetTimestamp(false),
+		Schema:  []*pb.SchemaUpdate{&su},
+		Reindex: true,
+	}
+	if err := groups().Node.proposeAndWait(ctx, &pb.Proposal{Mutations: m}); err != nil {
+		return errors.Wrapf(err, "while rebuilding indexes of %s", attr)
+	}
 	return nil
 }
 
@@ -139,8 +212,19 @@ func (w *grpcWorker) ReceivePredicate(stream pb.Worker_ReceivePredicateServer) e
 		// Takes care of throttling and batching.
 		che <- batchAndProposeKeyValues(ctx, kvs)
 	}()
+	// The keys of an ingest are proposed before replying, so that the sender learns of errors.
+	ingest := false
 	for {
 		kvBatch, err := stream.Recv()
+		if err == io.EOF && ingest {
+			close(kvs)
+			if err := <-che; err != nil {
+				glog.Errorf("Received %d keys. Error while ingesting: %v\n", count, err)
+				return err
+			}
+			payload.Data = []byte(fmt.Sprintf("%d", count))
+			return stream.SendAndClose(payload)
+		}
 		if err == io.EOF {
 			payload.Data = []byte(fmt.Sprintf("%d", count))
 			if err := stream.SendAndClose(payload); err != nil {
@@ -154,6 +238,7 @@ func (w *grpcWorker) ReceivePredicate(stream pb.Worker_ReceivePredicateServer) e
 			return err
 		}
 		count += len(kvBatch.Kv)
+		ingest = ingest || kvBatch.Ingest
 
 		select {
 		case kvs <- kvBatch:
@@ -191,6 +276,21 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 		return &emptyPayload, errEmptyPredicate
 	}
 
+	if in.DestGid == 0 && in.StartUid > 0 {
+		glog.Infof("Was instructed to delete uid range [%#x, %#x) of tablet: %v",
+			in.StartUid, in.EndUid, in.Predicate)
+		// Same as below, the range is only deleted once the members know they don't serve it.
+		p := &pb.Proposal{
+			CleanShard: &pb.Tablet{
+				Predicate: in.Predicate,
+				StartUid:  in.StartUid,
+				EndUid:    in.EndUid,
+				MoveTs:    in.TxnTs,
+			},
+			ExpectedChecksum: in.ExpectedChecksum,
+		}
+		return &emptyPayload, groups().Node.proposeAndWait(ctx, p)
+	}
 	if in.DestGid == 0 {
 		glog.Infof("Was instructed to delete tablet: %v", in.Predicate)
 		// Expected Checksum ensures that all the members of this group would block until they get
@@ -205,7 +305,8 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 		return &emptyPayload, errors.Errorf("While waiting for txn ts: %d. Error: %v", in.TxnTs, err)
 	}
 
-	gid, err := groups().BelongsTo(in.Predicate)
+	// A uid range is moved out of the shard serving its start.
+	gid, err := groups().BelongsToUid(in.Predicate, in.StartUid)
 	switch {
 	case err != nil:
 		return &emptyPayload, err
@@ -219,26 +320,102 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	glog.Info(msg)
 	span.Annotate(nil, msg)
 
-	err = movePredicateHelper(ctx, in)
+	// The number of keys sent lets Zero decide whether another catch-up round is needed.
+	count, err := movePredicateHelper(ctx, in)
 	if err != nil {
 		span.Annotatef(nil, "Error while movePredicateHelper: %v", err)
+		return &emptyPayload, err
 	}
-	return &emptyPayload, err
+	return &api.Payload{Data: []byte(strconv.Itoa(count))}, nil
 }
 
-func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error {
+// movePredicateHelper streams the predicate as of in.TxnTs to the leader of the destination group
+// and returns the number of keys it received. If in.SinceTs is set, only the keys changed after it
+// are sent, so that the destination catches up with the copy it got in an earlier round. If
+// in.StartUid is set, only the uid range is sent.
+func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) (int, error) {
 	span := otrace.FromContext(ctx)
 
 	pl := groups().Leader(in.DestGid)
 	if pl == nil {
-		return errors.Errorf("Unable to find a connection for group: %d\n", in.DestGid)
+		return 0, errors.Errorf("Unable to find a connection for group: %d\n", in.DestGid)
 	}
 	c := pb.NewWorkerClient(pl.Get())
 	s, err := c.ReceivePredicate(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "while calling ReceivePredicate")
+		return 0, errors.Wrapf(err, "while calling ReceivePredicate")
+	}
+	if in.SinceTs == 0 {
+		if err := sendPredicateSchema(s, in); err != nil {
+			return 0, err
+		}
+	}
+	if in.StartUid > 0 {
+		if err := sendUidRange(ctx, s, in); err != nil {
+			return 0, err
+		}
+		return closeMoveStream(ctx, s, pl.Addr)
 	}
 
+	// sends all data except schema, schema key has different prefix
+	// Read the predicate keys and stream to keysCh.
+	stream := pstore.NewStreamAt(in.TxnTs)
+	stream.LogPrefix = fmt.Sprintf("Sending predicate: [%s]", in.Predicate)
+	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	if in.SinceTs > 0 {
+		stream.ChooseKey = func(item *badger.Item) bool {
+			return item.Version() > in.SinceTs
+		}
+	}
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		// For now, just send out full posting lists, because we use delete markers to delete older
+		// data in the prefix range. So, by sending only one version per key, and writing it at a
+		// provided timestamp, we can ensure that these writes are above all the delete markers.
+		l, err := posting.ReadPostingList(key, itr)
+		if err != nil {
+			return nil, err
+		}
+		kvs, err := l.Rollup()
+		for _, kv := range kvs {
+			// Let's set all of them at this move timestamp.
+			kv.Version = in.TxnTs
+		}
+		return &bpb.KVList{Kv: kvs}, err
+	}
+	stream.Send = func(list *bpb.KVList) error {
+		return s.Send(&pb.KVS{Kv: list.Kv, SinceTs: in.SinceTs})
+	}
+	span.Annotatef(nil, "Starting stream list orchestrate")
+	if err := stream.Orchestrate(ctx); err != nil {
+		return 0, err
+	}
+
+	return closeMoveStream(ctx, s, pl.Addr)
+}
+
+// closeMoveStream closes the stream sending keys to the destination group at addr, and returns
+// the number of keys it received.
+func closeMoveStream(ctx context.Context, s pb.Worker_ReceivePredicateClient,
+	addr string) (int, error) {
+	span := otrace.FromContext(ctx)
+	payload, err := s.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	recvCount, err := strconv.Atoi(string(payload.Data))
+	if err != nil {
+		return 0, err
+	}
+
+	msg := fmt.Sprintf("Receiver %s says it got %d keys.\n", addr, recvCount)
+	span.Annotate(nil, msg)
+	glog.Infof(msg)
+	return recvCount, nil
+}
+
+// sendPredicateSchema sends the schema of the predicate, which must be the first key received.
+func sendPredicateSchema(s pb.Worker_ReceivePredicateClient, in *pb.MovePredicatePayload) error {
+
 	// This txn is only reading the schema. Doesn't really matter what read timestamp we use,
 	// because schema keys are always set at ts=1.
 	txn := pstore.NewTransactionAt(in.TxnTs, false)
@@ -258,7 +435,7 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 		if err != nil {
 			return err
 		}
-		kvs := &pb.KVS{}
+		kvs := &pb.KVS{Merge: in.StartUid > 0}
 		kv := &bpb.KV{}
 		kv.Key = schemaKey
 		kv.Value = val
@@ -269,46 +446,129 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 			return err
 		}
 	}
+	return nil
+}
 
-	// sends all data except schema, schema key has different prefix
-	// Read the predicate keys and stream to keysCh.
-	stream := pstore.NewStreamAt(in.TxnTs)
-	stream.LogPrefix = fmt.Sprintf("Sending predicate: [%s]", in.Predicate)
-	stream.Prefix = x.PredicatePrefix(in.Predicate)
-	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
-		// For now, just send out full posting lists, because we use delete markers to delete older
-		// data in the prefix range. So, by sending only one version per key, and writing it at a
-		// provided timestamp, we can ensure that these writes are above all the delete markers.
+// inUidRange returns true if the uid is in the range [start, end). An end of zero means the range
+// is unbounded.
+func inUidRange(uid, start, end uint64) bool {
+	return uid >= start && (end == 0 || uid < end)
+}
+
+// uidRangeDelta returns a delta with the postings of the list whose uids are in [start, end), and
+// their op set to the given one. It returns nil if there's none.
+func uidRangeDelta(l *posting.List, readTs, start, end uint64, op uint32) (*pb.PostingList, error) {
+	delta := &pb.PostingList{}
+	err := l.Iterate(readTs, start-1, func(p *pb.Posting) error {
+		if !inUidRange(p.Uid, start, end) {
+			return posting.ErrStopIteration
+		}
+		if op == posting.Del {
+			delta.Postings = append(delta.Postings, &pb.Posting{Uid: p.Uid, Op: op})
+			return nil
+		}
+		cp := *p
+		cp.Op = op
+		delta.Postings = append(delta.Postings, &cp)
+		return nil
+	})
+	if err != nil || len(delta.Postings) == 0 {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// uidRangeKeyToList returns a function converting the keys of a predicate to the KVs moving or
+// deleting the uid range [start, end) of it at the given timestamp. The data keys of the range
+// are converted whole, as complete posting lists when moving them. The index, reverse and count
+// keys hold the entries of all the uids of a group, so only the entries of the range are
+// converted, as deltas.
+func uidRangeKeyToList(start, end, ts uint64, op uint32) func(
+	key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+	return func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		pk, err := x.Parse(key)
+		if err != nil {
+			return nil, err
+		}
 		l, err := posting.ReadPostingList(key, itr)
 		if err != nil {
 			return nil, err
 		}
-		kvs, err := l.Rollup()
-		for _, kv := range kvs {
-			// Let's set all of them at this move timestamp.
-			kv.Version = in.TxnTs
+		if pk.IsData() && op == posting.Set {
+			kvs, err := l.Rollup()
+			for _, kv := range kvs {
+				kv.Version = ts
+			}
+			return &bpb.KVList{Kv: kvs}, err
 		}
-		return &bpb.KVList{Kv: kvs}, err
-	}
-	stream.Send = func(list *bpb.KVList) error {
-		return s.Send(&pb.KVS{Kv: list.Kv})
+
+		from, to := start, end
+		if pk.IsData() {
+			// All the postings of a data key in the range belong to it.
+			from, to = 1, 0
+		}
+		delta, err := uidRangeDelta(l, ts, from, to, op)
+		if err != nil || delta == nil {
+			return nil, err
+		}
+		val, err := delta.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		kv := &bpb.KV{
+			Key:      key,
+			Value:    val,
+			UserMeta: []byte{posting.BitDeltaPosting},
+			Version:  ts,
+		}
+		return &bpb.KVList{Kv: []*bpb.KV{kv}}, nil
 	}
-	span.Annotatef(nil, "Starting stream list orchestrate")
-	if err := stream.Orchestrate(ctx); err != nil {
-		return err
+}
+
+// uidRangeChooseKey returns a function choosing the keys of a predicate which can hold entries of
+// the uid range [start, end).
+func uidRangeChooseKey(start, end uint64) func(item *badger.Item) bool {
+	return func(item *badger.Item) bool {
+		pk, err := x.Parse(item.Key())
+		if err != nil || pk.HasStartUid {
+			// The parts of a multi-part list are read along with its main key.
+			return false
+		}
+		return !pk.IsData() || inUidRange(pk.Uid, start, end)
 	}
+}
 
-	payload, err := s.CloseAndRecv()
-	if err != nil {
-		return err
+// sendUidRange streams the uid range of the predicate as of in.TxnTs to the destination group,
+// which merges it with the data it already holds for the predicate.
+func sendUidRange(ctx context.Context, s pb.Worker_ReceivePredicateClient,
+	in *pb.MovePredicatePayload) error {
+	stream := pstore.NewStreamAt(in.TxnTs)
+	stream.LogPrefix = fmt.Sprintf("Sending uid range [%#x, %#x) of predicate: [%s]",
+		in.StartUid, in.EndUid, in.Predicate)
+	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	stream.ChooseKey = uidRangeChooseKey(in.StartUid, in.EndUid)
+	stream.KeyToList = uidRangeKeyToList(in.StartUid, in.EndUid, in.TxnTs, posting.Set)
+	stream.Send = func(list *bpb.KVList) error {
+		return s.Send(&pb.KVS{Kv: list.Kv, Merge: true})
 	}
-	recvCount, err := strconv.Atoi(string(payload.Data))
-	if err != nil {
+	return stream.Orchestrate(ctx)
+}
+
+// deleteUidRange deletes the uid range of the predicate given by the tablet, which was moved to
+// another group, by writing deltas at the timestamp of the move.
+func deleteUidRange(ctx context.Context, tablet *pb.Tablet) error {
+	glog.Infof("Deleting uid range [%#x, %#x) of predicate: [%s]",
+		tablet.StartUid, tablet.EndUid, tablet.Predicate)
+	writer := posting.NewTxnWriter(pstore)
+	stream := pstore.NewStreamAt(tablet.MoveTs)
+	stream.LogPrefix = fmt.Sprintf("Deleting uid range [%#x, %#x) of predicate: [%s]",
+		tablet.StartUid, tablet.EndUid, tablet.Predicate)
+	stream.Prefix = x.PredicatePrefix(tablet.Predicate)
+	stream.ChooseKey = uidRangeChooseKey(tablet.StartUid, tablet.EndUid)
+	stream.KeyToList = uidRangeKeyToList(tablet.StartUid, tablet.EndUid, tablet.MoveTs, posting.Del)
+	stream.Send = writer.Write
+	if err := stream.Orchestrate(ctx); err != nil {
 		return err
 	}
-
-	msg := fmt.Sprintf("Receiver %s says it got %d keys.\n", pl.Addr, recvCount)
-	span.Annotate(nil, msg)
-	glog.Infof(msg)
-	return nil
+	return writer.Flush()
 }
@@ -141,18 +141,31 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 	// timeout.
 	var noTimeout bool
 
-	checkTablet := func(pred string) error {
+	// For a predicate sharded by uid ranges, the group must serve the shard of the uid, or any
+	// shard if the uid is zero.
+	checkTablet := func(pred string, uid uint64) error {
 		tablet, err := groups().Tablet(pred)
 		switch {
 		case err != nil:
 			return err
 		case tablet == nil || tablet.GroupId == 0:
 			return errNonExistentTablet
-		case tablet.GroupId != groups().groupId():
+		}
+		if shards := groups().Shards(pred); len(shards) > 0 {
+			for _, shard := range shards {
+				if shard.GroupId != groups().groupId() {
+					continue
+				}
+				if uid == 0 || x.ShardFor(shards, uid) == shard {
+					return nil
+				}
+			}
+			return errUnservedTablet
+		}
+		if tablet.GroupId != groups().groupId() {
 			return errUnservedTablet
-		default:
-			return nil
 		}
+		return nil
 	}
 
 	// Do a type check here if schema is present
@@ -161,7 +174,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 	ctx = schema.GetWriteContext(ctx)
 	if proposal.Mutations != nil {
 		for _, edge := range proposal.Mutations.Edges {
-			if err := checkTablet(edge.Attr); err != nil {
+			if err := checkTablet(edge.Attr, edge.Entity); err != nil {
 				return err
 			}
 			su, ok := schema.State().Get(ctx, edge.Attr)
@@ -173,7 +186,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 		}
 
 		for _, schema := range proposal.Mutations.Schema {
-			if err := checkTablet(schema.Predicate); err != nil {
+			if err := checkTablet(schema.Predicate, 0); err != nil {
 				return err
 			}
 			if err := checkSchema(schema); err != nil {
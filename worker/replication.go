@@ -0,0 +1,717 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	bpb "github.com/dgraph-io/badger/v2/pb"
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxReplicationChunk is the max size of the keys sent, forwarded or proposed at once.
+const maxReplicationChunk = 32 << 20
+
+var errStandby = errors.New("This cluster is a standby cluster which only accepts the " +
+	"replicated data. Promote it via Zero to accept writes")
+
+// IsStandby returns true if this Alpha is part of a standby cluster which hasn't been promoted.
+func IsStandby() bool {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	return g.state.GetReplication().GetStandby()
+}
+
+// CheckStandby returns an error if this Alpha is part of a standby cluster.
+func CheckStandby() error {
+	if IsStandby() {
+		return errStandby
+	}
+	return nil
+}
+
+// replicator ships the changes of the group to the standby cluster, while this Alpha is the
+// leader of the group.
+type replicator struct {
+	// The index of the standby Alpha in --replicate_to we talk to.
+	target int
+	// Whether we know up to which timestamp the standby has applied our changes.
+	known bool
+	since uint64
+	// The fingerprint of the schema and types last shipped.
+	schemaFp uint64
+}
+
+func (n *node) processReplication() {
+	defer n.closer.Done() // CLOSER:1
+	if len(x.WorkerConfig.ReplicateTo) == 0 {
+		return
+	}
+	tick := time.NewTicker(x.WorkerConfig.ReplicationInterval)
+	defer tick.Stop()
+
+	r := &replicator{}
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !n.AmLeader() {
+				// Whoever becomes the leader starts by asking the standby where it stands.
+				r.known = false
+				r.schemaFp = 0
+				continue
+			}
+			if err := r.replicate(); err != nil {
+				glog.Warningf("While replicating group %d to %s: %v",
+					n.gid, x.WorkerConfig.ReplicateTo[r.target], err)
+				r.known = false
+				r.schemaFp = 0
+				r.target = (r.target + 1) % len(x.WorkerConfig.ReplicateTo)
+			}
+		}
+	}
+}
+
+func (r *replicator) replicate() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	pl := conn.GetPools().Connect(x.WorkerConfig.ReplicateTo[r.target])
+	c := pb.NewWorkerClient(pl.Get())
+	gid := groups().groupId()
+	if !r.known {
+		st, err := probeStandby(ctx, c, gid)
+		if err != nil {
+			return errors.Wrapf(err, "while asking for the replication status")
+		}
+		r.since, r.known = st.AppliedTs, true
+		glog.Infof("Standby has applied the changes of group %d up to ts: %d", gid, r.since)
+	}
+
+	upTo := posting.Oracle().MaxAssigned()
+	schemaKvs, fp, err := replicatedSchema()
+	if err != nil {
+		return err
+	}
+	if upTo <= r.since && fp == r.schemaFp {
+		return nil
+	}
+	drops, err := loggedDrops(r.since, upTo)
+	if err != nil {
+		return errors.Wrapf(err, "while reading the drops to replicate")
+	}
+
+	header := pb.ReplicationBatch{
+		GroupId: gid,
+		SinceTs: r.since,
+		UpToTs:  upTo,
+		MaxUid:  MaxLeaseId(),
+		SentAt:  time.Now().Unix(),
+	}
+	stream, err := c.Replicate(ctx)
+	if err != nil {
+		return err
+	}
+	first := header
+	first.Drops = drops
+	// The drops can remove schema and types we still have, so they are sent again after them.
+	if fp != r.schemaFp || len(drops) > 0 {
+		first.Kv = schemaKvs
+	}
+	if err := stream.Send(&first); err != nil {
+		return err
+	}
+
+	// Only the keys changed since the last shipment are sent, as complete posting lists at the
+	// version they were committed at. So they can be written on top of what the standby has.
+	var count int
+	st := pstore.NewStreamAt(upTo)
+	st.LogPrefix = fmt.Sprintf("Replicating group %d", gid)
+	st.ChooseKey = func(item *badger.Item) bool {
+		if item.Version() <= r.since {
+			return false
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil || pk.HasStartUid || pk.IsSchema() || pk.IsType() {
+			return false
+		}
+		return replicatesTablet(pk.Attr)
+	}
+	st.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		l, err := posting.ReadPostingList(key, itr)
+		if err != nil {
+			return nil, err
+		}
+		kvs, err := l.Rollup()
+		return &bpb.KVList{Kv: kvs}, err
+	}
+	st.Send = func(list *bpb.KVList) error {
+		count += len(list.Kv)
+		batch := header
+		batch.Kv = list.Kv
+		return stream.Send(&batch)
+	}
+	if err := st.Orchestrate(ctx); err != nil {
+		return err
+	}
+	status, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	if status.AppliedTs >= upTo {
+		r.schemaFp = fp
+	}
+	glog.V(2).Infof("Replicated %d keys and %d drops of group %d changed in (%d, %d]. Standby "+
+		"has applied up to ts: %d", count, len(drops), gid, r.since, upTo, status.AppliedTs)
+	r.since = status.AppliedTs
+	if err := pruneDrops(status.AppliedTs); err != nil {
+		glog.Warningf("While pruning the drops replicated up to ts %d: %v", status.AppliedTs, err)
+	}
+	return nil
+}
+
+// dropLogPrefix is the prefix of the keys in the WAL store logging the drops applied by the group,
+// until the standby has applied them. Dropping keys leaves no version behind for the replicator to
+// pick up, unlike the other changes.
+var dropLogPrefix = []byte("repldrop")
+
+func dropLogKey(ts uint64) []byte {
+	key := make([]byte, len(dropLogPrefix)+8)
+	copy(key, dropLogPrefix)
+	binary.BigEndian.PutUint64(key[len(dropLogPrefix):], ts)
+	return key
+}
+
+// logDrop logs a drop applied by this Alpha, if the group is replicated. It is logged by all the
+// members of the group, so whoever is the leader can send it to the standby.
+func logDrop(m *pb.Mutations) error {
+	if len(x.WorkerConfig.ReplicateTo) == 0 || IsStandby() {
+		return nil
+	}
+	drop := *m
+	if drop.StartTs == 0 {
+		drop.StartTs = posting.Oracle().MaxAssigned()
+	}
+	data, err := drop.Marshal()
+	if err != nil {
+		return err
+	}
+	return State.WALstore.Update(func(txn *badger.Txn) error {
+		return txn.Set(dropLogKey(drop.StartTs), data)
+	})
+}
+
+// loggedDrops returns the drops logged in (since, upTo], in the order they were applied.
+func loggedDrops(since, upTo uint64) ([]*pb.Mutations, error) {
+	var drops []*pb.Mutations
+	err := State.WALstore.View(func(txn *badger.Txn) error {
+		itr := txn.NewIterator(badger.IteratorOptions{Prefix: dropLogPrefix})
+		defer itr.Close()
+		for itr.Seek(dropLogKey(since + 1)); itr.Valid(); itr.Next() {
+			item := itr.Item()
+			if binary.BigEndian.Uint64(item.Key()[len(dropLogPrefix):]) > upTo {
+				break
+			}
+			drop := &pb.Mutations{}
+			if err := item.Value(drop.Unmarshal); err != nil {
+				return err
+			}
+			drops = append(drops, drop)
+		}
+		return nil
+	})
+	return drops, err
+}
+
+// pruneDrops deletes the drops logged up to ts, which the standby has applied.
+func pruneDrops(ts uint64) error {
+	var keys [][]byte
+	err := State.WALstore.View(func(txn *badger.Txn) error {
+		itr := txn.NewIterator(badger.IteratorOptions{Prefix: dropLogPrefix})
+		defer itr.Close()
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			key := itr.Item().KeyCopy(nil)
+			if binary.BigEndian.Uint64(key[len(dropLogPrefix):]) > ts {
+				break
+			}
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	wb := State.WALstore.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// probeStandby asks the standby up to which timestamp it has applied the changes of the group.
+func probeStandby(ctx context.Context, c pb.WorkerClient, gid uint32) (
+	*pb.ReplicationStatus, error) {
+	stream, err := c.Replicate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.ReplicationBatch{GroupId: gid}); err != nil {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+// replicatesTablet returns true if the changes of the predicate are replicated by this group. The
+// predicates sharded by uid ranges aren't replicated.
+func replicatesTablet(attr string) bool {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	tablet := g.tablets[attr]
+	return tablet != nil && tablet.GroupId == g.groupId() && len(g.shards[attr]) == 0
+}
+
+// replicatedSchema returns the schema keys of the predicates served by this group and all the type
+// keys, along with their fingerprint.
+func replicatedSchema() ([]*bpb.KV, uint64, error) {
+	// Schema and type keys are always set at ts=1.
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	var kvs []*bpb.KV
+	var buf bytes.Buffer
+	for _, prefix := range [][]byte{x.SchemaPrefix(), x.TypePrefix()} {
+		itr := txn.NewIterator(badger.IteratorOptions{Prefix: prefix})
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			item := itr.Item()
+			pk, err := x.Parse(item.Key())
+			if err != nil {
+				continue
+			}
+			if pk.IsSchema() && !replicatesTablet(pk.Attr) {
+				continue
+			}
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				itr.Close()
+				return nil, 0, err
+			}
+			kvs = append(kvs, &bpb.KV{
+				Key:      item.KeyCopy(nil),
+				Value:    val,
+				UserMeta: []byte{item.UserMeta()},
+				Version:  1,
+			})
+			buf.Write(item.Key())
+			buf.Write(val)
+		}
+		itr.Close()
+	}
+	return kvs, farm.Fingerprint64(buf.Bytes()), nil
+}
+
+// standbyApplied tracks up to which timestamp this Alpha has applied the changes of the primary
+// groups, ahead of the state Zero sends us.
+var standbyApplied = struct {
+	sync.Mutex
+	ts map[uint32]uint64
+}{ts: make(map[uint32]uint64)}
+
+func appliedTs(gid uint32) uint64 {
+	ts := groups().replicationStatus(gid).GetAppliedTs()
+	standbyApplied.Lock()
+	defer standbyApplied.Unlock()
+	return x.Max(ts, standbyApplied.ts[gid])
+}
+
+func setAppliedTs(gid uint32, ts uint64) {
+	standbyApplied.Lock()
+	defer standbyApplied.Unlock()
+	standbyApplied.ts[gid] = x.Max(ts, standbyApplied.ts[gid])
+}
+
+func (g *groupi) replicationStatus(gid uint32) *pb.ReplicationStatus {
+	g.RLock()
+	defer g.RUnlock()
+	return g.state.GetReplication().GetGroups()[gid]
+}
+
+// Replicate receives the changes of a primary group, and applies them to the groups of this
+// standby cluster serving their predicates.
+func (w *grpcWorker) Replicate(stream pb.Worker_ReplicateServer) error {
+	if !IsStandby() {
+		return errors.New("Replicate failed: This cluster is not a standby cluster")
+	}
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.Forwarded {
+		return applyForwarded(ctx, stream, first)
+	}
+
+	applied := appliedTs(first.GroupId)
+	if first.UpToTs == 0 || first.SinceTs != applied {
+		// The primary is asking, or doesn't know, up to which timestamp we have its changes.
+		return stream.SendAndClose(&pb.ReplicationStatus{GroupId: first.GroupId,
+			AppliedTs: applied})
+	}
+
+	fwd := newReplicationForwarder(ctx, first)
+	// The drops go first, as the keys sent after them are newer.
+	if err := fwd.drop(first.Drops); err != nil {
+		return errors.Wrapf(err, "while applying the drops of group %d", first.GroupId)
+	}
+	for batch := first; ; {
+		if err := fwd.route(batch.Kv); err != nil {
+			return err
+		}
+		batch, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := fwd.close(); err != nil {
+		return err
+	}
+
+	st := &pb.ReplicationStatus{
+		GroupId:   first.GroupId,
+		AppliedTs: first.UpToTs,
+		SentAt:    first.SentAt,
+		MaxUid:    first.MaxUid,
+	}
+	zc := pb.NewZeroClient(groups().connToZeroLeader().Get())
+	if _, err := zc.Replicated(ctx, st); err != nil {
+		return errors.Wrapf(err, "while recording the replication status in Zero")
+	}
+	setAppliedTs(first.GroupId, first.UpToTs)
+	return stream.SendAndClose(st)
+}
+
+// applyForwarded proposes the keys forwarded to the leader of this group by the standby Alpha
+// which received them.
+func applyForwarded(ctx context.Context, stream pb.Worker_ReplicateServer,
+	first *pb.ReplicationBatch) error {
+	n := groups().Node
+	if !n.AmLeader() {
+		return errNotLeader
+	}
+	var kvs []*bpb.KV
+	var size int
+	for batch := first; ; {
+		if len(batch.Drops) > 0 && len(kvs) > 0 {
+			if err := n.proposeAndWait(ctx, &pb.Proposal{Kv: kvs}); err != nil {
+				return err
+			}
+			kvs, size = nil, 0
+		}
+		for _, drop := range batch.Drops {
+			if err := applyDrop(ctx, drop); err != nil {
+				return err
+			}
+		}
+		for _, kv := range batch.Kv {
+			kvs = append(kvs, kv)
+			size += len(kv.Key) + len(kv.Value)
+			if size >= maxReplicationChunk {
+				if err := n.proposeAndWait(ctx, &pb.Proposal{Kv: kvs}); err != nil {
+					return err
+				}
+				kvs, size = nil, 0
+			}
+		}
+		var err error
+		batch, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(kvs) > 0 {
+		if err := n.proposeAndWait(ctx, &pb.Proposal{Kv: kvs}); err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(&pb.ReplicationStatus{GroupId: first.GroupId})
+}
+
+// replicationForwarder routes the replicated keys to the groups of the standby cluster serving
+// their predicates. The keys of this group are proposed directly if this Alpha is its leader.
+type replicationForwarder struct {
+	ctx     context.Context
+	header  pb.ReplicationBatch
+	pending map[uint32][]*bpb.KV
+	size    map[uint32]int
+	streams map[uint32]pb.Worker_ReplicateClient
+}
+
+func newReplicationForwarder(ctx context.Context,
+	first *pb.ReplicationBatch) *replicationForwarder {
+	return &replicationForwarder{
+		ctx:     ctx,
+		header:  pb.ReplicationBatch{GroupId: first.GroupId, Forwarded: true},
+		pending: make(map[uint32][]*bpb.KV),
+		size:    make(map[uint32]int),
+		streams: make(map[uint32]pb.Worker_ReplicateClient),
+	}
+}
+
+// replicationGroups returns the groups the key should be written to. The types are known by all
+// the groups.
+func replicationGroups(key []byte) ([]uint32, error) {
+	pk, err := x.Parse(key)
+	if err != nil {
+		return nil, err
+	}
+	if pk.IsType() {
+		return groups().KnownGroups(), nil
+	}
+	gid, err := groups().BelongsTo(pk.Attr)
+	if err != nil {
+		return nil, err
+	}
+	if gid == 0 {
+		return nil, errors.Errorf("No group serves predicate %q", pk.Attr)
+	}
+	return []uint32{gid}, nil
+}
+
+func (f *replicationForwarder) route(kvs []*bpb.KV) error {
+	for _, kv := range kvs {
+		gids, err := replicationGroups(kv.Key)
+		if err != nil {
+			return err
+		}
+		for _, gid := range gids {
+			f.pending[gid] = append(f.pending[gid], kv)
+			f.size[gid] += len(kv.Key) + len(kv.Value)
+			if f.size[gid] >= maxReplicationChunk {
+				if err := f.flush(gid); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (f *replicationForwarder) flush(gid uint32) error {
+	kvs := f.pending[gid]
+	delete(f.pending, gid)
+	delete(f.size, gid)
+	if len(kvs) == 0 {
+		return nil
+	}
+	if gid == groups().groupId() && groups().Node.AmLeader() {
+		return groups().Node.proposeAndWait(f.ctx, &pb.Proposal{Kv: kvs})
+	}
+	s, err := f.stream(gid)
+	if err != nil {
+		return err
+	}
+	batch := f.header
+	batch.Kv = kvs
+	return s.Send(&batch)
+}
+
+// stream returns the stream forwarding the batches to the leader of the group.
+func (f *replicationForwarder) stream(gid uint32) (pb.Worker_ReplicateClient, error) {
+	if s, ok := f.streams[gid]; ok {
+		return s, nil
+	}
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return nil, errors.Errorf("Unable to find a connection for group: %d", gid)
+	}
+	s, err := pb.NewWorkerClient(pl.Get()).Replicate(f.ctx)
+	if err != nil {
+		return nil, err
+	}
+	f.streams[gid] = s
+	return s, nil
+}
+
+// drop routes the drops of the primary group to the groups they apply to. They are sent before
+// any key, so they are applied before the keys on each group.
+func (f *replicationForwarder) drop(drops []*pb.Mutations) error {
+	for _, drop := range drops {
+		routed, err := standbyDrops(drop)
+		if err != nil {
+			return err
+		}
+		for gid, ms := range routed {
+			if gid == groups().groupId() && groups().Node.AmLeader() {
+				for _, m := range ms {
+					if err := applyDrop(f.ctx, m); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			s, err := f.stream(gid)
+			if err != nil {
+				return err
+			}
+			batch := f.header
+			batch.Drops = ms
+			if err := s.Send(&batch); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// standbyDrops splits a drop of the primary group into the drops to apply to each group of the
+// standby. Dropping the data is done in every group by writing empty posting lists over the keys
+// committed up to the drop, rather than by dropping the keys, since the other primary groups can
+// have sent newer keys already. Dropping all the data also drops the schema of the predicates the
+// primary group served and the types, which it sends again if they exist.
+//
+// Moving a predicate cleans it in the destination group before receiving it, but that isn't a
+// drop. The predicate is still there, so the standby keeps it.
+func standbyDrops(drop *pb.Mutations) (map[uint32][]*pb.Mutations, error) {
+	routed := make(map[uint32][]*pb.Mutations)
+	toAll := func(m *pb.Mutations) {
+		for _, gid := range groups().KnownGroups() {
+			routed[gid] = append(routed[gid], m)
+		}
+	}
+	switch drop.DropOp {
+	case pb.Mutations_ALL:
+		toAll(&pb.Mutations{StartTs: drop.StartTs, DropOp: pb.Mutations_DATA})
+		initial := make(map[string]bool)
+		for _, t := range schema.InitialTypes() {
+			initial[t.TypeName] = true
+		}
+		for _, name := range schema.State().Types() {
+			if !initial[name] {
+				toAll(&pb.Mutations{StartTs: drop.StartTs, DropOp: pb.Mutations_TYPE,
+					DropValue: name})
+			}
+		}
+	case pb.Mutations_DATA, pb.Mutations_TYPE:
+		toAll(drop)
+	}
+	for _, edge := range drop.Edges {
+		gid, err := groups().BelongsToReadOnly(edge.Attr, 0)
+		if err != nil {
+			return nil, err
+		}
+		if gid == 0 {
+			// The standby doesn't have the predicate.
+			continue
+		}
+		routed[gid] = append(routed[gid], &pb.Mutations{StartTs: drop.StartTs,
+			Edges: []*pb.DirectedEdge{edge}})
+	}
+	return routed, nil
+}
+
+// applyDrop applies a drop of the primary cluster to this group, of which this Alpha is the
+// leader.
+func applyDrop(ctx context.Context, drop *pb.Mutations) error {
+	n := groups().Node
+	if drop.DropOp != pb.Mutations_DATA {
+		return n.proposeAndWait(ctx, &pb.Proposal{Mutations: drop})
+	}
+	var kvs []*bpb.KV
+	var size int
+	err := dropTombstones(drop.StartTs, func(kv *bpb.KV) error {
+		kvs = append(kvs, kv)
+		if size += len(kv.Key); size < maxReplicationChunk {
+			return nil
+		}
+		err := n.proposeAndWait(ctx, &pb.Proposal{Kv: kvs})
+		kvs, size = nil, 0
+		return err
+	})
+	if err != nil || len(kvs) == 0 {
+		return err
+	}
+	return n.proposeAndWait(ctx, &pb.Proposal{Kv: kvs})
+}
+
+// dropTombstones calls fn with an empty posting list at ts for each key of the data last committed
+// at or before ts. The keys committed after ts are kept.
+func dropTombstones(ts uint64, fn func(kv *bpb.KV) error) error {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.IteratorOptions{})
+	defer itr.Close()
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.Version() > ts || item.UserMeta()&posting.BitEmptyPosting > 0 {
+			continue
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil || pk.IsSchema() || pk.IsType() {
+			continue
+		}
+		kv := &bpb.KV{
+			Key:      item.KeyCopy(nil),
+			UserMeta: []byte{posting.BitEmptyPosting},
+			Version:  ts,
+		}
+		if err := fn(kv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *replicationForwarder) close() error {
+	for gid := range f.pending {
+		if err := f.flush(gid); err != nil {
+			return err
+		}
+	}
+	for gid, s := range f.streams {
+		if _, err := s.CloseAndRecv(); err != nil {
+			return errors.Wrapf(err, "while forwarding the replicated keys to group %d", gid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	bpb "github.com/dgraph-io/badger/v2/pb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/codec"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestDropLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "droplog")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db, err := badger.Open(badger.DefaultOptions(dir))
+	require.NoError(t, err)
+	defer db.Close()
+
+	State.WALstore = db
+	x.WorkerConfig.ReplicateTo = []string{"standby:7080"}
+	defer func() {
+		State.WALstore = nil
+		x.WorkerConfig.ReplicateTo = nil
+	}()
+
+	dropAttr := &pb.Mutations{StartTs: 10, Edges: []*pb.DirectedEdge{
+		{Attr: "name", Value: []byte(x.Star), Op: pb.DirectedEdge_DEL}}}
+	dropType := &pb.Mutations{StartTs: 20, DropOp: pb.Mutations_TYPE, DropValue: "Person"}
+	dropData := &pb.Mutations{StartTs: 30, DropOp: pb.Mutations_DATA}
+	for _, m := range []*pb.Mutations{dropData, dropAttr, dropType} {
+		require.NoError(t, logDrop(m))
+	}
+
+	drops, err := loggedDrops(0, 25)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.Mutations{dropAttr, dropType}, drops)
+	drops, err = loggedDrops(10, 30)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.Mutations{dropType, dropData}, drops)
+
+	require.NoError(t, pruneDrops(20))
+	drops, err = loggedDrops(0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.Mutations{dropData}, drops)
+}
+
+func TestStandbyDropsPredicate(t *testing.T) {
+	drop := &pb.Mutations{StartTs: 10, Edges: []*pb.DirectedEdge{
+		{Attr: "friend_not_served", Value: []byte(x.Star), Op: pb.DirectedEdge_DEL}}}
+	routed, err := standbyDrops(drop)
+	require.NoError(t, err)
+	require.Equal(t, map[uint32][]*pb.Mutations{2: {drop}}, routed)
+}
+
+func TestDropTombstones(t *testing.T) {
+	attr := "replication_dropped"
+	list := func(uid uint64) []byte {
+		data, err := (&pb.PostingList{Pack: codec.Encode([]uint64{uid}, 256)}).Marshal()
+		require.NoError(t, err)
+		return data
+	}
+	// The key of uid 1 was committed before the drop at ts 20, and the key of uid 2 after it.
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, writer.Write(&bpb.KVList{Kv: []*bpb.KV{
+		{Key: x.DataKey(attr, 1), Value: list(5), UserMeta: []byte{posting.BitCompletePosting},
+			Version: 5},
+		{Key: x.DataKey(attr, 2), Value: list(6), UserMeta: []byte{posting.BitCompletePosting},
+			Version: 30},
+	}}))
+	require.NoError(t, writer.Flush())
+
+	var tombstones []*bpb.KV
+	require.NoError(t, dropTombstones(20, func(kv *bpb.KV) error {
+		if pk, err := x.Parse(kv.Key); err == nil && pk.Attr == attr {
+			tombstones = append(tombstones, kv)
+		}
+		return nil
+	}))
+	require.Len(t, tombstones, 1)
+	require.Equal(t, x.DataKey(attr, 1), tombstones[0].Key)
+	require.Equal(t, uint64(20), tombstones[0].Version)
+
+	// The data stays dropped even if the standby reads a key at a version before the drop.
+	writer = posting.NewTxnWriter(pstore)
+	require.NoError(t, writer.Write(&bpb.KVList{Kv: tombstones}))
+	require.NoError(t, writer.Flush())
+	for uid, length := range map[uint64]int{1: 0, 2: 1} {
+		l, err := posting.GetNoStore(x.DataKey(attr, uid), 40)
+		require.NoError(t, err)
+		require.Equal(t, length, l.Length(40, 0), "uid %d", uid)
+	}
+	l, err := posting.GetNoStore(x.DataKey(attr, 1), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, l.Length(10, 0))
+}
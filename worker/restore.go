@@ -34,8 +34,9 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 )
 
-// RunRestore calls badger.Load and tries to load data into a new DB.
-func RunRestore(pdir, location, backupId, keyfile string) LoadResult {
+// RunRestore calls badger.Load and tries to load data into a new DB. If backupNum is not zero,
+// the backup series is restored up to the backup with that number.
+func RunRestore(pdir, location, backupId string, backupNum uint64, keyfile string) LoadResult {
 	// Create the pdir if it doesn't exist.
 	if err := os.MkdirAll(pdir, 0700); err != nil {
 		return LoadResult{0, 0, err}
@@ -43,7 +44,7 @@ func RunRestore(pdir, location, backupId, keyfile string) LoadResult {
 
 	// Scan location for backup files and load them. Each file represents a node group,
 	// and we create a new p dir for each.
-	return LoadBackup(location, backupId,
+	return LoadBackup(location, backupId, backupNum,
 		func(r io.Reader, groupId int, preds predicateSet) (uint64, error) {
 
 			dir := filepath.Join(pdir, fmt.Sprintf("p%d", groupId))
@@ -76,7 +77,7 @@ func RunRestore(pdir, location, backupId, keyfile string) LoadResult {
 			if !pathExist(dir) {
 				fmt.Println("Creating new db:", dir)
 			}
-			maxUid, err := loadFromBackup(db, gzReader, 0, preds)
+			maxUid, err := loadFromBackup(db, gzReader, 0, preds, nil)
 			if err != nil {
 				return 0, err
 			}
@@ -87,20 +88,25 @@ func RunRestore(pdir, location, backupId, keyfile string) LoadResult {
 // loadFromBackup reads the backup, converts the keys and values to the required format,
 // and loads them to the given badger DB. The set of predicates is used to avoid restoring
 // values from predicates no longer assigned to this group.
+// If types is nil, all the schema and type definitions in the DB are replaced by the ones in the
+// backup. Otherwise, only the types in it are restored, and the caller must have dropped them,
+// along with the predicates, before.
 // If restoreTs is greater than zero, the key-value pairs will be written with that timestamp.
 // Otherwise, the original value is used.
 // TODO(DGRAPH-1234): Check whether restoreTs can be removed.
-func loadFromBackup(db *badger.DB, r io.Reader, restoreTs uint64, preds predicateSet) (
-	uint64, error) {
+func loadFromBackup(db *badger.DB, r io.Reader, restoreTs uint64, preds predicateSet,
+	types predicateSet) (uint64, error) {
 	br := bufio.NewReaderSize(r, 16<<10)
 	unmarshalBuf := make([]byte, 1<<10)
 
-	// Delete schemas and types. Each backup file should have a complete copy of the schema.
-	if err := db.DropPrefix([]byte{x.ByteSchema}); err != nil {
-		return 0, err
-	}
-	if err := db.DropPrefix([]byte{x.ByteType}); err != nil {
-		return 0, err
+	if types == nil {
+		// Delete schemas and types. Each backup file should have a complete copy of the schema.
+		if err := db.DropPrefix([]byte{x.ByteSchema}); err != nil {
+			return 0, err
+		}
+		if err := db.DropPrefix([]byte{x.ByteType}); err != nil {
+			return 0, err
+		}
 	}
 
 	loader := db.NewKVLoader(16)
@@ -145,7 +151,11 @@ func loadFromBackup(db *badger.DB, r io.Reader, restoreTs uint64, preds predicat
 			if err != nil {
 				return 0, errors.Wrapf(err, "could not parse key %s", hex.Dump(restoreKey))
 			}
-			if _, ok := preds[parsedKey.Attr]; !parsedKey.IsType() && !ok {
+			if parsedKey.IsType() {
+				if _, ok := types[parsedKey.Attr]; types != nil && !ok {
+					continue
+				}
+			} else if _, ok := preds[parsedKey.Attr]; !ok {
 				continue
 			}
 
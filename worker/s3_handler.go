@@ -272,7 +272,8 @@ func (h *s3Handler) readManifest(mc *minio.Client, object string, m *Manifest) e
 	return json.NewDecoder(reader).Decode(m)
 }
 
-func (h *s3Handler) GetManifests(uri *url.URL, backupId string) ([]*Manifest, error) {
+func (h *s3Handler) GetManifests(uri *url.URL, backupId string,
+	backupNum uint64) ([]*Manifest, error) {
 	mc, err := h.setup(uri)
 	if err != nil {
 		return nil, err
@@ -304,7 +305,7 @@ func (h *s3Handler) GetManifests(uri *url.URL, backupId string) ([]*Manifest, er
 		m.Path = path
 		manifests = append(manifests, &m)
 	}
-	manifests, err = filterManifests(manifests, backupId)
+	manifests, err = filterManifests(manifests, backupId, backupNum)
 	if err != nil {
 		return nil, err
 	}
@@ -320,8 +321,9 @@ func (h *s3Handler) GetManifests(uri *url.URL, backupId string) ([]*Manifest, er
 // Load creates a new session, scans for backup objects in a bucket, then tries to
 // load any backup objects found.
 // Returns nil and the maximum Since value on success, error otherwise.
-func (h *s3Handler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult {
-	manifests, err := h.GetManifests(uri, backupId)
+func (h *s3Handler) Load(uri *url.URL, backupId string, backupNum uint64,
+	fn loadFn) LoadResult {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
 	if err != nil {
 		return LoadResult{0, 0, errors.Wrapf(err, "while retrieving manifests")}
 	}
@@ -365,7 +367,7 @@ func (h *s3Handler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult {
 			// of the last backup.
 			predSet := manifests[len(manifests)-1].getPredsInGroup(gid)
 
-			groupMaxUid, err := fn(reader, int(gid), predSet)
+			groupMaxUid, err := loadFile(manifest, gid, reader, predSet, fn)
 			if err != nil {
 				return LoadResult{0, 0, err}
 			}
@@ -381,8 +383,9 @@ func (h *s3Handler) Load(uri *url.URL, backupId string, fn loadFn) LoadResult {
 
 // Verify performs basic checks to decide whether the specified backup can be restored
 // to a live cluster.
-func (h *s3Handler) Verify(uri *url.URL, backupId string, currentGroups []uint32) error {
-	manifests, err := h.GetManifests(uri, backupId)
+func (h *s3Handler) Verify(uri *url.URL, backupId string, backupNum uint64,
+	currentGroups []uint32) error {
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
 	if err != nil {
 		return errors.Wrapf(err, "while retrieving manifests")
 	}
@@ -428,6 +431,29 @@ func (h *s3Handler) ReadManifest(path string, m *Manifest) error {
 	return h.readManifest(mc, path, m)
 }
 
+// RemoveBackup deletes the objects of the backup of the manifest at the given path.
+func (h *s3Handler) RemoveBackup(path string) error {
+	mc, err := h.setup(h.uri)
+	if err != nil {
+		return err
+	}
+	if err := mc.RemoveObject(h.bucketName, path); err != nil {
+		return err
+	}
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for object := range mc.ListObjects(h.bucketName, filepath.Dir(path)+"/", true, doneCh) {
+		if object.Err != nil {
+			return object.Err
+		}
+		if err := mc.RemoveObject(h.bucketName, object.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // upload will block until it's done or an error occurs.
 func (h *s3Handler) upload(mc *minio.Client, object string) error {
 	start := time.Now()
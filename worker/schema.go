@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	otrace "go.opencensus.io/trace"
@@ -57,7 +58,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang", "noconflict"}
+			"lang", "noconflict", "unique", "ttl"}
 	}
 
 	myGid := groups().groupId()
@@ -112,6 +113,12 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Lang = schema.State().HasLang(attr)
 		case "noconflict":
 			schemaNode.NoConflict = schema.State().HasNoConflict(attr)
+		case "unique":
+			schemaNode.Unique = schema.State().IsUnique(attr)
+		case "ttl":
+			if ttl := schema.State().TTL(attr); ttl > 0 {
+				schemaNode.Ttl = (time.Duration(ttl) * time.Second).String()
+			}
 		default:
 			//pass
 		}
@@ -235,7 +242,12 @@ func (w *grpcWorker) Schema(ctx context.Context, s *pb.SchemaRequest) (*pb.Schem
 	if !groups().ServesGroup(s.GroupId) {
 		return &emptySchemaResult, errors.Errorf("This server doesn't serve group id: %v", s.GroupId)
 	}
-	return getSchema(ctx, s)
+	result, err := getSchema(ctx, s)
+	if err != nil || len(s.Types) == 0 {
+		return result, err
+	}
+	result.Types, err = GetTypes(ctx, s)
+	return result, err
 }
 
 // GetTypes processes the type requests and retrieves the desired types.
@@ -0,0 +1,345 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	otrace "go.opencensus.io/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// shardGroups returns the groups serving the shards of the predicate, or nil if the predicate
+// isn't sharded.
+func shardGroups(attr string) []uint32 {
+	var gids []uint32
+	seen := make(map[uint32]struct{})
+	for _, shard := range groups().Shards(attr) {
+		if _, ok := seen[shard.GroupId]; ok {
+			continue
+		}
+		seen[shard.GroupId] = struct{}{}
+		gids = append(gids, shard.GroupId)
+	}
+	return gids
+}
+
+// splitUidsByShard splits the uids among the groups serving their shards. It returns the groups
+// along with their uids, and the positions of these uids in the given list.
+func splitUidsByShard(shards []*pb.Tablet, uids []uint64) ([]uint32, []*pb.List, [][]int) {
+	var gids []uint32
+	var lists []*pb.List
+	var positions [][]int
+	idx := make(map[uint32]int)
+	for i, uid := range uids {
+		var gid uint32
+		if shard := x.ShardFor(shards, uid); shard != nil {
+			gid = shard.GroupId
+		}
+		j, ok := idx[gid]
+		if !ok {
+			j = len(gids)
+			idx[gid] = j
+			gids = append(gids, gid)
+			lists = append(lists, &pb.List{})
+			positions = append(positions, nil)
+		}
+		lists[j].Uids = append(lists[j].Uids, uid)
+		positions[j] = append(positions[j], i)
+	}
+	return gids, lists, positions
+}
+
+// processShardedTask processes the query on the groups serving the shards of the predicate and
+// merges their results. A query on a list of uids is split among the groups serving them. The
+// other ones are sent to all the groups, since the index, reverse and count entries of a uid are
+// kept along with its data.
+func processShardedTask(ctx context.Context, q *pb.Query,
+	shards []*pb.Tablet) (*pb.Result, error) {
+	for _, shard := range shards {
+		if q.ReadTs > 0 && q.ReadTs < shard.MoveTs {
+			return &pb.Result{}, errors.Errorf("StartTs: %d is from before MoveTs: %d for pred: %q",
+				q.ReadTs, shard.MoveTs, q.Attr)
+		}
+	}
+
+	if q.Reverse || q.UidList == nil || len(q.UidList.Uids) == 0 {
+		gids := shardGroups(q.Attr)
+		queries := make([]*pb.Query, len(gids))
+		for i := range gids {
+			qc := *q
+			queries[i] = &qc
+		}
+		results, err := processOnGroups(ctx, gids, queries)
+		if err != nil {
+			return &pb.Result{}, err
+		}
+		// The rows of a reverse edge are those of the uids, and the rows of a function are
+		// intersected if IntersectDest is set. Otherwise, the rows of a function are merged by
+		// the caller, so they can just be appended.
+		rowWise := q.SrcFunc == nil || (len(results) > 0 && results[0].IntersectDest)
+		return mergeShardResults(results, rowWise)
+	}
+
+	gids, lists, positions := splitUidsByShard(shards, q.UidList.Uids)
+	queries := make([]*pb.Query, len(gids))
+	for i := range gids {
+		qc := *q
+		qc.UidList = lists[i]
+		queries[i] = &qc
+	}
+	results, err := processOnGroups(ctx, gids, queries)
+	if err != nil {
+		return &pb.Result{}, err
+	}
+	if q.SrcFunc == nil {
+		return mergeShardResultsByUid(len(q.UidList.Uids), results, positions)
+	}
+	return mergeShardResults(results, len(results) > 0 && results[0].IntersectDest)
+}
+
+// processOnGroups processes the i-th query on the i-th group, concurrently.
+func processOnGroups(ctx context.Context, gids []uint32, queries []*pb.Query) (
+	[]*pb.Result, error) {
+	if span := otrace.FromContext(ctx); span != nil {
+		span.Annotatef(nil, "Processing task on the groups %v serving the shards", gids)
+	}
+
+	results := make([]*pb.Result, len(gids))
+	errCh := make(chan error, len(gids))
+	for i := range gids {
+		go func(i int) {
+			var err error
+			if gids[i] == 0 {
+				err = errNonExistentTablet
+			} else {
+				results[i], err = processTaskOnGroup(ctx, queries[i], gids[i])
+			}
+			errCh <- err
+		}(i)
+	}
+	var rerr error
+	for range gids {
+		if err := <-errCh; err != nil && rerr == nil {
+			rerr = err
+		}
+	}
+	return results, rerr
+}
+
+// mergeUidRows merges two rows of uids, along with their facets if any.
+func mergeUidRows(a, b *pb.List, af, bf *pb.FacetsList) (*pb.List, *pb.FacetsList) {
+	if af == nil || bf == nil {
+		return algo.MergeSorted([]*pb.List{a, b}), af
+	}
+
+	out := &pb.List{Uids: make([]uint64, 0, len(a.Uids)+len(b.Uids))}
+	fl := &pb.FacetsList{FacetsList: make([]*pb.Facets, 0, len(a.Uids)+len(b.Uids))}
+	facetsAt := func(l *pb.FacetsList, i int) *pb.Facets {
+		if i < len(l.FacetsList) {
+			return l.FacetsList[i]
+		}
+		return &pb.Facets{}
+	}
+	i, j := 0, 0
+	for i < len(a.Uids) || j < len(b.Uids) {
+		switch {
+		case j == len(b.Uids) || (i < len(a.Uids) && a.Uids[i] < b.Uids[j]):
+			out.Uids = append(out.Uids, a.Uids[i])
+			fl.FacetsList = append(fl.FacetsList, facetsAt(af, i))
+			i++
+		case i == len(a.Uids) || b.Uids[j] < a.Uids[i]:
+			out.Uids = append(out.Uids, b.Uids[j])
+			fl.FacetsList = append(fl.FacetsList, facetsAt(bf, j))
+			j++
+		default:
+			out.Uids = append(out.Uids, a.Uids[i])
+			fl.FacetsList = append(fl.FacetsList, facetsAt(af, i))
+			i++
+			j++
+		}
+	}
+	return out, fl
+}
+
+// mergeShardResults merges the results of a query sent to the groups serving the shards of a
+// predicate. If rowWise is set, the i-th rows of all the results are merged. Otherwise, the rows
+// of the results are appended.
+func mergeShardResults(results []*pb.Result, rowWise bool) (*pb.Result, error) {
+	out := &pb.Result{}
+	for _, r := range results {
+		out.IntersectDest = out.IntersectDest || r.IntersectDest
+		out.List = out.List || r.List
+	}
+	if !rowWise {
+		for _, r := range results {
+			out.UidMatrix = append(out.UidMatrix, r.UidMatrix...)
+			out.ValueMatrix = append(out.ValueMatrix, r.ValueMatrix...)
+			out.FacetMatrix = append(out.FacetMatrix, r.FacetMatrix...)
+			out.LangMatrix = append(out.LangMatrix, r.LangMatrix...)
+			out.Counts = append(out.Counts, r.Counts...)
+		}
+		return out, nil
+	}
+
+	for i, r := range results {
+		if i == 0 {
+			out.UidMatrix = append(out.UidMatrix, r.UidMatrix...)
+			out.ValueMatrix = append(out.ValueMatrix, r.ValueMatrix...)
+			out.FacetMatrix = append(out.FacetMatrix, r.FacetMatrix...)
+			out.LangMatrix = append(out.LangMatrix, r.LangMatrix...)
+			out.Counts = append(out.Counts, r.Counts...)
+			continue
+		}
+		if len(r.UidMatrix) != len(out.UidMatrix) {
+			return nil, errors.Errorf("Got %d rows from a shard, expected %d",
+				len(r.UidMatrix), len(out.UidMatrix))
+		}
+		for j, row := range r.UidMatrix {
+			var af, bf *pb.FacetsList
+			if j < len(out.FacetMatrix) && j < len(r.FacetMatrix) {
+				af, bf = out.FacetMatrix[j], r.FacetMatrix[j]
+			}
+			uids, facets := mergeUidRows(out.UidMatrix[j], row, af, bf)
+			out.UidMatrix[j] = uids
+			if af != nil && bf != nil {
+				out.FacetMatrix[j] = facets
+			}
+		}
+		// Each shard counts the entries of its own uids.
+		switch {
+		case len(out.Counts) == 0:
+			out.Counts = append(out.Counts, r.Counts...)
+		case len(out.Counts) == len(r.Counts):
+			for j, count := range r.Counts {
+				out.Counts[j] += count
+			}
+		}
+		if len(out.ValueMatrix) == 0 {
+			out.ValueMatrix = append(out.ValueMatrix, r.ValueMatrix...)
+		}
+		for j := 0; j < len(out.ValueMatrix) && j < len(r.ValueMatrix); j++ {
+			if len(out.ValueMatrix[j].GetValues()) == 0 {
+				out.ValueMatrix[j] = r.ValueMatrix[j]
+			}
+		}
+		if len(out.LangMatrix) == 0 {
+			out.LangMatrix = append(out.LangMatrix, r.LangMatrix...)
+		}
+		for j := 0; j < len(out.LangMatrix) && j < len(r.LangMatrix); j++ {
+			if len(out.LangMatrix[j].GetLang()) == 0 {
+				out.LangMatrix[j] = r.LangMatrix[j]
+			}
+		}
+	}
+	return out, nil
+}
+
+// mergeShardResultsByUid merges the results of a query on n uids split among the groups serving
+// their shards, putting the row of each uid back at its position. The uids of the i-th result are
+// at positions[i].
+func mergeShardResultsByUid(n int, results []*pb.Result, positions [][]int) (*pb.Result, error) {
+	out := &pb.Result{}
+	check := func(kind string, i, length int) (bool, error) {
+		switch length {
+		case 0:
+			return false, nil
+		case len(positions[i]):
+			return true, nil
+		default:
+			return false, errors.Errorf("Got %d %s rows from a shard for %d uids",
+				length, kind, len(positions[i]))
+		}
+	}
+
+	for i, r := range results {
+		out.IntersectDest = out.IntersectDest || r.IntersectDest
+		out.List = out.List || r.List
+
+		if ok, err := check("uid", i, len(r.UidMatrix)); err != nil {
+			return nil, err
+		} else if ok {
+			if out.UidMatrix == nil {
+				out.UidMatrix = make([]*pb.List, n)
+			}
+			for j, pos := range positions[i] {
+				out.UidMatrix[pos] = r.UidMatrix[j]
+			}
+		}
+		if ok, err := check("value", i, len(r.ValueMatrix)); err != nil {
+			return nil, err
+		} else if ok {
+			if out.ValueMatrix == nil {
+				out.ValueMatrix = make([]*pb.ValueList, n)
+			}
+			for j, pos := range positions[i] {
+				out.ValueMatrix[pos] = r.ValueMatrix[j]
+			}
+		}
+		if ok, err := check("facet", i, len(r.FacetMatrix)); err != nil {
+			return nil, err
+		} else if ok {
+			if out.FacetMatrix == nil {
+				out.FacetMatrix = make([]*pb.FacetsList, n)
+			}
+			for j, pos := range positions[i] {
+				out.FacetMatrix[pos] = r.FacetMatrix[j]
+			}
+		}
+		if ok, err := check("lang", i, len(r.LangMatrix)); err != nil {
+			return nil, err
+		} else if ok {
+			if out.LangMatrix == nil {
+				out.LangMatrix = make([]*pb.LangList, n)
+			}
+			for j, pos := range positions[i] {
+				out.LangMatrix[pos] = r.LangMatrix[j]
+			}
+		}
+		if ok, err := check("count", i, len(r.Counts)); err != nil {
+			return nil, err
+		} else if ok {
+			if out.Counts == nil {
+				out.Counts = make([]uint32, n)
+			}
+			for j, pos := range positions[i] {
+				out.Counts[pos] = r.Counts[j]
+			}
+		}
+	}
+
+	// Fill in the rows of the uids whose shard returned none of a kind.
+	for i := 0; i < n; i++ {
+		if out.UidMatrix != nil && out.UidMatrix[i] == nil {
+			out.UidMatrix[i] = &pb.List{}
+		}
+		if out.ValueMatrix != nil && out.ValueMatrix[i] == nil {
+			out.ValueMatrix[i] = &pb.ValueList{}
+		}
+		if out.FacetMatrix != nil && out.FacetMatrix[i] == nil {
+			out.FacetMatrix[i] = &pb.FacetsList{}
+		}
+		if out.LangMatrix != nil && out.LangMatrix[i] == nil {
+			out.LangMatrix[i] = &pb.LangList{}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestSplitUidsByShard(t *testing.T) {
+	shards := []*pb.Tablet{
+		{StartUid: 1, EndUid: 100, GroupId: 1},
+		{StartUid: 100, GroupId: 2},
+	}
+	gids, lists, positions := splitUidsByShard(shards, []uint64{1, 100, 50, 200})
+	require.Equal(t, []uint32{1, 2}, gids)
+	require.Equal(t, []uint64{1, 50}, lists[0].Uids)
+	require.Equal(t, []uint64{100, 200}, lists[1].Uids)
+	require.Equal(t, [][]int{{0, 2}, {1, 3}}, positions)
+}
+
+func TestMergeShardResultsRowWise(t *testing.T) {
+	results := []*pb.Result{
+		{
+			UidMatrix: []*pb.List{{Uids: []uint64{1, 5}}},
+			Counts:    []uint32{2},
+		},
+		{
+			UidMatrix: []*pb.List{{Uids: []uint64{3, 5, 7}}},
+			Counts:    []uint32{3},
+		},
+	}
+	out, err := mergeShardResults(results, true)
+	require.NoError(t, err)
+	require.Len(t, out.UidMatrix, 1)
+	require.Equal(t, []uint64{1, 3, 5, 7}, out.UidMatrix[0].Uids)
+	require.Equal(t, []uint32{5}, out.Counts)
+
+	results = append(results, &pb.Result{})
+	_, err = mergeShardResults(results, true)
+	require.Error(t, err)
+}
+
+func TestMergeShardResultsAppend(t *testing.T) {
+	results := []*pb.Result{
+		{UidMatrix: []*pb.List{{Uids: []uint64{1, 5}}}},
+		{UidMatrix: []*pb.List{{Uids: []uint64{3}}}, IntersectDest: true},
+	}
+	out, err := mergeShardResults(results, false)
+	require.NoError(t, err)
+	require.Len(t, out.UidMatrix, 2)
+	require.True(t, out.IntersectDest)
+}
+
+func TestMergeUidRowsWithFacets(t *testing.T) {
+	facets := func(key string) *pb.Facets {
+		return &pb.Facets{Facets: []*api.Facet{{Key: key}}}
+	}
+	a := &pb.List{Uids: []uint64{1, 4}}
+	b := &pb.List{Uids: []uint64{2, 4, 6}}
+	af := &pb.FacetsList{FacetsList: []*pb.Facets{facets("a1"), facets("a4")}}
+	bf := &pb.FacetsList{FacetsList: []*pb.Facets{facets("b2"), facets("b4"), facets("b6")}}
+
+	uids, fl := mergeUidRows(a, b, af, bf)
+	require.Equal(t, []uint64{1, 2, 4, 6}, uids.Uids)
+	var keys []string
+	for _, f := range fl.FacetsList {
+		keys = append(keys, f.Facets[0].Key)
+	}
+	require.Equal(t, []string{"a1", "b2", "a4", "b6"}, keys)
+
+	uids, fl = mergeUidRows(a, b, nil, nil)
+	require.Equal(t, []uint64{1, 2, 4, 6}, uids.Uids)
+	require.Nil(t, fl)
+}
+
+func TestMergeShardResultsByUid(t *testing.T) {
+	results := []*pb.Result{
+		{
+			UidMatrix: []*pb.List{{Uids: []uint64{11}}, {Uids: []uint64{13}}},
+		},
+		{
+			UidMatrix:   []*pb.List{{Uids: []uint64{12}}},
+			ValueMatrix: []*pb.ValueList{{Values: []*pb.TaskValue{{Val: []byte("v")}}}},
+		},
+	}
+	out, err := mergeShardResultsByUid(3, results, [][]int{{0, 2}, {1}})
+	require.NoError(t, err)
+	require.Len(t, out.UidMatrix, 3)
+	require.Equal(t, []uint64{11}, out.UidMatrix[0].Uids)
+	require.Equal(t, []uint64{12}, out.UidMatrix[1].Uids)
+	require.Equal(t, []uint64{13}, out.UidMatrix[2].Uids)
+	require.Len(t, out.ValueMatrix, 3)
+	require.Empty(t, out.ValueMatrix[0].Values)
+	require.Equal(t, []byte("v"), out.ValueMatrix[1].Values[0].Val)
+
+	_, err = mergeShardResultsByUid(3, results, [][]int{{0}, {1, 2}})
+	require.Error(t, err)
+}
+
+func shardUids(t *testing.T, key []byte, readTs uint64) []uint64 {
+	l, err := posting.GetNoStore(key, readTs)
+	require.NoError(t, err)
+	var uids []uint64
+	require.NoError(t, l.Iterate(readTs, 0, func(p *pb.Posting) error {
+		uids = append(uids, p.Uid)
+		return nil
+	}))
+	return uids
+}
+
+func TestDeleteUidRange(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("shard_friend: [uid] @reverse ."), 1))
+	for _, uid := range []uint64{10, 20, 30} {
+		edge := &pb.DirectedEdge{Entity: uid, Attr: "shard_friend", ValueId: 100}
+		addEdge(t, edge, getOrCreate(x.DataKey("shard_friend", uid)))
+	}
+	revKey := x.ReverseKey("shard_friend", 100)
+	require.Equal(t, []uint64{10, 20, 30}, shardUids(t, revKey, timestamp()))
+
+	// Only the entries of the range are sent for the reverse key.
+	readTs := timestamp()
+	toList := uidRangeKeyToList(20, 30, readTs, posting.Set)
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itr := txn.NewKeyIterator(revKey, badger.IteratorOptions{AllVersions: true})
+	defer itr.Close()
+	itr.Rewind()
+	list, err := toList(revKey, itr)
+	require.NoError(t, err)
+	require.Len(t, list.Kv, 1)
+	var delta pb.PostingList
+	require.NoError(t, delta.Unmarshal(list.Kv[0].Value))
+	require.Len(t, delta.Postings, 1)
+	require.Equal(t, uint64(20), delta.Postings[0].Uid)
+	require.Equal(t, uint32(posting.Set), delta.Postings[0].Op)
+
+	moveTs := timestamp()
+	tablet := &pb.Tablet{Predicate: "shard_friend", StartUid: 20, MoveTs: moveTs}
+	require.NoError(t, deleteUidRange(context.Background(), tablet))
+
+	readTs = timestamp()
+	require.Equal(t, []uint64{10}, shardUids(t, revKey, readTs))
+	require.Equal(t, []uint64{100}, shardUids(t, x.DataKey("shard_friend", 10), readTs))
+	require.Empty(t, shardUids(t, x.DataKey("shard_friend", 20), readTs))
+	require.Empty(t, shardUids(t, x.DataKey("shard_friend", 30), readTs))
+}
@@ -65,13 +65,17 @@ func SortOverNetwork(ctx context.Context, q *pb.SortMessage) (*pb.SortResult, er
 		span.Annotatef(nil, "worker.SortOverNetwork. Attr: %s. Group: %d", q.Order[0].Attr, gid)
 	}
 
+	if len(groups().Shards(q.Order[0].Attr)) > 0 {
+		return sortSharded(ctx, q)
+	}
+
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processSort(ctx, q)
 	}
 
 	result, err := processWithBackupRequest(
-		ctx, gid, func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+		ctx, gid, false, func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
 			return c.Sort(ctx, q)
 		})
 	if err != nil {
@@ -368,24 +372,8 @@ func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage) error {
 			continue
 		}
 
-		result := or.r
-		x.AssertTrue(len(result.ValueMatrix) == len(dest.Uids))
-		for i := range dest.Uids {
-			var sv types.Val
-			if len(result.ValueMatrix[i].Values) == 0 {
-				// Assign nil value which is sorted as greater than all other values.
-				sv.Value = nil
-			} else {
-				v := result.ValueMatrix[i].Values[0]
-				val := types.ValueForType(types.TypeID(v.ValType))
-				val.Value = v.Val
-				var err error
-				sv, err = types.Convert(val, val.Tid)
-				if err != nil {
-					return err
-				}
-			}
-			sortVals[i][or.idx] = sv
+		if err := fillSortVals(or, dest, sortVals); err != nil {
+			return err
 		}
 	}
 
@@ -493,6 +481,109 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 	return r.reply, err
 }
 
+// fillSortVals puts the values fetched for the sort order at or.idx in sortVals.
+func fillSortVals(or orderResult, dest *pb.List, sortVals [][]types.Val) error {
+	result := or.r
+	x.AssertTrue(len(result.ValueMatrix) == len(dest.Uids))
+	for i := range dest.Uids {
+		var sv types.Val
+		if len(result.ValueMatrix[i].Values) == 0 {
+			// Assign nil value which is sorted as greater than all other values.
+			sv.Value = nil
+		} else {
+			v := result.ValueMatrix[i].Values[0]
+			val := types.ValueForType(types.TypeID(v.ValType))
+			val.Value = v.Val
+			var err error
+			sv, err = types.Convert(val, val.Tid)
+			if err != nil {
+				return err
+			}
+		}
+		sortVals[i][or.idx] = sv
+	}
+	return nil
+}
+
+// sortSharded sorts the uid matrix when the first sort order is on a predicate sharded by uid
+// ranges. The values of such a predicate are spread among groups, so they are fetched for all
+// the sort orders and sorted here, like when sorting without an index.
+func sortSharded(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error) {
+	span := otrace.FromContext(ctx)
+	span.Annotate(nil, "sortSharded")
+
+	if ts.Count < 0 {
+		return nil, errors.Errorf(
+			"We do not yet support negative or infinite count with sorting: %s %d. "+
+				"Try flipping order and return first few elements instead.", ts.Order[0].Attr, ts.Count)
+	}
+	var lang string
+	if langCount := len(ts.Order[0].Langs); langCount == 1 {
+		lang = ts.Order[0].Langs[0]
+	} else if langCount > 1 {
+		return nil, errors.Errorf("Sorting on multiple language is not supported.")
+	}
+
+	dest := destUids(ts.UidMatrix)
+	sortVals := make([][]types.Val, len(dest.Uids))
+	for idx := range sortVals {
+		sortVals[idx] = make([]types.Val, len(ts.Order))
+	}
+	och := make(chan orderResult, len(ts.Order))
+	for i, order := range ts.Order {
+		in := &pb.Query{
+			Attr:    order.Attr,
+			UidList: dest,
+			Langs:   order.Langs,
+			ReadTs:  ts.ReadTs,
+		}
+		go fetchValues(ctx, in, i, och)
+	}
+	var oerr error
+	for range ts.Order {
+		or := <-och
+		if or.err == nil {
+			or.err = fillSortVals(or, dest, sortVals)
+		}
+		if or.err != nil && oerr == nil {
+			oerr = or.err
+		}
+	}
+	if oerr != nil {
+		return nil, oerr
+	}
+	for _, vals := range sortVals {
+		for i := range vals {
+			if vals[i].Value == nil {
+				// Uids without a value are sorted last, as when sorting without an index.
+				vals[i].Tid = types.DefaultID
+			}
+		}
+	}
+
+	desc := make([]bool, 0, len(ts.Order))
+	for _, o := range ts.Order {
+		desc = append(desc, o.Desc)
+	}
+	out := &pb.SortResult{}
+	for _, ul := range ts.UidMatrix {
+		uids := make([]uint64, len(ul.Uids))
+		copy(uids, ul.Uids)
+		vals := make([][]types.Val, len(uids))
+		for j, uid := range uids {
+			idx := algo.IndexOf(dest, uid)
+			x.AssertTrue(idx >= 0)
+			vals[j] = sortVals[idx]
+		}
+		if err := types.Sort(vals, &uids, desc, lang); err != nil {
+			return nil, err
+		}
+		start, end := x.PageRange(int(ts.Count), int(ts.Offset), len(uids))
+		out.UidMatrix = append(out.UidMatrix, &pb.List{Uids: uids[start:end]})
+	}
+	return out, nil
+}
+
 func destUids(uidMatrix []*pb.List) *pb.List {
 	included := make(map[uint64]struct{})
 	for _, ul := range uidMatrix {
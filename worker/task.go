@@ -64,11 +64,13 @@ func invokeNetworkRequest(ctx context.Context, addr string,
 const backupRequestGracePeriod = time.Second
 
 // TODO: Cross-server cancellation as described in Jeff Dean's talk.
+// If preferLearners is set, the request is sent to the learners of the group first.
 func processWithBackupRequest(
 	ctx context.Context,
 	gid uint32,
+	preferLearners bool,
 	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	addrs := groups().AnyTwoServers(gid)
+	addrs := groups().AnyTwoReadServers(gid, preferLearners)
 	if len(addrs) == 0 {
 		return nil, errors.New("No network connection")
 	}
@@ -145,12 +147,24 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 			attr, gid, q.ReadTs, groups().Node.Id)
 	}
 
+	if shards := groups().Shards(attr); len(shards) > 0 {
+		return processShardedTask(ctx, q, shards)
+	}
+	return processTaskOnGroup(ctx, q, gid)
+}
+
+// processTaskOnGroup processes the query on the given group, over the network if it isn't
+// served by this instance.
+func processTaskOnGroup(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, error) {
+	span := otrace.FromContext(ctx)
+	attr := q.Attr
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processTask(ctx, q, gid)
 	}
 
-	result, err := processWithBackupRequest(ctx, gid,
+	// Best effort queries don't need the latest data, so they're sent to the learners first.
+	result, err := processWithBackupRequest(ctx, gid, q.Cache == NoCache,
 		func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
 			return c.ServeTask(ctx, q)
 		})
@@ -220,6 +234,7 @@ const (
 	uidInFn
 	customIndexFn
 	matchFn
+	compositeFn
 	standardFn = 100
 )
 
@@ -262,6 +277,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "composite":
+		return compositeFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -311,7 +328,8 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn,
+		compositeFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -708,7 +726,7 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn,
-				compareAttrFn:
+				compareAttrFn, compositeFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
@@ -845,6 +863,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 
 	stop := x.SpanTimer(span, "processTask"+q.Attr)
 	defer stop()
+	tabletLoads.addRead(q.Attr)
 
 	span.Annotatef(nil, "Waiting for startTs: %d at node: %d, gid: %d",
 		q.ReadTs, groups().Node.Id, gid)
@@ -867,7 +886,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	// we get partitioned away from group zero as long as it's not removed.
 	// BelongsToReadOnly is called instead of BelongsTo to prevent this alpha
 	// from requesting to serve this tablet.
-	knownGid, err := groups().BelongsToReadOnly(q.Attr, q.ReadTs)
+	knownGid, err := groups().groupForRead(q.Attr, q.ReadTs)
 	switch {
 	case err != nil:
 		return &pb.Result{}, err
@@ -1831,6 +1850,18 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		if fc.isFuncAtRoot {
 			return nil, errors.Errorf("uid_in function not allowed at root")
 		}
+	case compositeFn:
+		// The first argument is the name of the composite index and the rest are the values
+		// for a prefix of its predicates.
+		if len(q.SrcFunc.Args) < 2 {
+			return nil, errors.Errorf("Function '%s' requires at least 2 arguments, but got %d",
+				q.SrcFunc.Name, len(q.SrcFunc.Args))
+		}
+		if fc.tokens, err = getCompositeTokens(q.ReadTs, attr, q.SrcFunc.Args[0],
+			q.SrcFunc.Args[1:]); err != nil {
+			return nil, err
+		}
+		fc.n = len(fc.tokens)
 	default:
 		return nil, errors.Errorf("FnType %d not handled in numFnAttrs.", fnType)
 	}
@@ -1846,7 +1877,7 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, er
 		return &pb.Result{}, ctx.Err()
 	}
 
-	gid, err := groups().BelongsToReadOnly(q.Attr, q.ReadTs)
+	gid, err := groups().groupForRead(q.Attr, q.ReadTs)
 	switch {
 	case err != nil:
 		return &pb.Result{}, err
@@ -23,6 +23,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
@@ -195,3 +197,56 @@ func getInequalityTokens(ctx context.Context, readTs uint64, attr, f, lang strin
 	}
 	return out, ineqToken, nil
 }
+
+// getCompositeTokens returns the tokens of the composite index with the given name that match
+// the values passed for a prefix of its predicates.
+func getCompositeTokens(readTs uint64, attr, name string, args []string) ([]string, error) {
+	var ci *pb.CompositeIndex
+	for _, idx := range schema.State().CompositeIndexes(attr) {
+		if schema.CompositeIndexName(idx) == name && idx.Predicates[0] == attr {
+			ci = idx
+		}
+	}
+	if ci == nil {
+		return nil, errors.Errorf("Composite index %s is not defined on attribute %s", name, attr)
+	}
+	if len(args) > len(ci.Predicates) {
+		return nil, errors.Errorf("Composite index %s has %d predicates but got %d values",
+			name, len(ci.Predicates), len(args))
+	}
+
+	vals := make([]types.Val, 0, len(args))
+	for _, arg := range args {
+		vals = append(vals, types.Val{Tid: types.StringID, Value: []byte(arg)})
+	}
+	token, err := posting.CompositeToken(ci, vals)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == len(ci.Predicates) {
+		return []string{token}, nil
+	}
+
+	// Only a prefix of the predicates has values, so all the tokens starting with the
+	// encoded values match.
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, token)
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	var out []string
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		k, err := x.Parse(itr.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		if len(out) == 0 || out[len(out)-1] != k.Term {
+			out = append(out, k.Term)
+		}
+	}
+	return out, nil
+}
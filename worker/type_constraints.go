@@ -0,0 +1,519 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dgryski/go-farm"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// ConstraintViolation describes a node that doesn't satisfy a constraint of one of its types.
+type ConstraintViolation struct {
+	Uid       string `json:"uid"`
+	Type      string `json:"type"`
+	Predicate string `json:"predicate"`
+	Message   string `json:"message"`
+}
+
+func (v *ConstraintViolation) Error() string {
+	return fmt.Sprintf("Node %s of type %s violates constraint on %s: %s",
+		v.Uid, v.Type, v.Predicate, v.Message)
+}
+
+// predInfo holds the schema of a predicate used in a constraint.
+type predInfo struct {
+	typ  types.TypeID
+	list bool
+}
+
+// constraintChecker checks the constraints of types against the values of their nodes.
+type constraintChecker struct {
+	types  map[string]*pb.TypeUpdate
+	preds  map[string]predInfo
+	readTs uint64
+	// nodeTypes holds the types with constraints of each node.
+	nodeTypes map[uint64][]string
+	// values holds the values of each constrained predicate, by node.
+	values map[string]map[uint64][]types.Val
+}
+
+// constrainedTypes returns the types that have at least one field constraint. If names is not
+// empty, only those types are returned.
+func constrainedTypes(names []string) (map[string]*pb.TypeUpdate, error) {
+	if len(names) == 0 {
+		names = schema.State().Types()
+	}
+	out := make(map[string]*pb.TypeUpdate)
+	for _, name := range names {
+		typ, ok := schema.State().GetType(name)
+		if !ok {
+			return nil, errors.Errorf("Type %s does not exist", name)
+		}
+		for _, field := range typ.Fields {
+			if field.Constraint != nil {
+				out[name] = &typ
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func newConstraintChecker(ctx context.Context, typeMap map[string]*pb.TypeUpdate,
+	readTs uint64) (*constraintChecker, error) {
+	var preds []string
+	for _, typ := range typeMap {
+		for _, field := range typ.Fields {
+			if field.Constraint != nil {
+				preds = append(preds, field.Predicate)
+			}
+		}
+	}
+	schemas, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: preds,
+		Fields: []string{"type", "list"}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve predicate information")
+	}
+
+	cc := &constraintChecker{
+		types:     typeMap,
+		preds:     make(map[string]predInfo),
+		readTs:    readTs,
+		nodeTypes: make(map[uint64][]string),
+		values:    make(map[string]map[uint64][]types.Val),
+	}
+	for _, sn := range schemas {
+		typ, _ := types.TypeForName(sn.Type)
+		cc.preds[sn.Predicate] = predInfo{typ: typ, list: sn.List}
+	}
+	return cc, nil
+}
+
+// fetch reads the values of the constrained predicates of the nodes in nodeTypes.
+func (cc *constraintChecker) fetch(ctx context.Context) error {
+	predUids := make(map[string][]uint64)
+	for uid, typeNames := range cc.nodeTypes {
+		for _, name := range typeNames {
+			for _, field := range cc.types[name].Fields {
+				if field.Constraint != nil {
+					predUids[field.Predicate] = append(predUids[field.Predicate], uid)
+				}
+			}
+		}
+	}
+
+	for pred, uids := range predUids {
+		info, ok := cc.preds[pred]
+		if !ok {
+			// The predicate has no schema yet, so there are no values to read.
+			continue
+		}
+		uids = sortedUnique(uids)
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    pred,
+			UidList: &pb.List{Uids: uids},
+			ReadTs:  cc.readTs,
+		})
+		if err != nil {
+			return err
+		}
+
+		vals := make(map[uint64][]types.Val)
+		for i, uid := range uids {
+			if info.typ == types.UidID {
+				if i < len(res.UidMatrix) {
+					for _, v := range res.UidMatrix[i].GetUids() {
+						vals[uid] = append(vals[uid], types.Val{Tid: types.UidID, Value: v})
+					}
+				}
+				continue
+			}
+			if i >= len(res.ValueMatrix) {
+				continue
+			}
+			for _, tv := range res.ValueMatrix[i].Values {
+				if len(tv.Val) == 0 {
+					continue
+				}
+				src := types.Val{Tid: types.TypeID(tv.ValType), Value: tv.Val}
+				v, err := types.Convert(src, info.typ)
+				if err != nil {
+					return err
+				}
+				vals[uid] = append(vals[uid], v)
+			}
+		}
+		cc.values[pred] = vals
+	}
+	return nil
+}
+
+// apply updates the values read by fetch with the edges of a mutation that hasn't been
+// applied yet.
+func (cc *constraintChecker) apply(edges []*pb.DirectedEdge) error {
+	for _, edge := range edges {
+		if len(edge.Lang) > 0 || len(cc.nodeTypes[edge.Entity]) == 0 {
+			continue
+		}
+		info, ok := cc.preds[edge.Attr]
+		if !ok {
+			// The predicate will be created by this mutation, using the same defaults as
+			// the mutation path: uid predicates are lists, scalar ones aren't.
+			if len(edge.Value) == 0 && edge.ValueId != 0 {
+				info = predInfo{typ: types.UidID, list: true}
+			} else {
+				info = predInfo{typ: types.TypeID(edge.ValueType)}
+			}
+			cc.preds[edge.Attr] = info
+		}
+		if _, ok := cc.values[edge.Attr]; !ok {
+			cc.values[edge.Attr] = make(map[uint64][]types.Val)
+		}
+		cur := cc.values[edge.Attr][edge.Entity]
+
+		if edge.Op == pb.DirectedEdge_DEL && isStarAll(edge.Value) {
+			cc.values[edge.Attr][edge.Entity] = nil
+			continue
+		}
+		var v types.Val
+		if info.typ == types.UidID {
+			v = types.Val{Tid: types.UidID, Value: edge.ValueId}
+		} else {
+			var err error
+			src := types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value}
+			if v, err = types.Convert(src, info.typ); err != nil {
+				return err
+			}
+		}
+
+		var rest []types.Val
+		for _, old := range cur {
+			if !sameValue(old, v) {
+				rest = append(rest, old)
+			}
+		}
+		switch {
+		case edge.Op == pb.DirectedEdge_DEL:
+			cur = rest
+		case info.list:
+			cur = append(rest, v)
+		default:
+			cur = []types.Val{v}
+		}
+		cc.values[edge.Attr][edge.Entity] = cur
+	}
+	return nil
+}
+
+// conflictKeys returns the conflict keys of the values the constraints of the nodes in nodeTypes
+// were checked against, and of their types. Two transactions checking the same node write the
+// same keys, so that one of them aborts instead of both committing values that only satisfy the
+// constraints on their own. The keys are computed like those of the data keys of single values.
+func (cc *constraintChecker) conflictKeys() []string {
+	var keys []string
+	add := func(attr string, uid uint64) {
+		fp := farm.Fingerprint64(x.DataKey(attr, uid))
+		keys = append(keys, strconv.FormatUint(fp, 36))
+	}
+	for uid, typeNames := range cc.nodeTypes {
+		add("dgraph.type", uid)
+		for _, name := range typeNames {
+			for _, field := range cc.types[name].Fields {
+				if field.Constraint != nil {
+					add(field.Predicate, uid)
+				}
+			}
+		}
+	}
+	return x.Unique(keys)
+}
+
+// check returns the constraint violations of the nodes in nodeTypes.
+func (cc *constraintChecker) check() []*ConstraintViolation {
+	var uids []uint64
+	for uid := range cc.nodeTypes {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	var out []*ConstraintViolation
+	for _, uid := range uids {
+		for _, name := range cc.nodeTypes[uid] {
+			for _, field := range cc.types[name].Fields {
+				if field.Constraint == nil {
+					continue
+				}
+				vals := cc.values[field.Predicate][uid]
+				if msg := cc.checkField(field, vals); msg != "" {
+					out = append(out, &ConstraintViolation{
+						Uid:       fmt.Sprintf("%#x", uid),
+						Type:      name,
+						Predicate: field.Predicate,
+						Message:   msg,
+					})
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (cc *constraintChecker) checkField(field *pb.SchemaUpdate, vals []types.Val) string {
+	c := field.Constraint
+	switch {
+	case c.Required && len(vals) == 0:
+		return "value is required"
+	case c.MinCount > 0 && uint64(len(vals)) < c.MinCount:
+		return fmt.Sprintf("has %d values, expected at least %d", len(vals), c.MinCount)
+	case c.MaxCount > 0 && uint64(len(vals)) > c.MaxCount:
+		return fmt.Sprintf("has %d values, expected at most %d", len(vals), c.MaxCount)
+	}
+
+	info := cc.preds[field.Predicate]
+	for _, v := range vals {
+		if c.Min != "" {
+			if min, err := constraintBound(c.Min, info.typ); err != nil ||
+				types.CompareVals("lt", v, min) {
+				return fmt.Sprintf("value %s is less than %s", valueString(v), c.Min)
+			}
+		}
+		if c.Max != "" {
+			if max, err := constraintBound(c.Max, info.typ); err != nil ||
+				types.CompareVals("gt", v, max) {
+				return fmt.Sprintf("value %s is greater than %s", valueString(v), c.Max)
+			}
+		}
+		if c.Regex != "" {
+			re, err := schema.ConstraintRegex(c.Regex)
+			if err != nil || !re.MatchString(valueString(v)) {
+				return fmt.Sprintf("value %s does not match %s", valueString(v), c.Regex)
+			}
+		}
+	}
+	return ""
+}
+
+// sameValue returns whether both values are equal, including for types that can't be
+// compared by types.Equal.
+func sameValue(a, b types.Val) bool {
+	if eq, err := types.Equal(a, b); err == nil {
+		return eq
+	}
+	return a.Tid == b.Tid && fmt.Sprintf("%v", a.Value) == fmt.Sprintf("%v", b.Value)
+}
+
+// constraintBound converts a bound of a range constraint to the type of the predicate.
+func constraintBound(bound string, typ types.TypeID) (types.Val, error) {
+	return types.Convert(types.Val{Tid: types.StringID, Value: []byte(bound)}, typ)
+}
+
+func valueString(v types.Val) string {
+	if v.Tid == types.UidID {
+		return fmt.Sprintf("%#x", v.Value)
+	}
+	s, err := types.Convert(v, types.StringID)
+	if err != nil {
+		return fmt.Sprintf("%v", v.Value)
+	}
+	return s.Value.(string)
+}
+
+func sortedUnique(uids []uint64) []uint64 {
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	out := uids[:0]
+	for i, uid := range uids {
+		if i == 0 || uid != uids[i-1] {
+			out = append(out, uid)
+		}
+	}
+	return out
+}
+
+// checkTypeConstraints verifies that the nodes modified by the mutation satisfy the constraints
+// of their types once the mutation is applied. The values are read at the start timestamp of
+// the transaction, so they include the mutations already done by it. It returns the conflict
+// keys of the checked values, to be added to the transaction.
+func checkTypeConstraints(ctx context.Context, m *pb.Mutations) ([]string, error) {
+	typeMap, err := constrainedTypes(nil)
+	if err != nil || len(typeMap) == 0 {
+		return nil, err
+	}
+
+	var uids []uint64
+	for _, edge := range m.Edges {
+		if edge.Entity != 0 {
+			uids = append(uids, edge.Entity)
+		}
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	uids = sortedUnique(uids)
+
+	// Find the types of the nodes once the mutation is applied.
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    "dgraph.type",
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  m.StartTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodeTypes := make(map[uint64]map[string]bool)
+	for i, uid := range uids {
+		nodeTypes[uid] = make(map[string]bool)
+		if i >= len(res.ValueMatrix) {
+			continue
+		}
+		for _, tv := range res.ValueMatrix[i].Values {
+			nodeTypes[uid][string(tv.Val)] = true
+		}
+	}
+	for _, edge := range m.Edges {
+		if edge.Attr != "dgraph.type" || edge.Entity == 0 {
+			continue
+		}
+		switch {
+		case edge.Op == pb.DirectedEdge_SET:
+			nodeTypes[edge.Entity][string(edge.Value)] = true
+		case isStarAll(edge.Value):
+			nodeTypes[edge.Entity] = make(map[string]bool)
+		default:
+			delete(nodeTypes[edge.Entity], string(edge.Value))
+		}
+	}
+
+	cc, err := newConstraintChecker(ctx, typeMap, m.StartTs)
+	if err != nil {
+		return nil, err
+	}
+	for uid, names := range nodeTypes {
+		for name := range names {
+			if _, ok := typeMap[name]; ok {
+				cc.nodeTypes[uid] = append(cc.nodeTypes[uid], name)
+			}
+		}
+		sort.Strings(cc.nodeTypes[uid])
+	}
+	if len(cc.nodeTypes) == 0 {
+		return nil, nil
+	}
+
+	if err := cc.fetch(ctx); err != nil {
+		return nil, err
+	}
+	if err := cc.apply(m.Edges); err != nil {
+		return nil, err
+	}
+	if violations := cc.check(); len(violations) > 0 {
+		return nil, violations[0]
+	}
+	if x.WorkerConfig.LudicrousMode {
+		// Conflicts aren't detected in ludicrous mode.
+		return nil, nil
+	}
+	return cc.conflictKeys(), nil
+}
+
+// ValidateTypeConstraints scans the nodes of the given types, or of all the types with
+// constraints if none are given, and returns the nodes that violate the constraints.
+func ValidateTypeConstraints(ctx context.Context, typeNames []string) (
+	[]*ConstraintViolation, error) {
+	typeMap, err := constrainedTypes(typeNames)
+	if err != nil || len(typeMap) == 0 {
+		return nil, err
+	}
+	ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := newConstraintChecker(ctx, typeMap, ts.ReadOnly)
+	if err != nil {
+		return nil, err
+	}
+	for name := range typeMap {
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    "dgraph.type",
+			SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{name}},
+			ReadTs:  cc.readTs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, list := range res.UidMatrix {
+			for _, uid := range list.GetUids() {
+				cc.nodeTypes[uid] = append(cc.nodeTypes[uid], name)
+			}
+		}
+	}
+	for uid := range cc.nodeTypes {
+		sort.Strings(cc.nodeTypes[uid])
+	}
+
+	if err := cc.fetch(ctx); err != nil {
+		return nil, err
+	}
+	return cc.check(), nil
+}
+
+// verifyFieldConstraints checks that the constraints of the fields of the type can be applied
+// to the types of their predicates.
+func verifyFieldConstraints(t *pb.TypeUpdate, updates []*pb.SchemaUpdate,
+	schemas []*pb.SchemaNode) error {
+	for _, field := range t.Fields {
+		c := field.Constraint
+		if c == nil {
+			continue
+		}
+		typ, list, found := fieldSchema(field.Predicate, updates, schemas)
+		if !found {
+			continue
+		}
+		for _, bound := range []string{c.Min, c.Max} {
+			if bound == "" {
+				continue
+			}
+			if typ == types.UidID || typ == types.PasswordID {
+				return errors.Errorf("@range constraint is not supported for field %s of "+
+					"type %s", field.Predicate, typ.Name())
+			}
+			if _, err := constraintBound(bound, typ); err != nil {
+				return errors.Wrapf(err, "invalid bound %q for field %s", bound, field.Predicate)
+			}
+		}
+		if c.Regex != "" && typ != types.StringID && typ != types.DefaultID {
+			return errors.Errorf("@regex constraint requires a string field. Got %s for %s",
+				typ.Name(), field.Predicate)
+		}
+		if (c.MinCount > 1 || c.MaxCount > 0) && !list {
+			return errors.Errorf("@count constraint requires a list field. Got %s",
+				field.Predicate)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func newTestChecker() *constraintChecker {
+	person := &pb.TypeUpdate{
+		TypeName: "Person",
+		Fields: []*pb.SchemaUpdate{
+			{Predicate: "name", Constraint: &pb.FieldConstraint{Required: true, Regex: "^[A-Z]"}},
+			{Predicate: "age", Constraint: &pb.FieldConstraint{Min: "0", Max: "150"}},
+			{Predicate: "friend", Constraint: &pb.FieldConstraint{MaxCount: 2}},
+		},
+	}
+	return &constraintChecker{
+		types: map[string]*pb.TypeUpdate{"Person": person},
+		preds: map[string]predInfo{
+			"name":   {typ: types.StringID},
+			"age":    {typ: types.IntID},
+			"friend": {typ: types.UidID, list: true},
+		},
+		nodeTypes: map[uint64][]string{1: {"Person"}},
+		values: map[string]map[uint64][]types.Val{
+			"name": {1: {{Tid: types.StringID, Value: "Alice"}}},
+		},
+	}
+}
+
+func TestTypeConstraints(t *testing.T) {
+	cc := newTestChecker()
+	require.Empty(t, cc.check())
+
+	require.NoError(t, cc.apply([]*pb.DirectedEdge{
+		{Entity: 1, Attr: "age", Value: []byte("200"), ValueType: pb.Posting_DEFAULT},
+		{Entity: 1, Attr: "friend", ValueId: 2},
+		{Entity: 1, Attr: "friend", ValueId: 3},
+		{Entity: 1, Attr: "friend", ValueId: 4},
+		{Entity: 1, Attr: "friend", ValueId: 3, Op: pb.DirectedEdge_DEL},
+		{Entity: 1, Attr: "name", Value: []byte("bob"), ValueType: pb.Posting_STRING},
+	}))
+	violations := cc.check()
+	require.Len(t, violations, 2)
+	require.Equal(t, "name", violations[0].Predicate)
+	require.Contains(t, violations[0].Message, "does not match")
+	require.Equal(t, "age", violations[1].Predicate)
+	require.Contains(t, violations[1].Message, "greater than 150")
+
+	require.NoError(t, cc.apply([]*pb.DirectedEdge{
+		{Entity: 1, Attr: "age", Value: []byte("20"), ValueType: pb.Posting_DEFAULT},
+		{Entity: 1, Attr: "name", Value: []byte("_STAR_ALL"), Op: pb.DirectedEdge_DEL},
+	}))
+	violations = cc.check()
+	require.Len(t, violations, 1)
+	require.Equal(t, "value is required", violations[0].Message)
+}
+
+func TestTypeConstraintConflictKeys(t *testing.T) {
+	cc := newTestChecker()
+	keys := cc.conflictKeys()
+	// The type of the node and its three constrained predicates.
+	require.Len(t, keys, 4)
+
+	// Another transaction checking the same node gets the same keys, so only one of them can
+	// commit.
+	other := newTestChecker()
+	require.ElementsMatch(t, keys, other.conflictKeys())
+
+	other.nodeTypes = map[uint64][]string{2: {"Person"}}
+	for _, key := range other.conflictKeys() {
+		require.NotContains(t, keys, key)
+	}
+}
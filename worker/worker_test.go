@@ -360,6 +360,35 @@ func TestProcessTaskIndex(t *testing.T) {
 	)
 }
 
+func TestUniqueConcurrentTxns(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	testutil.DropAll(t, dg)
+	require.NoError(t, dg.Alter(context.Background(), &api.Operation{
+		Schema: `email: string @index(hash) @unique .`}))
+
+	// Both transactions start before either commits, so neither of them sees the other's value.
+	ctx := context.Background()
+	txn1, txn2 := dg.NewTxn(), dg.NewTxn()
+	_, err = txn1.Mutate(ctx, &api.Mutation{SetNquads: []byte(`_:a <email> "a@dgraph.io" .`)})
+	require.NoError(t, err)
+	_, err = txn2.Mutate(ctx, &api.Mutation{SetNquads: []byte(`_:b <email> "a@dgraph.io" .`)})
+	require.NoError(t, err)
+	require.NoError(t, txn1.Commit(ctx))
+	require.Equal(t, dgo.ErrAborted, txn2.Commit(ctx))
+
+	// Once the value is committed, setting it again is rejected.
+	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:c <email> "a@dgraph.io" .`), CommitNow: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value for unique predicate email")
+
+	resp, err := dg.NewReadOnlyTxn().Query(ctx,
+		`{ q(func: eq(email, "a@dgraph.io")) { count(uid) } }`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"q": [{"count": 1}]}`, string(resp.Json))
+}
+
 func TestMain(m *testing.M) {
 	x.Init()
 	posting.Config.AllottedMemory = 1024.0
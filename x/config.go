@@ -84,6 +84,16 @@ type WorkerOptions struct {
 	LudicrousMode bool
 	// BadgerKeyFile is the file containing the key used for encryption. Enterprise only feature.
 	BadgerKeyFile string
+	// HistoryRetention is how long old versions of the data are kept for time-travel queries.
+	HistoryRetention time.Duration
+	// Learner makes this Alpha join its group as a non-voting learner, which receives the Raft
+	// log and serves reads, but doesn't take part in elections or in the quorum.
+	Learner bool
+	// ReplicateTo is the list of Alphas of a standby cluster the changes of the groups are
+	// asynchronously replicated to, by their leaders.
+	ReplicateTo []string
+	// ReplicationInterval is how often the changes are shipped to the standby cluster.
+	ReplicationInterval time.Duration
 }
 
 // WorkerConfig stores the global instance of the worker package's options.
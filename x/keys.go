@@ -18,7 +18,9 @@ package x
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -191,6 +193,31 @@ func CountKey(attr string, count uint32, reverse bool) []byte {
 	return buf
 }
 
+// ShardTabletKey returns the key under which Zero stores the tablet of the shard of a predicate
+// that starts at the given uid. The first shard, and the tablet of a predicate that isn't sharded,
+// are stored under the predicate itself.
+func ShardTabletKey(attr string, startUid uint64) string {
+	if startUid <= 1 {
+		return attr
+	}
+	return fmt.Sprintf("%s@%#x", attr, startUid)
+}
+
+// ShardFor returns the tablet serving the uid, out of the tablets of the shards of a predicate
+// sorted by their start uid.
+func ShardFor(shards []*pb.Tablet, uid uint64) *pb.Tablet {
+	idx := sort.Search(len(shards), func(i int) bool {
+		return shards[i].StartUid > uid
+	})
+	if idx == 0 {
+		return nil
+	}
+	if tab := shards[idx-1]; tab.EndUid == 0 || uid < tab.EndUid {
+		return tab
+	}
+	return nil
+}
+
 // ParsedKey represents a key that has been parsed into its multiple attributes.
 type ParsedKey struct {
 	ByteType    byte
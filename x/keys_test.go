@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
 )
 
 func TestDataKey(t *testing.T) {
@@ -266,3 +268,27 @@ func TestBadKeys(t *testing.T) {
 	_, err = Parse(key)
 	require.Error(t, err)
 }
+
+func TestShardTabletKey(t *testing.T) {
+	require.Equal(t, "name", ShardTabletKey("name", 0))
+	require.Equal(t, "name", ShardTabletKey("name", 1))
+	require.Equal(t, "name@0x100", ShardTabletKey("name", 0x100))
+}
+
+func TestShardFor(t *testing.T) {
+	shards := []*pb.Tablet{
+		{StartUid: 1, EndUid: 100, GroupId: 1},
+		{StartUid: 100, EndUid: 200, GroupId: 2},
+		{StartUid: 200, GroupId: 3},
+	}
+	require.Nil(t, ShardFor(shards, 0))
+	require.Equal(t, uint32(1), ShardFor(shards, 1).GroupId)
+	require.Equal(t, uint32(1), ShardFor(shards, 99).GroupId)
+	require.Equal(t, uint32(2), ShardFor(shards, 100).GroupId)
+	require.Equal(t, uint32(3), ShardFor(shards, 200).GroupId)
+	require.Equal(t, uint32(3), ShardFor(shards, math.MaxUint64).GroupId)
+
+	// A gap left by a shard being merged.
+	require.Nil(t, ShardFor(shards[:2], 200))
+	require.Nil(t, ShardFor(nil, 1))
+}
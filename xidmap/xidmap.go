@@ -43,6 +43,7 @@ type XidMap struct {
 	maxUidSeen uint64
 
 	// Optionally, these can be set to persist the mappings.
+	db     *badger.DB
 	writer *badger.WriteBatch
 }
 
@@ -85,6 +86,7 @@ func New(zero *grpc.ClientConn, db *badger.DB) *XidMap {
 	}
 	if db != nil {
 		// If DB is provided, let's load up all the xid -> uid mappings in memory.
+		xm.db = db
 		xm.writer = db.NewWriteBatch()
 
 		err := db.View(func(txn *badger.Txn) error {
@@ -179,6 +181,59 @@ func (m *XidMap) AssignUid(xid string) (uint64, bool) {
 	return newUid, true
 }
 
+// CheckUid returns the UID which the XID maps to, if it has been mapped. The mappings which
+// aren't in memory are looked up in the DB, if one was provided to XidMap.
+func (m *XidMap) CheckUid(xid string) (uint64, bool) {
+	sh := m.shardFor(xid)
+	sh.RLock()
+	uid, ok := sh.uidMap[xid]
+	sh.RUnlock()
+	if ok || m.db == nil {
+		return uid, ok
+	}
+
+	err := m.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(xid))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			uid = binary.BigEndian.Uint64(val)
+			return nil
+		})
+	})
+	switch {
+	case err == badger.ErrKeyNotFound:
+		return 0, false
+	case err != nil:
+		x.Panic(err)
+	}
+
+	sh.Lock()
+	defer sh.Unlock()
+	if cur, ok := sh.uidMap[xid]; ok {
+		return cur, true
+	}
+	sh.uidMap[xid] = uid
+	return uid, true
+}
+
+// SetUid maps the XID to a UID allocated elsewhere, like by an upsert.
+func (m *XidMap) SetUid(xid string, uid uint64) {
+	sh := m.shardFor(xid)
+	sh.Lock()
+	defer sh.Unlock()
+	sh.uidMap[xid] = uid
+
+	if m.writer != nil {
+		var uidBuf [8]byte
+		binary.BigEndian.PutUint64(uidBuf[:], uid)
+		if err := m.writer.Set([]byte(xid), uidBuf[:]); err != nil {
+			x.Panic(err)
+		}
+	}
+}
+
 func (sh *shard) Current() uint64 {
 	sh.RLock()
 	defer sh.RUnlock()
@@ -228,6 +283,19 @@ func (m *XidMap) AllocateUid() uint64 {
 	return sh.assign(m.newRanges)
 }
 
+// Sync writes the mappings assigned so far to the DB, if one was provided to XidMap. It must not be
+// called while uids are being assigned.
+func (m *XidMap) Sync() error {
+	if m.writer == nil {
+		return nil
+	}
+	if err := m.writer.Flush(); err != nil {
+		return err
+	}
+	m.writer = m.db.NewWriteBatch()
+	return nil
+}
+
 // Flush must be called if DB is provided to XidMap.
 func (m *XidMap) Flush() error {
 	// While running bulk loader, this method is called at the completion of map phase. After this
@@ -69,6 +69,44 @@ func TestXidmap(t *testing.T) {
 	})
 }
 
+func TestXidmapSetUid(t *testing.T) {
+	conn, err := x.SetupConnection(testutil.SockAddrZero, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+
+	withDB(t, func(db *badger.DB) {
+		xidmap := New(conn, db)
+
+		_, ok := xidmap.CheckUid("a")
+		require.False(t, ok)
+		xidmap.SetUid("a", 0x123)
+		uid, ok := xidmap.CheckUid("a")
+		require.True(t, ok)
+		require.Equal(t, uint64(0x123), uid)
+		uid, isNew := xidmap.AssignUid("a")
+		require.Equal(t, uint64(0x123), uid)
+		require.False(t, isNew)
+
+		require.NoError(t, xidmap.Flush())
+		xidmap = nil
+
+		xidmap2 := New(conn, db)
+		uid, ok = xidmap2.CheckUid("a")
+		require.True(t, ok)
+		require.Equal(t, uint64(0x123), uid)
+
+		// Mappings stored after the XidMap was created are looked up in the DB.
+		require.NoError(t, db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte("b"), []byte{0, 0, 0, 0, 0, 0, 0x4, 0x56})
+		}))
+		uid, ok = xidmap2.CheckUid("b")
+		require.True(t, ok)
+		require.Equal(t, uint64(0x456), uid)
+		_, ok = xidmap2.CheckUid("c")
+		require.False(t, ok)
+	})
+}
+
 func TestXidmapMemory(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping because -short=true")